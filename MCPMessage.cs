@@ -1,6 +1,7 @@
 using System;
 using System.Collections.Generic;
 using Newtonsoft.Json;
+using Newtonsoft.Json.Linq;
 
 /// <summary>
 /// MCP消息结构定义
@@ -20,12 +21,76 @@ public class MCPMessage
     [JsonProperty("timestamp")]
     public long timestamp;
     
+    /// <summary>
+    /// MCP会话ID (可选，仅用于日志关联)
+    /// </summary>
+    [JsonProperty("sessionId", NullValueHandling = NullValueHandling.Ignore)]
+    public string sessionId;
+    
+    /// <summary>
+    /// MCP客户端的progressToken (可选，仅用于日志关联)
+    /// </summary>
+    [JsonProperty("progressToken", NullValueHandling = NullValueHandling.Ignore)]
+    public string progressToken;
+    
     public MCPMessage()
     {
         parameters = new Dictionary<string, object>();
         id = Guid.NewGuid().ToString();
         timestamp = DateTimeOffset.UtcNow.ToUnixTimeMilliseconds();
     }
+    
+    /// <summary>
+    /// 把参数中嵌套的JObject/JArray转换为Dictionary/List
+    /// Newtonsoft把object类型的值反序列化为JToken，工具统一按Dictionary&lt;string, object&gt;和IEnumerable读取嵌套参数
+    /// </summary>
+    public void NormalizeParameters()
+    {
+        parameters = parameters == null ? new Dictionary<string, object>() : ToPlainDictionary(parameters);
+    }
+    
+    /// <summary>
+    /// 递归转换字典中的JToken值
+    /// </summary>
+    public static Dictionary<string, object> ToPlainDictionary(IDictionary<string, object> values)
+    {
+        var result = new Dictionary<string, object>(values.Count);
+        foreach (var pair in values)
+        {
+            result[pair.Key] = ToPlainValue(pair.Value);
+        }
+        return result;
+    }
+    
+    /// <summary>
+    /// 把JToken转换为Dictionary&lt;string, object&gt;、List&lt;object&gt;或基本类型 (long、double、string、bool、null)，其他值原样返回
+    /// </summary>
+    public static object ToPlainValue(object value)
+    {
+        switch (value)
+        {
+            case JObject obj:
+                var dict = new Dictionary<string, object>(obj.Count);
+                foreach (var property in obj.Properties())
+                {
+                    dict[property.Name] = ToPlainValue(property.Value);
+                }
+                return dict;
+            case JArray array:
+                var list = new List<object>(array.Count);
+                foreach (JToken item in array)
+                {
+                    list.Add(ToPlainValue(item));
+                }
+                return list;
+            case JValue jsonValue:
+                return jsonValue.Value;
+            case IDictionary<string, object> nested:
+                return ToPlainDictionary(nested);
+            default:
+                return value;
+        }
+    }
 }
 
 /// <summary>
@@ -49,6 +114,24 @@ public class MCPResponse
     [JsonProperty("timestamp")]
     public long timestamp;
     
+    /// <summary>
+    /// 二进制附件 (如PNG图片)，在JSON响应之后作为单独一帧原始字节发送，不写入JSON
+    /// </summary>
+    [JsonIgnore]
+    public byte[] binary;
+    
+    /// <summary>
+    /// 附件的字节数，Go服务器据此读取随后的附件帧
+    /// </summary>
+    [JsonProperty("binaryLength", NullValueHandling = NullValueHandling.Ignore)]
+    public int? binaryLength;
+    
+    /// <summary>
+    /// 附件的MIME类型
+    /// </summary>
+    [JsonProperty("binaryMimeType", NullValueHandling = NullValueHandling.Ignore)]
+    public string binaryMimeType;
+    
     public MCPResponse()
     {
         timestamp = DateTimeOffset.UtcNow.ToUnixTimeMilliseconds();
@@ -64,6 +147,21 @@ public class MCPResponse
         };
     }
     
+    /// <summary>
+    /// 带二进制附件的成功响应
+    /// </summary>
+    public static MCPResponse SuccessWithBinary(object data, byte[] binary, string mimeType)
+    {
+        return new MCPResponse
+        {
+            success = true,
+            data = data,
+            binary = binary,
+            binaryLength = binary.Length,
+            binaryMimeType = mimeType
+        };
+    }
+    
     public static MCPResponse Error(string error, string id = null)
     {
         return new MCPResponse
@@ -74,3 +172,32 @@ public class MCPResponse
         };
     }
 }
+
+/// <summary>
+/// MCP进度消息，在最终响应之前发送，Go服务器会转发为notifications/progress
+/// </summary>
+[Serializable]
+public class MCPProgress
+{
+    [JsonProperty("type")]
+    public string type = "progress";
+    
+    [JsonProperty("id")]
+    public string id;
+    
+    [JsonProperty("progress")]
+    public float progress;
+    
+    [JsonProperty("message")]
+    public string message;
+    
+    public static MCPProgress Create(string id, float progress, string message = null)
+    {
+        return new MCPProgress
+        {
+            id = id,
+            progress = progress,
+            message = message
+        };
+    }
+}
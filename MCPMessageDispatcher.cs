@@ -12,6 +12,11 @@ public class MCPMessageDispatcher
     private Dictionary<string, IMCPTool> registeredTools;
     private MCPServer server;
     
+    /// <summary>
+    /// 正在执行的请求ID，工具在执行期间发送的进度帧使用该ID
+    /// </summary>
+    private string currentMessageId;
+    
     public MCPMessageDispatcher(MCPServer server)
     {
         this.server = server;
@@ -58,6 +63,17 @@ public class MCPMessageDispatcher
         }
     }
     
+    /// <summary>
+    /// 按名称查找已注册的工具
+    /// </summary>
+    /// <param name="toolName">工具名称</param>
+    /// <param name="tool">找到的工具</param>
+    /// <returns>是否找到</returns>
+    public bool TryGetTool(string toolName, out IMCPTool tool)
+    {
+        return registeredTools.TryGetValue(toolName, out tool);
+    }
+    
     /// <summary>
     /// 注册所有工具
     /// </summary>
@@ -67,16 +83,155 @@ public class MCPMessageDispatcher
         
         // 注册脚本操作工具
         RegisterTool(new ScriptReadTool());
+        RegisterTool(new ScriptListTool());
         RegisterTool(new ScriptWriteTool());
+        RegisterTool(new ScriptCompileErrorsTool());
         
         // 注册场景操作工具
         RegisterTool(new SceneGetTool());
         RegisterTool(new SceneCreateObjectTool());
         RegisterTool(new SceneObjectAddComponentTool());
+        RegisterTool(new GameObjectComponentsTool());
+        RegisterTool(new SceneObjectSetActiveTool());
+        RegisterTool(new SceneObjectSetTagTool());
+        RegisterTool(new SceneObjectSetLayerTool());
+        RegisterTool(new GameObjectSetStaticTool());
+        RegisterTool(new GameObjectGetBoundsTool());
+        RegisterTool(new SceneObjectRenameTool());
+        RegisterTool(new SceneObjectSetParentTool());
+        RegisterTool(new SceneObjectDuplicateTool());
+        RegisterTool(new SceneValidateTool(this));
+        RegisterTool(new SceneResolvePathTool());
+        RegisterTool(new SceneGetPathTool());
+        RegisterTool(new SceneStatsTool());
+        
+        // 注册组件属性工具
+        RegisterTool(new ComponentGetTool());
+        RegisterTool(new ComponentSetPropertyTool());
         
         // 注册Transform操作工具
         RegisterTool(new SceneTransformGetTool());
         RegisterTool(new SceneTransformSetTool());
+        RegisterTool(new TransformBatchSetTool());
+        
+        // 注册UI控件工具
+        RegisterTool(new UIRectTransformTool());
+        RegisterTool(new UIRectTransformGetTool());
+        RegisterTool(new UIImageTool());
+        RegisterTool(new UITextTool());
+        RegisterTool(new UICreateElementTool());
+        RegisterTool(new UIButtonSetTool());
+        RegisterTool(new UIToggleSetTool());
+        RegisterTool(new UISliderSetTool());
+        RegisterTool(new UIDropdownSetTool());
+        RegisterTool(new UIInputFieldSetTool());
+        RegisterTool(new UILayoutSetTool());
+        RegisterTool(new TMPTextSetTool());
+        
+        // 注册资源工具
+        RegisterTool(new AssetInfoTool());
+        RegisterTool(new AssetDependencyTool());
+        RegisterTool(new AssetReferencesTool());
+        RegisterTool(new AssetFindUnusedTool(this));
+        RegisterTool(new AssetReimportTool());
+        RegisterTool(new PresetApplyTool());
+        
+        // 注册材质工具
+        RegisterTool(new MaterialCreateTool());
+        RegisterTool(new MaterialSetPropertiesTool());
+        RegisterTool(new RendererSetTool());
+        
+        // 注册精灵工具
+        RegisterTool(new TextureGetInfoTool());
+        RegisterTool(new SpriteConfigureTool());
+        RegisterTool(new SpriteAtlasCreateTool(this));
+        RegisterTool(new SpriteAtlasModifyTool(this));
+        
+        // 注册相机工具
+        RegisterTool(new CameraGetTool());
+        RegisterTool(new CameraSetTool());
+        
+        // 注册光照工具
+        RegisterTool(new LightSetTool());
+        RegisterTool(new RenderSettingsSetTool());
+        RegisterTool(new LightingBakeTool());
+        
+        // 注册物理工具
+        RegisterTool(new RigidbodySetTool());
+        RegisterTool(new ColliderSetTool());
+        
+        // 注册动画工具
+        RegisterTool(new AnimatorControllerCreateTool());
+        RegisterTool(new AnimatorSetControllerTool());
+        RegisterTool(new AnimatorSetParametersTool());
+        RegisterTool(new AnimationClipCreateTool());
+        RegisterTool(new TimelineCreateTool());
+        RegisterTool(new TimelineGetInfoTool());
+        
+        // 注册音频工具
+        RegisterTool(new AudioSourceSetTool());
+        RegisterTool(new AudioClipInfoTool());
+        
+        // 注册粒子工具
+        RegisterTool(new ParticleSystemGetTool());
+        RegisterTool(new ParticleSystemSetTool());
+        
+        // 注册地形工具
+        RegisterTool(new TerrainCreateTool());
+        RegisterTool(new TerrainModifyTool());
+        
+        // 注册导航工具
+        RegisterTool(new NavMeshBakeTool());
+        RegisterTool(new NavMeshSamplePositionTool());
+        RegisterTool(new NavMeshCalculatePathTool());
+        
+        // 注册ScriptableObject工具
+        RegisterTool(new ScriptableObjectCreateTool());
+        RegisterTool(new ScriptableObjectGetTool());
+        RegisterTool(new ScriptableObjectSetTool());
+        
+        // 注册项目设置工具
+        RegisterTool(new ProjectTagsGetTool());
+        RegisterTool(new ProjectTagsAddTool());
+        RegisterTool(new ProjectTagsRemoveTool());
+        RegisterTool(new ProjectLayersGetTool());
+        RegisterTool(new ProjectLayersSetTool());
+        RegisterTool(new ProjectSortingLayersGetTool());
+        RegisterTool(new ProjectSortingLayersAddTool());
+        RegisterTool(new ProjectSortingLayersReorderTool());
+        RegisterTool(new PlayerSettingsGetTool());
+        RegisterTool(new PlayerSettingsSetTool());
+        RegisterTool(new QualitySettingsGetTool());
+        RegisterTool(new QualitySettingsSetTool());
+        RegisterTool(new ProjectSettingsSnapshotTool());
+        
+        // 注册编辑器工具
+        RegisterTool(new EditorPlayModeTool());
+        RegisterTool(new EditorRunTestsTool());
+        RegisterTool(new EditorBusyStateTool());
+        RegisterTool(new UnityInfoTool());
+        RegisterTool(new EditorScreenshotTool());
+        RegisterTool(new EditorSelectionGetTool());
+        RegisterTool(new EditorSelectionSetTool());
+        RegisterTool(new EditorOpenAssetTool());
+        RegisterTool(new SceneViewControlTool());
+        RegisterTool(new EditorLogTool());
+        RegisterTool(new ConsoleStatsTool());
+        RegisterTool(new ConsoleClearTool());
+        
+        // 注册包管理工具
+        RegisterTool(new PackageListTool());
+        RegisterTool(new PackageAddTool());
+        RegisterTool(new PackageRemoveTool());
+        
+        // 注册构建工具
+        RegisterTool(new BuildPlayerTool(this));
+        RegisterTool(new AssetBundleSetNameTool());
+        RegisterTool(new AssetBundleListTool());
+        RegisterTool(new AssetBundleBuildTool(this));
+        
+        // 注册批处理工具
+        RegisterTool(new BatchTool(this));
         
         Debug.Log($"MCP工具注册完成，共注册 {registeredTools.Count} 个工具");
     }
@@ -109,7 +264,19 @@ public class MCPMessageDispatcher
                 return;
             }
             
-            Debug.Log($"处理MCP消息: action={message.action}, id={message.id}");
+            // 嵌套的对象和数组参数统一转换为Dictionary/List，工具无需处理JToken
+            message.NormalizeParameters();
+            
+            Debug.Log(string.IsNullOrEmpty(message.sessionId)
+                ? $"处理MCP消息: action={message.action}, id={message.id}"
+                : $"处理MCP消息: action={message.action}, id={message.id}, session={message.sessionId}");
+            
+            // 内置ping: 在主线程上应答，用于检测编辑器是否仍在响应
+            if (message.action == "ping")
+            {
+                SendResponse(MCPResponse.Success(new Dictionary<string, object> { { "pong", true } }, message.id), client);
+                return;
+            }
             
             // 查找对应的工具
             if (!registeredTools.ContainsKey(message.action))
@@ -120,6 +287,14 @@ public class MCPMessageDispatcher
             
             IMCPTool tool = registeredTools[message.action];
             
+            // 按层级路径指定的目标对象在验证参数之前解析为instanceId
+            string pathError = HierarchyPathHelper.ResolveTargetPath(message.parameters);
+            if (pathError != null)
+            {
+                SendErrorResponse(pathError, message.id, client);
+                return;
+            }
+            
             // 验证参数
             string validationError = tool.ValidateParameters(message.parameters ?? new Dictionary<string, object>());
             if (!string.IsNullOrEmpty(validationError))
@@ -129,7 +304,16 @@ public class MCPMessageDispatcher
             }
             
             // 执行工具
-            MCPResponse response = tool.Execute(message.parameters ?? new Dictionary<string, object>(), client);
+            MCPResponse response;
+            currentMessageId = message.id;
+            try
+            {
+                response = tool.Execute(message.parameters ?? new Dictionary<string, object>(), client);
+            }
+            finally
+            {
+                currentMessageId = null;
+            }
             response.id = message.id; // 确保响应ID与请求ID一致
             
             // 发送响应
@@ -158,6 +342,10 @@ public class MCPMessageDispatcher
         {
             string responseJson = JsonConvert.SerializeObject(response, Formatting.None);
             server.SendMessage(responseJson, client);
+            if (response.binary != null)
+            {
+                server.SendBinary(response.binary, client);
+            }
         }
         catch (Exception e)
         {
@@ -165,6 +353,21 @@ public class MCPMessageDispatcher
         }
     }
     
+    /// <summary>
+    /// 在最终响应之前发送进度帧，只能在工具执行期间调用
+    /// </summary>
+    /// <param name="client">目标客户端</param>
+    /// <param name="progress">进度 (0~1)</param>
+    /// <param name="message">进度说明</param>
+    public void SendProgress(TcpClient client, float progress, string message)
+    {
+        if (currentMessageId == null)
+        {
+            return;
+        }
+        server.SendMessage(JsonConvert.SerializeObject(MCPProgress.Create(currentMessageId, progress, message), Formatting.None), client);
+    }
+    
     /// <summary>
     /// 发送错误响应
     /// </summary>
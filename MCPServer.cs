@@ -213,19 +213,7 @@ public class MCPServer
         {
             if (client?.Connected == true)
             {
-                byte[] messageBytes = Encoding.UTF8.GetBytes(message);
-                byte[] headerBytes = BitConverter.GetBytes(messageBytes.Length);
-                
-                if (BitConverter.IsLittleEndian)
-                {
-                    Array.Reverse(headerBytes); // 转换为大端序
-                }
-                
-                NetworkStream stream = client.GetStream();
-                stream.Write(headerBytes, 0, 4);
-                stream.Write(messageBytes, 0, messageBytes.Length);
-                stream.Flush();
-                
+                WriteFrame(Encoding.UTF8.GetBytes(message), client);
                 Debug.Log($"消息已发送: {message}");
             }
         }
@@ -235,6 +223,39 @@ public class MCPServer
         }
     }
     
+    // 发送二进制附件帧，紧跟在声明了binaryLength的响应之后
+    public void SendBinary(byte[] payload, TcpClient client)
+    {
+        try
+        {
+            if (client?.Connected == true)
+            {
+                WriteFrame(payload, client);
+                Debug.Log($"二进制附件已发送: {payload.Length} 字节");
+            }
+        }
+        catch (Exception e)
+        {
+            Debug.LogError($"发送二进制附件失败: {e.Message}");
+        }
+    }
+    
+    // 写入一帧: 4字节长度头（大端序）加消息体
+    private void WriteFrame(byte[] payload, TcpClient client)
+    {
+        byte[] headerBytes = BitConverter.GetBytes(payload.Length);
+        
+        if (BitConverter.IsLittleEndian)
+        {
+            Array.Reverse(headerBytes); // 转换为大端序
+        }
+        
+        NetworkStream stream = client.GetStream();
+        stream.Write(headerBytes, 0, 4);
+        stream.Write(payload, 0, payload.Length);
+        stream.Flush();
+    }
+    
     // 广播消息给所有客户端
     public void BroadcastMessage(string message)
     {
@@ -316,11 +316,11 @@ public class UnityMCPWindow : EditorWindow
             EditorGUILayout.BeginHorizontal();
             if (GUILayout.Button("打开健康检查"))
             {
-                Application.OpenURL($"http://localhost:{mcpPort + 1}/health");
+                Application.OpenURL($"http://localhost:{mcpPort}/health");
             }
             if (GUILayout.Button("查看工具列表"))
             {
-                Application.OpenURL($"http://localhost:{mcpPort + 1}/tools");
+                Application.OpenURL($"http://localhost:{mcpPort}/tools");
             }
             EditorGUILayout.EndHorizontal();
 
@@ -481,8 +481,8 @@ public class UnityMCPWindow : EditorWindow
             // 健康检查URL
             EditorGUILayout.BeginVertical("box");
             EditorGUILayout.LabelField("管理接口", EditorStyles.boldLabel);
-            string healthUrl = $"http://localhost:{mcpPort + 1}/health";
-            string toolsUrl = $"http://localhost:{mcpPort + 1}/tools";
+            string healthUrl = $"http://localhost:{mcpPort}/health";
+            string toolsUrl = $"http://localhost:{mcpPort}/tools";
 
             EditorGUILayout.BeginHorizontal();
             EditorGUILayout.LabelField("健康检查:", GUILayout.Width(80));
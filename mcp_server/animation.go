@@ -0,0 +1,417 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Animator控制器的参数类型和过渡条件模式，与Unity的AnimatorControllerParameterType / AnimatorConditionMode一致
+var (
+	animatorParameterTypes = []string{"Float", "Int", "Bool", "Trigger"}
+	animatorConditionModes = []string{"If", "IfNot", "Greater", "Less", "Equals", "NotEqual"}
+)
+
+// anyStateName 过渡的from使用该名称表示Any State
+const anyStateName = "Any"
+
+// animatorConditionModesByType 每种参数类型可用的条件模式
+var animatorConditionModesByType = map[string][]string{
+	"Float":   {"Greater", "Less"},
+	"Int":     {"Greater", "Less", "Equals", "NotEqual"},
+	"Bool":    {"If", "IfNot"},
+	"Trigger": {"If"},
+}
+
+// animatorStateItems / animatorParameterItems / animatorTransitionItems 数组参数的元素结构
+var (
+	animatorStateItems = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":     map[string]interface{}{"type": "string"},
+			"clipPath": map[string]interface{}{"type": "string", "description": "AnimationClip asset path (optional)"},
+			"speed":    map[string]interface{}{"type": "number"},
+		},
+		"required": []string{"name"},
+	}
+	animatorParameterItems = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name":    map[string]interface{}{"type": "string"},
+			"type":    map[string]interface{}{"type": "string", "enum": animatorParameterTypes},
+			"default": map[string]interface{}{"description": "Default value: number for Float/Int, boolean for Bool; not used for Trigger"},
+		},
+		"required": []string{"name", "type"},
+	}
+	animatorTransitionItems = map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"from":        map[string]interface{}{"type": "string", "description": "Source state name, or Any for Any State"},
+			"to":          map[string]interface{}{"type": "string"},
+			"hasExitTime": map[string]interface{}{"type": "boolean"},
+			"exitTime":    map[string]interface{}{"type": "number"},
+			"duration":    map[string]interface{}{"type": "number", "description": "Transition duration in seconds"},
+			"conditions": map[string]interface{}{
+				"type": "array",
+				"items": map[string]interface{}{
+					"type": "object",
+					"properties": map[string]interface{}{
+						"parameter": map[string]interface{}{"type": "string"},
+						"mode":      map[string]interface{}{"type": "string", "enum": animatorConditionModes},
+						"threshold": map[string]interface{}{"type": "number"},
+					},
+					"required": []string{"parameter", "mode"},
+				},
+			},
+		},
+		"required": []string{"from", "to"},
+	}
+)
+
+// objectList 把数组参数转换为对象列表，非对象元素记录为问题
+func objectList(arguments map[string]interface{}, name string, problems *[]string) []map[string]interface{} {
+	list, _ := arguments[name].([]interface{})
+	objects := make([]map[string]interface{}, 0, len(list))
+	for i, item := range list {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			*problems = append(*problems, fmt.Sprintf("%s[%d] must be an object", name, i))
+			continue
+		}
+		objects = append(objects, object)
+	}
+	return objects
+}
+
+// checkAnimatorParameters 检查参数定义: 名称唯一、类型有效、默认值与类型一致
+// 返回参数名到类型的映射，类型已规范为Unity的枚举名
+func checkAnimatorParameters(arguments map[string]interface{}, problems *[]string) map[string]string {
+	declared := map[string]string{}
+	for i, parameter := range objectList(arguments, "parameters", problems) {
+		name, _ := parameter["name"].(string)
+		if name == "" {
+			*problems = append(*problems, fmt.Sprintf("parameters[%d] needs a name", i))
+			continue
+		}
+		if _, dup := declared[name]; dup {
+			*problems = append(*problems, fmt.Sprintf("parameter %q is declared more than once", name))
+			continue
+		}
+		typeName, _ := parameter["type"].(string)
+		canonical, found := canonicalName(animatorParameterTypes, typeName)
+		if !found {
+			*problems = append(*problems, fmt.Sprintf("parameter %q has invalid type %q, expected one of: %s", name, typeName, strings.Join(animatorParameterTypes, ", ")))
+			continue
+		}
+		parameter["type"] = canonical
+		declared[name] = canonical
+		if value, ok := parameter["default"]; ok {
+			if problem := checkAnimatorValue(name, canonical, value); problem != "" {
+				*problems = append(*problems, "default of "+problem)
+			}
+		}
+	}
+	return declared
+}
+
+// checkAnimatorValue 检查参数值是否与参数类型一致
+func checkAnimatorValue(name, typeName string, value interface{}) string {
+	switch typeName {
+	case "Float":
+		if _, ok := value.(float64); !ok {
+			return fmt.Sprintf("%s must be a number", name)
+		}
+	case "Int":
+		if n, ok := value.(float64); !ok || n != math.Trunc(n) {
+			return fmt.Sprintf("%s must be an integer", name)
+		}
+	case "Bool", "Trigger":
+		if _, ok := value.(bool); !ok {
+			return fmt.Sprintf("%s must be a boolean", name)
+		}
+	}
+	return ""
+}
+
+// normalizeAnimatorControllerCreateArgs 检查状态、参数和过渡
+// 过渡的from/to必须是声明的状态，条件引用的参数必须在parameters中声明且条件模式适用于参数类型
+func normalizeAnimatorControllerCreateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if err := normalizeSavePath(arguments, ".controller"); err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	states := objectList(arguments, "states", &problems)
+	if len(states) == 0 && len(problems) == 0 {
+		problems = append(problems, "states must contain at least one state")
+	}
+	var stateNames []string
+	for i, state := range states {
+		name, _ := state["name"].(string)
+		switch {
+		case name == "":
+			problems = append(problems, fmt.Sprintf("states[%d] needs a name", i))
+			continue
+		case strings.EqualFold(name, anyStateName):
+			problems = append(problems, fmt.Sprintf("state name %q is reserved for Any State", name))
+		case slices.Contains(stateNames, name):
+			problems = append(problems, fmt.Sprintf("state %q is declared more than once", name))
+		}
+		stateNames = append(stateNames, name)
+		if clip, ok := state["clipPath"].(string); ok && !strings.HasPrefix(clip, "Assets/") && !strings.HasPrefix(clip, "Packages/") {
+			problems = append(problems, fmt.Sprintf("state %q clipPath must be under Assets/ or Packages/, got %q", name, clip))
+		}
+	}
+	if defaultState, ok := arguments["defaultState"].(string); ok && len(stateNames) > 0 && !slices.Contains(stateNames, defaultState) {
+		problems = append(problems, fmt.Sprintf("defaultState %q is not one of the states: %s", defaultState, strings.Join(stateNames, ", ")))
+	}
+
+	declared := checkAnimatorParameters(arguments, &problems)
+
+	for i, transition := range objectList(arguments, "transitions", &problems) {
+		from, _ := transition["from"].(string)
+		to, _ := transition["to"].(string)
+		label := fmt.Sprintf("transitions[%d] (%s -> %s)", i, from, to)
+		if strings.EqualFold(from, anyStateName) {
+			transition["from"] = anyStateName
+		} else if !slices.Contains(stateNames, from) {
+			problems = append(problems, fmt.Sprintf("%s: from %q is not a declared state or Any", label, from))
+		}
+		if !slices.Contains(stateNames, to) {
+			problems = append(problems, fmt.Sprintf("%s: to %q is not a declared state", label, to))
+		}
+
+		conditions := objectList(transition, "conditions", &problems)
+		if hasExitTime, _ := transition["hasExitTime"].(bool); len(conditions) == 0 && !hasExitTime {
+			problems = append(problems, label+": needs conditions or hasExitTime=true, otherwise it fires immediately")
+		}
+		for j, condition := range conditions {
+			parameter, _ := condition["parameter"].(string)
+			typeName, ok := declared[parameter]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s conditions[%d]: parameter %q is not declared in parameters", label, j, parameter))
+				continue
+			}
+			mode, _ := condition["mode"].(string)
+			allowed := animatorConditionModesByType[typeName]
+			canonical, found := canonicalName(allowed, mode)
+			if !found {
+				problems = append(problems, fmt.Sprintf("%s conditions[%d]: mode %q is not valid for %s parameter %q, expected one of: %s",
+					label, j, mode, typeName, parameter, strings.Join(allowed, ", ")))
+				continue
+			}
+			condition["mode"] = canonical
+			if _, hasThreshold := condition["threshold"]; !hasThreshold && (typeName == "Float" || typeName == "Int") {
+				problems = append(problems, fmt.Sprintf("%s conditions[%d]: %s needs a threshold", label, j, canonical))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid animator controller: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// normalizeAnimatorSetControllerArgs controllerPath为空字符串表示移除控制器
+func normalizeAnimatorSetControllerArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	controller, _ := arguments["controllerPath"].(string)
+	if controller != "" && !strings.HasPrefix(controller, "Assets/") && !strings.HasPrefix(controller, "Packages/") {
+		return nil, fmt.Errorf("controllerPath must be an asset path under Assets/ or Packages/, or empty to remove the controller, got %q", controller)
+	}
+	return arguments, nil
+}
+
+// normalizeAnimatorSetParametersArgs 需要instanceId或controllerPath之一
+// values设置运行时的值，只能用于instanceId (并且需要播放模式，由Unity检查)
+func normalizeAnimatorSetParametersArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasID := arguments["instanceId"]
+	_, hasPath := arguments["controllerPath"]
+	if hasID == hasPath {
+		return nil, fmt.Errorf("exactly one of instanceId or controllerPath is required")
+	}
+	values, hasValues := arguments["values"].(map[string]interface{})
+	if _, hasParameters := arguments["parameters"]; !hasParameters && !hasValues {
+		return nil, fmt.Errorf("nothing to set: provide parameters and/or values")
+	}
+	if hasValues && !hasID {
+		return nil, fmt.Errorf("values set runtime parameter values on an Animator and need instanceId")
+	}
+
+	var problems []string
+	declared := checkAnimatorParameters(arguments, &problems)
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		// 未在本次调用中声明的参数由Unity按控制器中的类型检查
+		if typeName, ok := declared[name]; ok {
+			if problem := checkAnimatorValue(name, typeName, values[name]); problem != "" {
+				problems = append(problems, "value of "+problem)
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid animator parameters: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// animation_clip_create 的限制: Unity只接受不超过1MB的请求，曲线和关键帧数量有上限，序列化后的大小另外检查
+const (
+	maxClipCurves      = 200
+	maxCurveKeyframes  = 1000
+	maxClipKeyframes   = 10000
+	maxClipPayloadSize = 900 << 10
+)
+
+// animationCurveItems curves数组的元素结构
+var animationCurveItems = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"targetPath":    map[string]interface{}{"type": "string", "description": "Transform path relative to the animated object, e.g. Panel/Title; empty for the object itself"},
+		"property":      map[string]interface{}{"type": "string", "description": "Property, e.g. localPosition.x or color.a"},
+		"componentType": map[string]interface{}{"type": "string", "description": "Component that owns the property when several can, e.g. Image or Text for color"},
+		"keyframes": map[string]interface{}{
+			"type": "array",
+			"items": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"time":       map[string]interface{}{"type": "number"},
+					"value":      map[string]interface{}{"type": "number"},
+					"inTangent":  map[string]interface{}{"type": "number"},
+					"outTangent": map[string]interface{}{"type": "number"},
+				},
+				"required": []string{"time", "value"},
+			},
+		},
+	},
+	"required": []string{"property", "keyframes"},
+}
+
+// animationProperty 可以写入曲线的属性: Unity的绑定名和可用的组件类型，第一个为默认
+type animationProperty struct {
+	Binding    string
+	Components []string
+}
+
+// animationProperties 属性白名单，键为友好名 (localPosition.x) 和绑定名 (m_LocalPosition.x)
+var animationProperties = buildAnimationProperties()
+
+func buildAnimationProperties() map[string]animationProperty {
+	properties := map[string]animationProperty{}
+	add := func(name, binding string, components ...string) {
+		property := animationProperty{Binding: binding, Components: components}
+		properties[name] = property
+		properties[binding] = property
+	}
+	for _, axis := range []string{"x", "y", "z"} {
+		add("localPosition."+axis, "m_LocalPosition."+axis, "Transform", "RectTransform")
+		add("localEulerAngles."+axis, "localEulerAnglesRaw."+axis, "Transform", "RectTransform")
+		add("localScale."+axis, "m_LocalScale."+axis, "Transform", "RectTransform")
+	}
+	for _, axis := range []string{"x", "y"} {
+		add("anchoredPosition."+axis, "m_AnchoredPosition."+axis, "RectTransform")
+		add("sizeDelta."+axis, "m_SizeDelta."+axis, "RectTransform")
+	}
+	for _, channel := range []string{"r", "g", "b", "a"} {
+		add("color."+channel, "m_Color."+channel, "Image", "RawImage", "Text", "TextMeshProUGUI", "SpriteRenderer", "Light")
+	}
+	add("alpha", "m_Alpha", "CanvasGroup")
+	add("active", "m_IsActive", "GameObject")
+	add("intensity", "m_Intensity", "Light")
+	return properties
+}
+
+// animationPropertyNames 友好属性名，用于错误信息
+func animationPropertyNames() []string {
+	var names []string
+	for name, property := range animationProperties {
+		if name != property.Binding {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// normalizeAnimationClipCreateArgs 检查属性白名单、组件类型和关键帧时间顺序，并限制关键帧数量和请求大小
+// 属性名转换为Unity的绑定名 (propertyName) 和组件类型 (componentType)
+func normalizeAnimationClipCreateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if err := normalizeSavePath(arguments, ".anim"); err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	curves := objectList(arguments, "curves", &problems)
+	switch {
+	case len(curves) == 0 && len(problems) == 0:
+		problems = append(problems, "curves must contain at least one curve")
+	case len(curves) > maxClipCurves:
+		problems = append(problems, fmt.Sprintf("%d curves exceed the limit of %d", len(curves), maxClipCurves))
+	}
+
+	unknownProperty := false
+	totalKeyframes := 0
+	for i, curve := range curves {
+		label := fmt.Sprintf("curves[%d]", i)
+		if target, _ := curve["targetPath"].(string); strings.HasPrefix(target, "/") {
+			problems = append(problems, fmt.Sprintf("%s targetPath %q must be relative to the animated object, without a leading /", label, target))
+		}
+
+		name, _ := curve["property"].(string)
+		property, ok := animationProperties[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s property %q is not supported", label, name))
+			unknownProperty = true
+		} else {
+			component := property.Components[0]
+			if requested, ok := curve["componentType"].(string); ok {
+				canonical, found := canonicalName(property.Components, requested)
+				if !found {
+					problems = append(problems, fmt.Sprintf("%s componentType %q cannot animate %s, expected one of: %s", label, requested, name, strings.Join(property.Components, ", ")))
+				}
+				component = canonical
+			}
+			curve["propertyName"] = property.Binding
+			curve["componentType"] = component
+			delete(curve, "property")
+		}
+
+		keyframes := objectList(curve, "keyframes", &problems)
+		totalKeyframes += len(keyframes)
+		switch {
+		case len(keyframes) == 0:
+			problems = append(problems, label+" needs at least one keyframe")
+		case len(keyframes) > maxCurveKeyframes:
+			problems = append(problems, fmt.Sprintf("%s has %d keyframes, the limit is %d per curve", label, len(keyframes), maxCurveKeyframes))
+		}
+		previous := math.Inf(-1)
+		for j, keyframe := range keyframes {
+			t, okTime := keyframe["time"].(float64)
+			_, okValue := keyframe["value"].(float64)
+			if !okTime || !okValue {
+				problems = append(problems, fmt.Sprintf("%s keyframes[%d] needs numeric time and value", label, j))
+				continue
+			}
+			if t < 0 || t <= previous {
+				problems = append(problems, fmt.Sprintf("%s keyframes[%d] time %g must be >= 0 and after the previous keyframe (%g)", label, j, t, previous))
+			}
+			previous = t
+		}
+	}
+	if totalKeyframes > maxClipKeyframes {
+		problems = append(problems, fmt.Sprintf("%d keyframes in total exceed the limit of %d, split the clip or reduce the sampling", totalKeyframes, maxClipKeyframes))
+	}
+	if unknownProperty {
+		problems = append(problems, "supported properties: "+strings.Join(animationPropertyNames(), ", ")+" (or their m_ binding names)")
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid animation clip: %s", strings.Join(problems, "; "))
+	}
+
+	if encoded, err := json.Marshal(arguments["curves"]); err == nil && len(encoded) > maxClipPayloadSize {
+		return nil, fmt.Errorf("curves are %d bytes when serialized, exceeding the limit of %d bytes; reduce the keyframes", len(encoded), maxClipPayloadSize)
+	}
+	return arguments, nil
+}
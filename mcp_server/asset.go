@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// normalizeAssetFindArgs 在发送到Unity之前检查正则、标签、时间和大小范围
+// maxResults是pageSize的旧名称，两者同时给出时以pageSize为准
+func normalizeAssetFindArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if pattern, ok := arguments["nameRegex"].(string); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid nameRegex: %w", err)
+		}
+	}
+
+	if labels, ok := arguments["labels"].([]interface{}); ok {
+		for i, label := range labels {
+			if s, ok := label.(string); !ok || s == "" {
+				return nil, fmt.Errorf("labels[%d] must be a non-empty string", i)
+			}
+		}
+	}
+
+	var bounds [2]time.Time
+	for i, name := range []string{"modifiedAfter", "modifiedBefore"} {
+		value, ok := arguments[name].(string)
+		if !ok {
+			continue
+		}
+		t, err := parseTimestamp(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", name, err)
+		}
+		bounds[i] = t
+		arguments[name] = t.UTC().Format(time.RFC3339)
+	}
+	if !bounds[0].IsZero() && !bounds[1].IsZero() && !bounds[0].Before(bounds[1]) {
+		return nil, fmt.Errorf("modifiedAfter must be earlier than modifiedBefore")
+	}
+
+	sizeMin, hasMin := arguments["sizeMin"].(float64)
+	sizeMax, hasMax := arguments["sizeMax"].(float64)
+	if hasMin && hasMax && sizeMin > sizeMax {
+		return nil, fmt.Errorf("sizeMin (%v) must not be greater than sizeMax (%v)", sizeMin, sizeMax)
+	}
+
+	if maxResults, ok := arguments["maxResults"]; ok {
+		delete(arguments, "maxResults")
+		if _, ok := arguments["pageSize"]; !ok {
+			arguments["pageSize"] = maxResults
+		}
+	}
+	return arguments, nil
+}
+
+// normalizeAssetReferencesArgs 检查assetTypes中的类型名称
+func normalizeAssetReferencesArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if types, ok := arguments["assetTypes"].([]interface{}); ok {
+		for i, t := range types {
+			if s, ok := t.(string); !ok || s == "" {
+				return nil, fmt.Errorf("assetTypes[%d] must be a non-empty string", i)
+			}
+		}
+	}
+	return arguments, nil
+}
+
+// assetFindUnusedTimeout asset_find_unused 遍历整个依赖图，大项目需要几分钟
+const assetFindUnusedTimeout = 10 * time.Minute
+
+// normalizeAssetFindUnusedArgs 检查rootPath位于Assets下，assetTypes和excludePatterns为非空字符串
+func normalizeAssetFindUnusedArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	if rootPath, ok := arguments["rootPath"].(string); ok {
+		cleaned := path.Clean(strings.ReplaceAll(rootPath, "\\", "/"))
+		if cleaned != "Assets" && !strings.HasPrefix(cleaned, "Assets/") {
+			problems = append(problems, fmt.Sprintf("rootPath must be Assets or a folder under Assets/, got %q", rootPath))
+		}
+		arguments["rootPath"] = cleaned
+	}
+	for _, key := range []string{"assetTypes", "excludePatterns"} {
+		list, _ := arguments[key].([]interface{})
+		for i, item := range list {
+			if s, ok := item.(string); !ok || s == "" {
+				problems = append(problems, fmt.Sprintf("%s[%d] must be a non-empty string", key, i))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid asset_find_unused arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// importerSettingSpecs asset_reimport 的importerSettings按导入器类型允许的键和值
+// 键名与Unity导入器的属性对应，值按ParamSpec校验，拼写错误直接返回可用键的列表
+var importerSettingSpecs = map[string][]ParamSpec{
+	"texture": {
+		{Name: "textureType", Type: "string", Enum: []string{"Default", "NormalMap", "GUI", "Sprite", "Cursor", "Cookie", "Lightmap", "SingleChannel"}},
+		{Name: "maxSize", Type: "integer", Minimum: floatPtr(32), Maximum: floatPtr(16384)},
+		{Name: "compression", Type: "string", Enum: []string{"Uncompressed", "Compressed", "CompressedHQ", "CompressedLQ"}},
+		{Name: "sRGB", Type: "boolean"},
+		{Name: "mipmapEnabled", Type: "boolean"},
+		{Name: "filterMode", Type: "string", Enum: []string{"Point", "Bilinear", "Trilinear"}},
+		{Name: "wrapMode", Type: "string", Enum: []string{"Repeat", "Clamp", "Mirror", "MirrorOnce"}},
+		{Name: "isReadable", Type: "boolean"},
+		{Name: "alphaIsTransparency", Type: "boolean"},
+	},
+	"model": {
+		{Name: "scaleFactor", Type: "number", Minimum: floatPtr(0.0001)},
+		{Name: "importMaterials", Type: "boolean"},
+		{Name: "importAnimation", Type: "boolean"},
+		{Name: "isReadable", Type: "boolean"},
+		{Name: "meshCompression", Type: "string", Enum: []string{"Off", "Low", "Medium", "High"}},
+		{Name: "generateSecondaryUV", Type: "boolean"},
+	},
+	"audio": {
+		{Name: "loadType", Type: "string", Enum: []string{"DecompressOnLoad", "CompressedInMemory", "Streaming"}},
+		{Name: "compressionFormat", Type: "string", Enum: []string{"PCM", "Vorbis", "ADPCM"}},
+		{Name: "quality", Type: "number", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+		{Name: "forceToMono", Type: "boolean"},
+		{Name: "loadInBackground", Type: "boolean"},
+	},
+}
+
+// importerTypes asset_reimport 的importerType可选值
+var importerTypes = []string{"texture", "model", "audio"}
+
+// importerTypeByExtension 按扩展名推断导入器类型
+var importerTypeByExtension = map[string]string{
+	".png": "texture", ".jpg": "texture", ".jpeg": "texture", ".tga": "texture", ".psd": "texture",
+	".tif": "texture", ".tiff": "texture", ".bmp": "texture", ".gif": "texture", ".exr": "texture", ".hdr": "texture",
+	".fbx": "model", ".obj": "model", ".blend": "model", ".dae": "model", ".3ds": "model", ".max": "model",
+	".wav": "audio", ".mp3": "audio", ".ogg": "audio", ".aif": "audio", ".aiff": "audio", ".flac": "audio",
+}
+
+// normalizeAssetReimportArgs 校验importerSettings: 导入器类型由importerType指定或按扩展名推断，
+// 每个键必须是该类型支持的设置，值转换为声明的类型
+func normalizeAssetReimportArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	settings, ok := arguments["importerSettings"].(map[string]interface{})
+	if !ok {
+		if _, ok := arguments["importerType"]; ok {
+			return nil, fmt.Errorf("importerType requires importerSettings")
+		}
+		return arguments, nil
+	}
+	assetPath, _ := arguments["assetPath"].(string)
+	importerType, _ := arguments["importerType"].(string)
+	if importerType == "" {
+		importerType = importerTypeByExtension[strings.ToLower(path.Ext(assetPath))]
+		if importerType == "" {
+			return nil, fmt.Errorf("cannot infer the importer type of %q; set importerType to one of %s", assetPath, strings.Join(importerTypes, ", "))
+		}
+		arguments["importerType"] = importerType
+	}
+
+	specs := importerSettingSpecs[importerType]
+	normalized := make(map[string]interface{}, len(settings))
+	for key, value := range settings {
+		i := slices.IndexFunc(specs, func(p ParamSpec) bool { return p.Name == key })
+		if i < 0 {
+			names := make([]string, len(specs))
+			for j, p := range specs {
+				names[j] = p.Name
+			}
+			sort.Strings(names)
+			return nil, fmt.Errorf("unknown %s importer setting %q; valid settings: %s", importerType, key, strings.Join(names, ", "))
+		}
+		coerced, err := specs[i].coerce(value)
+		if err != nil {
+			return nil, fmt.Errorf("importerSettings.%s: %w", key, err)
+		}
+		if size, ok := coerced.(int64); ok && key == "maxSize" && size&(size-1) != 0 {
+			return nil, fmt.Errorf("importerSettings.maxSize must be a power of two between 32 and 16384, got %d", size)
+		}
+		normalized[key] = coerced
+	}
+	arguments["importerSettings"] = normalized
+	return arguments, nil
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// assetBundleBuildTimeout assetbundle_build 等待Unity响应的最长时间
+const assetBundleBuildTimeout = time.Hour
+
+// assetBundleCompressions assetbundle_build 的压缩方式: LZ4 (ChunkBasedCompression)、LZMA (默认) 或不压缩
+var assetBundleCompressions = []string{"LZ4", "LZMA", "Uncompressed"}
+
+// assetBundleNamePattern Unity保存的AssetBundle名称为小写，可以用/分组
+var assetBundleNamePattern = regexp.MustCompile(`^[a-z0-9_\-]+(/[a-z0-9_\-]+)*(\.[a-z0-9_\-]+)*$`)
+
+// handleAssetBundleBuild 与build_player共用构建锁，拒绝并发构建，然后转发给Unity并转发进度
+func handleAssetBundleBuild(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "assetbundle_build"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	if !buildRunning.CompareAndSwap(false, true) {
+		return toolErrorResult(ctx, errCodeInvalidArguments, "a player or AssetBundle build is already running, wait for it to finish", toolName), nil
+	}
+	defer buildRunning.Store(false)
+
+	log := callInfoFromContext(ctx).Logger()
+	log.Info("AssetBundle build started", "target", arguments["target"], "output_path", arguments["outputPath"])
+	start := time.Now()
+	result, err := forwardToUnity(ctx, toolName, arguments, request)
+	log.Info("AssetBundle build finished", "target", arguments["target"], "duration_ms", time.Since(start).Milliseconds())
+	return result, err
+}
+
+// normalizeAssetBundleSetNameArgs 把名称和变体规范为Unity保存的小写形式；空的bundleName清除分配
+func normalizeAssetBundleSetNameArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	name, _ := arguments["bundleName"].(string)
+	name = strings.ToLower(strings.TrimSpace(name))
+	arguments["bundleName"] = name
+	if name != "" && !assetBundleNamePattern.MatchString(name) {
+		problems = append(problems, fmt.Sprintf("bundleName %q may only contain letters, digits, _, - and / separated groups", name))
+	}
+	if variant, ok := arguments["variant"].(string); ok {
+		variant = strings.ToLower(strings.TrimSpace(variant))
+		arguments["variant"] = variant
+		switch {
+		case variant != "" && name == "":
+			problems = append(problems, "variant requires a bundleName")
+		case variant != "" && !assetBundleNamePattern.MatchString(variant):
+			problems = append(problems, fmt.Sprintf("variant %q may only contain letters, digits, _ and -", variant))
+		}
+	}
+	if assetPath, _ := arguments["assetPath"].(string); !strings.HasPrefix(assetPath, "Assets/") {
+		problems = append(problems, fmt.Sprintf("assetPath %q must be under Assets/", assetPath))
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid AssetBundle arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// normalizeAssetBundleBuildArgs 构建需要显式确认；输出目录不能位于Assets/中
+func normalizeAssetBundleBuildArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if confirm, _ := arguments["confirm"].(bool); !confirm {
+		return nil, fmt.Errorf("assetbundle_build requires confirm=true")
+	}
+	outputPath, _ := arguments["outputPath"].(string)
+	cleaned := path.Clean(strings.ReplaceAll(outputPath, "\\", "/"))
+	if cleaned == "Assets" || strings.HasPrefix(cleaned, "Assets/") {
+		return nil, fmt.Errorf("invalid AssetBundle arguments: outputPath must not be inside Assets/, the bundles would be imported as assets")
+	}
+	if bundles, ok := arguments["bundles"].([]interface{}); ok {
+		if len(bundles) == 0 {
+			return nil, fmt.Errorf("invalid AssetBundle arguments: bundles must not be empty; omit it to build every bundle")
+		}
+		for i, item := range bundles {
+			name, _ := item.(string)
+			bundles[i] = strings.ToLower(name)
+		}
+	}
+	return arguments, nil
+}
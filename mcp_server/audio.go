@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// audioRolloffModes AudioSource的距离衰减模式，与Unity的AudioRolloffMode一致
+var audioRolloffModes = []string{"Logarithmic", "Linear", "Custom"}
+
+// normalizeAudioSourceSetArgs 检查资源路径和衰减距离，并把mixerGroupPath拆分为混音器资源路径和分组路径
+// mixerGroupPath 形如 Assets/Audio/Main.mixer/Master/SFX，只给出混音器资源时使用Master分组；空字符串表示清除
+func normalizeAudioSourceSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	if clip, ok := arguments["clipPath"].(string); ok && clip != "" && !strings.HasPrefix(clip, "Assets/") && !strings.HasPrefix(clip, "Packages/") {
+		problems = append(problems, fmt.Sprintf("clipPath must be an asset path under Assets/ or Packages/, or empty to remove the clip, got %q", clip))
+	}
+	if group, ok := arguments["mixerGroupPath"].(string); ok && group != "" {
+		i := strings.Index(strings.ToLower(group), ".mixer")
+		if i < 0 || !strings.HasPrefix(group, "Assets/") {
+			problems = append(problems, fmt.Sprintf("mixerGroupPath must look like Assets/Audio/Main.mixer/Master/SFX, got %q", group))
+		} else {
+			arguments["mixerPath"] = group[:i+len(".mixer")]
+			arguments["mixerGroup"] = strings.Trim(group[i+len(".mixer"):], "/")
+			if arguments["mixerGroup"] == "" {
+				arguments["mixerGroup"] = "Master"
+			}
+		}
+	}
+	minDistance, hasMin := arguments["minDistance"].(float64)
+	maxDistance, hasMax := arguments["maxDistance"].(float64)
+	if hasMin && hasMax && maxDistance <= minDistance {
+		problems = append(problems, fmt.Sprintf("maxDistance (%g) must be greater than minDistance (%g)", maxDistance, minDistance))
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid audio source arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// auditRecord 审计日志中的一行
+type auditRecord struct {
+	Time         string      `json:"time"`
+	Tool         string      `json:"tool"`
+	RequestID    string      `json:"requestId,omitempty"`
+	MCPRequestID string      `json:"mcpRequestId,omitempty"`
+	SessionID    string      `json:"sessionId,omitempty"`
+	ReadOnly     bool        `json:"readOnly"`
+	Arguments    interface{} `json:"arguments"`
+	Success      bool        `json:"success"`
+	Error        string      `json:"error,omitempty"`
+	Canceled     bool        `json:"canceled,omitempty"`
+	DryRun       bool        `json:"dryRun,omitempty"`
+	DurationMs   int64       `json:"durationMs"`
+}
+
+// auditLog 以JSONL格式追加写入工具调用记录，每条记录立即写入文件
+// 未配置文件时所有方法都是空操作，重新加载配置时通过Configure切换文件
+type auditLog struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+	all  bool // 同时记录只读工具
+}
+
+var audit = &auditLog{}
+
+// openAuditFile 打开审计日志文件，必要时创建父目录
+func openAuditFile(path string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory for %s: %w", path, err)
+	}
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+	return file, nil
+}
+
+// Configure 设置审计日志文件，path为空时关闭审计
+// 路径不变时保留已打开的文件；新文件打开失败时保持原配置不变
+func (a *auditLog) Configure(path string, all bool) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if path != a.path {
+		var file *os.File
+		if path != "" {
+			var err error
+			if file, err = openAuditFile(path); err != nil {
+				return err
+			}
+		}
+		if a.file != nil {
+			a.file.Close()
+		}
+		a.path, a.file = path, file
+	}
+	a.all = all
+	return nil
+}
+
+// Enabled 判断工具调用是否需要审计: 修改项目的工具总是记录，只读工具仅在-audit-all时记录
+func (a *auditLog) Enabled(readOnly bool) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.file != nil && (!readOnly || a.all)
+}
+
+// Record 写入一条审计记录，写入失败只记录错误日志，不影响工具调用
+func (a *auditLog) Record(record auditRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		errorLog("Failed to encode audit record for %s: %v", record.Tool, err)
+		return
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.file == nil {
+		return
+	}
+	if _, err := a.file.Write(line); err != nil {
+		errorLog("Failed to write audit record for %s: %v", record.Tool, err)
+	}
+}
+
+// Close 关闭审计日志文件
+func (a *auditLog) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.file == nil {
+		return nil
+	}
+	err := a.file.Close()
+	a.path, a.file = "", nil
+	return err
+}
+
+// auditArguments 返回写入审计日志的参数
+// 不超过payloadLimit时保留JSON结构，否则截断为字符串
+func auditArguments(arguments map[string]interface{}) interface{} {
+	raw, err := json.Marshal(arguments)
+	if err != nil {
+		return fmt.Sprintf("%v", arguments)
+	}
+	limit := payloadLimit()
+	if limit <= 0 || len(raw) <= limit {
+		return json.RawMessage(raw)
+	}
+	return truncateBytes(raw, limit)
+}
+
+// newAuditRecord 根据工具调用结果创建审计记录
+func newAuditRecord(def *ToolDefinition, info callInfo, arguments map[string]interface{}, start time.Time, errMsg string, canceled bool) auditRecord {
+	return auditRecord{
+		Time:         start.UTC().Format(time.RFC3339Nano),
+		Tool:         def.Name,
+		RequestID:    info.ID,
+		MCPRequestID: info.MCPRequestID,
+		SessionID:    info.SessionID,
+		ReadOnly:     def.ReadOnly,
+		Arguments:    auditArguments(arguments),
+		Success:      errMsg == "" && !canceled,
+		Error:        errMsg,
+		Canceled:     canceled,
+		DryRun:       info.DryRun,
+		DurationMs:   time.Since(start).Milliseconds(),
+	}
+}
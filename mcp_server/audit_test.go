@@ -0,0 +1,126 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// toolRequest 构造一个工具调用请求
+func toolRequest(name string, arguments map[string]interface{}) mcp.CallToolRequest {
+	var request mcp.CallToolRequest
+	request.Params.Name = name
+	request.Params.Arguments = arguments
+	return request
+}
+
+// lookupDefinition 返回工具定义，不存在时测试失败
+func lookupDefinition(t *testing.T, name string) *ToolDefinition {
+	t.Helper()
+	for i := range toolDefinitions {
+		if toolDefinitions[i].Name == name {
+			return &toolDefinitions[i]
+		}
+	}
+	t.Fatalf("tool %s is not defined", name)
+	return nil
+}
+
+func TestAuditConcurrentWrites(t *testing.T) {
+	// 试运行使调用不需要Unity，审计记录照常写入
+	useState(t, func(c *ServerConfig) { c.DryRun = true })
+	path := filepath.Join(t.TempDir(), "audit", "audit.jsonl")
+	if err := audit.Configure(path, false); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { audit.Configure("", false) })
+
+	handler := lookupDefinition(t, "scene_create_object").handler()
+	const workers, callsPerWorker = 16, 25
+	// 较长的参数使每行超过一次小写入的大小，交错写入会产生损坏的行
+	longName := strings.Repeat("对象", 2000)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < callsPerWorker; i++ {
+				name := fmt.Sprintf("%d-%d-%s", w, i, longName)
+				result, err := handler(context.Background(), toolRequest("scene_create_object", map[string]interface{}{"name": name}))
+				if err != nil || result.IsError {
+					t.Errorf("call %d-%d failed: %v %s", w, i, err, resultText(result))
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 1<<20), 1<<20)
+	lines := 0
+	for scanner.Scan() {
+		lines++
+		var record auditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("line %d is not valid JSON: %v", lines, err)
+		}
+		if record.Tool != "scene_create_object" || !record.Success || !record.DryRun {
+			t.Errorf("line %d: unexpected record %+v", lines, record)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if lines != workers*callsPerWorker {
+		t.Errorf("audit log has %d lines, want %d", lines, workers*callsPerWorker)
+	}
+}
+
+func TestAuditSkipsReadOnlyToolsUnlessAll(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	if err := audit.Configure(path, false); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { audit.Configure("", false) })
+	if audit.Enabled(true) || !audit.Enabled(false) {
+		t.Error("without -audit-all only mutating tools should be audited")
+	}
+	audit.Configure(path, true)
+	if !audit.Enabled(true) {
+		t.Error("-audit-all should audit read-only tools")
+	}
+}
+
+func TestAuditArgumentsTruncation(t *testing.T) {
+	saved := payloadLimit()
+	t.Cleanup(func() { setPayloadLimit(saved) })
+	setPayloadLimit(64)
+
+	small := map[string]interface{}{"name": "Cube"}
+	if _, ok := auditArguments(small).(json.RawMessage); !ok {
+		t.Errorf("arguments under the limit should keep their JSON structure, got %T", auditArguments(small))
+	}
+
+	large := map[string]interface{}{"name": strings.Repeat("界", 100)}
+	truncated, ok := auditArguments(large).(string)
+	if !ok {
+		t.Fatalf("arguments over the limit should be truncated to a string, got %T", auditArguments(large))
+	}
+	if !utf8.ValidString(truncated) || !strings.Contains(truncated, "truncated") {
+		t.Errorf("truncation must keep valid UTF-8 and note the total size, got %q", truncated)
+	}
+}
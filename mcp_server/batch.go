@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// unity_batch 把多个工具调用合并为一条Unity消息，Unity按顺序在主线程上执行:
+//   {"action": "unity_batch", "params": {"steps": [{"action": "...", "params": {...}}], "atomic": true}}
+// Unity返回每一步的结果，以及失败的步骤和被跳过的步骤数
+
+// 批处理的限制，避免单条消息长时间阻塞Unity主线程
+const (
+	batchToolName       = "unity_batch"
+	maxBatchSteps       = 100
+	maxBatchPayloadSize = 1 << 20 // 所有步骤参数序列化后的总字节数
+)
+
+// batchStep 批处理中的一步
+type batchStep struct {
+	Action string                 `json:"action"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+// handleBatch 校验批处理步骤后转发到Unity
+// 每一步都必须是当前策略允许的工具，被拒绝的工具不能通过批处理绕过；
+// 步骤直接发送到Unity，因此有自定义Handler的工具不能放在批处理中，否则会跳过其Go端逻辑
+func handleBatch(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+	steps, err := parseBatchSteps(arguments["steps"])
+	if err != nil {
+		return toolErrorResult(ctx, errCodeInvalidArguments, err.Error(), batchToolName), nil
+	}
+	tools := stateFromContext(ctx).Tools
+	for i, step := range steps {
+		if reason, denied := tools.Denied(step.Action); denied {
+			return toolErrorResult(ctx, errCodeToolDenied,
+				fmt.Sprintf("Step %d: tool %s is disabled by server policy: %s", i, step.Action, reason),
+				batchToolName), nil
+		}
+		def := tools.Lookup(step.Action)
+		if step.Action == batchToolName || def == nil {
+			return toolErrorResult(ctx, errCodeInvalidArguments,
+				fmt.Sprintf("Step %d: unknown or unsupported action %q", i, step.Action),
+				batchToolName), nil
+		}
+		// 有Go端处理函数的工具 (构建锁、编译等待、烘焙轮询、预检查等) 不能作为单纯的Unity消息执行
+		if def.Handler != nil {
+			return toolErrorResult(ctx, errCodeInvalidArguments,
+				fmt.Sprintf("Step %d: %s cannot run in a batch because the server handles it specially; call it directly", i, step.Action),
+				batchToolName), nil
+		}
+		params, err := def.validateArguments(step.Params)
+		if err != nil {
+			return toolErrorResult(ctx, errCodeInvalidArguments,
+				fmt.Sprintf("Step %d (%s): %v", i, step.Action, err), batchToolName), nil
+		}
+		steps[i].Params = params
+	}
+
+	forwarded := map[string]interface{}{
+		"steps":       steps,
+		"atomic":      request.GetBool("atomic", false),
+		"stopOnError": request.GetBool("stopOnError", true),
+	}
+	debugLog("Forwarding batch of %d steps (atomic=%t)", len(steps), forwarded["atomic"])
+	return forwardToUnity(ctx, batchToolName, forwarded, request)
+}
+
+// parseBatchSteps 解析并校验steps参数，检查步骤数和总负载大小
+func parseBatchSteps(raw interface{}) ([]batchStep, error) {
+	if raw == nil {
+		return nil, fmt.Errorf("steps is required")
+	}
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("steps is not valid JSON: %w", err)
+	}
+	if len(encoded) > maxBatchPayloadSize {
+		return nil, fmt.Errorf("batch payload is %d bytes, exceeds the limit of %d bytes", len(encoded), maxBatchPayloadSize)
+	}
+
+	var steps []batchStep
+	if err := json.Unmarshal(encoded, &steps); err != nil {
+		return nil, fmt.Errorf("steps must be an array of {action, params} objects: %w", err)
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("steps must not be empty")
+	}
+	if len(steps) > maxBatchSteps {
+		return nil, fmt.Errorf("batch has %d steps, exceeds the limit of %d", len(steps), maxBatchSteps)
+	}
+	for i := range steps {
+		if steps[i].Action == "" {
+			return nil, fmt.Errorf("step %d is missing action", i)
+		}
+		if steps[i].Params == nil {
+			steps[i].Params = map[string]interface{}{}
+		}
+	}
+	return steps, nil
+}
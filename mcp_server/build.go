@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// build_player 在Unity主线程上同步构建，构建期间Unity通过构建回调发送进度帧，
+// 每一帧都会重置读取超时 (见unity_client.go)，两帧之间的长时间步骤 (如IL2CPP编译) 由TimeoutHint兜底
+
+// buildPlayerTimeout build_player 等待Unity响应的最长时间
+const buildPlayerTimeout = 2 * time.Hour
+
+// buildRunning 同一时间只允许一个构建 (build_player或assetbundle_build)，第二个构建会在Unity连接上排队并在第一个结束后立即开始
+var buildRunning atomic.Bool
+
+// buildTargets build_player 支持的构建目标，与Unity的BuildTarget枚举名一致
+var buildTargets = []string{"StandaloneWindows64", "StandaloneWindows", "StandaloneOSX", "StandaloneLinux64",
+	"Android", "iOS", "WebGL", "tvOS", "PS4", "PS5", "XboxOne", "Switch"}
+
+// buildOptions 可以通过options打开的BuildOptions标志；Development由development参数控制
+var buildOptions = []string{"AutoRunPlayer", "ShowBuiltPlayer", "AllowDebugging", "ConnectWithProfiler",
+	"EnableDeepProfilingSupport", "BuildScriptsOnly", "CleanBuildCache", "StrictMode", "DetailedBuildReport",
+	"CompressWithLz4", "CompressWithLz4HC", "SymlinkSources"}
+
+// buildOutputExtensions 构建目标要求的输出文件扩展名，未列出的目标输出到目录
+var buildOutputExtensions = map[string][]string{
+	"StandaloneWindows64": {".exe"},
+	"StandaloneWindows":   {".exe"},
+	"StandaloneOSX":       {".app"},
+	"Android":             {".apk", ".aab"},
+}
+
+// handleBuildPlayer 拒绝并发构建，然后转发给Unity并转发进度
+func handleBuildPlayer(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "build_player"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	if !buildRunning.CompareAndSwap(false, true) {
+		return toolErrorResult(ctx, errCodeInvalidArguments, "a player or AssetBundle build is already running, wait for it to finish", toolName), nil
+	}
+	defer buildRunning.Store(false)
+
+	log := callInfoFromContext(ctx).Logger()
+	log.Info("Player build started", "target", arguments["target"], "output_path", arguments["outputPath"])
+	start := time.Now()
+	result, err := forwardToUnity(ctx, toolName, arguments, request)
+	log.Info("Player build finished", "target", arguments["target"], "duration_ms", time.Since(start).Milliseconds())
+	return result, err
+}
+
+// normalizeBuildPlayerArgs 构建需要显式确认；检查输出路径扩展名和场景路径，把options规范为BuildOptions名称
+func normalizeBuildPlayerArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if confirm, _ := arguments["confirm"].(bool); !confirm {
+		return nil, fmt.Errorf("build_player requires confirm=true")
+	}
+
+	var problems []string
+	target, _ := arguments["target"].(string)
+	outputPath, _ := arguments["outputPath"].(string)
+	cleaned := path.Clean(strings.ReplaceAll(outputPath, "\\", "/"))
+	if cleaned == "Assets" || strings.HasPrefix(cleaned, "Assets/") {
+		problems = append(problems, "outputPath must not be inside Assets/, the build would be imported as assets")
+	}
+	if extensions, ok := buildOutputExtensions[target]; ok {
+		if !slices.Contains(extensions, strings.ToLower(path.Ext(cleaned))) {
+			problems = append(problems, fmt.Sprintf("outputPath for %s must end with %s", target, strings.Join(extensions, " or ")))
+		}
+	}
+
+	if scenes, ok := arguments["scenes"].([]interface{}); ok {
+		if len(scenes) == 0 {
+			problems = append(problems, "scenes must not be empty; omit it to build the scenes enabled in Build Settings")
+		}
+		for i, item := range scenes {
+			scene, _ := item.(string)
+			if !strings.HasPrefix(scene, "Assets/") || !strings.HasSuffix(scene, ".unity") {
+				problems = append(problems, fmt.Sprintf("scenes[%d] %v must be a scene path like Assets/Scenes/Main.unity", i, item))
+			}
+		}
+	}
+
+	if list, ok := arguments["options"].([]interface{}); ok {
+		names := make([]interface{}, 0, len(list))
+		for i, item := range list {
+			name, _ := item.(string)
+			canonical, found := canonicalName(buildOptions, name)
+			if !found {
+				problems = append(problems, fmt.Sprintf("options[%d] %v is not a build option, expected one of: %s", i, item, strings.Join(buildOptions, ", ")))
+				continue
+			}
+			names = append(names, canonical)
+		}
+		arguments["options"] = names
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid build arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
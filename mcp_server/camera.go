@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 相机的枚举，与Unity的枚举名一致
+var (
+	cameraClearFlags     = []string{"Skybox", "SolidColor", "Depth", "Nothing"}
+	cameraRenderingPaths = []string{"UsePlayerSettings", "Forward", "DeferredShading", "VertexLit"}
+)
+
+// maxCullingMaskLayers cullingMask最多的层数
+const maxCullingMaskLayers = 32
+
+// cameraTargetParams 返回camera_get/camera_set共用的目标参数
+func cameraTargetParams(extra ...ParamSpec) []ParamSpec {
+	return append([]ParamSpec{
+		{Name: "instanceId", Type: "integer", Description: "InstanceID of the GameObject with the Camera"},
+		{Name: "useMainCamera", Type: "boolean", Description: "Target the camera tagged MainCamera instead of instanceId"},
+	}, extra...)
+}
+
+// normalizeCameraTarget instanceId和useMainCamera必须给出且只能给出一个
+func normalizeCameraTarget(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasID := arguments["instanceId"]
+	useMain, _ := arguments["useMainCamera"].(bool)
+	if hasID == useMain {
+		return nil, fmt.Errorf("exactly one of instanceId or useMainCamera: true is required")
+	}
+	if !useMain {
+		delete(arguments, "useMainCamera")
+	}
+	return arguments, nil
+}
+
+// normalizeCameraSetArgs 检查目标、裁剪面和cullingMask层名；层名是否存在由Unity逐个检查
+func normalizeCameraSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	arguments, err := normalizeCameraTarget(arguments)
+	if err != nil {
+		return nil, err
+	}
+	near, hasNear := arguments["nearClipPlane"].(float64)
+	far, hasFar := arguments["farClipPlane"].(float64)
+	if hasNear && hasFar && near >= far {
+		return nil, fmt.Errorf("nearClipPlane (%v) must be less than farClipPlane (%v)", near, far)
+	}
+	if layers, ok := arguments["cullingMask"].([]interface{}); ok {
+		if len(layers) > maxCullingMaskLayers {
+			return nil, fmt.Errorf("cullingMask has %d layers, Unity has at most %d", len(layers), maxCullingMaskLayers)
+		}
+		seen := map[string]bool{}
+		for i, layer := range layers {
+			name, ok := layer.(string)
+			if !ok || strings.TrimSpace(name) == "" {
+				return nil, fmt.Errorf("cullingMask[%d] must be a layer name, got %v", i, layer)
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("cullingMask lists layer %q more than once", name)
+			}
+			seen[name] = true
+		}
+	}
+	return arguments, nil
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// mcp-go不会因客户端的 notifications/cancelled 取消处理器的context，
+// 因此在这里自行记录进行中的工具调用，收到取消通知或会话断开时调用对应的cancel
+
+// 内部使用的请求头，用于把JSON-RPC请求ID从hook传递给工具处理器
+const requestIDHeader = "X-Unity-Mcp-Request-Id"
+
+// inflightCalls 进行中的工具调用，键为 会话ID + 请求ID
+type inflightCalls struct {
+	mu    sync.Mutex
+	calls map[string]context.CancelFunc
+}
+
+var inflight = &inflightCalls{calls: make(map[string]context.CancelFunc)}
+
+// inflightKey 生成调用键，请求ID统一规范化 (JSON数字可能被解析为float64)
+func inflightKey(sessionID string, requestID any) string {
+	return sessionID + "/" + mcp.NewRequestId(requestID).String()
+}
+
+// Track 为工具调用创建可取消的context，调用结束后需调用返回的release
+func (c *inflightCalls) Track(ctx context.Context, request mcp.CallToolRequest) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	requestID := request.Header.Get(requestIDHeader)
+	if requestID == "" {
+		return ctx, cancel
+	}
+
+	key := sessionIDFromContext(ctx) + "/" + requestID
+	c.mu.Lock()
+	c.calls[key] = cancel
+	c.mu.Unlock()
+
+	return ctx, func() {
+		c.mu.Lock()
+		delete(c.calls, key)
+		c.mu.Unlock()
+		cancel()
+	}
+}
+
+// Cancel 取消指定调用，返回是否找到
+func (c *inflightCalls) Cancel(key string) bool {
+	c.mu.Lock()
+	cancel, ok := c.calls[key]
+	c.mu.Unlock()
+	if ok {
+		cancel()
+	}
+	return ok
+}
+
+// CancelSession 取消会话中所有进行中的调用
+func (c *inflightCalls) CancelSession(sessionID string) int {
+	prefix := sessionID + "/"
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	count := 0
+	for key, cancel := range c.calls {
+		if strings.HasPrefix(key, prefix) {
+			cancel()
+			count++
+		}
+	}
+	return count
+}
+
+// CancelAll 取消所有进行中的调用，返回取消的数量
+func (c *inflightCalls) CancelAll() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, cancel := range c.calls {
+		cancel()
+	}
+	return len(c.calls)
+}
+
+// sessionIDFromContext 返回当前MCP会话ID，无会话时为空字符串
+func sessionIDFromContext(ctx context.Context) string {
+	if session := server.ClientSessionFromContext(ctx); session != nil {
+		return session.SessionID()
+	}
+	return ""
+}
+
+// newServerHooks 创建用于跟踪调用取消的MCP服务器hook
+func newServerHooks() *server.Hooks {
+	hooks := &server.Hooks{}
+	hooks.AddBeforeCallTool(func(ctx context.Context, id any, message *mcp.CallToolRequest) {
+		// 复制请求头，避免修改原始HTTP请求
+		header := http.Header{}
+		if message.Header != nil {
+			header = message.Header.Clone()
+		}
+		header.Set(requestIDHeader, mcp.NewRequestId(id).String())
+		message.Header = header
+	})
+	hooks.AddOnUnregisterSession(func(ctx context.Context, session server.ClientSession) {
+		if n := inflight.CancelSession(session.SessionID()); n > 0 {
+			debugLog("Session %s closed, canceled %d in-flight tool calls", session.SessionID(), n)
+		}
+	})
+	return hooks
+}
+
+// handleCancelledNotification 处理客户端发送的 notifications/cancelled
+func handleCancelledNotification(ctx context.Context, notification mcp.JSONRPCNotification) {
+	requestID, ok := notification.Params.AdditionalFields["requestId"]
+	if !ok {
+		return
+	}
+	reason, _ := notification.Params.AdditionalFields["reason"].(string)
+	key := inflightKey(sessionIDFromContext(ctx), requestID)
+	if inflight.Cancel(key) {
+		logger.Info("Tool call cancellation requested by client", "request", key, "reason", reason)
+	} else {
+		debugLog("Cancellation for unknown or finished request %s ignored", key)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// script_write 的编译反馈: waitForCompile 为true时，写入成功后轮询 script_get_compile_errors，
+// 直到Unity完成一次新的编译，再把编译结果附加到工具结果的 compile 字段
+// 编译成功后Unity会重载脚本域并断开连接，轮询期间的通信失败只记录调试日志
+
+// compilePollInterval 等待编译时轮询Unity的间隔
+const compilePollInterval = 500 * time.Millisecond
+
+// compileStatus 查询编译状态的结果
+type compileStatus struct {
+	Compiling  bool
+	Generation int
+	Data       map[string]interface{}
+}
+
+// handleScriptWrite 转发script_write，需要时等待编译完成
+func handleScriptWrite(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+	wait, _ := arguments["waitForCompile"].(bool)
+	st := stateFromContext(ctx)
+	if !wait || isDryRun(ctx) {
+		result, err := forwardToUnity(ctx, "script_write", arguments, request)
+		if st.Config.AutoWaitForIdle && err == nil && result != nil && !result.IsError && !isDryRun(ctx) {
+			// 写入会触发编译，下一次忙碌状态轮询之前的调用也需要等待
+			editorBusy.Set(true, "script_write")
+		}
+		return result, err
+	}
+
+	budget := st.Config.CompileWaitTimeout
+	if seconds, ok := arguments["compileTimeout"].(int64); ok {
+		budget = time.Duration(seconds) * time.Second
+	}
+	// 写入之前记录已完成的编译次数，之后出现更大的编号才是这次写入触发的编译
+	before, err := queryCompileStatus(ctx)
+	if err != nil {
+		debugLog("Cannot read compile status before script_write: %v", err)
+	}
+
+	result, err := forwardToUnity(ctx, "script_write", arguments, request)
+	if err != nil || result == nil || result.IsError {
+		return result, err
+	}
+
+	start := time.Now()
+	outcome := waitForCompile(ctx, before, budget)
+	outcome["waitedMs"] = time.Since(start).Milliseconds()
+	addResultField(result, "compile", outcome)
+	return result, nil
+}
+
+// waitForCompile 等待写入之后的编译完成，返回 compile 字段的内容
+// status: succeeded/failed/timeout/unavailable
+func waitForCompile(ctx context.Context, before *compileStatus, budget time.Duration) map[string]interface{} {
+	log := callInfoFromContext(ctx).Logger()
+	deadline := time.Now().Add(budget)
+	for {
+		status, err := queryCompileStatus(ctx)
+		if err == nil && !status.Compiling && (before == nil || status.Generation > before.Generation) {
+			outcome := map[string]interface{}{
+				"compileSucceeded": status.Data["compileSucceeded"],
+				"errors":           status.Data["errors"],
+				"errorCount":       status.Data["errorCount"],
+				"warningCount":     status.Data["warningCount"],
+			}
+			if succeeded, _ := status.Data["compileSucceeded"].(bool); succeeded {
+				outcome["status"] = "succeeded"
+			} else {
+				outcome["status"] = "failed"
+			}
+			if before == nil {
+				// 无法确认结果是否来自这次写入
+				outcome["note"] = "compile status before the write was unavailable; result may predate this write"
+			}
+			log.Info("Compilation finished after script_write", "status", outcome["status"], "errors", outcome["errorCount"])
+			return outcome
+		}
+		if err != nil {
+			log.Debug("Waiting for Unity to finish compiling", "error", err.Error())
+		}
+
+		if time.Now().Add(compilePollInterval).After(deadline) {
+			log.Warn("Timed out waiting for compilation", "budget", budget.String())
+			if err != nil {
+				return map[string]interface{}{"status": "unavailable", "error": err.Error()}
+			}
+			return map[string]interface{}{"status": "timeout", "compiling": status.Compiling}
+		}
+		select {
+		case <-time.After(compilePollInterval):
+		case <-ctx.Done():
+			return map[string]interface{}{"status": "canceled"}
+		}
+	}
+}
+
+// queryCompileStatus 调用 script_get_compile_errors，失败时只记录调试日志
+func queryCompileStatus(ctx context.Context) (*compileStatus, error) {
+	data, err := queryUnityLevel(ctx, "script_get_compile_errors", map[string]interface{}{}, slog.LevelDebug)
+	if err != nil {
+		return nil, err
+	}
+	m, _ := data.(map[string]interface{})
+	compiling, _ := m["isCompiling"].(bool)
+	generation, _ := m["compileGeneration"].(float64)
+	return &compileStatus{Compiling: compiling, Generation: int(generation), Data: m}, nil
+}
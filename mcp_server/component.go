@@ -0,0 +1,136 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+// componentPropertyCacheSize 最多缓存多少个组件的属性类型，超过时清空重新记录
+const componentPropertyCacheSize = 256
+
+// componentPropertyTypes 记录component_get返回的属性类型 (组件InstanceID → 属性路径 → jsonType)，
+// component_set_property据此在发送到Unity之前检查值的JSON类型；没有记录的组件和属性交给Unity检查
+var componentPropertyTypes = struct {
+	sync.Mutex
+	byComponent map[int64]map[string]string
+}{byComponent: make(map[int64]map[string]string)}
+
+// normalizeComponentGetArgs 需要componentInstanceId，或instanceId和componentType
+func normalizeComponentGetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasComponent := arguments["componentInstanceId"]
+	_, hasObject := arguments["instanceId"]
+	_, hasType := arguments["componentType"]
+	switch {
+	case hasComponent && (hasObject || hasType):
+		return nil, fmt.Errorf("componentInstanceId cannot be combined with instanceId or componentType")
+	case !hasComponent && !(hasObject && hasType):
+		return nil, fmt.Errorf("either componentInstanceId or both instanceId and componentType are required")
+	}
+	return arguments, nil
+}
+
+// inspectComponentGet 记录组件的属性类型
+func inspectComponentGet(data map[string]interface{}) {
+	id, err := toNumber(data["componentInstanceId"])
+	if err != nil {
+		return
+	}
+	properties, _ := data["properties"].([]interface{})
+	types := make(map[string]string, len(properties))
+	for _, item := range properties {
+		property, _ := item.(map[string]interface{})
+		path, _ := property["path"].(string)
+		jsonType, _ := property["jsonType"].(string)
+		if path != "" && jsonType != "" {
+			types[path] = jsonType
+		}
+	}
+
+	componentPropertyTypes.Lock()
+	defer componentPropertyTypes.Unlock()
+	if len(componentPropertyTypes.byComponent) >= componentPropertyCacheSize {
+		componentPropertyTypes.byComponent = make(map[int64]map[string]string)
+	}
+	componentPropertyTypes.byComponent[int64(id)] = types
+}
+
+// cachedPropertyType 返回component_get记录的属性类型，没有记录时返回空字符串
+func cachedPropertyType(componentID int64, path string) string {
+	componentPropertyTypes.Lock()
+	defer componentPropertyTypes.Unlock()
+	return componentPropertyTypes.byComponent[componentID][path]
+}
+
+// normalizeComponentSetArgs 按component_get报告的属性类型检查value
+func normalizeComponentSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	componentID, _ := arguments["componentInstanceId"].(int64)
+	path, _ := arguments["propertyPath"].(string)
+	jsonType := cachedPropertyType(componentID, path)
+	if jsonType == "" {
+		return arguments, nil
+	}
+	value, hasValue := arguments["value"]
+	if !hasValue {
+		if jsonType == "reference" {
+			return arguments, nil
+		}
+		return nil, fmt.Errorf("value is required for %s (%s); only object references can be cleared with null", path, jsonType)
+	}
+	coerced, err := checkPropertyValue(jsonType, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s (%s): %w", path, jsonType, err)
+	}
+	arguments["value"] = coerced
+	return arguments, nil
+}
+
+// checkPropertyValue 检查值是否符合属性的jsonType，字符串形式的数字和布尔值会被转换
+func checkPropertyValue(jsonType string, value interface{}) (interface{}, error) {
+	switch jsonType {
+	case "integer":
+		n, err := toNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		return toInteger(n)
+	case "number":
+		return toNumber(value)
+	case "boolean":
+		return ParamSpec{Type: "boolean"}.coerce(value)
+	case "string":
+		if _, ok := value.(string); !ok {
+			return nil, fmt.Errorf("expected string, got %s", jsonTypeName(value))
+		}
+	case "enum":
+		switch value.(type) {
+		case string:
+		case float64, int64:
+			n, _ := toNumber(value)
+			return toInteger(n)
+		default:
+			return nil, fmt.Errorf("expected enum name or index, got %s", jsonTypeName(value))
+		}
+	case "object":
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected object, got %s", jsonTypeName(value))
+		}
+		for key, field := range fields {
+			if _, err := toNumber(field); err != nil {
+				return nil, fmt.Errorf("field %s: %w", key, err)
+			}
+		}
+	case "reference":
+		switch value.(type) {
+		case string:
+		case float64, int64:
+			n, _ := toNumber(value)
+			return toInteger(n)
+		default:
+			return nil, fmt.Errorf("expected asset path or InstanceID, got %s", jsonTypeName(value))
+		}
+	case "generic":
+		return nil, fmt.Errorf("arrays and structs cannot be set directly; set their child properties or <path>.Array.size")
+	}
+	return value, nil
+}
@@ -0,0 +1,364 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ServerConfig 服务器配置
+// 每个字段通过yaml标签对应配置文件键名，通过flag标签对应命令行参数
+// 带有reload标签的字段可以通过SIGHUP重新加载，其他字段需要重启
+// 优先级: 默认值 < 配置文件 < 环境变量 (UNITYMCP_<FLAG>) < 显式命令行参数
+type ServerConfig struct {
+	Bind               string        `yaml:"bind" flag:"bind"` // 监听地址，默认只对本机可见
+	Port               string        `yaml:"port" flag:"port"`
+	ManagementPort     string        `yaml:"managementPort" flag:"management-port"`
+	NoManagement       bool          `yaml:"noManagement" flag:"no-management"`
+	ManagementToken    string        `yaml:"managementToken" flag:"management-token" secret:"true"` // 管理端点的Bearer token
+	DualPort           bool          `yaml:"dualPort" flag:"dual-port"`                             // 兼容旧版: 管理端点使用独立端口
+	CORSOrigins        string        `yaml:"corsOrigins" flag:"cors-origins"`                       // 管理端点允许的跨域来源，逗号分隔，*表示全部
+	UnityHost          string        `yaml:"unityHost" flag:"unity-host"`
+	UnityPort          string        `yaml:"unityPort" flag:"unity-port"`
+	Timeout            time.Duration `yaml:"timeout" flag:"timeout" reload:"true"`                   // 单次Unity通信超时
+	UnityRetries       int           `yaml:"unityRetries" flag:"unity-retries" reload:"true"`        // 只读工具失败后的重试次数，0表示不重试
+	UnityRetryDelay    time.Duration `yaml:"unityRetryDelay" flag:"unity-retry-delay" reload:"true"` // 两次重试之间的等待时间
+	Debug              bool          `yaml:"debug" flag:"debug" reload:"true"`
+	LogFormat          string        `yaml:"logFormat" flag:"log-format"`                            // text 或 json
+	LogLevel           string        `yaml:"logLevel" flag:"log-level" reload:"true"`                // error/warn/info/debug/trace
+	LogPayloadLimit    int           `yaml:"logPayloadLimit" flag:"log-payload-limit" reload:"true"` // 日志中负载的最大字节数
+	LogFile            string        `yaml:"logFile" flag:"log-file"`                                // 日志文件路径，为空时只输出到stderr
+	LogMaxSizeMB       int           `yaml:"logMaxSizeMB" flag:"log-max-size-mb"`
+	LogMaxBackups      int           `yaml:"logMaxBackups" flag:"log-max-backups"`
+	ReadOnly           bool          `yaml:"readonly" flag:"readonly" reload:"true"`                       // 只启用只读工具
+	AllowTools         string        `yaml:"allowTools" flag:"allow-tools" reload:"true"`                  // 允许的工具名glob，逗号分隔，为空表示全部
+	DenyTools          string        `yaml:"denyTools" flag:"deny-tools" reload:"true"`                    // 拒绝的工具名glob，逗号分隔，优先于allowTools
+	AuditLog           string        `yaml:"auditLog" flag:"audit-log" reload:"true"`                      // 工具调用审计日志 (JSONL)，为空时不记录
+	AuditAll           bool          `yaml:"auditAll" flag:"audit-all" reload:"true"`                      // 审计日志同时记录只读工具
+	DryRun             bool          `yaml:"dryRun" flag:"dry-run" reload:"true"`                          // 工具调用只返回将发送到Unity的消息，不执行
+	HistorySize        int           `yaml:"historySize" flag:"history-size" reload:"true"`                // /history 保留的最近工具调用数量
+	MaxResponseSize    int           `yaml:"maxResponseSize" flag:"max-response-size"`                     // Unity响应的最大字节数
+	ForwardEvents      bool          `yaml:"forwardEvents" flag:"forward-events" reload:"true"`            // 把Unity Console日志推送给MCP客户端
+	EventPollInterval  time.Duration `yaml:"eventPollInterval" flag:"event-poll-interval" reload:"true"`   // 转发日志时轮询Unity的间隔
+	CompileWaitTimeout time.Duration `yaml:"compileWaitTimeout" flag:"compile-wait-timeout" reload:"true"` // script_write waitForCompile 的默认等待时间
+	AutoWaitForIdle    bool          `yaml:"autoWaitForIdle" flag:"auto-wait-for-idle" reload:"true"`      // Unity编译或导入时，修改类工具先等待编辑器空闲
+	SnapshotDir        string        `yaml:"snapshotDir" flag:"snapshot-dir" reload:"true"`                // project_settings_snapshot 保存快照的目录，为空时使用用户缓存目录
+	// 兼容旧版: 成功结果返回 "Tool X executed successfully:" 文本而不是结构化内容，将在下个版本移除
+	LegacyTextResults bool `yaml:"legacyTextResults" flag:"legacy-text-results" reload:"true"`
+}
+
+// defaultConfig 返回默认配置
+func defaultConfig() ServerConfig {
+	return ServerConfig{
+		Bind:               "127.0.0.1",
+		Port:               "13000",
+		UnityHost:          "localhost",
+		UnityPort:          "12000",
+		Timeout:            10 * time.Second,
+		UnityRetries:       2,
+		UnityRetryDelay:    time.Second,
+		LogFormat:          "text",
+		LogLevel:           "info",
+		LogPayloadLimit:    2048,
+		LogMaxSizeMB:       10,
+		LogMaxBackups:      3,
+		HistorySize:        200,
+		MaxResponseSize:    1024 * 1024,
+		EventPollInterval:  2 * time.Second,
+		CompileWaitTimeout: 60 * time.Second,
+	}
+}
+
+// 不对应配置字段的命令行参数
+var nonConfigFlags = map[string]bool{"config": true, "version": true}
+
+// defineFlags 注册所有命令行参数，默认值取自defaultConfig
+// 返回-config和-version的值
+func defineFlags(fs *flag.FlagSet) (configPath *string, showVersion *bool) {
+	d := defaultConfig()
+	configPath = fs.String("config", "", "Path to a YAML or JSON configuration file")
+	showVersion = fs.Bool("version", false, "Print version information and exit")
+	fs.String("bind", d.Bind, "Address to bind HTTP listeners to (use 0.0.0.0 to listen on all interfaces)")
+	fs.String("port", d.Port, "MCP server port")
+	fs.String("management-port", d.ManagementPort, "Management HTTP server port in -dual-port mode (default: port+1)")
+	fs.Bool("no-management", d.NoManagement, "Disable the management endpoints (/health, /tools)")
+	fs.String("management-token", d.ManagementToken, "Require 'Authorization: Bearer <token>' on management endpoints")
+	fs.Bool("dual-port", d.DualPort, "Serve management endpoints on a separate port (deprecated)")
+	fs.String("cors-origins", d.CORSOrigins, "Comma-separated origins allowed to call management endpoints from a browser ('*' for any; default: no CORS)")
+	fs.String("unity-host", d.UnityHost, "Unity TCP server host")
+	fs.String("unity-port", d.UnityPort, "Unity TCP server port")
+	fs.Duration("timeout", d.Timeout, "Timeout for a single Unity request")
+	fs.Int("unity-retries", d.UnityRetries, "Number of retries for failed read-only Unity requests (0 = no retries)")
+	fs.Duration("unity-retry-delay", d.UnityRetryDelay, "Delay between Unity request retries")
+	fs.Bool("debug", d.Debug, "Alias for -log-level=debug")
+	fs.String("log-format", d.LogFormat, "Log output format (text|json)")
+	fs.String("log-level", d.LogLevel, "Log level (error|warn|info|debug|trace)")
+	fs.Int("log-payload-limit", d.LogPayloadLimit, "Maximum bytes of a payload written to logs below trace level (0 = unlimited)")
+	fs.String("log-file", d.LogFile, "Also write logs to this file (reopened on SIGHUP)")
+	fs.Int("log-max-size-mb", d.LogMaxSizeMB, "Rotate the log file when it exceeds this size in MB (0 = never)")
+	fs.Int("log-max-backups", d.LogMaxBackups, "Number of rotated log files to keep")
+	fs.Bool("readonly", d.ReadOnly, "Disable all tools that modify the Unity project")
+	fs.String("allow-tools", d.AllowTools, "Comma-separated glob patterns of tools to expose (default: all)")
+	fs.String("deny-tools", d.DenyTools, "Comma-separated glob patterns of tools to hide (takes precedence over -allow-tools)")
+	fs.String("audit-log", d.AuditLog, "Append a JSON line per mutating tool call to this file")
+	fs.Bool("audit-all", d.AuditAll, "Also audit read-only tool calls (requires -audit-log)")
+	fs.Bool("dry-run", d.DryRun, "Return the Unity message each tool call would send instead of executing it")
+	fs.Int("history-size", d.HistorySize, "Number of recent tool calls kept for /history (0 = disabled)")
+	fs.Bool("forward-events", d.ForwardEvents, "Push new Unity Console logs to MCP clients as notifications/message")
+	fs.Duration("event-poll-interval", d.EventPollInterval, "How often Unity is polled for new logs when -forward-events is on")
+	fs.Duration("compile-wait-timeout", d.CompileWaitTimeout, "How long script_write waits for Unity to compile when waitForCompile is set")
+	fs.Bool("auto-wait-for-idle", d.AutoWaitForIdle, "Wait (up to -compile-wait-timeout) for Unity to finish compiling or importing before forwarding mutating tools")
+	fs.String("snapshot-dir", d.SnapshotDir, "Directory where project_settings_snapshot stores captured snapshots (default: <user cache dir>/unitymcp/settings-snapshots)")
+	fs.Int("max-response-size", d.MaxResponseSize, "Maximum size in bytes of a Unity response; larger responses fail with response_too_large")
+	fs.Bool("legacy-text-results", d.LegacyTextResults, "Return tool results as formatted text instead of structured content (deprecated)")
+	return configPath, showVersion
+}
+
+// 环境变量前缀，例如 -unity-host 对应 UNITYMCP_UNITY_HOST
+const envPrefix = "UNITYMCP_"
+
+// envName 返回flag对应的环境变量名
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// loadConfig 按优先级合并默认值、配置文件、环境变量和显式命令行参数
+// 返回值envKeys为从环境变量读取的配置项；warnings为不影响加载的问题 (如未知键)，
+// 由调用方在日志器按配置初始化之后输出
+func loadConfig(fs *flag.FlagSet, configPath string) (cfg ServerConfig, envKeys []string, warnings []string, err error) {
+	cfg = defaultConfig()
+
+	if configPath == "" {
+		configPath = os.Getenv(envName("config"))
+	}
+	if configPath != "" {
+		unknown, err := loadConfigFile(configPath, &cfg)
+		if err != nil {
+			return cfg, nil, nil, err
+		}
+		if len(unknown) > 0 {
+			warnings = append(warnings, fmt.Sprintf("Config file %s contains unknown keys (ignored): %s", configPath, strings.Join(unknown, ", ")))
+		}
+	}
+
+	// 遍历所有flag查找对应的环境变量，新增flag自动获得环境变量支持
+	var envErr error
+	fs.VisitAll(func(f *flag.Flag) {
+		if envErr != nil || nonConfigFlags[f.Name] {
+			return
+		}
+		value, ok := os.LookupEnv(envName(f.Name))
+		if !ok {
+			return
+		}
+		if err := setConfigField(&cfg, f.Name, value); err != nil {
+			envErr = fmt.Errorf("invalid value for %s: %w", envName(f.Name), err)
+			return
+		}
+		envKeys = append(envKeys, envName(f.Name))
+	})
+	if envErr != nil {
+		return cfg, envKeys, warnings, envErr
+	}
+
+	// 只应用用户显式指定的命令行参数
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		if flagErr != nil || nonConfigFlags[f.Name] {
+			return
+		}
+		if err := setConfigField(&cfg, f.Name, f.Value.String()); err != nil {
+			flagErr = fmt.Errorf("invalid value for -%s: %w", f.Name, err)
+		}
+	})
+	if flagErr != nil {
+		return cfg, envKeys, warnings, flagErr
+	}
+
+	// -debug 等同于 -log-level=debug，但不降低已指定的trace级别
+	if cfg.Debug {
+		if level, err := parseLogLevel(cfg.LogLevel); err == nil && level > slog.LevelDebug {
+			cfg.LogLevel = "debug"
+		}
+	}
+
+	// 未指定管理端口时沿用 SSE端口 + 1 (仅-dual-port模式使用)
+	if cfg.ManagementPort == "" {
+		if p, err := strconv.Atoi(cfg.Port); err == nil {
+			cfg.ManagementPort = strconv.Itoa(p + 1)
+		}
+	}
+
+	return cfg, envKeys, warnings, cfg.Validate()
+}
+
+// loadConfigFile 读取YAML或JSON配置文件 (YAML是JSON的超集，统一使用YAML解析)
+// 返回排序后的未知键，这些键被忽略
+func loadConfigFile(path string, cfg *ServerConfig) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	// 先解析为map以检查未知键
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	known := make(map[string]bool)
+	for _, field := range configFields() {
+		known[field.Tag.Get("yaml")] = true
+	}
+	var unknown []string
+	for key := range raw {
+		if !known[key] {
+			unknown = append(unknown, key)
+		}
+	}
+	sort.Strings(unknown)
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return unknown, nil
+}
+
+// Validate 检查配置是否有效
+func (c ServerConfig) Validate() error {
+	if err := validatePort("port", c.Port); err != nil {
+		return err
+	}
+	if c.DualPort && !c.NoManagement {
+		if err := validatePort("management-port", c.ManagementPort); err != nil {
+			return err
+		}
+	}
+	if err := validatePort("unity-port", c.UnityPort); err != nil {
+		return err
+	}
+	if c.UnityHost == "" {
+		return fmt.Errorf("unity-host must not be empty")
+	}
+	if c.Timeout <= 0 {
+		return fmt.Errorf("timeout must be positive, got %v", c.Timeout)
+	}
+	if c.UnityRetries < 0 {
+		return fmt.Errorf("unity-retries must not be negative, got %d", c.UnityRetries)
+	}
+	if c.UnityRetryDelay < 0 {
+		return fmt.Errorf("unity-retry-delay must not be negative, got %v", c.UnityRetryDelay)
+	}
+	if c.LogFormat != "text" && c.LogFormat != "json" {
+		return fmt.Errorf("log-format must be text or json, got %q", c.LogFormat)
+	}
+	if _, err := parseLogLevel(c.LogLevel); err != nil {
+		return err
+	}
+	if c.LogPayloadLimit < 0 {
+		return fmt.Errorf("log-payload-limit must not be negative, got %d", c.LogPayloadLimit)
+	}
+	if c.LogMaxSizeMB < 0 || c.LogMaxBackups < 0 {
+		return fmt.Errorf("log-max-size-mb and log-max-backups must not be negative")
+	}
+	if c.HistorySize < 0 {
+		return fmt.Errorf("history-size must not be negative, got %d", c.HistorySize)
+	}
+	if c.CompileWaitTimeout <= 0 {
+		return fmt.Errorf("compile-wait-timeout must be positive, got %v", c.CompileWaitTimeout)
+	}
+	if c.EventPollInterval <= 0 {
+		return fmt.Errorf("event-poll-interval must be positive, got %v", c.EventPollInterval)
+	}
+	if c.MaxResponseSize <= 0 {
+		return fmt.Errorf("max-response-size must be positive, got %d", c.MaxResponseSize)
+	}
+	if err := validatePatterns("allow-tools", c.AllowTools); err != nil {
+		return err
+	}
+	if err := validatePatterns("deny-tools", c.DenyTools); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validatePort(name, value string) error {
+	p, err := strconv.Atoi(value)
+	if err != nil || p < 1 || p > 65535 {
+		return fmt.Errorf("%s must be a port number between 1 and 65535, got %q", name, value)
+	}
+	return nil
+}
+
+// logEffectiveConfig 在debug模式下打印最终生效的配置 (敏感字段脱敏)
+func logEffectiveConfig(c ServerConfig, envKeys []string) {
+	if len(envKeys) > 0 {
+		debugLog("Settings from environment: %s", strings.Join(envKeys, ", "))
+	}
+	v := reflect.ValueOf(c)
+	attrs := make([]interface{}, 0, 2*len(configFields()))
+	for _, field := range configFields() {
+		value := fmt.Sprintf("%v", v.FieldByName(field.Name).Interface())
+		if field.Tag.Get("secret") == "true" && value != "" {
+			value = "***"
+		}
+		attrs = append(attrs, field.Tag.Get("yaml"), value)
+	}
+	logger.Debug("Effective configuration", attrs...)
+}
+
+// configFields 返回ServerConfig中所有可配置字段
+func configFields() []reflect.StructField {
+	t := reflect.TypeOf(ServerConfig{})
+	fields := make([]reflect.StructField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("flag") != "" {
+			fields = append(fields, t.Field(i))
+		}
+	}
+	return fields
+}
+
+// setConfigField 根据flag名称设置配置字段，value为字符串形式
+func setConfigField(cfg *ServerConfig, flagName, value string) error {
+	v := reflect.ValueOf(cfg).Elem()
+	for _, field := range configFields() {
+		if field.Tag.Get("flag") != flagName {
+			continue
+		}
+		fv := v.FieldByName(field.Name)
+		switch {
+		case fv.Type() == reflect.TypeOf(time.Duration(0)):
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				return err
+			}
+			fv.SetInt(int64(d))
+		case fv.Kind() == reflect.String:
+			fv.SetString(value)
+		case fv.Kind() == reflect.Bool:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			fv.SetBool(b)
+		case fv.Kind() == reflect.Int:
+			i, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+			fv.SetInt(int64(i))
+		default:
+			return fmt.Errorf("unsupported config field type %s", fv.Type())
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown config field %s", flagName)
+}
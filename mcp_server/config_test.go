@@ -0,0 +1,37 @@
+package main
+
+import (
+	"flag"
+	"log/slog"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfigDefersUnknownKeyWarnings(t *testing.T) {
+	path := t.TempDir() + "/unitymcp.yaml"
+	if err := os.WriteFile(path, []byte("unityPort: \"6401\"\nunityPrt: 6402\nextra: true\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	logs := captureLogs(t, slog.LevelDebug)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	configPath, _ := defineFlags(fs)
+	if err := fs.Parse([]string{"-config", path}); err != nil {
+		t.Fatal(err)
+	}
+	cfg, _, warnings, err := loadConfig(fs, *configPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.UnityPort != "6401" {
+		t.Errorf("UnityPort = %q, want 6401", cfg.UnityPort)
+	}
+	// 日志器在加载配置之后才按配置初始化，加载期间不能输出日志
+	if logs.Len() != 0 {
+		t.Errorf("loadConfig logged before the logger was configured: %s", logs.String())
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "extra, unityPrt") {
+		t.Errorf("warnings = %q, want one warning listing extra, unityPrt", warnings)
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// console_stats 和 console_clear 的结果中包含Console的错误数，服务器记录最近一次的值，
+// 在 /health 中报告为 unityConsoleErrors；/health?probe=true 探测成功时顺便刷新
+
+// consoleErrors 最近一次得到的Console错误数
+var consoleErrors = struct {
+	sync.Mutex
+	count int64
+	at    time.Time
+}{}
+
+// recordConsoleStats 记录结果中的错误数 (console_stats、console_clear 的Inspect)
+func recordConsoleStats(data map[string]interface{}) {
+	n, err := toNumber(data["errors"])
+	if err != nil {
+		return
+	}
+	consoleErrors.Lock()
+	defer consoleErrors.Unlock()
+	consoleErrors.count = int64(n)
+	consoleErrors.at = time.Now()
+}
+
+// lastConsoleErrors 返回最近一次记录的错误数，没有记录时ok为false
+func lastConsoleErrors() (count int64, at time.Time, ok bool) {
+	consoleErrors.Lock()
+	defer consoleErrors.Unlock()
+	return consoleErrors.count, consoleErrors.at, !consoleErrors.at.IsZero()
+}
+
+// refreshConsoleStats 查询Unity的Console统计，失败只记录调试日志
+func refreshConsoleStats(timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	data, err := queryUnityLevel(ctx, "console_stats", map[string]interface{}{}, slog.LevelDebug)
+	if err != nil {
+		debugLog("Console stats refresh failed: %v", err)
+		return
+	}
+	if stats, ok := data.(map[string]interface{}); ok {
+		recordConsoleStats(stats)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// callInfo 一次工具调用的关联信息
+// ID在调用开始时生成，作为Unity消息的id，并出现在该调用的所有日志、错误结果、审计和历史记录中，
+// 用户报告问题时引用这个ID即可找到对应的服务器日志和Unity日志
+type callInfo struct {
+	ID            string // 服务器生成的UUIDv4
+	MCPRequestID  string // 客户端的JSON-RPC请求ID
+	SessionID     string // MCP会话ID
+	ProgressToken string // 客户端提供的progressToken
+	DryRun        bool   // 试运行，不发送到Unity
+}
+
+// callInfoKey 在工具调用的context中保存关联信息
+type callInfoKey struct{}
+
+// newRequestID 生成Unity请求ID
+func newRequestID() string {
+	return uuid.NewString()
+}
+
+// newCallInfo 为工具调用生成关联信息
+func newCallInfo(ctx context.Context, request mcp.CallToolRequest) callInfo {
+	info := callInfo{
+		ID:           newRequestID(),
+		MCPRequestID: request.Header.Get(requestIDHeader),
+		SessionID:    sessionIDFromContext(ctx),
+	}
+	if request.Params.Meta != nil && request.Params.Meta.ProgressToken != nil {
+		info.ProgressToken = fmt.Sprint(request.Params.Meta.ProgressToken)
+	}
+	return info
+}
+
+// withCallInfo 把关联信息保存到context中
+func withCallInfo(ctx context.Context, info callInfo) context.Context {
+	return context.WithValue(ctx, callInfoKey{}, info)
+}
+
+// callInfoFromContext 返回当前工具调用的关联信息，不在工具调用中时ID为空
+func callInfoFromContext(ctx context.Context) callInfo {
+	info, _ := ctx.Value(callInfoKey{}).(callInfo)
+	return info
+}
+
+// logAttrs 返回日志的结构化字段，空值省略
+func (c callInfo) logAttrs() []any {
+	attrs := []any{"request_id", c.ID}
+	if c.MCPRequestID != "" {
+		attrs = append(attrs, "mcp_request_id", c.MCPRequestID)
+	}
+	if c.SessionID != "" {
+		attrs = append(attrs, "session_id", c.SessionID)
+	}
+	if c.ProgressToken != "" {
+		attrs = append(attrs, "progress_token", c.ProgressToken)
+	}
+	if c.DryRun {
+		attrs = append(attrs, "dry_run", true)
+	}
+	return attrs
+}
+
+// Logger 返回带有关联字段的日志记录器
+func (c callInfo) Logger() *slog.Logger {
+	return logger.With(c.logAttrs()...)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// 试运行: 构造Unity消息后直接返回，不联系Unity
+// 由 -dry-run 全局开启，或在单次调用中传入 _dryRun: true (转发前移除)
+// callUnityTool返回构造的消息，queryUnity拒绝非只读的action，因此试运行对修改项目的工具也没有副作用
+const dryRunArgument = "_dryRun"
+
+// isDryRun 判断当前工具调用是否为试运行
+// 处理函数把dryRunRequested的结果 (已包含 -dry-run) 记录在callInfo中，工具调用之外总是返回false
+func isDryRun(ctx context.Context) bool {
+	return callInfoFromContext(ctx).DryRun
+}
+
+// dryRunRequested 判断工具调用是否为试运行
+func dryRunRequested(c ServerConfig, arguments map[string]interface{}) (bool, error) {
+	value, ok := arguments[dryRunArgument]
+	if !ok {
+		return c.DryRun, nil
+	}
+	var requested bool
+	switch v := value.(type) {
+	case bool:
+		requested = v
+	case string:
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			return false, fmt.Errorf("%s must be a boolean, got %q", dryRunArgument, v)
+		}
+		requested = parsed
+	default:
+		return false, fmt.Errorf("%s must be a boolean, got %T", dryRunArgument, value)
+	}
+	// -dry-run 开启时不能在单次调用中关闭
+	return c.DryRun || requested, nil
+}
+
+// withoutArgument 返回去掉指定参数后的参数副本，参数不存在时原样返回
+func withoutArgument(arguments map[string]interface{}, name string) map[string]interface{} {
+	if _, ok := arguments[name]; !ok {
+		return arguments
+	}
+	forwarded := make(map[string]interface{}, len(arguments)-1)
+	for key, value := range arguments {
+		if key != name {
+			forwarded[key] = value
+		}
+	}
+	return forwarded
+}
+
+// dryRunResult 返回本应发送到Unity的消息、超时和重试策略
+func dryRunResult(ctx context.Context, toolName string, unityMsg map[string]interface{}, timeout time.Duration, policy retryPolicy) *mcp.CallToolResult {
+	payload, err := json.Marshal(unityMsg)
+	if err != nil {
+		return toolErrorResult(ctx, errCodeInvalidArguments,
+			fmt.Sprintf("Cannot serialize Unity message: %v", err), toolName)
+	}
+	return toolSuccessResult(ctx, toolName, map[string]interface{}{
+		"dryRun":    true,
+		"payload":   string(payload),
+		"timeout":   timeout.String(),
+		"timeoutMs": timeout.Milliseconds(),
+		"retryPolicy": map[string]interface{}{
+			"retries":  policy.Retries,
+			"attempts": policy.Attempts(),
+			"delay":    policy.Delay.String(),
+		},
+	})
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"testing"
+)
+
+func TestQueryUnityHonorsDryRun(t *testing.T) {
+	useState(t, nil)
+	unity := startFakeUnity(t, func(n int, request map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{"success": true, "data": map[string]interface{}{}}
+	})
+	dryRun := withCallInfo(context.Background(), callInfo{ID: "test", DryRun: true})
+
+	// 修改项目的action和未知的action都不发送
+	for _, action := range []string{"lighting_bake", "scene_create_object", "not_a_tool"} {
+		if _, err := queryUnity(dryRun, action, map[string]interface{}{}); err == nil {
+			t.Errorf("%s: dry run query should fail without contacting Unity", action)
+		}
+	}
+	if got := unity.Requests(); got != 0 {
+		t.Fatalf("Unity received %d requests during dry run, want 0", got)
+	}
+
+	// 只读查询照常发送
+	if _, err := queryUnity(dryRun, "asset_get_info", map[string]interface{}{"assetPath": "Assets/a.mat"}); err != nil {
+		t.Errorf("read-only query failed during dry run: %v", err)
+	}
+	if _, err := queryUnity(context.Background(), "lighting_bake", map[string]interface{}{}); err != nil {
+		t.Errorf("query outside a dry run failed: %v", err)
+	}
+	if got := unity.Requests(); got != 2 {
+		t.Errorf("Unity received %d requests, want 2", got)
+	}
+}
+
+func TestDryRunRequested(t *testing.T) {
+	tests := []struct {
+		name     string
+		global   bool
+		argument interface{}
+		want     bool
+		wantErr  bool
+	}{
+		{"default", false, nil, false, false},
+		{"global", true, nil, true, false},
+		{"argument", false, true, true, false},
+		{"string argument", false, "true", true, false},
+		{"argument cannot disable global", true, false, true, false},
+		{"invalid string", false, "yes please", false, true},
+		{"invalid type", false, 1.0, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arguments := map[string]interface{}{}
+			if tt.argument != nil {
+				arguments[dryRunArgument] = tt.argument
+			}
+			got, err := dryRunRequested(ServerConfig{DryRun: tt.global}, arguments)
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("dryRunRequested() = %t, %v, want %t, wantErr %t", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Unity Console日志转发 (-forward-events)
+// Unity与服务器之间只有请求/响应，因此服务器定期用游标调用 editor_get_logs，
+// 把新日志作为 notifications/message 推送给所有MCP客户端；
+// 偏好轮询的客户端继续使用 editor_get_logs 的 afterSequence
+// -auto-wait-for-idle 开启时同一循环还轮询编辑器忙碌状态 (见idle.go)
+
+// eventBatchSize 每次轮询最多读取的日志条数，剩余的在下次轮询继续读取
+const eventBatchSize = 200
+
+// Unity日志级别对应的MCP日志级别
+var unityLogLevels = map[string]mcp.LoggingLevel{
+	"Error":     mcp.LoggingLevelError,
+	"Exception": mcp.LoggingLevelError,
+	"Warning":   mcp.LoggingLevelWarning,
+	"Log":       mcp.LoggingLevelInfo,
+}
+
+// eventForwarder 保存转发游标，只在自身的goroutine中使用
+type eventForwarder struct {
+	srv    *server.MCPServer
+	cursor int64 // 最后转发的日志序号，-1表示尚未建立游标
+}
+
+// startEventForwarder 启动日志转发
+// 是否转发和轮询间隔在每次轮询时读取，因此可以通过重新加载配置开关
+func startEventForwarder(srv *server.MCPServer) {
+	f := &eventForwarder{srv: srv, cursor: -1}
+	go f.run()
+}
+
+func (f *eventForwarder) run() {
+	for {
+		time.Sleep(currentState().Config.EventPollInterval)
+		c := currentState().Config
+		if !c.ForwardEvents {
+			// 重新开启时不推送关闭期间积累的日志
+			f.cursor = -1
+		}
+		if !c.ForwardEvents && !c.AutoWaitForIdle {
+			continue
+		}
+		// 未连接时暂停转发，不为转发而反复重连Unity
+		if unityClient == nil || !unityClient.IsConnected() {
+			continue
+		}
+		if c.AutoWaitForIdle {
+			if err := f.pollBusyState(c.Timeout, c.ForwardEvents); err != nil {
+				debugLog("Busy state poll failed: %v", err)
+			}
+		}
+		if !c.ForwardEvents {
+			continue
+		}
+		if err := f.poll(c.Timeout); err != nil {
+			debugLog("Event forwarding poll failed: %v", err)
+		}
+	}
+}
+
+// poll 读取游标之后的新日志并推送
+// 第一次轮询只建立游标，已有的日志不推送
+func (f *eventForwarder) poll(timeout time.Duration) error {
+	params := map[string]interface{}{
+		"maxLogs":           eventBatchSize,
+		"excludeBridgeLogs": true,
+	}
+	if f.cursor >= 0 {
+		params["afterSequence"] = f.cursor
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	data, err := queryUnity(ctx, "editor_get_logs", params)
+	if err != nil {
+		return err
+	}
+	result, _ := data.(map[string]interface{})
+	next, ok := result["nextCursor"].(float64)
+	if !ok {
+		return fmt.Errorf("editor_get_logs response has no nextCursor, Unity package may be outdated")
+	}
+	if f.cursor < 0 {
+		f.cursor = int64(next)
+		debugLog("Event forwarding started at log sequence %d", f.cursor)
+		return nil
+	}
+
+	logs, _ := result["logs"].([]interface{})
+	for _, entry := range logs {
+		level, _ := entry.(map[string]interface{})["level"].(string)
+		mcpLevel, ok := unityLogLevels[level]
+		if !ok {
+			mcpLevel = mcp.LoggingLevelInfo
+		}
+		f.srv.SendNotificationToAllClients("notifications/message", map[string]any{
+			"level":  mcpLevel,
+			"logger": "unity",
+			"data":   entry,
+		})
+	}
+	if len(logs) > 0 {
+		traceLog("Forwarded %d Unity log entries", len(logs))
+	}
+	f.cursor = int64(next)
+	return nil
+}
+
+// normalizeEditorLogsArgs 检查正则搜索并把sinceTimestamp转换为RFC3339 (UTC)
+func normalizeEditorLogsArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if regex, _ := arguments["searchRegex"].(bool); regex {
+		pattern, ok := arguments["search"].(string)
+		if !ok {
+			return nil, fmt.Errorf("searchRegex requires search")
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid search regex: %w", err)
+		}
+	}
+	if value, ok := arguments["sinceTimestamp"].(string); ok {
+		t, err := parseTimestamp(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sinceTimestamp: %w", err)
+		}
+		arguments["sinceTimestamp"] = t.UTC().Format(time.RFC3339Nano)
+	}
+	return arguments, nil
+}
@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// 历史记录中每条记录保存的参数和响应预览的最大字节数
+const (
+	historyArgumentsLimit = 1024
+	historyResponseLimit  = 2048
+)
+
+// historyEntry 一次工具调用的记录
+type historyEntry struct {
+	Time         string `json:"time"`
+	Tool         string `json:"tool"`
+	RequestID    string `json:"requestId"`
+	MCPRequestID string `json:"mcpRequestId,omitempty"`
+	SessionID    string `json:"sessionId,omitempty"`
+	Arguments    string `json:"arguments"`
+	Success      bool   `json:"success"`
+	Error        string `json:"error,omitempty"`
+	Canceled     bool   `json:"canceled,omitempty"`
+	DryRun       bool   `json:"dryRun,omitempty"`
+	DurationMs   int64  `json:"durationMs"`
+	Response     string `json:"response,omitempty"` // 只在 /history/{requestId} 中返回
+}
+
+// callHistory 最近工具调用的环形缓冲区，内存占用受条目数和单条大小限制
+type callHistory struct {
+	mu      sync.Mutex
+	entries []historyEntry
+	next    int
+	size    int
+}
+
+var history = newCallHistory(200)
+
+func newCallHistory(size int) *callHistory {
+	return &callHistory{size: size}
+}
+
+// Resize 修改容量，保留最近的记录
+func (h *callHistory) Resize(size int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if size == h.size {
+		return
+	}
+	entries := h.ordered()
+	if len(entries) > size {
+		entries = entries[len(entries)-size:]
+	}
+	h.entries, h.next, h.size = entries, 0, size
+}
+
+// Add 添加一条记录，容量为0时不记录
+func (h *callHistory) Add(entry historyEntry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.size <= 0 {
+		return
+	}
+	if len(h.entries) < h.size {
+		h.entries = append(h.entries, entry)
+		return
+	}
+	h.entries[h.next] = entry
+	h.next = (h.next + 1) % h.size
+}
+
+// ordered 按时间顺序返回记录，调用方需持有锁
+func (h *callHistory) ordered() []historyEntry {
+	entries := make([]historyEntry, 0, len(h.entries))
+	entries = append(entries, h.entries[h.next:]...)
+	return append(entries, h.entries[:h.next]...)
+}
+
+// List 返回满足过滤条件的记录，最新的在前，不包含响应预览
+func (h *callHistory) List(tool string, errorsOnly bool) []historyEntry {
+	h.mu.Lock()
+	entries := h.ordered()
+	h.mu.Unlock()
+
+	result := make([]historyEntry, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if tool != "" && e.Tool != tool {
+			continue
+		}
+		if errorsOnly && e.Success {
+			continue
+		}
+		e.Response = ""
+		result = append(result, e)
+	}
+	return result
+}
+
+// Get 按服务器生成的请求ID查找记录
+// 客户端的JSON-RPC请求ID只在会话内唯一，可能对应多条记录，因此不用于查找
+func (h *callHistory) Get(requestID string) (historyEntry, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, e := range h.entries {
+		if e.RequestID == requestID {
+			return e, true
+		}
+	}
+	return historyEntry{}, false
+}
+
+// newHistoryEntry 根据工具调用结果创建历史记录
+func newHistoryEntry(toolName string, info callInfo, arguments map[string]interface{}, result *mcp.CallToolResult, start time.Time, errMsg string, canceled bool) historyEntry {
+	args, err := json.Marshal(arguments)
+	if err != nil {
+		args = []byte(fmt.Sprintf("%v", arguments))
+	}
+	entry := historyEntry{
+		Time:         start.UTC().Format(time.RFC3339Nano),
+		Tool:         toolName,
+		RequestID:    info.ID,
+		MCPRequestID: info.MCPRequestID,
+		SessionID:    info.SessionID,
+		Arguments:    truncateBytes(args, historyArgumentsLimit),
+		Success:      errMsg == "" && !canceled,
+		Error:        truncateBytes([]byte(errMsg), historyResponseLimit),
+		Canceled:     canceled,
+		DryRun:       info.DryRun,
+		DurationMs:   time.Since(start).Milliseconds(),
+	}
+	if result != nil {
+		for _, content := range result.Content {
+			if text, ok := mcp.AsTextContent(content); ok {
+				entry.Response = truncateBytes([]byte(text.Text), historyResponseLimit)
+				break
+			}
+		}
+	}
+	return entry
+}
+
+// 最近的工具调用，支持 ?tool= 和 ?errors=true 过滤
+func handleHistory(w http.ResponseWriter, r *http.Request) {
+	errorsOnly, _ := strconv.ParseBool(r.URL.Query().Get("errors"))
+	entries := history.List(r.URL.Query().Get("tool"), errorsOnly)
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"count":   len(entries),
+		"entries": entries,
+	})
+}
+
+// 按请求ID返回完整的调用记录，包括响应预览
+func handleHistoryEntry(w http.ResponseWriter, r *http.Request) {
+	entry, ok := history.Get(r.PathValue("requestId"))
+	if !ok {
+		http.Error(w, "Request not found in history", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, entry)
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// 编辑器空闲等待: Unity编译、导入资源或重载脚本域期间发出的请求会失败或被中断
+// editor_wait_for_idle 轮询 editor_get_busy_state 直到编辑器连续两次报告空闲 (编译通常在写入后的下一帧才开始)，
+// 轮询期间的通信失败视为域重载中
+// -auto-wait-for-idle 开启时，日志转发循环同时轮询忙碌状态，修改类工具在编辑器忙碌时先等待
+
+// idlePollInterval 等待空闲时轮询Unity的间隔
+const idlePollInterval = 500 * time.Millisecond
+
+// idleConfirmations 连续多少次报告空闲才认为编辑器空闲
+const idleConfirmations = 2
+
+// editorBusy 最近一次观察到的编辑器忙碌状态
+var editorBusy busyTracker
+
+// busyTracker 记录编辑器是否忙碌及原因
+type busyTracker struct {
+	mu     sync.Mutex
+	busy   bool
+	reason string
+	since  time.Time
+}
+
+// Set 更新忙碌状态，状态发生变化时返回true
+func (b *busyTracker) Set(busy bool, reason string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	changed := b.busy != busy
+	if changed {
+		b.since = time.Now()
+	}
+	b.busy = busy
+	b.reason = reason
+	return changed
+}
+
+// Busy 返回编辑器是否忙碌及原因
+func (b *busyTracker) Busy() (bool, string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.busy, b.reason
+}
+
+// busyState editor_get_busy_state 的结果
+type busyState struct {
+	Idle              bool
+	Compiling         bool
+	Importing         bool
+	CompileGeneration int
+	ReloadCount       int
+	Reasons           []string
+}
+
+// queryBusyState 调用 editor_get_busy_state，失败时只记录调试日志
+func queryBusyState(ctx context.Context) (*busyState, error) {
+	data, err := queryUnityLevel(ctx, "editor_get_busy_state", map[string]interface{}{}, slog.LevelDebug)
+	if err != nil {
+		return nil, err
+	}
+	m, _ := data.(map[string]interface{})
+	state := &busyState{}
+	state.Idle, _ = m["idle"].(bool)
+	state.Compiling, _ = m["isCompiling"].(bool)
+	state.Importing, _ = m["isImporting"].(bool)
+	generation, _ := m["compileGeneration"].(float64)
+	reloads, _ := m["domainReloadCount"].(float64)
+	state.CompileGeneration = int(generation)
+	state.ReloadCount = int(reloads)
+	reasons, _ := m["busyReasons"].([]interface{})
+	for _, r := range reasons {
+		if s, ok := r.(string); ok {
+			state.Reasons = append(state.Reasons, s)
+		}
+	}
+	return state, nil
+}
+
+// handleWaitForIdle editor_wait_for_idle: 等待编辑器空闲并报告等待期间发生的编译
+func handleWaitForIdle(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "editor_wait_for_idle"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return dryRunResult(ctx, toolName, map[string]interface{}{"action": "editor_get_busy_state"}, idlePollInterval, retryPolicy{}), nil
+	}
+
+	progress := newProgressReporter(ctx, request)
+	stop := make(chan struct{})
+	go progress.Heartbeat(stop)
+	defer close(stop)
+
+	seconds, _ := arguments["maxWaitSeconds"].(int64)
+	outcome := waitForIdle(ctx, time.Duration(seconds)*time.Second)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	progress.Done()
+	return toolSuccessResult(ctx, toolName, outcome), nil
+}
+
+// waitForIdle 等待编辑器空闲，返回等待结果
+// 等待期间完成了编译时附带编译结果 (compile字段)
+func waitForIdle(ctx context.Context, budget time.Duration) map[string]interface{} {
+	log := callInfoFromContext(ctx).Logger()
+	start := time.Now()
+	deadline := start.Add(budget)
+
+	var first, last *busyState
+	var lastErr error
+	sawCompiling, sawImporting, sawUnreachable := false, false, false
+	confirmations := 0
+	for {
+		state, err := queryBusyState(ctx)
+		switch {
+		case err != nil:
+			lastErr = err
+			sawUnreachable = true
+			confirmations = 0
+			log.Debug("Waiting for Unity to become reachable", "error", err.Error())
+		case state.Idle:
+			confirmations++
+		default:
+			confirmations = 0
+			sawCompiling = sawCompiling || state.Compiling
+			sawImporting = sawImporting || state.Importing
+			log.Debug("Unity editor busy", "reasons", strings.Join(state.Reasons, ","))
+		}
+		if state != nil {
+			if first == nil {
+				first = state
+			}
+			last = state
+		}
+
+		idle := confirmations >= idleConfirmations
+		timedOut := !idle && time.Now().Add(idlePollInterval).After(deadline)
+		if idle || timedOut || ctx.Err() != nil {
+			outcome := map[string]interface{}{
+				"idle":            idle,
+				"timedOut":        timedOut,
+				"elapsedMs":       time.Since(start).Milliseconds(),
+				"sawCompiling":    sawCompiling,
+				"sawImporting":    sawImporting,
+				"sawDomainReload": sawUnreachable || (first != nil && last.ReloadCount > first.ReloadCount),
+				"unityReachable":  err == nil,
+			}
+			if last != nil && !idle {
+				outcome["busyReasons"] = last.Reasons
+			}
+			if err != nil {
+				outcome["error"] = lastErr.Error()
+			}
+			if idle {
+				editorBusy.Set(false, "")
+			}
+			// 等待期间完成了编译 (或开始时的状态未知) 时附带最近的编译结果
+			if idle && (sawCompiling || first == nil || last.CompileGeneration > first.CompileGeneration) {
+				if status, err := queryCompileStatus(ctx); err == nil && status.Data["compileSucceeded"] != nil {
+					outcome["compile"] = map[string]interface{}{
+						"compileSucceeded": status.Data["compileSucceeded"],
+						"errors":           status.Data["errors"],
+						"errorCount":       status.Data["errorCount"],
+						"warningCount":     status.Data["warningCount"],
+					}
+				}
+			}
+			if timedOut {
+				log.Warn("Timed out waiting for Unity editor to become idle", "budget", budget.String())
+			}
+			return outcome
+		}
+
+		select {
+		case <-time.After(idlePollInterval):
+		case <-ctx.Done():
+		}
+	}
+}
+
+// autoWaitForIdle -auto-wait-for-idle: 编辑器已知忙碌时，在转发修改类工具之前等待空闲
+// 等待超时不阻止调用，由Unity决定能否执行
+func autoWaitForIdle(ctx context.Context, def *ToolDefinition) {
+	st := stateFromContext(ctx)
+	if def.ReadOnly || !st.Config.AutoWaitForIdle || isDryRun(ctx) {
+		return
+	}
+	busy, reason := editorBusy.Busy()
+	if !busy {
+		return
+	}
+	log := callInfoFromContext(ctx).Logger()
+	log.Info("Unity editor busy, waiting before forwarding", "tool", def.Name, "reason", reason)
+	outcome := waitForIdle(ctx, st.Config.CompileWaitTimeout)
+	log.Info("Finished waiting for Unity editor", "tool", def.Name, "idle", outcome["idle"], "elapsed_ms", outcome["elapsedMs"])
+}
+
+// pollBusyState 由日志转发循环调用，更新编辑器忙碌状态；状态变化时开启日志转发的客户端会收到通知
+func (f *eventForwarder) pollBusyState(timeout time.Duration, notify bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	state, err := queryBusyState(ctx)
+	if err != nil {
+		var actionErr *unityActionError
+		if errors.As(err, &actionErr) {
+			return fmt.Errorf("editor_get_busy_state failed, Unity package may be outdated: %w", err)
+		}
+		return err
+	}
+	reason := strings.Join(state.Reasons, ",")
+	if !editorBusy.Set(!state.Idle, reason) {
+		return nil
+	}
+	debugLog("Unity editor busy state changed: idle=%t reasons=%s", state.Idle, reason)
+	if notify {
+		event := "idle"
+		if !state.Idle {
+			event = "busy"
+		}
+		f.srv.SendNotificationToAllClients("notifications/message", map[string]any{
+			"level":  mcp.LoggingLevelInfo,
+			"logger": "unity.editor",
+			"data":   map[string]interface{}{"event": event, "busyReasons": state.Reasons},
+		})
+	}
+	return nil
+}
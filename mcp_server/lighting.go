@@ -0,0 +1,213 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// 灯光和场景光照的枚举，与Unity的枚举名一致
+var (
+	lightTypes       = []string{"Directional", "Point", "Spot"}
+	lightShadowModes = []string{"None", "Hard", "Soft"}
+	lightModes       = []string{"Realtime", "Mixed", "Baked"}
+	ambientModes     = []string{"Skybox", "Trilight", "Flat"}
+	fogModes         = []string{"Linear", "Exponential", "ExponentialSquared"}
+	lightingScopes   = []string{"active", "all"}
+)
+
+// normalizeLightSetArgs 给出type时检查range/spotAngle是否适用于该类型；未给出时由Unity按当前类型检查
+func normalizeLightSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	lightType, hasType := arguments["type"].(string)
+	if !hasType {
+		return arguments, nil
+	}
+	var problems []string
+	if _, ok := arguments["range"]; ok && lightType == "Directional" {
+		problems = append(problems, "range is only valid for Point and Spot lights")
+	}
+	if _, ok := arguments["spotAngle"]; ok && lightType != "Spot" {
+		problems = append(problems, "spotAngle is only valid for Spot lights")
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid light arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// handleRenderSettingsSet 转发之前确认天空盒材质存在
+func handleRenderSettingsSet(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "render_settings_set"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	if skybox, _ := arguments["skyboxMaterialPath"].(string); skybox != "" {
+		if result, err := checkMaterialAssets(ctx, toolName, []string{skybox}); result != nil || err != nil {
+			return result, err
+		}
+	}
+	return forwardToUnity(ctx, toolName, arguments, request)
+}
+
+// normalizeRenderSettingsArgs 检查天空盒路径、环境光颜色与环境光模式以及雾的距离
+// skyboxMaterialPath为空字符串表示移除天空盒
+func normalizeRenderSettingsArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if skybox, ok := arguments["skyboxMaterialPath"].(string); ok && skybox != "" &&
+		!strings.HasPrefix(skybox, "Assets/") && !strings.HasPrefix(skybox, "Packages/") {
+		return nil, fmt.Errorf("skyboxMaterialPath must be a material path under Assets/ or Packages/, or empty to remove the skybox, got %q", skybox)
+	}
+
+	var problems []string
+	if mode, ok := arguments["ambientMode"].(string); ok {
+		if _, has := arguments["ambientColor"]; has && mode != "Flat" {
+			problems = append(problems, "ambientColor is only used with ambientMode Flat")
+		}
+		for _, name := range []string{"ambientSkyColor", "ambientEquatorColor", "ambientGroundColor"} {
+			if _, has := arguments[name]; has && mode != "Trilight" {
+				problems = append(problems, name+" is only used with ambientMode Trilight")
+			}
+		}
+		if _, has := arguments["ambientIntensity"]; has && mode != "Skybox" {
+			problems = append(problems, "ambientIntensity is only used with ambientMode Skybox")
+		}
+	}
+	if mode, ok := arguments["fogMode"].(string); ok {
+		_, hasStart := arguments["fogStartDistance"]
+		_, hasEnd := arguments["fogEndDistance"]
+		if (hasStart || hasEnd) && mode != "Linear" {
+			problems = append(problems, "fogStartDistance/fogEndDistance are only used with fogMode Linear")
+		}
+		if _, has := arguments["fogDensity"]; has && mode == "Linear" {
+			problems = append(problems, "fogDensity is only used with fogMode Exponential or ExponentialSquared")
+		}
+	}
+	start, hasStart := arguments["fogStartDistance"].(float64)
+	end, hasEnd := arguments["fogEndDistance"].(float64)
+	if hasStart && hasEnd && start >= end {
+		problems = append(problems, fmt.Sprintf("fogStartDistance (%v) must be less than fogEndDistance (%v)", start, end))
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid render settings: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// lighting_bake 默认在后台烘焙 (Lightmapping.BakeAsync): 先发送start，再轮询status并转发烘焙进度，
+// 调用被取消 (notifications/cancelled) 或lighting_bake_cancel时取消Unity中的烘焙
+// async=false 时同步烘焙，烘焙期间Unity主线程被占用，无法取消
+
+// lightingBakeTimeout lighting_bake 等待烘焙结束的最长时间
+const lightingBakeTimeout = 2 * time.Hour
+
+// lightingPollInterval 轮询光照烘焙状态的间隔
+const lightingPollInterval = time.Second
+
+// lightingBakeRunning 同一时间只允许一个光照烘焙
+var lightingBakeRunning atomic.Bool
+
+// handleLightingBake 拒绝并发烘焙；同步烘焙直接转发，异步烘焙启动后轮询到结束
+func handleLightingBake(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "lighting_bake"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	if !lightingBakeRunning.CompareAndSwap(false, true) {
+		return toolErrorResult(ctx, errCodeInvalidArguments, "a lighting bake is already running, wait for it or call lighting_bake_cancel", toolName), nil
+	}
+	defer lightingBakeRunning.Store(false)
+	if async, _ := arguments["async"].(bool); !async {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	log := callInfoFromContext(ctx).Logger()
+
+	progress := newProgressReporter(ctx, request)
+	stop := make(chan struct{})
+	go progress.Heartbeat(stop)
+	defer close(stop)
+
+	params := map[string]interface{}{"operation": "start"}
+	for _, name := range []string{"clear", "scope"} {
+		if v, ok := arguments[name]; ok {
+			params[name] = v
+		}
+	}
+	data, err := queryUnity(ctx, toolName, params)
+	if err != nil {
+		return unityErrorResult(ctx, toolName, err)
+	}
+	status, ok := data.(map[string]interface{})
+	if !ok {
+		return toolErrorResult(ctx, errCodeUnityToolFailed, "unity returned no lighting bake status", toolName), nil
+	}
+	log.Info("Lighting bake started", "scenes", status["scenes"])
+
+	deadline := time.Now().Add(lightingBakeTimeout)
+	for {
+		if state, _ := status["status"].(string); state != "running" {
+			break
+		}
+		if time.Now().After(deadline) {
+			log.Warn("Lighting bake timed out, canceling", "timeout", lightingBakeTimeout.String())
+			cancelLightingBake(slog.LevelWarn)
+			return toolErrorResult(ctx, errCodeUnityToolFailed, fmt.Sprintf("lighting bake did not finish within %s and was canceled", lightingBakeTimeout), toolName), nil
+		}
+		select {
+		case <-time.After(lightingPollInterval):
+		case <-ctx.Done():
+			log.Info("Lighting bake call canceled, canceling the bake in Unity")
+			cancelLightingBake(slog.LevelWarn)
+			return nil, ctx.Err()
+		}
+
+		latest, err := queryUnityLevel(ctx, toolName, map[string]interface{}{"operation": "status"}, slog.LevelDebug)
+		switch {
+		case isUnityActionError(err):
+			return unityErrorResult(ctx, toolName, err)
+		case err != nil:
+			log.Debug("Waiting for Unity during lighting bake", "error", err.Error())
+		default:
+			if m, ok := latest.(map[string]interface{}); ok {
+				status = m
+				if fraction, ok := status["progress"].(float64); ok {
+					// 留出最后一步给Done
+					progress.Report(fraction*0.99, fmt.Sprintf("baking lighting %d%%", int(fraction*100)))
+				}
+			}
+		}
+	}
+
+	if state, _ := status["status"].(string); state != "succeeded" {
+		log.Warn("Lighting bake did not complete", "status", state)
+		return toolErrorResult(ctx, errCodeUnityToolFailed, fmt.Sprintf("lighting bake %s", state), toolName), nil
+	}
+	progress.Done()
+	log.Info("Lighting bake finished", "duration_ms", status["durationMs"], "lightmaps", status["lightmapCount"])
+	return toolSuccessResult(ctx, toolName, status), nil
+}
+
+// handleLightingBakeCancel 取消Unity中正在进行的后台烘焙，等待中的lighting_bake调用随后以canceled结束
+func handleLightingBakeCancel(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "lighting_bake_cancel"
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, "lighting_bake", map[string]interface{}{"operation": "cancel"}, request)
+	}
+	data, err := queryUnity(ctx, "lighting_bake", map[string]interface{}{"operation": "cancel"})
+	if err != nil {
+		return unityErrorResult(ctx, toolName, err)
+	}
+	return toolSuccessResult(ctx, toolName, data), nil
+}
+
+// cancelLightingBake 取消后台烘焙；调用方的ctx可能已取消，因此使用独立的超时
+func cancelLightingBake(failLevel slog.Level) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	queryUnityLevel(ctx, "lighting_bake", map[string]interface{}{"operation": "cancel"}, failLevel)
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+)
+
+// LevelTrace 比debug更详细的级别，用于逐字节的通信细节
+const LevelTrace = slog.Level(-8)
+
+// 共享日志器，traceLog/debugLog/infoLog/warnLog/errorLog 都是它的薄封装
+// logLevel可以在运行时修改 (PUT /loglevel)
+var (
+	logLevel = new(slog.LevelVar)
+	logger   = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{Level: logLevel}))
+)
+
+// parseLogLevel 解析日志级别名称
+func parseLogLevel(name string) (slog.Level, error) {
+	switch strings.ToLower(name) {
+	case "error":
+		return slog.LevelError, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "info":
+		return slog.LevelInfo, nil
+	case "debug":
+		return slog.LevelDebug, nil
+	case "trace":
+		return LevelTrace, nil
+	}
+	return slog.LevelInfo, fmt.Errorf("unknown log level %q (expected error, warn, info, debug or trace)", name)
+}
+
+// levelName 返回日志级别名称
+func levelName(level slog.Level) string {
+	if level <= LevelTrace {
+		return "trace"
+	}
+	return strings.ToLower(level.String())
+}
+
+// logEnabled 判断指定级别是否会输出
+func logEnabled(level slog.Level) bool {
+	return logger.Enabled(context.Background(), level)
+}
+
+// setupLogger 按格式 (text/json) 初始化共享日志器，json模式每行一个事件
+func setupLogger(format string, w io.Writer) error {
+	opts := &slog.HandlerOptions{
+		Level: logLevel,
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			// 将trace级别显示为TRACE而不是DEBUG-4
+			if a.Key == slog.LevelKey && len(groups) == 0 {
+				if level, ok := a.Value.Any().(slog.Level); ok && level <= LevelTrace {
+					a.Value = slog.StringValue("TRACE")
+				}
+			}
+			return a
+		},
+	}
+
+	var handler slog.Handler
+	switch format {
+	case "text":
+		handler = slog.NewTextHandler(w, opts)
+	case "json":
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return fmt.Errorf("unknown log format %q (expected text or json)", format)
+	}
+
+	logger = slog.New(handler)
+	slog.SetDefault(logger)
+	return nil
+}
+
+// Trace日志函数，仅在trace级别输出逐字节的通信细节
+func traceLog(format string, args ...interface{}) {
+	if logEnabled(LevelTrace) {
+		logger.Log(context.Background(), LevelTrace, fmt.Sprintf(format, args...))
+	}
+}
+
+// Debug日志函数
+func debugLog(format string, args ...interface{}) {
+	if logEnabled(slog.LevelDebug) {
+		logger.Debug(fmt.Sprintf(format, args...))
+	}
+}
+
+func infoLog(format string, args ...interface{}) {
+	logger.Info(fmt.Sprintf(format, args...))
+}
+
+func warnLog(format string, args ...interface{}) {
+	logger.Warn(fmt.Sprintf(format, args...))
+}
+
+func errorLog(format string, args ...interface{}) {
+	logger.Error(fmt.Sprintf(format, args...))
+}
+
+// payloadLimitBytes 日志中单个负载的最大字节数，0表示不限制 (trace级别始终输出完整负载)
+// 可以在运行时重新加载，因此使用原子变量
+var payloadLimitBytes atomic.Int64
+
+func init() {
+	payloadLimitBytes.Store(2048)
+}
+
+// payloadLimit 返回当前的负载限制
+func payloadLimit() int {
+	return int(payloadLimitBytes.Load())
+}
+
+// setPayloadLimit 修改负载限制
+func setPayloadLimit(limit int) {
+	payloadLimitBytes.Store(int64(limit))
+}
+
+// summarizePayload 将参数/响应转换为适合写入日志的字符串
+// 超过payloadLimit时截断，map类型额外输出键列表
+func summarizePayload(data interface{}) string {
+	if logEnabled(LevelTrace) {
+		return formatJSON(data)
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return truncatePayload([]byte(fmt.Sprintf("%v", data)))
+	}
+	if limit := payloadLimit(); limit <= 0 || len(raw) <= limit {
+		return string(raw)
+	}
+
+	summary := truncatePayload(raw)
+	if m, ok := data.(map[string]interface{}); ok {
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		summary = fmt.Sprintf("keys=[%s] %s", strings.Join(keys, ", "), summary)
+	}
+	return summary
+}
+
+// truncatePayload 截断原始负载，保留前payloadLimit字节并注明总长度；trace级别不截断
+func truncatePayload(raw []byte) string {
+	if logEnabled(LevelTrace) {
+		return string(raw)
+	}
+	return truncateBytes(raw, payloadLimit())
+}
+
+// truncateBytes 保留前limit字节并注明总长度，limit<=0表示不截断
+func truncateBytes(raw []byte, limit int) string {
+	if limit <= 0 || len(raw) <= limit {
+		return string(raw)
+	}
+
+	// 避免截断在UTF-8字符中间
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(raw[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s...(truncated, %d bytes total)", raw[:cut], len(raw))
+}
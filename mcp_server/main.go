@@ -5,14 +5,21 @@ Only English logs are allowed.
 */
 
 import (
+	"context"
+	"crypto/subtle"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
-	"log"
+	"io"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -20,75 +27,176 @@ import (
 	"github.com/mark3labs/mcp-go/server"
 )
 
-// 服务器配置
-type ServerConfig struct {
-	Port      string
-	UnityHost string
-	UnityPort string
-}
-
 // 全局变量
+// config为启动时的配置，可热更新的设置应通过currentState()读取
 var (
 	config      ServerConfig
 	unityClient *UnityTCPClient
-	debugMode   bool
+	logFile     *rotatingFile
 )
 
 func main() {
-	// 解析命令行参数
+	// 解析命令行参数和配置文件
+	configPath, showVersion := defineFlags(flag.CommandLine)
+	flag.Parse()
+
+	if *showVersion {
+		fmt.Println(versionString())
+		return
+	}
+
 	var (
-		port      = flag.String("port", "13000", "MCP server port")
-		unityHost = flag.String("unity-host", "localhost", "Unity TCP server host")
-		unityPort = flag.String("unity-port", "12000", "Unity TCP server port")
-		debug     = flag.Bool("debug", false, "Enable debug mode with verbose logging")
+		envKeys  []string
+		warnings []string
+		err      error
 	)
-	flag.Parse()
+	config, envKeys, warnings, err = loadConfig(flag.CommandLine, *configPath)
+	if err != nil {
+		errorLog("Invalid configuration: %v", err)
+		os.Exit(1)
+	}
 
-	debugMode = *debug
+	var logOutput io.Writer = os.Stderr
+	if config.LogFile != "" {
+		logFile, err = openRotatingFile(config.LogFile, config.LogMaxSizeMB, config.LogMaxBackups)
+		if err != nil {
+			errorLog("Cannot open log file (check -log-file): %v", err)
+			os.Exit(1)
+		}
+		logOutput = io.MultiWriter(os.Stderr, logFile)
+	}
+	if err := setupLogger(config.LogFormat, logOutput); err != nil {
+		errorLog("Failed to set up logger: %v", err)
+		os.Exit(1)
+	}
 
-	config = ServerConfig{
-		Port:      *port,
-		UnityHost: *unityHost,
-		UnityPort: *unityPort,
+	// 应用可热更新的设置 (日志级别、负载限制、审计日志、工具策略等)
+	if err := applyConfig(config); err != nil {
+		errorLog("Failed to apply configuration: %v", err)
+		os.Exit(1)
+	}
+	for _, warning := range warnings {
+		warnLog("%s", warning)
+	}
+	logEffectiveConfig(config, envKeys)
+
+	if config.AuditLog != "" {
+		scope := "mutating"
+		if config.AuditAll {
+			scope = "all"
+		}
+		infoLog("Auditing %s tool calls to %s", scope, config.AuditLog)
 	}
 
 	// 初始化Unity TCP客户端
-	unityClient = NewUnityTCPClient(config.UnityHost, config.UnityPort)
+	unityClient = NewUnityTCPClient(config.UnityHost, config.UnityPort, config.Timeout, config.MaxResponseSize)
 
 	// 创建MCP服务器
-	mcpServer := server.NewMCPServer("unity-mcp-server", "1.0.0")
+	mcpServer := server.NewMCPServer("unity-mcp-server", version,
+		server.WithHooks(newServerHooks()),
+		server.WithToolFilter(hideDeniedTools),
+		server.WithLogging())
+	mcpServer.AddNotificationHandler("notifications/cancelled", handleCancelledNotification)
 
-	// 注册工具处理器
+	// 注册工具处理器、资源和提示词
 	registerTools(mcpServer)
+	registerResources(mcpServer)
+	registerPrompts(mcpServer)
+	startEventForwarder(mcpServer)
 
-	// 创建SSE服务器 (mcp-go库自带完整的HTTP服务器)
-	baseURL := fmt.Sprintf("http://localhost:%s", config.Port)
-	sseServer := server.NewSSEServer(mcpServer, baseURL)
+	// 创建SSE服务器，其处理器挂载到我们自己的mux上
+	baseURL := fmt.Sprintf("http://%s", net.JoinHostPort(advertisedHost(config.Bind), config.Port))
+	sseServer := server.NewSSEServer(mcpServer, server.WithBaseURL(baseURL))
 
-	// 创建辅助HTTP服务器用于管理端点 (/health, /tools)
-	// 注: SSE服务器由mcp-go库管理，无法与其他HTTP端点合并到同一服务器
-	// 这是因为mcp-go的SSEServer.Start()方法会创建并启动自己的HTTP服务器
+	// 主服务器: SSE端点，统一模式下同时挂载管理端点
 	mux := http.NewServeMux()
-	mux.HandleFunc("/health", withLogging(handleHealth, "/health"))
-	mux.HandleFunc("/tools", withLogging(handleListTools, "/tools"))
+	mux.Handle("/sse", sseServer.SSEHandler())
+	mux.Handle("/message", sseServer.MessageHandler())
+
+	// 管理端点 (/health, /healthz, /readyz, /tools, /prompts, /loglevel, /status, /reconnect, /history)
+	managementMux := mux
+	if config.DualPort {
+		managementMux = http.NewServeMux()
+	}
+	if !config.NoManagement {
+		managementMux.HandleFunc("/health", managementHandler(handleHealth, "/health"))
+		managementMux.HandleFunc("/healthz", managementHandler(handleHealthz, "/healthz"))
+		managementMux.HandleFunc("/readyz", managementHandler(handleReadyz, "/readyz"))
+		managementMux.HandleFunc("/tools", managementHandler(handleListTools, "/tools"))
+		managementMux.HandleFunc("/prompts", managementHandler(handleListPrompts, "/prompts"))
+		managementMux.HandleFunc("/loglevel", managementHandler(handleLogLevel, "/loglevel"))
+		managementMux.HandleFunc("/status", managementHandler(handleStatus, "/status"))
+		managementMux.HandleFunc("/reconnect", managementHandler(handleReconnect, "/reconnect"))
+		managementMux.HandleFunc("/history", managementHandler(handleHistory, "/history"))
+		managementMux.HandleFunc("/history/{requestId}", managementHandler(handleHistoryEntry, "/history/{requestId}"))
+
+		// 管理端点对其他主机可见且未设置token时给出警告
+		if config.ManagementToken == "" && !isLoopbackHost(config.Bind) {
+			warnLog("Management endpoints are reachable from other hosts without authentication; set -management-token to protect them")
+		}
 
-	if debugMode {
-		infoLog("Debug mode enabled")
 	}
 
-	// 计算管理端口 (SSE端口 + 1)
-	managementPort := fmt.Sprintf("%d", mustParseInt(config.Port)+1)
+	infoLog("Log level: %s", levelName(logLevel.Level()))
 
 	infoLog("Unity MCP server starting...")
+	infoLog("Version: %s", versionString())
 	infoLog("Unity connection target: %s:%s", config.UnityHost, config.UnityPort)
+	if config.ReadOnly {
+		infoLog("Read-only mode: mutating tools are disabled")
+	}
+	if config.DryRun {
+		warnLog("Dry-run mode: tool calls return the Unity message without contacting Unity")
+	}
+	infoLog("Unity retry policy: %s (read-only tools only)", defaultRetryPolicy(config))
+	if config.ForwardEvents {
+		infoLog("Forwarding Unity Console logs to MCP clients every %v", config.EventPollInterval)
+	}
+	if !isLoopbackHost(config.Bind) {
+		warnLog("==================================================================")
+		warnLog("Listening on %s exposes Unity project control to the network", config.Bind)
+		if config.ManagementToken == "" {
+			warnLog("Consider -bind 127.0.0.1 or protect management endpoints with -management-token")
+		}
+		warnLog("==================================================================")
+	}
 	infoLog("Server architecture:")
-	infoLog("  ┌─ Port %s (Main)", config.Port)
-	infoLog("  └─ SSE /sse        - MCP SSE endpoint (managed by mcp-go library)")
-	infoLog("  ┌─ Port %v (Management)", managementPort)
-	infoLog("  ├─ GET /health     - Health check")
-	infoLog("  └─ GET /tools      - Tool list")
+	switch {
+	case config.NoManagement:
+		infoLog("  ┌─ Port %s", config.Port)
+		infoLog("  ├─ GET  /sse       - MCP SSE endpoint")
+		infoLog("  └─ POST /message   - MCP message endpoint")
+		infoLog("  Management endpoints disabled (-no-management)")
+	case config.DualPort:
+		infoLog("  ┌─ Port %s (Main)", config.Port)
+		infoLog("  ├─ GET  /sse       - MCP SSE endpoint")
+		infoLog("  └─ POST /message   - MCP message endpoint")
+		infoLog("  ┌─ Port %s (Management)", config.ManagementPort)
+		infoLog("  ├─ GET /health     - Health check")
+		infoLog("  ├─ GET /healthz    - Liveness probe")
+		infoLog("  ├─ GET /readyz     - Readiness probe")
+		infoLog("  ├─ GET /tools      - Tool list")
+		infoLog("  ├─ GET /prompts    - Prompt list")
+		infoLog("  ├─ GET /status     - Per-tool statistics")
+		infoLog("  ├─ GET /history    - Recent tool calls")
+		infoLog("  ├─ PUT /loglevel   - Change log level at runtime")
+		infoLog("  └─ POST /reconnect - Reconnect to Unity")
+		infoLog("Note: -dual-port is deprecated and will be removed in the next release")
+	default:
+		infoLog("  ┌─ Port %s", config.Port)
+		infoLog("  ├─ GET  /sse       - MCP SSE endpoint")
+		infoLog("  ├─ POST /message   - MCP message endpoint")
+		infoLog("  ├─ GET  /health    - Health check")
+		infoLog("  ├─ GET  /healthz   - Liveness probe")
+		infoLog("  ├─ GET  /readyz    - Readiness probe")
+		infoLog("  ├─ GET  /tools     - Tool list")
+		infoLog("  ├─ GET  /prompts   - Prompt list")
+		infoLog("  ├─ GET  /status    - Per-tool statistics")
+		infoLog("  ├─ GET  /history   - Recent tool calls")
+		infoLog("  ├─ PUT  /loglevel  - Change log level at runtime")
+		infoLog("  └─ POST /reconnect - Reconnect to Unity")
+	}
 	infoLog("")
-	infoLog("Note: Due to limitations in the mcp-go library, the SSE server must run independently")
 
 	// 设置优雅关闭
 	go func() {
@@ -99,353 +207,78 @@ func main() {
 		if unityClient != nil {
 			unityClient.Close()
 		}
+		if logFile != nil {
+			logFile.Close()
+		}
+		audit.Close()
 		os.Exit(0)
 	}()
 
-	// 启动管理HTTP服务器在后台
+	// SIGHUP: 重新打开日志文件 (配合外部日志轮转)，并重新加载配置文件
 	go func() {
-		infoLog("Starting management HTTP server on port %s", managementPort)
-		if err := http.ListenAndServe(":"+managementPort, mux); err != nil {
-			errorLog("Management HTTP server error: %v", err)
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGHUP)
+		for range c {
+			if logFile != nil {
+				if err := logFile.Reopen(); err != nil {
+					errorLog("Failed to reopen log file: %v", err)
+				} else {
+					infoLog("Log file reopened")
+				}
+			}
+			reloadConfig(mcpServer, flag.CommandLine, *configPath)
 		}
 	}()
 
-	// 启动SSE服务器 (这会阻塞)
-	infoLog("Starting SSE server on port %s", config.Port)
-	if err := sseServer.Start(":" + config.Port); err != nil {
-		errorLog("Failed to start SSE server: %v", err)
-		os.Exit(1)
+	// 兼容模式: 管理端点运行在独立端口 (先同步监听，端口被占用时直接退出)
+	if config.DualPort && !config.NoManagement {
+		infoLog("Starting management HTTP server on %s", managementAddr())
+		listener, err := net.Listen("tcp", managementAddr())
+		if err != nil {
+			errorLog("Failed to listen on management port %s (change it with -management-port or disable with -no-management): %v",
+				config.ManagementPort, err)
+			os.Exit(1)
+		}
+		go func() {
+			if err := http.Serve(listener, managementMux); err != nil {
+				errorLog("Management HTTP server error: %v", err)
+			}
+		}()
 	}
-}
-
-// 注册所有Unity工具
-func registerTools(s *server.MCPServer) {
-	// 注册脚本读取工具
-	s.AddTool(
-		mcp.NewTool("script_read",
-			mcp.WithDescription("Read script file content from Unity project"),
-			mcp.WithString("path", mcp.Description("Script file path to read (relative to Assets directory)"), mcp.Required()),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("script_read", arguments)
-		},
-	)
-
-	// 注册脚本写入工具
-	s.AddTool(
-		mcp.NewTool("script_write",
-			mcp.WithDescription("Create or update script file in Unity project"),
-			mcp.WithString("path", mcp.Description("Script file path (relative to Assets directory)"), mcp.Required()),
-			mcp.WithString("content", mcp.Description("Script file content"), mcp.Required()),
-			mcp.WithBoolean("overwrite", mcp.Description("Whether to overwrite existing file"), mcp.DefaultBool(true)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("script_write", arguments)
-		},
-	)
-
-	// 注册场景获取工具
-	s.AddTool(
-		mcp.NewTool("scene_get",
-			mcp.WithDescription("Get Unity current scene hierarchy data"),
-			mcp.WithBoolean("includeComponents", mcp.Description("Whether to include component information"), mcp.DefaultBool(false)),
-			mcp.WithBoolean("includeTransform", mcp.Description("Whether to include Transform information"), mcp.DefaultBool(true)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_get", arguments)
-		},
-	)
-
-	// 注册场景创建对象工具
-	s.AddTool(
-		mcp.NewTool("scene_create_object",
-			mcp.WithDescription("Create new GameObject in Unity scene"),
-			mcp.WithString("name", mcp.Description("GameObject name"), mcp.DefaultString("New GameObject")),
-			mcp.WithNumber("parentId", mcp.Description("Parent object's InstanceID")),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_create_object", arguments)
-		},
-	)
-
-	// 注册场景对象添加组件工具
-	s.AddTool(
-		mcp.NewTool("scene_object_add_component",
-			mcp.WithDescription("Add component to GameObject in Unity scene"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-			mcp.WithString("componentType", mcp.Description("Component type name to add"), mcp.Required()),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_object_add_component", arguments)
-		},
-	)
-
-	// 注册Transform获取工具
-	s.AddTool(
-		mcp.NewTool("scene_transform_get",
-			mcp.WithDescription("Get Transform information of GameObject in Unity scene"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-			mcp.WithBoolean("worldSpace", mcp.Description("Whether to use world coordinate system"), mcp.DefaultBool(true)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_transform_get", arguments)
-		},
-	)
-
-	// 注册Transform设置工具
-	s.AddTool(
-		mcp.NewTool("scene_transform_set",
-			mcp.WithDescription("Set Transform information of GameObject in Unity scene"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_transform_set", arguments)
-		},
-	)
-
-	// =================== UI工具 ===================
-	
-	// 注册UI RectTransform设置工具
-	s.AddTool(
-		mcp.NewTool("ui_rect_transform_set",
-			mcp.WithDescription("Set UI element RectTransform properties (position, size, anchors)"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("ui_rect_transform_set", arguments)
-		},
-	)
-
-	// 注册UI RectTransform获取工具
-	s.AddTool(
-		mcp.NewTool("ui_rect_transform_get",
-			mcp.WithDescription("Get UI element RectTransform information"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-			mcp.WithBoolean("includeWorldSpace", mcp.Description("Whether to include world space information"), mcp.DefaultBool(true)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("ui_rect_transform_get", arguments)
-		},
-	)
-
-	// 注册UI Image组件工具
-	s.AddTool(
-		mcp.NewTool("ui_image_set",
-			mcp.WithDescription("Set UI Image component properties (sprite, color, material)"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("ui_image_set", arguments)
-		},
-	)
-
-	// 注册UI Text组件工具
-	s.AddTool(
-		mcp.NewTool("ui_text_set",
-			mcp.WithDescription("Set UI Text component properties (text content, font, color)"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("ui_text_set", arguments)
-		},
-	)
-
-	// =================== 资源管理工具 ===================
-	
-	// 注册资源查找工具
-	s.AddTool(
-		mcp.NewTool("asset_find",
-			mcp.WithDescription("Find project assets by conditions (path, type, name)"),
-			mcp.WithString("path", mcp.Description("Search path relative to Assets directory"), mcp.DefaultString("Assets")),
-			mcp.WithString("type", mcp.Description("Asset type name (Texture2D, AudioClip, etc.)")),
-			mcp.WithString("name", mcp.Description("Asset name (supports wildcards)")),
-			mcp.WithString("extension", mcp.Description("File extension")),
-			mcp.WithBoolean("recursive", mcp.Description("Whether to search subdirectories"), mcp.DefaultBool(true)),
-			mcp.WithNumber("maxResults", mcp.Description("Maximum number of results")),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("asset_find", arguments)
-		},
-	)
 
-	// 注册资源信息获取工具
-	s.AddTool(
-		mcp.NewTool("asset_get_info",
-			mcp.WithDescription("Get detailed asset information (metadata, import settings)"),
-			mcp.WithString("assetPath", mcp.Description("Asset path"), mcp.Required()),
-			mcp.WithBoolean("includeMetadata", mcp.Description("Whether to include metadata"), mcp.DefaultBool(true)),
-			mcp.WithBoolean("includeImportSettings", mcp.Description("Whether to include import settings"), mcp.DefaultBool(false)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("asset_get_info", arguments)
-		},
-	)
-
-	// 注册资源依赖关系工具
-	s.AddTool(
-		mcp.NewTool("asset_get_dependencies",
-			mcp.WithDescription("Get asset dependency relationships"),
-			mcp.WithString("assetPath", mcp.Description("Asset path"), mcp.Required()),
-			mcp.WithBoolean("recursive", mcp.Description("Whether to get dependencies recursively"), mcp.DefaultBool(false)),
-			mcp.WithBoolean("includeImplicit", mcp.Description("Whether to include implicit dependencies"), mcp.DefaultBool(true)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("asset_get_dependencies", arguments)
-		},
-	)
-
-	// 注册项目结构工具
-	s.AddTool(
-		mcp.NewTool("project_get_structure",
-			mcp.WithDescription("Get project directory structure and statistics"),
-			mcp.WithString("rootPath", mcp.Description("Root directory path"), mcp.DefaultString("Assets")),
-			mcp.WithNumber("maxDepth", mcp.Description("Maximum directory depth")),
-			mcp.WithBoolean("includeFiles", mcp.Description("Whether to include files"), mcp.DefaultBool(true)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("project_get_structure", arguments)
-		},
-	)
-
-	// =================== 扩展Prefab工具 ===================
-	
-	// 注册预制体创建工具
-	s.AddTool(
-		mcp.NewTool("prefab_create",
-			mcp.WithDescription("Create prefab from scene GameObject"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-			mcp.WithString("prefabPath", mcp.Description("Prefab save path"), mcp.Required()),
-			mcp.WithBoolean("overwrite", mcp.Description("Whether to overwrite existing prefab"), mcp.DefaultBool(false)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("prefab_create", arguments)
-		},
-	)
-
-	// 注册预制体信息工具
-	s.AddTool(
-		mcp.NewTool("prefab_get_info",
-			mcp.WithDescription("Get detailed prefab information"),
-			mcp.WithString("prefabPath", mcp.Description("Prefab asset path")),
-			mcp.WithNumber("instanceId", mcp.Description("Prefab instance ID")),
-			mcp.WithBoolean("includeInstances", mcp.Description("Whether to include scene instances"), mcp.DefaultBool(false)),
-			mcp.WithBoolean("includeVariants", mcp.Description("Whether to include variant information"), mcp.DefaultBool(false)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("prefab_get_info", arguments)
-		},
-	)
-
-	// 注册预制体修改工具
-	s.AddTool(
-		mcp.NewTool("prefab_modify",
-			mcp.WithDescription("Manage prefab instance modifications"),
-			mcp.WithNumber("instanceId", mcp.Description("Prefab instance ID"), mcp.Required()),
-			mcp.WithString("operation", mcp.Description("Operation type (apply/revert/unpack/disconnect/check_overrides)"), mcp.Required()),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("prefab_modify", arguments)
-		},
-	)
-
-	// =================== 场景管理工具 ===================
-	
-	// 注册场景保存工具
-	s.AddTool(
-		mcp.NewTool("scene_save",
-			mcp.WithDescription("Save current or specified scene"),
-			mcp.WithString("scenePath", mcp.Description("Scene file path to save")),
-			mcp.WithBoolean("saveAsNew", mcp.Description("Whether to save as new file"), mcp.DefaultBool(false)),
-			mcp.WithBoolean("saveAll", mcp.Description("Whether to save all open scenes"), mcp.DefaultBool(false)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_save", arguments)
-		},
-	)
-
-	// 注册场景加载工具
-	s.AddTool(
-		mcp.NewTool("scene_load",
-			mcp.WithDescription("Load specified scene file"),
-			mcp.WithString("scenePath", mcp.Description("Scene file path to load"), mcp.Required()),
-			mcp.WithString("loadMode", mcp.Description("Load mode (single/additive)"), mcp.DefaultString("single")),
-			mcp.WithBoolean("saveCurrentScene", mcp.Description("Whether to save current scene before loading"), mcp.DefaultBool(true)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_load", arguments)
-		},
-	)
-
-	// 注册场景信息工具
-	s.AddTool(
-		mcp.NewTool("scene_get_info",
-			mcp.WithDescription("Get detailed scene information"),
-			mcp.WithString("scenePath", mcp.Description("Scene file path")),
-			mcp.WithBoolean("includeObjects", mcp.Description("Whether to include object list"), mcp.DefaultBool(false)),
-			mcp.WithBoolean("includeComponents", mcp.Description("Whether to include component analysis"), mcp.DefaultBool(false)),
-			mcp.WithBoolean("analyzePerformance", mcp.Description("Whether to analyze performance"), mcp.DefaultBool(false)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_get_info", arguments)
-		},
-	)
-
-	// 注册场景对象查找工具
-	s.AddTool(
-		mcp.NewTool("scene_find_objects",
-			mcp.WithDescription("Find GameObjects in scene by criteria"),
-			mcp.WithString("name", mcp.Description("Object name to search for")),
-			mcp.WithString("tag", mcp.Description("Object tag to filter by")),
-			mcp.WithString("componentType", mcp.Description("Component type to filter by")),
-			mcp.WithString("layer", mcp.Description("Layer name or number to filter by")),
-			mcp.WithBoolean("activeOnly", mcp.Description("Whether to include only active objects"), mcp.DefaultBool(false)),
-			mcp.WithBoolean("exactMatch", mcp.Description("Whether to use exact name matching"), mcp.DefaultBool(false)),
-			mcp.WithNumber("maxResults", mcp.Description("Maximum number of results")),
-			mcp.WithString("scenePath", mcp.Description("Scene path to search in")),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_find_objects", arguments)
-		},
-	)
-
-	// 注册场景删除对象工具
-	s.AddTool(
-		mcp.NewTool("scene_delete_object",
-			mcp.WithDescription("Delete GameObject from scene"),
-			mcp.WithNumber("instanceId", mcp.Description("GameObject's InstanceID"), mcp.Required()),
-			mcp.WithBoolean("deleteChildren", mcp.Description("Whether to delete children"), mcp.DefaultBool(true)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("scene_delete_object", arguments)
-		},
-	)
-
-	// =================== 其他工具 ===================
-	
-	// 注册Editor日志工具
-	s.AddTool(
-		mcp.NewTool("editor_get_logs",
-			mcp.WithDescription("Read Unity Editor Console logs"),
-			mcp.WithNumber("maxLogs", mcp.Description("Maximum number of logs to retrieve")),
-			mcp.WithString("logLevel", mcp.Description("Log level filter (all/error/warning/log/exception)"), mcp.DefaultString("all")),
-			mcp.WithBoolean("clearLogs", mcp.Description("Whether to clear logs after reading"), mcp.DefaultBool(false)),
-			mcp.WithBoolean("includeStackTrace", mcp.Description("Whether to include stack trace"), mcp.DefaultBool(false)),
-		),
-		func(arguments map[string]interface{}) (*mcp.CallToolResult, error) {
-			return callUnityTool("editor_get_logs", arguments)
-		},
-	)
+	// 启动主HTTP服务器 (这会阻塞)
+	httpServer := &http.Server{
+		Addr:    mainAddr(),
+		Handler: mux,
+	}
+	infoLog("Starting HTTP server on %s", mainAddr())
+	if err := httpServer.ListenAndServe(); err != nil {
+		errorLog("Failed to start HTTP server: %v", err)
+		os.Exit(1)
+	}
 }
 
 // 调用Unity工具的通用函数
-func callUnityTool(toolName string, arguments map[string]interface{}) (*mcp.CallToolResult, error) {
+// ctx被取消时 (客户端取消或会话断开) 立即停止重试，返回ctx.Err()
+// onProgress可以为nil，用于接收Unity发送的进度帧
+func callUnityTool(ctx context.Context, toolName string, arguments map[string]interface{}, onProgress progressFunc) (*mcp.CallToolResult, error) {
 	startTime := time.Now()
-	requestId := fmt.Sprintf("mcp_%s_%d", toolName, time.Now().UnixNano())
+	info := callInfoFromContext(ctx)
+	if info.ID == "" {
+		info.ID = newRequestID()
+	}
+	requestId := info.ID
 
-	infoLog("=== TOOL CALL START ===")
-	infoLog("Tool: %s", toolName)
-	infoLog("Request ID: %s", requestId)
-	infoLog("Arguments: %s", formatJSON(arguments))
+	callLog := info.Logger().With("tool", toolName)
+	callLog.Info("Tool call started", "arguments", summarizePayload(arguments))
+
+	st := stateFromContext(ctx)
+	arguments = withoutArgument(arguments, dryRunArgument)
+	policy, arguments, err := resolveRetryPolicy(st, toolName, arguments)
+	if err != nil {
+		callLog.Error("Invalid retry override", "error", err.Error())
+		return toolErrorResult(ctx, errCodeInvalidArguments, err.Error(), toolName), nil
+	}
 
 	// 构造Unity消息
 	unityMsg := map[string]interface{}{
@@ -454,337 +287,555 @@ func callUnityTool(toolName string, arguments map[string]interface{}) (*mcp.Call
 		"id":        requestId,
 		"timestamp": time.Now().UnixMilli(),
 	}
+	if info.SessionID != "" {
+		unityMsg["sessionId"] = info.SessionID
+	}
+	if info.ProgressToken != "" {
+		unityMsg["progressToken"] = info.ProgressToken
+	}
 
-	debugLog("Unity message payload: %s", formatJSON(unityMsg))
+	traceLog("Unity message payload: %s", summarizePayload(unityMsg))
 
-	// 发送到Unity，如果失败则重试
+	// 发送到Unity，如果失败则按重试策略重试
 	var response map[string]interface{}
-	var err error
 
-	maxRetries := 3
-	debugLog("Starting Unity communication with %d max retries", maxRetries)
+	maxRetries := policy.Attempts()
+	timeout := st.Config.Timeout
+	if def := st.Tools.Lookup(toolName); def != nil && def.TimeoutHint > 0 {
+		timeout = def.TimeoutHint
+	}
+	callLog.Debug("Retry policy", "retries", policy.Retries, "retry_delay", policy.Delay.String())
+
+	// 试运行: 返回构造好的消息，不联系Unity
+	if isDryRun(ctx) {
+		callLog.Info("Dry run, Unity message not sent", "timeout", timeout.String())
+		return dryRunResult(ctx, toolName, unityMsg, timeout, policy), nil
+	}
 
 	for i := 0; i < maxRetries; i++ {
 		attemptStart := time.Now()
-		debugLog("=== UNITY COMMUNICATION ATTEMPT %d/%d ===", i+1, maxRetries)
-		debugLog("Tool: %s, Request ID: %s", toolName, requestId)
-		debugLog("Attempt start time: %s", attemptStart.Format("15:04:05.000"))
+		traceLog("=== UNITY COMMUNICATION ATTEMPT %d/%d ===", i+1, maxRetries)
+		traceLog("Tool: %s, Request ID: %s", toolName, requestId)
+		traceLog("Attempt start time: %s", attemptStart.Format("15:04:05.000"))
 
 		// 检查Unity客户端连接状态
 		if unityClient != nil {
-			if debugMode {
+			if logEnabled(slog.LevelDebug) {
 				isConnected := unityClient.IsConnected()
-				debugLog("Unity client connection status: %t", isConnected)
+				callLog.Debug("Unity request attempt", "attempt", i+1, "max_attempts", maxRetries, "unity_connected", isConnected)
 				if !isConnected {
-					debugLog("Unity client not connected, will attempt to connect during SendMessage")
+					callLog.Debug("Unity client not connected, will attempt to connect during SendMessage")
 				}
 			}
 		}
 
-		response, err = unityClient.SendMessage(unityMsg)
+		response, err = unityClient.SendMessageWithProgress(ctx, unityMsg, timeout, onProgress)
 		attemptDuration := time.Since(attemptStart)
 
+		if ctx.Err() != nil {
+			callLog.Info("Tool call canceled",
+				"attempt", i+1,
+				"duration_ms", time.Since(startTime).Milliseconds(),
+				"reason", ctx.Err().Error())
+			return nil, ctx.Err()
+		}
+
 		if err == nil {
-			debugLog("=== UNITY COMMUNICATION SUCCESS ===")
-			debugLog("Attempt %d succeeded in %v", i+1, attemptDuration)
-			debugLog("Response size: %d bytes", len(formatJSON(response)))
-			if debugMode {
-				debugLog("Raw response preview: %.200s", formatJSON(response))
+			traceLog("=== UNITY COMMUNICATION SUCCESS ===")
+			traceLog("Attempt %d succeeded in %v", i+1, attemptDuration)
+			traceLog("Response size: %d bytes", len(formatJSON(response)))
+			if logEnabled(LevelTrace) {
+				traceLog("Raw response preview: %s", summarizePayload(response))
 			}
 			break
 		}
 
-		errorLog("=== UNITY COMMUNICATION FAILURE ===")
-		errorLog("Attempt %d/%d failed for tool %s", i+1, maxRetries, toolName)
-		errorLog("Attempt duration: %v", attemptDuration)
-		errorLog("Error details: %s", err.Error())
-		errorLog("Unity message that failed: %s", formatJSON(unityMsg))
+		var tooLarge *responseTooLargeError
+		if errors.As(err, &tooLarge) {
+			// 重试只会得到同样大小的响应
+			callLog.Warn("Unity response too large",
+				"attempt", i+1,
+				"size", tooLarge.Size,
+				"limit", tooLarge.Limit)
+			return toolErrorResult(ctx, errCodeResponseTooLarge, responseTooLargeMessage(st.Tools.Lookup(toolName), toolName, tooLarge), toolName), nil
+		}
+
+		callLog.Error("Unity request failed",
+			"attempt", i+1,
+			"max_attempts", maxRetries,
+			"duration_ms", attemptDuration.Milliseconds(),
+			"error", err.Error())
+		traceLog("Unity message that failed: %s", summarizePayload(unityMsg))
 
 		if i < maxRetries-1 {
-			debugLog("Retrying in 1 second...")
-			debugLog("Next attempt will be %d/%d", i+2, maxRetries)
-			time.Sleep(time.Second)
+			callLog.Debug("Retrying", "delay", policy.Delay.String())
+			traceLog("Next attempt will be %d/%d", i+2, maxRetries)
+			select {
+			case <-time.After(policy.Delay):
+			case <-ctx.Done():
+				callLog.Info("Tool call canceled while waiting to retry",
+					"attempt", i+1,
+					"duration_ms", time.Since(startTime).Milliseconds(),
+					"reason", ctx.Err().Error())
+				return nil, ctx.Err()
+			}
 		} else {
-			errorLog("All %d attempts exhausted, giving up", maxRetries)
+			callLog.Error("All attempts exhausted, giving up", "max_attempts", maxRetries)
 		}
 	}
 
 	totalDuration := time.Since(startTime)
 
 	if err != nil {
-		errorLog("Unity communication completely failed for tool %s after %d attempts (total time: %v): %s",
-			toolName, maxRetries, totalDuration, err.Error())
-		infoLog("=== TOOL CALL FAILED ===")
-		return mcp.NewToolResultError(fmt.Sprintf("Unity communication failed after %d attempts: %s", maxRetries, err.Error())), nil
+		callLog.Error("Tool call failed",
+			"duration_ms", totalDuration.Milliseconds(),
+			"attempts", maxRetries,
+			"error", err.Error())
+		return toolErrorResult(ctx, errCodeUnityUnavailable,
+			fmt.Sprintf("Unity communication failed after %d attempts: %s", maxRetries, err.Error()),
+			toolName), nil
 	}
 
-	debugLog("Unity response received: %s", formatJSON(response))
+	traceLog("Unity response received: %s", summarizePayload(response))
 
 	// 解析响应结构
-	debugLog("=== RESPONSE ANALYSIS START ===")
-	debugLog("Full response structure analysis:")
+	traceLog("=== RESPONSE ANALYSIS START ===")
+	traceLog("Full response structure analysis:")
 
 	var responseId, responseData, responseError interface{}
 	responseKeys := make([]string, 0, len(response))
 	for key := range response {
 		responseKeys = append(responseKeys, key)
 	}
-	debugLog("Response contains keys: %v", responseKeys)
+	traceLog("Response contains keys: %v", responseKeys)
 
 	if id, exists := response["id"]; exists {
 		responseId = id
-		debugLog("✓ Response ID found: %v (type: %T)", responseId, responseId)
+		traceLog("✓ Response ID found: %v (type: %T)", responseId, responseId)
 	} else {
-		debugLog("⚠ Response ID not found in response")
+		traceLog("⚠ Response ID not found in response")
 	}
 
 	if data, exists := response["data"]; exists {
 		responseData = data
-		debugLog("✓ Response data found (type: %T)", responseData)
-		if debugMode && responseData != nil {
-			debugLog("Response data preview: %.500s", formatJSON(responseData))
+		traceLog("✓ Response data found (type: %T)", responseData)
+		if logEnabled(LevelTrace) && responseData != nil {
+			traceLog("Response data preview: %s", summarizePayload(responseData))
 		}
 	} else {
-		debugLog("⚠ Response data not found in response")
+		traceLog("⚠ Response data not found in response")
 	}
 
 	if errData, exists := response["error"]; exists {
 		responseError = errData
-		debugLog("⚠ Response error found: %v (type: %T)", responseError, responseError)
+		traceLog("⚠ Response error found: %v (type: %T)", responseError, responseError)
 	} else {
-		debugLog("✓ No error field in response")
+		traceLog("✓ No error field in response")
 	}
 
 	// 检查success字段
 	if success, exists := response["success"]; exists {
-		debugLog("✓ Success field found: %v (type: %T)", success, success)
+		traceLog("✓ Success field found: %v (type: %T)", success, success)
 	} else {
-		debugLog("⚠ Success field not found in response")
+		traceLog("⚠ Success field not found in response")
 	}
 
-	debugLog("=== RESPONSE ANALYSIS END ===")
+	traceLog("=== RESPONSE ANALYSIS END ===")
 
 	// 处理Unity响应
-	debugLog("=== RESPONSE PROCESSING START ===")
+	traceLog("=== RESPONSE PROCESSING START ===")
 	if success, ok := response["success"].(bool); ok && success {
-		debugLog("✓ Success field validation passed: %t", success)
+		traceLog("✓ Success field validation passed: %t", success)
 
 		data := response["data"]
 		if data == nil {
-			debugLog("⚠ Response data is nil, using empty map")
+			traceLog("⚠ Response data is nil, using empty map")
 			data = map[string]interface{}{}
 		} else {
-			debugLog("✓ Response data is valid, type: %T", data)
+			traceLog("✓ Response data is valid, type: %T", data)
 		}
 
-		infoLog("=== TOOL CALL SUCCESS ===")
-		infoLog("Tool: %s", toolName)
-		infoLog("Request ID: %s", requestId)
-		infoLog("Total execution time: %v", totalDuration)
-		infoLog("Success: Tool executed successfully")
-		debugLog("Final response data: %s", formatJSON(data))
+		if def := st.Tools.Lookup(toolName); def != nil && def.Inspect != nil {
+			if m, ok := data.(map[string]interface{}); ok {
+				def.Inspect(m)
+			}
+		}
 
-		// 创建结果文本
-		resultText := fmt.Sprintf("Tool %s executed successfully:\n%s", toolName, formatJSON(data))
-		debugLog("Result text length: %d characters", len(resultText))
+		callLog.Info("Tool call succeeded", "duration_ms", totalDuration.Milliseconds())
+		callLog.Debug("Unity response data", "data", summarizePayload(data))
 
-		return mcp.NewToolResultText(resultText), nil
+		result := toolSuccessResult(ctx, toolName, data)
+		if attachment, ok := response[binaryAttachmentKey].(binaryAttachment); ok {
+			mimeType, _ := response[binaryMimeTypeKey].(string)
+			callLog.Debug("Unity response has binary attachment", "bytes", len(attachment), "mime_type", mimeType)
+			addBinaryContent(result, toolName, attachment, mimeType)
+		}
+		return result, nil
 	} else {
-		debugLog("✗ Success field validation failed")
+		traceLog("✗ Success field validation failed")
 		if !ok {
-			debugLog("Success field type assertion failed, value: %v (type: %T)", response["success"], response["success"])
+			traceLog("Success field type assertion failed, value: %v (type: %T)", response["success"], response["success"])
 		} else {
-			debugLog("Success field is false: %t", success)
+			traceLog("Success field is false: %t", success)
 		}
 
 		errorMsg := "unknown error"
 		if errStr, ok := response["error"].(string); ok {
 			errorMsg = errStr
-			debugLog("✓ Error message extracted from response: %s", errorMsg)
+			traceLog("✓ Error message extracted from response: %s", errorMsg)
 		} else {
-			debugLog("⚠ Could not extract error message from response")
+			traceLog("⚠ Could not extract error message from response")
 			if errField, exists := response["error"]; exists {
-				debugLog("Error field exists but wrong type: %v (type: %T)", errField, errField)
+				traceLog("Error field exists but wrong type: %v (type: %T)", errField, errField)
 				errorMsg = fmt.Sprintf("%v", errField)
 			}
 		}
 
-		errorLog("=== TOOL CALL ERROR ===")
-		errorLog("Tool: %s", toolName)
-		errorLog("Request ID: %s", requestId)
-		errorLog("Total execution time: %v", totalDuration)
-		errorLog("Error: %s", errorMsg)
-		debugLog("Full error response: %s", formatJSON(response))
+		callLog.Error("Tool call returned error",
+			"duration_ms", totalDuration.Milliseconds(),
+			"error", errorMsg)
+		traceLog("Full error response: %s", summarizePayload(response))
 
-		return mcp.NewToolResultError(fmt.Sprintf("Unity tool execution failed: %s", errorMsg)), nil
+		return toolErrorResult(ctx, errCodeUnityToolFailed,
+			fmt.Sprintf("Unity tool execution failed: %s", errorMsg),
+			toolName), nil
 	}
 }
 
-// 健康检查
+// 健康检查 (详细报告)
+// status: healthy=已连接Unity, degraded=未连接但Unity可达, unhealthy=Unity不可达 (返回503)
 func handleHealth(w http.ResponseWriter, r *http.Request) {
 	debugLog("Health check requested")
 
-	// 检查Unity连接状态
-	unityConnected := unityClient != nil && unityClient.IsConnected()
+	readiness := checkReadiness()
+	health, code := "healthy", http.StatusOK
+	switch {
+	case !readiness.Ready:
+		health, code = "unhealthy", http.StatusServiceUnavailable
+	case !readiness.Connected:
+		health = "degraded"
+	}
 
+	st := currentState()
 	status := map[string]interface{}{
-		"status":         "healthy",
+		"status":         health,
 		"timestamp":      time.Now().Unix(),
-		"unityHost":      config.UnityHost,
-		"unityPort":      config.UnityPort,
-		"unityConnected": unityConnected,
-		"toolCount":      23,
-		"debugMode":      debugMode,
-		"version":        "1.0.0",
+		"unityHost":      st.Config.UnityHost,
+		"unityPort":      st.Config.UnityPort,
+		"unityConnected": readiness.Connected,
+		"listenAddr":     mainAddr(),
+		"managementAddr": managementAddr(),
+		"toolCount":      len(st.Tools.registry),
+		"toolCategories": st.Tools.toolCategoryCounts(),
+		"disabledTools":  st.Tools.disabledToolList(),
+		"debugMode":      logEnabled(slog.LevelDebug),
+		"logLevel":       levelName(logLevel.Level()),
+		"version":        version,
+		"build":          versionInfo(),
+	}
+	if readiness.Reason != "" {
+		status["reason"] = readiness.Reason
+	}
+	if info, at := cachedUnityInfo(); info != nil {
+		info["cachedAt"] = at.Format(time.RFC3339)
+		status["unity"] = info
+	}
+	if last := stats.LastSuccess(); !last.IsZero() {
+		status["lastSuccessfulToolCall"] = last.Format(time.RFC3339)
+	} else {
+		status["lastSuccessfulToolCall"] = nil
+	}
+
+	// ?probe=true 时通过ping测量Unity的实际响应延迟
+	if r.URL.Query().Get("probe") == "true" {
+		probe := unityProbe.Run()
+		status["unityResponsive"] = probe.Responsive
+		status["unityLatencyMs"] = probe.LatencyMs
+		status["probedAt"] = probe.At.Format(time.RFC3339)
+		status["probeCached"] = probe.Cached
+		if probe.Error != "" {
+			status["probeError"] = probe.Error
+		}
+	}
+
+	if count, at, ok := lastConsoleErrors(); ok {
+		status["unityConsoleErrors"] = count
+		status["unityConsoleErrorsAt"] = at.Format(time.RFC3339)
 	}
 
 	debugLog("Health status: %s", formatJSON(status))
+	writeJSON(w, code, status)
+}
+
+// 存活检查: 只表示进程可以处理HTTP请求，不访问Unity
+func handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{"status": "ok"})
+}
+
+// 就绪检查: Unity不可用时返回503
+func handleReadyz(w http.ResponseWriter, r *http.Request) {
+	readiness := checkReadiness()
+	body := map[string]interface{}{
+		"ready":          readiness.Ready,
+		"unityConnected": readiness.Connected,
+	}
+	if readiness.Reason != "" {
+		body["reason"] = readiness.Reason
+	}
+	code := http.StatusOK
+	if !readiness.Ready {
+		code = http.StatusServiceUnavailable
+		debugLog("Readiness check failed: %s", readiness.Reason)
+	}
+	writeJSON(w, code, body)
+}
 
+// readiness 就绪状态
+type readiness struct {
+	Ready     bool
+	Connected bool
+	Reason    string
+}
+
+// checkReadiness 检查能否处理工具调用
+// 与Unity的连接是按需建立的，未连接时通过一次独立的拨号确认Unity可达，不改变客户端状态
+func checkReadiness() readiness {
+	if unityClient != nil && unityClient.IsConnected() {
+		return readiness{Ready: true, Connected: true}
+	}
+
+	c := currentState().Config
+	addr := net.JoinHostPort(c.UnityHost, c.UnityPort)
+	probeTimeout := c.Timeout
+	if probeTimeout > readinessProbeTimeout {
+		probeTimeout = readinessProbeTimeout
+	}
+	conn, err := net.DialTimeout("tcp", addr, probeTimeout)
+	if err != nil {
+		return readiness{Reason: fmt.Sprintf("Unity server %s is unreachable: %v", addr, err)}
+	}
+	conn.Close()
+	return readiness{Ready: true, Reason: "not connected yet; Unity server is reachable"}
+}
+
+// 主动探测Unity的ping超时，以及两次实际探测之间的最小间隔
+const (
+	healthProbeTimeout  = 3 * time.Second
+	healthProbeInterval = 5 * time.Second
+)
+
+// probeResult 一次ping探测的结果
+type probeResult struct {
+	Responsive bool
+	LatencyMs  float64
+	Error      string
+	At         time.Time
+	Cached     bool
+}
+
+// healthProber 对 /health?probe=true 的ping进行限流
+// 间隔内的重复请求直接返回上一次的结果，避免监控程序频繁ping Unity
+type healthProber struct {
+	mu   sync.Mutex
+	last probeResult
+}
+
+var unityProbe = &healthProber{}
+
+// Reset 丢弃缓存的探测结果，下一次请求重新探测
+func (p *healthProber) Reset() {
+	p.mu.Lock()
+	p.last = probeResult{}
+	p.mu.Unlock()
+}
+
+// Run 执行一次ping探测，或在限流间隔内返回缓存结果
+func (p *healthProber) Run() probeResult {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.last.At.IsZero() && time.Since(p.last.At) < healthProbeInterval {
+		cached := p.last
+		cached.Cached = true
+		return cached
+	}
+
+	result := probeResult{At: time.Now()}
+	if unityClient == nil {
+		result.Error = "Unity client not initialized"
+	} else if err := unityClient.TestConnectionWithTimeout(healthProbeTimeout); err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Responsive = true
+	}
+	result.LatencyMs = float64(time.Since(result.At).Microseconds()) / 1000
+	if result.Responsive {
+		refreshConsoleStats(healthProbeTimeout)
+	}
+	p.last = result
+	return result
+}
+
+// POST /reconnect 等待进行中的工具调用结束的最长时间
+const reconnectWaitTimeout = 30 * time.Second
+
+// 强制重新连接Unity: 关闭当前连接，重新拨号并发送ping
+// 默认等待进行中的工具调用结束，?force=true 时先取消所有进行中的调用
+func handleReconnect(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	force, _ := strconv.ParseBool(r.URL.Query().Get("force"))
+
+	start := time.Now()
+	canceled := 0
+	if force {
+		canceled = inflight.CancelAll()
+		if canceled > 0 {
+			infoLog("Reconnect requested with force, canceled %d in-flight tool calls", canceled)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), reconnectWaitTimeout)
+	defer cancel()
+	err := unityClient.Reconnect(ctx)
+
+	c := currentState().Config
+	body := map[string]interface{}{
+		"forced":        force,
+		"canceledCalls": canceled,
+		"unityHost":     c.UnityHost,
+		"unityPort":     c.UnityPort,
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		body["reconnected"] = false
+		body["error"] = "an in-flight tool call did not finish in time; retry with force=true"
+		body["durationMs"] = time.Since(start).Milliseconds()
+		writeJSON(w, http.StatusConflict, body)
+		return
+	}
+
+	code := http.StatusOK
+	body["reconnected"] = err == nil
+	if err != nil {
+		body["error"] = err.Error()
+		code = http.StatusServiceUnavailable
+	} else if testErr := unityClient.TestConnectionWithTimeout(healthProbeTimeout); testErr != nil {
+		body["responsive"] = false
+		body["error"] = testErr.Error()
+		code = http.StatusServiceUnavailable
+	} else {
+		body["responsive"] = true
+		body["localAddr"], body["remoteAddr"] = unityClient.ConnectionInfo()
+	}
+	unityProbe.Reset()
+	body["durationMs"] = time.Since(start).Milliseconds()
+
+	logger.Info("Unity reconnect requested", "forced", force, "reconnected", err == nil, "status", code)
+	writeJSON(w, code, body)
+}
+
+// 就绪检查拨号的最长等待时间，避免探针超时
+const readinessProbeTimeout = 2 * time.Second
+
+// writeJSON 以指定状态码输出JSON响应
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
 	w.Header().Set("Content-Type", "application/json")
-	if err := json.NewEncoder(w).Encode(status); err != nil {
-		errorLog("Failed to encode health status: %v", err)
-		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		errorLog("Failed to encode JSON response: %v", err)
+	}
+}
+
+// 列出提示词，指定?name=时用其余查询参数作为提示词参数渲染该提示词 (便于调试)
+func handleListPrompts(w http.ResponseWriter, r *http.Request) {
+	debugLog("Prompts list requested")
+
+	query := r.URL.Query()
+	if name := query.Get("name"); name != "" {
+		for i := range promptDefinitions {
+			def := &promptDefinitions[i]
+			if def.Name != name {
+				continue
+			}
+			args := make(map[string]string)
+			for key := range query {
+				if key != "name" {
+					args[key] = query.Get(key)
+				}
+			}
+			request := mcp.GetPromptRequest{}
+			request.Params.Name = name
+			request.Params.Arguments = args
+			result, err := def.handler()(r.Context(), request)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			writeJSON(w, http.StatusOK, result)
+			return
+		}
+		http.Error(w, "Prompt not found", http.StatusNotFound)
 		return
 	}
 
-	debugLog("Health check response sent successfully")
+	prompts := make([]map[string]interface{}, 0, len(promptDefinitions))
+	for i := range promptDefinitions {
+		def := &promptDefinitions[i]
+		prompts = append(prompts, map[string]interface{}{
+			"name":        def.Name,
+			"description": def.Description,
+			"arguments":   def.MCPPrompt().Arguments,
+			"tools":       def.Tools,
+		})
+	}
+
+	debugLog("Prompts list: %d prompts available", len(prompts))
+	writeJSON(w, http.StatusOK, prompts)
 }
 
 // 列出可用工具
 func handleListTools(w http.ResponseWriter, r *http.Request) {
 	debugLog("Tools list requested")
 
-	tools := []map[string]interface{}{
-		// 基础工具
-		{
-			"name":        "script_read",
-			"description": "Read script file content from Unity project",
-			"category":    "file",
-		},
-		{
-			"name":        "script_write",
-			"description": "Create or update script file in Unity project",
-			"category":    "file",
-		},
-		{
-			"name":        "scene_get",
-			"description": "Get Unity current scene hierarchy data",
-			"category":    "scene",
-		},
-		{
-			"name":        "scene_create_object",
-			"description": "Create new GameObject in Unity scene",
-			"category":    "scene",
-		},
-		{
-			"name":        "scene_object_add_component",
-			"description": "Add component to GameObject in Unity scene",
-			"category":    "scene",
-		},
-		{
-			"name":        "scene_transform_get",
-			"description": "Get Transform information of GameObject in Unity scene",
-			"category":    "transform",
-		},
-		{
-			"name":        "scene_transform_set",
-			"description": "Set Transform information of GameObject in Unity scene",
-			"category":    "transform",
-		},
-		// UI工具
-		{
-			"name":        "ui_rect_transform_set",
-			"description": "Set UI element RectTransform properties (position, size, anchors)",
-			"category":    "ui",
-		},
-		{
-			"name":        "ui_rect_transform_get",
-			"description": "Get UI element RectTransform information",
-			"category":    "ui",
-		},
-		{
-			"name":        "ui_image_set",
-			"description": "Set UI Image component properties (sprite, color, material)",
-			"category":    "ui",
-		},
-		{
-			"name":        "ui_text_set",
-			"description": "Set UI Text component properties (text content, font, color)",
-			"category":    "ui",
-		},
-		// 资源管理工具
-		{
-			"name":        "asset_find",
-			"description": "Find project assets by conditions (path, type, name)",
-			"category":    "asset",
-		},
-		{
-			"name":        "asset_get_info",
-			"description": "Get detailed asset information (metadata, import settings)",
-			"category":    "asset",
-		},
-		{
-			"name":        "asset_get_dependencies",
-			"description": "Get asset dependency relationships",
-			"category":    "asset",
-		},
-		{
-			"name":        "project_get_structure",
-			"description": "Get project directory structure and statistics",
-			"category":    "project",
-		},
-		// 扩展Prefab工具
-		{
-			"name":        "prefab_create",
-			"description": "Create prefab from scene GameObject",
-			"category":    "prefab",
-		},
-		{
-			"name":        "prefab_get_info",
-			"description": "Get detailed prefab information",
-			"category":    "prefab",
-		},
-		{
-			"name":        "prefab_modify",
-			"description": "Manage prefab instance modifications",
-			"category":    "prefab",
-		},
-		// 场景管理工具
-		{
-			"name":        "scene_save",
-			"description": "Save current or specified scene",
-			"category":    "scene",
-		},
-		{
-			"name":        "scene_load",
-			"description": "Load specified scene file",
-			"category":    "scene",
-		},
-		{
-			"name":        "scene_get_info",
-			"description": "Get detailed scene information",
-			"category":    "scene",
-		},
-		{
-			"name":        "scene_find_objects",
-			"description": "Find GameObjects in scene by criteria",
-			"category":    "scene",
-		},
-		{
-			"name":        "scene_delete_object",
-			"description": "Delete GameObject from scene",
-			"category":    "scene",
-		},
-		// 其他工具
-		{
-			"name":        "editor_get_logs",
-			"description": "Read Unity Editor Console logs",
-			"category":    "editor",
-		},
+	// 解析过滤参数
+	query := r.URL.Query()
+	category := query.Get("category")
+	search := query.Get("q")
+	var readOnly *bool
+	if v := query.Get("readonly"); v != "" {
+		parsed, err := strconv.ParseBool(v)
+		if err != nil {
+			http.Error(w, "Invalid readonly parameter", http.StatusBadRequest)
+			return
+		}
+		readOnly = &parsed
+	}
+
+	registry := currentState().Tools.registry
+	tools := make([]map[string]interface{}, 0, len(registry))
+	for i := range registry {
+		def := &registry[i]
+		if !def.matches(category, readOnly, search) {
+			continue
+		}
+		entry := map[string]interface{}{
+			"name":        def.Name,
+			"description": def.Description,
+			"category":    def.Category,
+			"readOnly":    def.ReadOnly,
+			"inputSchema": def.MCPTool().InputSchema,
+		}
+		if def.TimeoutHint > 0 {
+			entry["timeoutHintMs"] = def.TimeoutHint.Milliseconds()
+		}
+		tools = append(tools, entry)
 	}
 
 	debugLog("Tools list: %d tools available", len(tools))
-	if debugMode {
+	if logEnabled(slog.LevelDebug) {
 		for _, tool := range tools {
 			debugLog("Tool: %s (%s) - %s", tool["name"], tool["category"], tool["description"])
 		}
@@ -800,6 +851,74 @@ func handleListTools(w http.ResponseWriter, r *http.Request) {
 	debugLog("Tools list response sent successfully")
 }
 
+// 工具调用统计快照，?reset=true 清空计数
+func handleStatus(w http.ResponseWriter, r *http.Request) {
+	debugLog("Status requested")
+
+	if reset, _ := strconv.ParseBool(r.URL.Query().Get("reset")); reset {
+		stats.Reset()
+		infoLog("Tool statistics reset")
+	}
+
+	status := stats.Snapshot()
+	status["unityConnected"] = unityClient != nil && unityClient.IsConnected()
+	st := currentState()
+	status["config"] = map[string]interface{}{
+		"port":      st.Config.Port,
+		"unityHost": st.Config.UnityHost,
+		"unityPort": st.Config.UnityPort,
+		"timeout":   st.Config.Timeout.String(),
+		"logLevel":  levelName(logLevel.Level()),
+		"logFormat": st.Config.LogFormat,
+		"readOnly":  st.Config.ReadOnly,
+		"dryRun":    st.Config.DryRun,
+		"toolCount": len(st.Tools.registry),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(status); err != nil {
+		errorLog("Failed to encode status: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+}
+
+// 查看或修改日志级别 (GET 返回当前级别，PUT 修改级别)
+func handleLogLevel(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPut:
+		// 支持 ?level=debug 或 JSON body {"level": "debug"}
+		requested := r.URL.Query().Get("level")
+		if requested == "" {
+			var body struct {
+				Level string `json:"level"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, "Invalid request body, expected {\"level\": \"debug\"}", http.StatusBadRequest)
+				return
+			}
+			requested = body.Level
+		}
+
+		level, err := parseLogLevel(requested)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		previous := logLevel.Level()
+		logLevel.Set(level)
+		logger.Info("Log level changed", "from", levelName(previous), "to", levelName(level))
+	default:
+		w.Header().Set("Allow", "GET, PUT")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"level": levelName(logLevel.Level())})
+}
+
 // 工具函数
 func formatJSON(data interface{}) string {
 	if data == nil {
@@ -812,27 +931,118 @@ func formatJSON(data interface{}) string {
 	return string(bytes)
 }
 
-func mustParseInt(s string) int {
-	i, err := strconv.Atoi(s)
-	if err != nil {
-		log.Fatalf("Failed to parse port number: %s", s)
+// managementHandler 为管理端点组合日志、CORS和鉴权中间件
+// CORS位于鉴权之前，使浏览器预检请求无需携带token
+func managementHandler(handler http.HandlerFunc, endpoint string) http.HandlerFunc {
+	return withLogging(withCORS(withAuth(handler)), endpoint)
+}
+
+// CORS中间件: 仅对-cors-origins中列出的来源添加跨域响应头，并直接应答OPTIONS预检
+func withCORS(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			handler(w, r)
+			return
+		}
+		origins := currentState().Config.CORSOrigins
+		if origins == "" {
+			handler(w, r)
+			return
+		}
+
+		allowed, ok := allowedOrigin(origins, origin)
+		if !ok {
+			debugLog("HTTP [%s] %s - CORS origin not allowed: %s", r.Method, r.URL.Path, origin)
+			if r.Method == http.MethodOptions {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			handler(w, r)
+			return
+		}
+
+		h := w.Header()
+		h.Set("Access-Control-Allow-Origin", allowed)
+		if allowed != "*" {
+			h.Add("Vary", "Origin")
+		}
+
+		if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+			h.Set("Access-Control-Allow-Methods", "GET, PUT, POST, OPTIONS")
+			h.Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+			h.Set("Access-Control-Max-Age", "600")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// allowedOrigin 检查来源是否在允许列表中，返回应写入Access-Control-Allow-Origin的值
+func allowedOrigin(origins, origin string) (string, bool) {
+	for _, o := range strings.Split(origins, ",") {
+		o = strings.TrimSpace(o)
+		if o == "*" {
+			return "*", true
+		}
+		if strings.EqualFold(strings.TrimSuffix(o, "/"), origin) {
+			return origin, true
+		}
+	}
+	return "", false
+}
+
+// 鉴权中间件: 配置了management-token时要求 Authorization: Bearer <token>
+func withAuth(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if config.ManagementToken != "" && !validBearerToken(r.Header.Get("Authorization"), config.ManagementToken) {
+			debugLog("HTTP [%s] %s - Rejected: missing or invalid bearer token", r.Method, r.URL.Path)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="unity-mcp-server"`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler(w, r)
 	}
-	return i
 }
 
-// Debug日志函数
-func debugLog(format string, args ...interface{}) {
-	if debugMode {
-		log.Printf("[DEBUG] "+format, args...)
+// validBearerToken 使用常量时间比较校验Bearer token
+func validBearerToken(header, token string) bool {
+	const prefix = "Bearer "
+	if !strings.HasPrefix(header, prefix) {
+		return false
 	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(header, prefix)), []byte(token)) == 1
+}
+
+// mainAddr 返回主HTTP服务器的监听地址
+func mainAddr() string {
+	return net.JoinHostPort(config.Bind, config.Port)
 }
 
-func infoLog(format string, args ...interface{}) {
-	log.Printf("[INFO] "+format, args...)
+// managementAddr 返回管理端点的监听地址 (统一模式下与主服务器相同)
+func managementAddr() string {
+	if !config.DualPort {
+		return mainAddr()
+	}
+	return net.JoinHostPort(config.Bind, config.ManagementPort)
 }
 
-func errorLog(format string, args ...interface{}) {
-	log.Printf("[ERROR] "+format, args...)
+// advertisedHost 返回提供给客户端的主机名，通配地址时使用localhost
+func advertisedHost(bind string) string {
+	if bind == "" || bind == "0.0.0.0" || bind == "::" {
+		return "localhost"
+	}
+	return bind
+}
+
+// isLoopbackHost 判断监听地址是否只对本机可见，空地址表示所有网卡
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
 }
 
 // HTTP日志中间件
@@ -844,11 +1054,11 @@ func withLogging(handler http.HandlerFunc, endpoint string) http.HandlerFunc {
 		debugLog("HTTP [%s] %s %s - Client: %s, User-Agent: %s",
 			r.Method, endpoint, r.URL.RawQuery, r.RemoteAddr, r.UserAgent())
 
-		if debugMode {
-			// 记录请求头
+		if logEnabled(LevelTrace) {
+			// 记录请求头，凭据类请求头只记录是否存在
 			for name, values := range r.Header {
 				for _, value := range values {
-					debugLog("HTTP [%s] %s - Header: %s = %s", r.Method, endpoint, name, value)
+					traceLog("HTTP [%s] %s - Header: %s = %s", r.Method, endpoint, name, redactHeader(name, value))
 				}
 			}
 		}
@@ -861,9 +1071,25 @@ func withLogging(handler http.HandlerFunc, endpoint string) http.HandlerFunc {
 
 		// 记录请求出口
 		duration := time.Since(start)
-		infoLog("HTTP [%s] %s - Status: %d, Duration: %v",
-			r.Method, endpoint, wrapped.statusCode, duration)
+		logger.Info("HTTP request",
+			"method", r.Method,
+			"endpoint", endpoint,
+			"status", wrapped.statusCode,
+			"duration_ms", duration.Milliseconds())
+	}
+}
+
+// redactedHeaders 日志中不记录值的请求头 (management-token、会话cookie)
+var redactedHeaders = []string{"Authorization", "Cookie", "Proxy-Authorization"}
+
+// redactHeader 返回日志中使用的请求头值，凭据类请求头替换为占位符
+func redactHeader(name, value string) string {
+	for _, h := range redactedHeaders {
+		if strings.EqualFold(name, h) {
+			return "[REDACTED]"
+		}
 	}
+	return value
 }
 
 // 响应写入器包装器
@@ -0,0 +1,449 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// TestMain 测试期间丢弃日志输出，需要检查日志的测试使用captureLogs
+func TestMain(m *testing.M) {
+	setupLogger("text", io.Discard)
+	os.Exit(m.Run())
+}
+
+// withConfig 在测试期间替换全局配置，结束时恢复
+func withConfig(t *testing.T, modify func(c *ServerConfig)) {
+	t.Helper()
+	saved := config
+	modify(&config)
+	t.Cleanup(func() { config = saved })
+}
+
+// captureLogs 把共享日志器输出到缓冲区并设置级别，结束时恢复
+func captureLogs(t *testing.T, level slog.Level) *bytes.Buffer {
+	t.Helper()
+	savedLogger, savedLevel := logger, logLevel.Level()
+	var buf bytes.Buffer
+	if err := setupLogger("text", &buf); err != nil {
+		t.Fatal(err)
+	}
+	logLevel.Set(level)
+	t.Cleanup(func() {
+		logger = savedLogger
+		slog.SetDefault(savedLogger)
+		logLevel.Set(savedLevel)
+	})
+	return &buf
+}
+
+// useState 在测试期间使用由配置生成的运行状态 (工具策略、重试策略等)，结束时恢复
+func useState(t *testing.T, modify func(c *ServerConfig)) *liveState {
+	t.Helper()
+	c := defaultConfig()
+	if modify != nil {
+		modify(&c)
+	}
+	st := newLiveState(c)
+	saved := live.Load()
+	live.Store(st)
+	t.Cleanup(func() { live.Store(saved) })
+	return st
+}
+
+// fakeUnity 按Unity的帧协议 (4字节大端长度头 + JSON) 应答请求的测试服务器
+// respond返回nil时直接关闭连接，模拟Unity断开
+type fakeUnity struct {
+	listener net.Listener
+	respond  func(n int, request map[string]interface{}) map[string]interface{}
+
+	mu       sync.Mutex
+	requests []map[string]interface{}
+	times    []time.Time
+}
+
+// startFakeUnity 启动fakeUnity并让全局unityClient连接到它，测试结束时恢复
+func startFakeUnity(t *testing.T, respond func(n int, request map[string]interface{}) map[string]interface{}) *fakeUnity {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeUnity{listener: listener, respond: respond}
+	go f.serve()
+
+	host, port, _ := net.SplitHostPort(listener.Addr().String())
+	saved := unityClient
+	unityClient = NewUnityTCPClient(host, port, 2*time.Second, 1<<20)
+	t.Cleanup(func() {
+		listener.Close()
+		unityClient.Close()
+		unityClient = saved
+	})
+	return f
+}
+
+func (f *fakeUnity) serve() {
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			return
+		}
+		go f.handle(conn)
+	}
+}
+
+func (f *fakeUnity) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		header := make([]byte, 4)
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return
+		}
+		body := make([]byte, binary.BigEndian.Uint32(header))
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return
+		}
+		var request map[string]interface{}
+		if err := json.Unmarshal(body, &request); err != nil {
+			return
+		}
+		f.mu.Lock()
+		n := len(f.requests)
+		f.requests = append(f.requests, request)
+		f.times = append(f.times, time.Now())
+		f.mu.Unlock()
+
+		response := f.respond(n, request)
+		if response == nil {
+			return
+		}
+		response["id"] = request["id"]
+		payload, _ := json.Marshal(response)
+		binary.BigEndian.PutUint32(header, uint32(len(payload)))
+		conn.Write(append(header, payload...))
+	}
+}
+
+// Requests 返回收到的请求数
+func (f *fakeUnity) Requests() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.requests)
+}
+
+// resultText 返回工具结果的第一个文本内容
+func resultText(result *mcp.CallToolResult) string {
+	for _, content := range result.Content {
+		if text, ok := content.(mcp.TextContent); ok {
+			return text.Text
+		}
+	}
+	return ""
+}
+
+// newTestMCPServer 按main中的方式创建注册了所有工具的MCP服务器
+func newTestMCPServer() *server.MCPServer {
+	s := server.NewMCPServer("unity-mcp-server", version,
+		server.WithHooks(newServerHooks()),
+		server.WithToolFilter(hideDeniedTools))
+	registerTools(s)
+	return s
+}
+
+// mcpCall 向MCP服务器发送一条JSON-RPC请求，返回result字段
+func mcpCall(t *testing.T, s *server.MCPServer, method string, params interface{}) json.RawMessage {
+	t.Helper()
+	message, err := json.Marshal(map[string]interface{}{"jsonrpc": "2.0", "id": 1, "method": method, "params": params})
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload, err := json.Marshal(s.HandleMessage(context.Background(), message))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var response struct {
+		Result json.RawMessage `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(payload, &response); err != nil {
+		t.Fatal(err)
+	}
+	if response.Error != nil {
+		t.Fatalf("%s failed: %s", method, response.Error.Message)
+	}
+	return response.Result
+}
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte("ok"))
+}
+
+func TestManagementAuth(t *testing.T) {
+	withConfig(t, func(c *ServerConfig) { c.ManagementToken = "s3cret" })
+	handler := managementHandler(okHandler, "/stats")
+
+	tests := []struct {
+		name   string
+		header string
+		status int
+	}{
+		{"missing token", "", http.StatusUnauthorized},
+		{"wrong token", "Bearer nope", http.StatusUnauthorized},
+		{"wrong scheme", "Basic s3cret", http.StatusUnauthorized},
+		{"valid token", "Bearer s3cret", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/stats", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if tt.status == http.StatusUnauthorized && rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("401 response without WWW-Authenticate header")
+			}
+		})
+	}
+}
+
+func TestManagementAuthDisabledWithoutToken(t *testing.T) {
+	withConfig(t, func(c *ServerConfig) { c.ManagementToken = "" })
+	rec := httptest.NewRecorder()
+	managementHandler(okHandler, "/stats")(rec, httptest.NewRequest(http.MethodGet, "/stats", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+}
+
+func TestTraceLoggingRedactsCredentials(t *testing.T) {
+	withConfig(t, func(c *ServerConfig) { c.ManagementToken = "s3cret" })
+	logs := captureLogs(t, LevelTrace)
+
+	for _, token := range []string{"s3cret", "wrong-token"} {
+		req := httptest.NewRequest(http.MethodGet, "/stats", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		req.Header.Set("Cookie", "session=abc123")
+		req.Header.Set("X-Request-Id", "visible")
+		managementHandler(okHandler, "/stats")(httptest.NewRecorder(), req)
+	}
+
+	out := logs.String()
+	for _, secret := range []string{"s3cret", "wrong-token", "abc123"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("trace log contains %q:\n%s", secret, out)
+		}
+	}
+	if !strings.Contains(out, "visible") || !strings.Contains(out, "[REDACTED]") {
+		t.Errorf("trace log should keep other headers and mark redacted ones:\n%s", out)
+	}
+}
+
+func TestCORS(t *testing.T) {
+	withConfig(t, func(c *ServerConfig) { c.ManagementToken = "s3cret" })
+	useState(t, func(c *ServerConfig) { c.CORSOrigins = "http://localhost:3000, https://dash.example.com/" })
+	handler := managementHandler(okHandler, "/stats")
+
+	tests := []struct {
+		name        string
+		method      string
+		origin      string
+		preflight   bool
+		status      int
+		allowOrigin string
+	}{
+		{"allowed origin", http.MethodGet, "http://localhost:3000", false, http.StatusOK, "http://localhost:3000"},
+		{"allowed origin with trailing slash in config", http.MethodGet, "https://dash.example.com", false, http.StatusOK, "https://dash.example.com"},
+		{"disallowed origin", http.MethodGet, "http://evil.example.com", false, http.StatusOK, ""},
+		{"preflight from allowed origin", http.MethodOptions, "http://localhost:3000", true, http.StatusNoContent, "http://localhost:3000"},
+		{"preflight from disallowed origin", http.MethodOptions, "http://evil.example.com", true, http.StatusForbidden, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/stats", nil)
+			req.Header.Set("Origin", tt.origin)
+			if tt.preflight {
+				// 预检请求不携带token
+				req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+			} else {
+				req.Header.Set("Authorization", "Bearer s3cret")
+			}
+			rec := httptest.NewRecorder()
+			handler(rec, req)
+			if rec.Code != tt.status {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.status)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.allowOrigin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.allowOrigin)
+			}
+			if tt.status == http.StatusNoContent {
+				if rec.Header().Get("Access-Control-Allow-Headers") == "" || rec.Header().Get("Access-Control-Allow-Methods") == "" {
+					t.Error("preflight response is missing Access-Control-Allow-Headers/Methods")
+				}
+			}
+		})
+	}
+}
+
+func TestCORSWildcard(t *testing.T) {
+	withConfig(t, func(c *ServerConfig) { c.ManagementToken = "" })
+	useState(t, func(c *ServerConfig) { c.CORSOrigins = "*" })
+	req := httptest.NewRequest(http.MethodGet, "/stats", nil)
+	req.Header.Set("Origin", "http://anything.example.com")
+	rec := httptest.NewRecorder()
+	managementHandler(okHandler, "/stats")(rec, req)
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "*" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want *", got)
+	}
+	if rec.Header().Get("Vary") != "" {
+		t.Error("wildcard origin should not set Vary: Origin")
+	}
+}
+
+func TestHealthToolCountMatchesToolsList(t *testing.T) {
+	// Unity不可达时health返回503，但仍报告工具数量
+	unreachable := func(c *ServerConfig) { c.UnityHost, c.UnityPort = "127.0.0.1", "1" }
+	useState(t, unreachable)
+	s := newTestMCPServer()
+
+	tests := []struct {
+		name   string
+		modify func(c *ServerConfig)
+	}{
+		{"default", nil},
+		{"readonly", func(c *ServerConfig) { c.ReadOnly = true }},
+		{"allow", func(c *ServerConfig) { c.AllowTools = "scene_*,editor_*" }},
+		{"deny", func(c *ServerConfig) { c.DenyTools = "asset_*,build_*" }},
+		{"allow and deny", func(c *ServerConfig) { c.AllowTools = "scene_*"; c.DenyTools = "scene_delete*" }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useState(t, func(c *ServerConfig) {
+				unreachable(c)
+				if tt.modify != nil {
+					tt.modify(c)
+				}
+			})
+
+			var list struct {
+				Tools []mcp.Tool `json:"tools"`
+			}
+			if err := json.Unmarshal(mcpCall(t, s, "tools/list", map[string]interface{}{}), &list); err != nil {
+				t.Fatal(err)
+			}
+
+			rec := httptest.NewRecorder()
+			handleHealth(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+			var health struct {
+				ToolCount     int                 `json:"toolCount"`
+				DisabledTools []map[string]string `json:"disabledTools"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &health); err != nil {
+				t.Fatal(err)
+			}
+
+			if health.ToolCount != len(list.Tools) {
+				t.Errorf("health toolCount = %d, tools/list returned %d tools", health.ToolCount, len(list.Tools))
+			}
+			if health.ToolCount+len(health.DisabledTools) != len(toolDefinitions) {
+				t.Errorf("toolCount %d + disabled %d != %d defined tools", health.ToolCount, len(health.DisabledTools), len(toolDefinitions))
+			}
+			if tt.modify != nil && len(health.DisabledTools) == 0 {
+				t.Error("policy should disable some tools")
+			}
+		})
+	}
+}
+
+func TestConnectionStateDuringRequest(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	startFakeUnity(t, func(n int, request map[string]interface{}) map[string]interface{} {
+		if n == 0 {
+			close(started)
+			<-release
+		}
+		return map[string]interface{}{"success": true}
+	})
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := unityClient.SendMessage(map[string]interface{}{"id": "slow", "action": "scene_get"})
+		done <- err
+	}()
+	<-started
+
+	// 请求进行中查询状态不能等待请求结束
+	checked := make(chan bool, 1)
+	go func() { checked <- unityClient.IsConnected() }()
+	select {
+	case connected := <-checked:
+		if !connected {
+			t.Error("IsConnected = false during an in-flight request")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("IsConnected blocked on the in-flight request")
+	}
+	if local, remote := unityClient.ConnectionInfo(); local == "" || remote == "" {
+		t.Errorf("ConnectionInfo = %q, %q during an in-flight request", local, remote)
+	}
+
+	close(release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if !unityClient.IsConnected() {
+		t.Error("IsConnected = false after the request completed")
+	}
+}
+
+func TestReconnectDelayDoesNotHoldConnection(t *testing.T) {
+	startFakeUnity(t, func(n int, request map[string]interface{}) map[string]interface{} {
+		if n == 0 {
+			return nil
+		}
+		return map[string]interface{}{"success": true}
+	})
+
+	if _, err := unityClient.SendMessage(map[string]interface{}{"id": "drop", "action": "scene_get"}); err == nil {
+		t.Fatal("expected an error when Unity closes the connection")
+	}
+
+	// 重连等待期间连接未被占用，状态查询立即返回
+	start := time.Now()
+	if unityClient.IsConnected() {
+		t.Error("IsConnected = true after the connection was dropped")
+	}
+	if elapsed := time.Since(start); elapsed >= reconnectDelay/2 {
+		t.Errorf("IsConnected took %v during the reconnect delay", elapsed)
+	}
+
+	// 下一次请求等待reconnectDelay后重新连接
+	start = time.Now()
+	if _, err := unityClient.SendMessage(map[string]interface{}{"id": "retry", "action": "scene_get"}); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < reconnectDelay/2 {
+		t.Errorf("reconnected after %v, want about %v", elapsed, reconnectDelay)
+	}
+}
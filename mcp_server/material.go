@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// material_create / material_set_properties 的properties按值的形式检查，具体类型由Unity根据着色器属性决定:
+// "#RRGGBB[AA]"和 {r,g,b,a} 为颜色，{x,y,z,w} 为向量，数字和布尔值为Float/Range，
+// 其他字符串为纹理资源路径，null清除纹理；数组为3或4个数字 (颜色或向量)
+
+// maxMaterialProperties 一次最多设置的属性数
+const maxMaterialProperties = 200
+
+// vectorXYZW 材质向量属性的分量
+var vectorXYZW = []string{"x", "y", "z", "w"}
+
+// shaderKeywordPattern 着色器关键字
+var shaderKeywordPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// normalizeMaterialCreateArgs 检查savePath和初始属性
+func normalizeMaterialCreateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if err := normalizeSavePath(arguments, ".mat"); err != nil {
+		return nil, err
+	}
+	return normalizeMaterialProperties(arguments)
+}
+
+// normalizeMaterialSetArgs 需要materialPath或instanceId之一，并检查属性和关键字
+func normalizeMaterialSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasPath := arguments["materialPath"]
+	_, hasID := arguments["instanceId"]
+	if hasPath == hasID {
+		return nil, fmt.Errorf("exactly one of materialPath or instanceId is required")
+	}
+	_, hasProperties := arguments["properties"]
+	_, hasEnable := arguments["enableKeywords"]
+	_, hasDisable := arguments["disableKeywords"]
+	if !hasProperties && !hasEnable && !hasDisable {
+		return nil, fmt.Errorf("nothing to set: provide properties, enableKeywords or disableKeywords")
+	}
+
+	enabled := map[string]bool{}
+	for _, name := range []string{"enableKeywords", "disableKeywords"} {
+		keywords, _ := arguments[name].([]interface{})
+		for i, k := range keywords {
+			keyword, ok := k.(string)
+			if !ok || !shaderKeywordPattern.MatchString(keyword) {
+				return nil, fmt.Errorf("%s[%d] must be a shader keyword such as _EMISSION, got %v", name, i, k)
+			}
+			if name == "enableKeywords" {
+				enabled[keyword] = true
+			} else if enabled[keyword] {
+				return nil, fmt.Errorf("keyword %s is both enabled and disabled", keyword)
+			}
+		}
+	}
+	return normalizeMaterialProperties(arguments)
+}
+
+// normalizeMaterialProperties 检查properties中每个值的形式，颜色统一转换为 {r,g,b,a} 对象
+func normalizeMaterialProperties(arguments map[string]interface{}) (map[string]interface{}, error) {
+	properties, ok := arguments["properties"].(map[string]interface{})
+	if !ok {
+		return arguments, nil
+	}
+	if len(properties) > maxMaterialProperties {
+		return nil, fmt.Errorf("properties has %d entries, at most %d can be set in one call", len(properties), maxMaterialProperties)
+	}
+	for name, value := range properties {
+		normalized, err := materialPropertyValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("properties.%s: %w", name, err)
+		}
+		properties[name] = normalized
+	}
+	return arguments, nil
+}
+
+// materialPropertyValue 检查并规范化一个材质属性值
+func materialPropertyValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case nil, float64, bool:
+		return v, nil
+	case string:
+		if strings.HasPrefix(v, "#") {
+			return parseColor(v)
+		}
+		texture := strings.ReplaceAll(v, "\\", "/")
+		if texture != "" && !strings.HasPrefix(texture, "Assets/") && !strings.HasPrefix(texture, "Packages/") {
+			return nil, fmt.Errorf("expected a #RRGGBB color or a texture path under Assets/ or Packages/, got %q", v)
+		}
+		return texture, nil
+	case []interface{}:
+		if len(v) != 3 && len(v) != 4 {
+			return nil, fmt.Errorf("expected 3 or 4 numbers, got %d elements", len(v))
+		}
+		for i, component := range v {
+			n, err := toNumber(component)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			v[i] = n
+		}
+		return v, nil
+	case map[string]interface{}:
+		// HDR颜色 (如自发光) 的分量可以大于1，因此这里只要求非负
+		components := vectorXYZW
+		if slices.ContainsFunc(colorComponents, func(c string) bool { _, ok := v[c]; return ok }) {
+			components = colorComponents
+		}
+		for key, component := range v {
+			if !slices.Contains(components, key) {
+				return nil, fmt.Errorf("unknown component %q, expected %s", key, strings.Join(components, ", "))
+			}
+			n, err := toNumber(component)
+			if err != nil {
+				return nil, fmt.Errorf("component %s: %w", key, err)
+			}
+			if n < 0 && components[0] == "r" {
+				return nil, fmt.Errorf("color component %s must not be negative, got %v", key, n)
+			}
+			v[key] = n
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("unsupported value of type %s", jsonTypeName(value))
+}
+
+// rendererShadowModes renderer_set 的castShadows，对应Unity的ShadowCastingMode
+var rendererShadowModes = []string{"Off", "On", "TwoSided", "ShadowsOnly"}
+
+// handleRendererSet 转发之前确认引用的材质存在，Unity端不会因为缺少材质而部分修改渲染器
+func handleRendererSet(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "renderer_set"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+
+	if result, err := checkMaterialAssets(ctx, toolName, rendererMaterialPaths(arguments)); result != nil || err != nil {
+		return result, err
+	}
+	return forwardToUnity(ctx, toolName, arguments, request)
+}
+
+// checkMaterialAssets 通过asset_get_info确认路径都是材质资源；全部存在时返回nil, nil
+func checkMaterialAssets(ctx context.Context, toolName string, materialPaths []string) (*mcp.CallToolResult, error) {
+	for _, materialPath := range materialPaths {
+		data, err := queryUnityLevel(ctx, "asset_get_info", map[string]interface{}{"assetPath": materialPath, "includeMetadata": false}, slog.LevelDebug)
+		switch {
+		case isUnityActionError(err):
+			return toolErrorResult(ctx, errCodeInvalidArguments, "material not found: "+materialPath, toolName), nil
+		case err != nil:
+			return unityErrorResult(ctx, toolName, err)
+		}
+		info, _ := data.(map[string]interface{})
+		assetType, _ := info["mainAssetType"].(map[string]interface{})
+		if name, _ := assetType["name"].(string); name != "Material" {
+			return toolErrorResult(ctx, errCodeInvalidArguments, fmt.Sprintf("not a material: %s (%s)", materialPath, name), toolName), nil
+		}
+	}
+	return nil, nil
+}
+
+// rendererMaterialPaths 返回renderer_set引用的材质路径，去重
+func rendererMaterialPaths(arguments map[string]interface{}) []string {
+	var paths []string
+	if p, ok := arguments["materialPath"].(string); ok {
+		paths = append(paths, p)
+	}
+	list, _ := arguments["materialPaths"].([]interface{})
+	for _, item := range list {
+		if p, ok := item.(string); ok && p != "" && !slices.Contains(paths, p) {
+			paths = append(paths, p)
+		}
+	}
+	return paths
+}
+
+// normalizeRendererSetArgs materialPaths与materialPath互斥，slot只能与materialPath一起使用
+// materialPaths中的null表示保留该槽位的材质
+func normalizeRendererSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	list, hasList := arguments["materialPaths"].([]interface{})
+	_, hasPath := arguments["materialPath"]
+	if hasList && hasPath {
+		return nil, fmt.Errorf("materialPaths and materialPath cannot be used together")
+	}
+	if _, hasSlot := arguments["slot"]; hasSlot && !hasPath {
+		return nil, fmt.Errorf("slot requires materialPath")
+	}
+	for i, item := range list {
+		if item == nil {
+			continue
+		}
+		p, ok := item.(string)
+		if !ok || !strings.HasPrefix(p, "Assets/") && !strings.HasPrefix(p, "Packages/") {
+			return nil, fmt.Errorf("materialPaths[%d] must be a material path under Assets/ or Packages/, or null to keep the slot, got %v", i, item)
+		}
+	}
+	return arguments, nil
+}
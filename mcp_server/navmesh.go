@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// navmesh_bake 默认在Unity主线程上同步烘焙，大场景可能需要数分钟，由TimeoutHint兜底
+// async=true 时先发送start，再轮询status直到烘焙结束，期间发送进度通知；调用被取消时同时取消Unity中的烘焙
+
+// navmeshBakeTimeout navmesh_bake 等待Unity响应的最长时间
+const navmeshBakeTimeout = 30 * time.Minute
+
+// navmeshPollInterval 轮询异步烘焙状态的间隔
+const navmeshPollInterval = 500 * time.Millisecond
+
+// handleNavMeshBake 同步烘焙直接转发；异步烘焙启动后轮询到结束
+func handleNavMeshBake(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "navmesh_bake"
+	arguments := request.GetArguments()
+	if async, _ := arguments["async"].(bool); !async || isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	log := callInfoFromContext(ctx).Logger()
+
+	progress := newProgressReporter(ctx, request)
+	stop := make(chan struct{})
+	go progress.Heartbeat(stop)
+	defer close(stop)
+
+	params := map[string]interface{}{"operation": "start"}
+	for _, name := range []string{"agentRadius", "agentHeight", "maxSlope", "stepHeight"} {
+		if v, ok := arguments[name]; ok {
+			params[name] = v
+		}
+	}
+	data, err := queryUnity(ctx, toolName, params)
+	if err != nil {
+		return unityErrorResult(ctx, toolName, err)
+	}
+	status, ok := data.(map[string]interface{})
+	if !ok {
+		return toolErrorResult(ctx, errCodeUnityToolFailed, "unity returned no navmesh bake status", toolName), nil
+	}
+	log.Info("NavMesh bake started", "scene", status["scene"])
+	progress.Report(0.05, fmt.Sprintf("baking NavMesh for %v", status["scene"]))
+
+	deadline := time.Now().Add(navmeshBakeTimeout)
+	for {
+		if state, _ := status["status"].(string); state != "running" {
+			break
+		}
+		if time.Now().After(deadline) {
+			log.Warn("NavMesh bake timed out, canceling", "timeout", navmeshBakeTimeout.String())
+			cancelNavMeshBake()
+			return toolErrorResult(ctx, errCodeUnityToolFailed, fmt.Sprintf("NavMesh bake did not finish within %s and was canceled", navmeshBakeTimeout), toolName), nil
+		}
+		select {
+		case <-time.After(navmeshPollInterval):
+		case <-ctx.Done():
+			cancelNavMeshBake()
+			return nil, ctx.Err()
+		}
+
+		latest, err := queryUnityLevel(ctx, toolName, map[string]interface{}{"operation": "status"}, slog.LevelDebug)
+		switch {
+		case isUnityActionError(err):
+			return unityErrorResult(ctx, toolName, err)
+		case err != nil:
+			log.Debug("Waiting for Unity during NavMesh bake", "error", err.Error())
+		default:
+			if m, ok := latest.(map[string]interface{}); ok {
+				status = m
+			}
+		}
+	}
+
+	if state, _ := status["status"].(string); state != "succeeded" {
+		return toolErrorResult(ctx, errCodeUnityToolFailed, fmt.Sprintf("NavMesh bake %s", state), toolName), nil
+	}
+	progress.Done()
+	log.Info("NavMesh bake finished", "scene", status["scene"], "duration_ms", status["durationMs"])
+	return toolSuccessResult(ctx, toolName, status), nil
+}
+
+// cancelNavMeshBake 取消异步烘焙；调用方的ctx可能已取消，因此使用独立的超时
+func cancelNavMeshBake() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	queryUnityLevel(ctx, "navmesh_bake", map[string]interface{}{"operation": "cancel"}, slog.LevelWarn)
+}
+
+// normalizeNavMeshBakeArgs 同时给出时台阶高度必须小于代理高度
+func normalizeNavMeshBakeArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	height, hasHeight := arguments["agentHeight"].(float64)
+	step, hasStep := arguments["stepHeight"].(float64)
+	if hasHeight && hasStep && step >= height {
+		return nil, fmt.Errorf("stepHeight (%g) must be less than agentHeight (%g)", step, height)
+	}
+	return arguments, nil
+}
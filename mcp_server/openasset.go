@@ -0,0 +1,34 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// editor_open_asset 不修改项目，但会在用户的IDE或编辑器中打开窗口，因此每次调用都记录info日志
+
+// handleOpenAsset 记录打开的资源后转发给Unity
+func handleOpenAsset(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "editor_open_asset"
+	arguments := request.GetArguments()
+	callInfoFromContext(ctx).Logger().Info("Opening asset in the user's editor", "asset_path", arguments["assetPath"], "line", arguments["line"])
+	return forwardToUnity(ctx, toolName, arguments, request)
+}
+
+// normalizeOpenAssetArgs 资源路径位于Assets或Packages下，column需要line
+func normalizeOpenAssetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	assetPath, _ := arguments["assetPath"].(string)
+	assetPath = strings.ReplaceAll(assetPath, "\\", "/")
+	if !strings.HasPrefix(assetPath, "Assets/") && !strings.HasPrefix(assetPath, "Packages/") {
+		return nil, fmt.Errorf("assetPath must be a path under Assets/ or Packages/, got %q", arguments["assetPath"])
+	}
+	arguments["assetPath"] = assetPath
+	_, hasLine := arguments["line"]
+	if _, hasColumn := arguments["column"]; hasColumn && !hasLine {
+		return nil, fmt.Errorf("column requires line")
+	}
+	return arguments, nil
+}
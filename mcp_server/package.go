@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// package_add / package_remove 在Unity中异步进行: 先发送start，再轮询status直到操作结束或超时
+// 安装或删除包会触发编译和域重载，轮询期间的通信失败只记录调试日志；
+// 操作成功后继续等待编辑器空闲，结果附带编译结果 (见idle.go)
+
+// packagePollInterval 轮询包操作状态的间隔
+const packagePollInterval = time.Second
+
+// packageNamePattern UPM包名: 小写字母、数字、点、连字符和下划线
+var packageNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// packageOperationHandler 返回包操作工具的处理函数
+func packageOperationHandler(toolName string) server.ToolHandlerFunc {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		return handlePackageOperation(ctx, toolName, request)
+	}
+}
+
+// handlePackageOperation 启动包操作并等待结果和随后的编译
+func handlePackageOperation(ctx context.Context, toolName string, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	log := callInfoFromContext(ctx).Logger()
+
+	progress := newProgressReporter(ctx, request)
+	stop := make(chan struct{})
+	go progress.Heartbeat(stop)
+	defer close(stop)
+
+	timeoutSeconds, _ := arguments["timeoutSeconds"].(int64)
+	deadline := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+
+	params := map[string]interface{}{"operation": "start"}
+	for _, name := range []string{"name", "version", "gitUrl"} {
+		if v, ok := arguments[name]; ok {
+			params[name] = v
+		}
+	}
+	data, err := queryUnity(ctx, toolName, params)
+	if err != nil {
+		return unityErrorResult(ctx, toolName, err)
+	}
+	status, ok := data.(map[string]interface{})
+	if !ok {
+		return toolErrorResult(ctx, errCodeUnityToolFailed, "unity returned no package operation status", toolName), nil
+	}
+	operationID, _ := status["operationId"].(string)
+	log.Info("Package operation started", "operation_id", operationID, "identifier", status["identifier"])
+	progress.Report(0, fmt.Sprintf("%s %v", toolName, status["identifier"]))
+
+	for {
+		if state, _ := status["status"].(string); state != "running" {
+			break
+		}
+		if time.Now().After(deadline) {
+			// Package Manager的请求无法取消，Unity会继续完成它
+			log.Warn("Timed out waiting for package operation", "operation_id", operationID, "timeout_seconds", timeoutSeconds)
+			message := fmt.Sprintf("package operation did not finish within timeoutSeconds=%d; it continues in Unity, check package_list later", timeoutSeconds)
+			return toolErrorResult(ctx, errCodeUnityToolFailed, message, toolName), nil
+		}
+		select {
+		case <-time.After(packagePollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		latest, err := queryUnityLevel(ctx, toolName, map[string]interface{}{"operation": "status", "operationId": operationID}, slog.LevelDebug)
+		switch {
+		case isUnityActionError(err):
+			return unityErrorResult(ctx, toolName, err)
+		case err != nil:
+			log.Debug("Waiting for Unity during package operation", "error", err.Error())
+		default:
+			if m, ok := latest.(map[string]interface{}); ok {
+				status = m
+			}
+		}
+	}
+
+	if state, _ := status["status"].(string); state != "succeeded" {
+		message, _ := status["error"].(string)
+		log.Warn("Package operation failed", "operation_id", operationID, "error", message, "error_code", status["errorCode"])
+		return toolErrorResult(ctx, errCodeUnityToolFailed, fmt.Sprintf("%s failed: %s", toolName, message), toolName), nil
+	}
+
+	// 包变化后Unity会重新编译，等待编译结束再返回
+	progress.Report(0.5, "waiting for Unity to recompile")
+	remaining := time.Until(deadline)
+	if remaining < idlePollInterval*idleConfirmations {
+		remaining = idlePollInterval * idleConfirmations
+	}
+	status["editor"] = waitForIdle(ctx, remaining)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	progress.Done()
+	log.Info("Package operation finished", "operation_id", operationID, "package", status["packageName"], "version", status["installedVersion"])
+	return toolSuccessResult(ctx, toolName, status), nil
+}
+
+// normalizePackageAddArgs 需要name或gitUrl之一；version只能与name一起使用
+func normalizePackageAddArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	name, hasName := arguments["name"].(string)
+	gitURL, hasGitURL := arguments["gitUrl"].(string)
+	_, hasVersion := arguments["version"].(string)
+	switch {
+	case hasName == hasGitURL:
+		return nil, fmt.Errorf("exactly one of name or gitUrl is required")
+	case hasVersion && !hasName:
+		return nil, fmt.Errorf("version can only be used with name; put the revision in gitUrl after #")
+	case hasName && !packageNamePattern.MatchString(name):
+		return nil, fmt.Errorf("invalid package name %q, expected a lowercase name such as com.unity.textmeshpro", name)
+	case hasGitURL && !validGitURL(gitURL):
+		return nil, fmt.Errorf("invalid gitUrl %q, expected an https://, ssh://, git@, git+ or file: URL", gitURL)
+	}
+	return arguments, nil
+}
+
+// normalizePackageRemoveArgs 删除包必须显式确认
+func normalizePackageRemoveArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if confirm, _ := arguments["confirm"].(bool); !confirm {
+		return nil, fmt.Errorf("package_remove requires confirm=true")
+	}
+	if name, _ := arguments["name"].(string); !packageNamePattern.MatchString(name) {
+		return nil, fmt.Errorf("invalid package name %q", name)
+	}
+	return arguments, nil
+}
+
+// validGitURL 判断是否为Package Manager接受的git或本地包地址
+func validGitURL(url string) bool {
+	for _, prefix := range []string{"https://", "http://", "ssh://", "git@", "git+", "file:"} {
+		if strings.HasPrefix(url, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// particle_system_set / particle_system_get 只覆盖常用的模块和属性，结构为 {模块: {属性: 值}}
+// range 属性接受常量或 {min, max} 两个常量，colorRange 接受一个颜色或 {min, max} 两个颜色
+
+var particleModules = map[string]map[string]settingKey{
+	"main": {
+		"duration":        {Type: "number", Minimum: floatPtr(0.05), Description: "System duration in seconds"},
+		"looping":         {Type: "boolean", Description: "Loop the system"},
+		"prewarm":         {Type: "boolean", Description: "Start as if one loop already ran (looping only)"},
+		"startDelay":      {Type: "range", Minimum: floatPtr(0), Description: "Delay before emitting"},
+		"startLifetime":   {Type: "range", Minimum: floatPtr(0), Description: "Particle lifetime in seconds"},
+		"startSpeed":      {Type: "range", Description: "Initial speed"},
+		"startSize":       {Type: "range", Minimum: floatPtr(0), Description: "Initial size"},
+		"startRotation":   {Type: "range", Description: "Initial rotation in degrees"},
+		"startColor":      {Type: "colorRange", Description: "Initial color"},
+		"gravityModifier": {Type: "range", Description: "Scale of Physics.gravity"},
+		"simulationSpace": {Type: "string", Enum: []string{"Local", "World"}, Description: "Space particles are simulated in"},
+		"simulationSpeed": {Type: "number", Minimum: floatPtr(0), Description: "Playback speed multiplier"},
+		"playOnAwake":     {Type: "boolean", Description: "Play when the object awakes"},
+		"maxParticles":    {Type: "integer", Minimum: floatPtr(0), Description: "Maximum alive particles"},
+	},
+	"emission": {
+		"enabled":          {Type: "boolean", Description: "Enable the module"},
+		"rateOverTime":     {Type: "range", Minimum: floatPtr(0), Description: "Particles per second"},
+		"rateOverDistance": {Type: "range", Minimum: floatPtr(0), Description: "Particles per unit moved"},
+		"bursts":           {Type: "array", Description: "Replaces all bursts: [{time, count, cycles, interval, probability}]"},
+	},
+	"shape": {
+		"enabled":         {Type: "boolean", Description: "Enable the module"},
+		"shapeType":       {Type: "string", Enum: []string{"Sphere", "Hemisphere", "Cone", "ConeVolume", "Box", "BoxShell", "BoxEdge", "Circle", "Donut", "Rectangle", "SingleSidedEdge"}, Description: "Emitter shape"},
+		"radius":          {Type: "number", Minimum: floatPtr(0), Description: "Shape radius"},
+		"radiusThickness": {Type: "number", Minimum: floatPtr(0), Maximum: floatPtr(1), Description: "0 emits from the surface, 1 from the whole volume"},
+		"angle":           {Type: "number", Minimum: floatPtr(0), Maximum: floatPtr(90), Description: "Cone angle in degrees"},
+		"arc":             {Type: "number", Minimum: floatPtr(0), Maximum: floatPtr(360), Description: "Arc in degrees"},
+	},
+}
+
+// particleModuleNames 模块名，用于错误信息
+func particleModuleNames() []string {
+	return slices.Sorted(maps.Keys(particleModules))
+}
+
+// describeParticleModules 工具说明中列出的模块和属性
+func describeParticleModules() string {
+	var parts []string
+	for _, name := range particleModuleNames() {
+		parts = append(parts, name+": "+describeSettingKeys(particleModules[name]))
+	}
+	return strings.Join(parts, ". ")
+}
+
+// normalizeParticleSystemSetArgs 按particleModules检查modules，未知模块或属性直接失败
+func normalizeParticleSystemSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	modules, _ := arguments["modules"].(map[string]interface{})
+	restart, _ := arguments["restart"].(bool)
+	if len(modules) == 0 && !restart {
+		return nil, fmt.Errorf("nothing to change: provide modules and/or restart=true, valid modules: %s", strings.Join(particleModuleNames(), ", "))
+	}
+
+	var problems []string
+	for _, moduleName := range slices.Sorted(maps.Keys(modules)) {
+		keys, ok := particleModules[moduleName]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown module %q, valid modules: %s", moduleName, strings.Join(particleModuleNames(), ", ")))
+			continue
+		}
+		properties, ok := modules[moduleName].(map[string]interface{})
+		if !ok || len(properties) == 0 {
+			problems = append(problems, fmt.Sprintf("%s must be a non-empty object of properties", moduleName))
+			continue
+		}
+		for _, problem := range checkSettingValues(properties, keys) {
+			problems = append(problems, moduleName+": "+problem)
+		}
+		if bursts, ok := properties["bursts"].([]interface{}); ok {
+			for _, problem := range checkParticleBursts(bursts) {
+				problems = append(problems, moduleName+": "+problem)
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid particle system arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// particleBurstKeys 每个burst的字段
+var particleBurstKeys = map[string]settingKey{
+	"time":        {Type: "number", Minimum: floatPtr(0)},
+	"count":       {Type: "range", Minimum: floatPtr(0)},
+	"cycles":      {Type: "integer", Minimum: floatPtr(1)},
+	"interval":    {Type: "number", Minimum: floatPtr(0.01)},
+	"probability": {Type: "number", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+}
+
+// checkParticleBursts 每个burst需要time和count
+func checkParticleBursts(bursts []interface{}) []string {
+	var problems []string
+	for i, item := range bursts {
+		burst, ok := item.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("bursts[%d] must be an object", i))
+			continue
+		}
+		_, hasTime := burst["time"]
+		_, hasCount := burst["count"]
+		if !hasTime || !hasCount {
+			problems = append(problems, fmt.Sprintf("bursts[%d] needs time and count", i))
+		}
+		for _, problem := range checkSettingValues(burst, particleBurstKeys) {
+			problems = append(problems, fmt.Sprintf("bursts[%d] %s", i, problem))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// rigidbody_set / collider_set 通过is2D选择3D或2D物理组件，各自的枚举与Unity的枚举名一致
+
+var (
+	rigidbodyInterpolations = []string{"None", "Interpolate", "Extrapolate"}
+	collisionModes3D        = []string{"Discrete", "Continuous", "ContinuousDynamic", "ContinuousSpeculative"}
+	collisionModes2D        = []string{"Discrete", "Continuous"}
+	rigidbodyConstraints3D  = []string{"None", "FreezePositionX", "FreezePositionY", "FreezePositionZ",
+		"FreezeRotationX", "FreezeRotationY", "FreezeRotationZ", "FreezePosition", "FreezeRotation", "FreezeAll"}
+	rigidbodyConstraints2D = []string{"None", "FreezePositionX", "FreezePositionY", "FreezeRotation", "FreezePosition", "FreezeAll"}
+	colliderTypes3D        = []string{"Box", "Sphere", "Capsule", "Mesh"}
+	colliderTypes2D        = []string{"Box", "Circle", "Capsule", "Polygon", "Edge"}
+	capsuleDirections      = []string{"X", "Y", "Z", "Vertical", "Horizontal"}
+)
+
+// colliderParams 每种碰撞体适用的形状参数，键为 "3D:Box" 形式
+var colliderParams = map[string][]string{
+	"3D:Box":     {"center", "size"},
+	"3D:Sphere":  {"center", "radius"},
+	"3D:Capsule": {"center", "radius", "height", "direction"},
+	"3D:Mesh":    {"convex"},
+	"2D:Box":     {"center", "size"},
+	"2D:Circle":  {"center", "radius"},
+	"2D:Capsule": {"center", "size", "direction"},
+	"2D:Polygon": {"center"},
+	"2D:Edge":    {"center"},
+}
+
+// colliderShapeParams 所有形状参数，用于检查未给出colliderType时的组合
+var colliderShapeParams = []string{"center", "size", "radius", "height", "direction", "convex"}
+
+// normalizeRigidbodySetArgs 按is2D检查只适用于3D或2D的参数，并把constraints名称规范为Unity的枚举名
+func normalizeRigidbodySetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	is2D, _ := arguments["is2D"].(bool)
+	var problems []string
+	if is2D {
+		if _, ok := arguments["useGravity"]; ok {
+			problems = append(problems, "useGravity is 3D only; use gravityScale for Rigidbody2D")
+		}
+	} else if _, ok := arguments["gravityScale"]; ok {
+		problems = append(problems, "gravityScale is only valid with is2D: true")
+	}
+
+	modes, constraints, dimension := collisionModes3D, rigidbodyConstraints3D, "3D"
+	if is2D {
+		modes, constraints, dimension = collisionModes2D, rigidbodyConstraints2D, "2D"
+	}
+	if mode, ok := arguments["collisionDetection"].(string); ok {
+		if canonical, found := canonicalName(modes, mode); found {
+			arguments["collisionDetection"] = canonical
+		} else {
+			problems = append(problems, fmt.Sprintf("collisionDetection %q is not valid for %s, expected one of: %s", mode, dimension, strings.Join(modes, ", ")))
+		}
+	}
+	if list, ok := arguments["constraints"].([]interface{}); ok {
+		names := make([]interface{}, 0, len(list))
+		for i, item := range list {
+			name, _ := item.(string)
+			canonical, found := canonicalName(constraints, name)
+			if !found {
+				problems = append(problems, fmt.Sprintf("constraints[%d] %v is not a %s constraint, expected one of: %s", i, item, dimension, strings.Join(constraints, ", ")))
+				continue
+			}
+			names = append(names, canonical)
+		}
+		arguments["constraints"] = names
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid rigidbody arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// normalizeColliderSetArgs 检查colliderType与is2D匹配，以及形状参数是否适用于该碰撞体
+// 未给出colliderType时使用对象上已有的碰撞体，由Unity检查形状参数
+func normalizeColliderSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	is2D, _ := arguments["is2D"].(bool)
+	types, dimension := colliderTypes3D, "3D"
+	if is2D {
+		types, dimension = colliderTypes2D, "2D"
+	}
+
+	var problems []string
+	if center, ok := arguments["center"].(map[string]interface{}); ok && is2D {
+		if _, hasZ := center["z"]; hasZ {
+			problems = append(problems, "center.z is not used by 2D colliders")
+		}
+	}
+	if size, ok := arguments["size"].(map[string]interface{}); ok && is2D {
+		if _, hasZ := size["z"]; hasZ {
+			problems = append(problems, "size.z is not used by 2D colliders")
+		}
+	}
+	if direction, ok := arguments["direction"].(string); ok {
+		valid := []string{"X", "Y", "Z"}
+		if is2D {
+			valid = []string{"Vertical", "Horizontal"}
+		}
+		if !slices.Contains(valid, direction) {
+			problems = append(problems, fmt.Sprintf("direction %s is not valid for %s capsules, expected one of: %s", direction, dimension, strings.Join(valid, ", ")))
+		}
+	}
+
+	if colliderType, ok := arguments["colliderType"].(string); ok {
+		canonical, found := canonicalName(types, colliderType)
+		if !found {
+			problems = append(problems, fmt.Sprintf("colliderType %q is not a %s collider, expected one of: %s", colliderType, dimension, strings.Join(types, ", ")))
+		} else {
+			arguments["colliderType"] = canonical
+			allowed := colliderParams[dimension+":"+canonical]
+			for _, name := range colliderShapeParams {
+				if _, has := arguments[name]; has && !slices.Contains(allowed, name) {
+					problems = append(problems, fmt.Sprintf("%s is not used by %s %s colliders", name, dimension, canonical))
+				}
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid collider arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// canonicalName 不区分大小写地在names中查找name，返回规范的写法
+func canonicalName(names []string, name string) (string, bool) {
+	i := slices.IndexFunc(names, func(n string) bool { return strings.EqualFold(n, name) })
+	if i < 0 {
+		return "", false
+	}
+	return names[i], true
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// player_settings_set 的 settings 只接受 playerSettingKeys 中声明的键
+// perPlatform 的键按 platform 写入对应的 BuildTargetGroup，其余键为项目全局设置
+// confirm 的键 (包名、脚本后端) 影响构建产物和已发布应用的身份，必须显式确认
+
+// playerSettingPlatforms 可用于platform的BuildTargetGroup名称
+var playerSettingPlatforms = []string{"Standalone", "Android", "iOS", "WebGL", "tvOS", "PS4", "PS5", "XboxOne", "Switch"}
+
+var playerSettingKeys = map[string]settingKey{
+	"companyName":         {Type: "string", Description: "Company name"},
+	"productName":         {Type: "string", Description: "Product name"},
+	"version":             {Type: "string", Description: "Application version (bundleVersion)"},
+	"defaultOrientation":  {Type: "string", Enum: []string{"Portrait", "PortraitUpsideDown", "LandscapeLeft", "LandscapeRight", "AutoRotation"}, Description: "Default screen orientation on mobile"},
+	"runInBackground":     {Type: "boolean", Description: "Keep running when the application loses focus"},
+	"defaultScreenWidth":  {Type: "integer", Minimum: floatPtr(1), Description: "Default standalone window width"},
+	"defaultScreenHeight": {Type: "integer", Minimum: floatPtr(1), Description: "Default standalone window height"},
+	"fullScreenMode":      {Type: "string", Enum: []string{"ExclusiveFullScreen", "FullScreenWindow", "MaximizedWindow", "Windowed"}, Description: "Default standalone fullscreen mode"},
+	"bundleIdentifier":    {Type: "string", PerPlatform: true, Confirm: true, Description: "Application identifier, e.g. com.company.game"},
+	"scriptingBackend":    {Type: "string", Enum: []string{"Mono2x", "IL2CPP"}, PerPlatform: true, Confirm: true, Description: "Scripting backend"},
+	"apiCompatibilityLevel": {Type: "string", Enum: []string{"NET_Standard_2_0", "NET_Standard", "NET_4_6", "NET_Unity_4_8"},
+		PerPlatform: true, Description: ".NET API compatibility level"},
+	"il2cppCompilerConfiguration": {Type: "string", Enum: []string{"Debug", "Release", "Master"}, PerPlatform: true, Description: "IL2CPP compiler configuration"},
+	"scriptingDefineSymbols":      {Type: "string", PerPlatform: true, Description: "Scripting define symbols separated by ;"},
+}
+
+// normalizePlayerSettingsSetArgs 按playerSettingKeys检查settings
+// 含perPlatform键时需要platform，含confirm键时需要confirm=true
+func normalizePlayerSettingsSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	settings, _ := arguments["settings"].(map[string]interface{})
+	if len(settings) == 0 {
+		return nil, fmt.Errorf("settings must contain at least one key, valid keys: %s", strings.Join(settingKeyNames(playerSettingKeys), ", "))
+	}
+	_, hasPlatform := arguments["platform"]
+	confirm, _ := arguments["confirm"].(bool)
+
+	problems := checkSettingValues(settings, playerSettingKeys)
+	var needPlatform, needConfirm []string
+	for _, name := range settingKeyNames(playerSettingKeys) {
+		key := playerSettingKeys[name]
+		if _, ok := settings[name]; !ok {
+			continue
+		}
+		if key.PerPlatform && !hasPlatform {
+			needPlatform = append(needPlatform, name)
+		}
+		if key.Confirm && !confirm {
+			needConfirm = append(needConfirm, name)
+		}
+	}
+	if len(needPlatform) > 0 {
+		problems = append(problems, "per platform keys require platform: "+strings.Join(needPlatform, ", "))
+	}
+	if len(needConfirm) > 0 {
+		problems = append(problems, fmt.Sprintf("changing %s requires confirm=true", strings.Join(needConfirm, ", ")))
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid player settings: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// editor_play_mode 的play/stop在Unity的下一帧才生效，进入播放模式时通常会重载脚本域并断开TCP连接
+// 因此play/stop只发送一次 (不按重试策略重试)，之后轮询status直到Unity重新连接并完成切换，
+// 断线期间的通信失败是预期的，只记录调试日志
+
+// playModeOperations editor_play_mode 的操作
+var playModeOperations = []string{"play", "stop", "pause", "resume", "step", "status"}
+
+// playModePollInterval 等待播放模式切换时轮询Unity的间隔
+const playModePollInterval = 500 * time.Millisecond
+
+// handlePlayMode play/stop等待切换完成，其他操作直接转发
+func handlePlayMode(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "editor_play_mode"
+	arguments := request.GetArguments()
+	operation, _ := arguments["operation"].(string)
+	st := stateFromContext(ctx)
+	if (operation != "play" && operation != "stop") || isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	log := callInfoFromContext(ctx).Logger()
+
+	before, err := queryPlayModeStatus(ctx)
+	if err != nil {
+		return toolErrorResult(ctx, errCodeUnityUnavailable, fmt.Sprintf("Unity communication failed: %s", err.Error()), toolName), nil
+	}
+
+	start := time.Now()
+	data, err := queryUnityLevel(ctx, toolName, arguments, slog.LevelDebug)
+	switch {
+	case isUnityActionError(err) || ctx.Err() != nil:
+		return unityErrorResult(ctx, toolName, err)
+	case err != nil:
+		// 连接可能在响应之前就因域重载断开，继续等待Unity恢复后确认状态
+		log.Debug("Play mode request got no response, waiting for Unity", "operation", operation, "error", err.Error())
+	}
+	acknowledged := err == nil
+	invalidateUnityInfo()
+	if response, _ := data.(map[string]interface{}); response != nil {
+		if expected, _ := response["domainReloadExpected"].(bool); expected {
+			// 当前连接即将失效，下一次请求重新连接
+			if err := unityClient.Disconnect(ctx); err != nil {
+				log.Debug("Failed to drop Unity connection before domain reload", "error", err.Error())
+			}
+		}
+	}
+
+	status, err := waitForPlayMode(ctx, operation == "play")
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return toolErrorResult(ctx, errCodeUnityUnavailable,
+			fmt.Sprintf("Unity did not finish %s within %v: %s", operation, playModeWaitTimeout(st), err.Error()), toolName), nil
+	}
+
+	beforeReloads, _ := before["domainReloadCount"].(float64)
+	afterReloads, _ := status["domainReloadCount"].(float64)
+	status["operation"] = operation
+	status["acknowledged"] = acknowledged
+	status["domainReloaded"] = afterReloads > beforeReloads
+	status["waitedMs"] = time.Since(start).Milliseconds()
+	log.Info("Play mode changed", "operation", operation, "domain_reloaded", status["domainReloaded"], "waited_ms", status["waitedMs"])
+	return toolSuccessResult(ctx, toolName, status), nil
+}
+
+// playModeWaitTimeout 等待播放模式切换的总时间，使用工具的超时提示
+func playModeWaitTimeout(st *liveState) time.Duration {
+	if def := st.Tools.Lookup("editor_play_mode"); def != nil && def.TimeoutHint > 0 {
+		return def.TimeoutHint
+	}
+	return st.Config.Timeout
+}
+
+// waitForPlayMode 轮询status直到isPlaying等于playing且不在切换中
+func waitForPlayMode(ctx context.Context, playing bool) (map[string]interface{}, error) {
+	log := callInfoFromContext(ctx).Logger()
+	deadline := time.Now().Add(playModeWaitTimeout(stateFromContext(ctx)))
+	for {
+		status, err := queryPlayModeStatus(ctx)
+		if err == nil {
+			isPlaying, _ := status["isPlaying"].(bool)
+			transitioning, _ := status["isTransitioning"].(bool)
+			if isPlaying == playing && !transitioning {
+				return status, nil
+			}
+		} else {
+			log.Debug("Waiting for Unity to finish play mode change", "error", err.Error())
+		}
+
+		if time.Now().Add(playModePollInterval).After(deadline) {
+			if err == nil {
+				err = fmt.Errorf("play mode is still changing (isPlaying=%v, isCompiling=%v)", status["isPlaying"], status["isCompiling"])
+			}
+			log.Warn("Timed out waiting for play mode change", "error", err.Error())
+			return nil, err
+		}
+		select {
+		case <-time.After(playModePollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// queryPlayModeStatus 调用 editor_play_mode status，失败时只记录调试日志
+func queryPlayModeStatus(ctx context.Context) (map[string]interface{}, error) {
+	data, err := queryUnityLevel(ctx, "editor_play_mode", map[string]interface{}{"operation": "status"}, slog.LevelDebug)
+	if err != nil {
+		return nil, err
+	}
+	status, _ := data.(map[string]interface{})
+	if status == nil {
+		return nil, fmt.Errorf("unity returned no play mode status")
+	}
+	return status, nil
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// toolPolicy 决定哪些工具可用，在启动和重新加载配置时评估
+// 被拒绝的工具不会出现在MCP工具列表和 /tools 中
+type toolPolicy struct {
+	ReadOnly bool     // -readonly: 拒绝所有非只读工具
+	Allow    []string // -allow-tools: 非空时只允许匹配的工具
+	Deny     []string // -deny-tools: 拒绝匹配的工具，优先于allow
+}
+
+// newToolPolicy 根据配置创建工具策略
+func newToolPolicy(c ServerConfig) toolPolicy {
+	return toolPolicy{
+		ReadOnly: c.ReadOnly,
+		Allow:    splitPatterns(c.AllowTools),
+		Deny:     splitPatterns(c.DenyTools),
+	}
+}
+
+// Check 返回工具被拒绝的原因，允许时返回空字符串
+func (p toolPolicy) Check(def *ToolDefinition) string {
+	if p.ReadOnly && !def.ReadOnly {
+		return "server is running in read-only mode"
+	}
+	if pattern, ok := matchPattern(p.Deny, def.Name); ok {
+		return fmt.Sprintf("denied by -deny-tools pattern %q", pattern)
+	}
+	if len(p.Allow) > 0 {
+		if _, ok := matchPattern(p.Allow, def.Name); !ok {
+			return "not matched by -allow-tools"
+		}
+	}
+	return ""
+}
+
+// splitPatterns 解析逗号分隔的glob列表
+func splitPatterns(list string) []string {
+	var patterns []string
+	for _, p := range strings.Split(list, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns
+}
+
+// matchPattern 返回第一个匹配工具名的glob
+func matchPattern(patterns []string, name string) (string, bool) {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, name); ok {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// validatePatterns 检查glob列表的语法
+func validatePatterns(name, list string) error {
+	for _, p := range splitPatterns(list) {
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("%s contains invalid pattern %q: %w", name, p, err)
+		}
+	}
+	return nil
+}
+
+// toolSet 按工具策略划分的工具集合，重新加载配置时整体替换
+type toolSet struct {
+	registry []ToolDefinition // 启用的工具
+	index    map[string]*ToolDefinition
+	denied   map[string]string // 被策略拒绝的工具及原因
+}
+
+// newToolSet 按策略划分所有工具定义
+func newToolSet(policy toolPolicy) *toolSet {
+	t := &toolSet{
+		registry: make([]ToolDefinition, 0, len(toolDefinitions)),
+		denied:   make(map[string]string),
+	}
+	for i := range toolDefinitions {
+		def := &toolDefinitions[i]
+		if reason := policy.Check(def); reason != "" {
+			t.denied[def.Name] = reason
+			continue
+		}
+		t.registry = append(t.registry, *def)
+	}
+	t.index = make(map[string]*ToolDefinition, len(t.registry))
+	for i := range t.registry {
+		t.index[t.registry[i].Name] = &t.registry[i]
+	}
+	return t
+}
+
+// Lookup 按名称查找启用的工具
+func (t *toolSet) Lookup(name string) *ToolDefinition {
+	return t.index[name]
+}
+
+// Denied 返回工具被拒绝的原因
+func (t *toolSet) Denied(name string) (string, bool) {
+	reason, ok := t.denied[name]
+	return reason, ok
+}
+
+// deniedResult 被拒绝工具的调用结果，不联系Unity
+// 用于客户端缓存了旧工具列表的情况
+func deniedResult(ctx context.Context, toolName, reason string) *mcp.CallToolResult {
+	callInfoFromContext(ctx).Logger().Warn("Rejected call to disabled tool", "tool", toolName, "reason", reason)
+	return toolErrorResult(ctx, errCodeToolDenied,
+		fmt.Sprintf("Tool %s is disabled by server policy: %s", toolName, reason),
+		toolName)
+}
+
+// disabledToolList 返回被策略拒绝的工具及原因，按名称排序
+func (t *toolSet) disabledToolList() []map[string]string {
+	names := make([]string, 0, len(t.denied))
+	for name := range t.denied {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	list := make([]map[string]string, 0, len(names))
+	for _, name := range names {
+		list = append(list, map[string]string{"name": name, "reason": t.denied[name]})
+	}
+	return list
+}
+
+// hideDeniedTools 从tools/list结果中移除被拒绝的工具
+func hideDeniedTools(ctx context.Context, tools []mcp.Tool) []mcp.Tool {
+	set := currentState().Tools
+	if len(set.denied) == 0 {
+		return tools
+	}
+	visible := make([]mcp.Tool, 0, len(tools))
+	for _, tool := range tools {
+		if _, denied := set.Denied(tool.Name); !denied {
+			visible = append(visible, tool)
+		}
+	}
+	return visible
+}
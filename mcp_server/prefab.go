@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// prefab_modify 的操作，以及每个操作允许的附加参数
+var prefabOperationParams = map[string][]string{
+	"apply":           {"mode", "propertyPath"},
+	"revert":          {"mode", "propertyPath"},
+	"unpack":          {"completely"},
+	"disconnect":      {},
+	"check_overrides": {"includeDefaultOverrides"},
+}
+
+// prefabOperations 按固定顺序返回所有操作，用于参数的enum
+var prefabOperations = []string{"apply", "revert", "unpack", "disconnect", "check_overrides"}
+
+// normalizePrefabModifyArgs 检查参数组合是否适用于所选操作
+// apply/revert 给出propertyPath而未给出mode时视为 mode=property
+func normalizePrefabModifyArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	operation, _ := arguments["operation"].(string)
+	allowed := prefabOperationParams[operation]
+	for name := range arguments {
+		if name == "instanceId" || name == "operation" || name == targetPathArgument || reservedArgument(name) {
+			continue
+		}
+		if !slices.Contains(allowed, name) {
+			if len(allowed) == 0 {
+				return nil, fmt.Errorf("parameter %q is not supported by operation %s, which takes no extra parameters", name, operation)
+			}
+			return nil, fmt.Errorf("parameter %q is not supported by operation %s; supported: %s", name, operation, strings.Join(allowed, ", "))
+		}
+	}
+
+	if operation != "apply" && operation != "revert" {
+		return arguments, nil
+	}
+	mode, hasMode := arguments["mode"].(string)
+	path, _ := arguments["propertyPath"].(string)
+	switch {
+	case !hasMode && path != "":
+		arguments["mode"] = "property"
+	case mode == "property" && path == "":
+		return nil, fmt.Errorf("mode=property requires propertyPath")
+	case mode == "all" && path != "":
+		return nil, fmt.Errorf("propertyPath cannot be used with mode=all")
+	}
+	return arguments, nil
+}
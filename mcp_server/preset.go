@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// presetImporterClasses importerTypeByExtension的导入器类型对应的Unity导入器类名
+var presetImporterClasses = map[string]string{"texture": "TextureImporter", "model": "ModelImporter", "audio": "AudioImporter"}
+
+// handlePresetApply 转发之前通过asset_get_info读取预设的目标类型，确认它能用于给出的目标
+func handlePresetApply(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "preset_apply"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+
+	presetPath, _ := arguments["presetPath"].(string)
+	data, err := queryUnityLevel(ctx, "asset_get_info", map[string]interface{}{"assetPath": presetPath, "includeMetadata": false}, slog.LevelDebug)
+	switch {
+	case isUnityActionError(err):
+		return toolErrorResult(ctx, errCodeInvalidArguments, "preset not found: "+presetPath, toolName), nil
+	case err != nil:
+		return unityErrorResult(ctx, toolName, err)
+	}
+	info, _ := data.(map[string]interface{})
+	assetType, _ := info["mainAssetType"].(map[string]interface{})
+	if name, _ := assetType["name"].(string); name != "Preset" {
+		return toolErrorResult(ctx, errCodeInvalidArguments, fmt.Sprintf("not a preset: %s (%s)", presetPath, name), toolName), nil
+	}
+	// 旧版本的Unity插件不返回presetTarget，此时只由Unity端检查
+	if target, ok := info["presetTarget"].(map[string]interface{}); ok {
+		targetType, _ := target["name"].(string)
+		if problem := checkPresetTarget(arguments, targetType); problem != "" {
+			return toolErrorResult(ctx, errCodeInvalidArguments, problem, toolName), nil
+		}
+	}
+	return forwardToUnity(ctx, toolName, arguments, request)
+}
+
+// checkPresetTarget 导入器预设只能用于assetPath/folder，组件预设只能用于instanceId + 同类型的componentType
+func checkPresetTarget(arguments map[string]interface{}, targetType string) string {
+	importer := strings.HasSuffix(targetType, "Importer")
+	if componentType, ok := arguments["componentType"].(string); ok {
+		if importer {
+			return fmt.Sprintf("the preset targets %s, an asset importer; give assetPath or folder instead of a component", targetType)
+		}
+		short := componentType[strings.LastIndex(componentType, ".")+1:]
+		if !strings.EqualFold(short, targetType) {
+			return fmt.Sprintf("the preset targets %s but componentType is %s", targetType, componentType)
+		}
+		return ""
+	}
+	if !importer {
+		return fmt.Sprintf("the preset targets %s, not an asset importer; give instanceId (or path) and componentType instead", targetType)
+	}
+	assetPath, _ := arguments["assetPath"].(string)
+	if class := presetImporterClasses[importerTypeByExtension[strings.ToLower(path.Ext(assetPath))]]; class != "" && class != targetType {
+		return fmt.Sprintf("the preset targets %s but %s is imported by %s", targetType, assetPath, class)
+	}
+	return ""
+}
+
+// normalizePresetApplyArgs 需要assetPath、folder或instanceId/path中的一个；include和exclude只能用于folder
+func normalizePresetApplyArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	presetPath, _ := arguments["presetPath"].(string)
+	if !strings.HasPrefix(presetPath, "Assets/") || !strings.HasSuffix(strings.ToLower(presetPath), ".preset") {
+		problems = append(problems, fmt.Sprintf("presetPath %q must be a .preset asset under Assets/", presetPath))
+	}
+
+	_, hasAsset := arguments["assetPath"]
+	folder, hasFolder := arguments["folder"].(string)
+	_, hasId := arguments["instanceId"]
+	_, hasPath := arguments["path"]
+	hasObject := hasId || hasPath
+	targets := 0
+	for _, has := range []bool{hasAsset, hasFolder, hasObject} {
+		if has {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return nil, fmt.Errorf("exactly one target is required: assetPath (importer preset), folder (importer preset for many assets) or instanceId/path with componentType (component preset)")
+	}
+
+	if hasObject {
+		if err := resolveTargetPath(arguments); err != nil {
+			return nil, err
+		}
+		if _, ok := arguments["componentType"]; !ok {
+			problems = append(problems, "componentType is required with instanceId or path")
+		}
+	} else if _, ok := arguments["componentType"]; ok {
+		problems = append(problems, "componentType can only be used with instanceId or path")
+	}
+	if hasFolder {
+		cleaned := path.Clean(strings.ReplaceAll(folder, "\\", "/"))
+		if cleaned != "Assets" && !strings.HasPrefix(cleaned, "Assets/") {
+			problems = append(problems, fmt.Sprintf("folder must be Assets or a folder under Assets/, got %q", folder))
+		}
+		arguments["folder"] = cleaned
+	}
+	for _, key := range []string{"include", "exclude"} {
+		list, ok := arguments[key].([]interface{})
+		if ok && !hasFolder {
+			problems = append(problems, key+" can only be used with folder")
+		}
+		for i, item := range list {
+			if s, ok := item.(string); !ok || s == "" {
+				problems = append(problems, fmt.Sprintf("%s[%d] must be a non-empty string", key, i))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid preset_apply arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
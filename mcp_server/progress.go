@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// Unity在最终响应之前可以发送任意数量的进度帧，格式为:
+//   {"type": "progress", "id": "<请求ID>", "progress": 0.5, "message": "..."}
+// progress取值0~1，帧会被转发为MCP的 notifications/progress
+
+// 没有进度帧时发送心跳的间隔
+const progressHeartbeatInterval = 5 * time.Second
+
+// progressFunc 接收Unity发送的进度 (0~1) 和说明
+type progressFunc func(progress float64, message string)
+
+// progressReporter 将工具调用进度以 notifications/progress 发送给MCP客户端
+// 客户端未提供progressToken时为nil，所有方法都可以安全地在nil上调用
+type progressReporter struct {
+	ctx      context.Context
+	srv      *server.MCPServer
+	token    mcp.ProgressToken
+	start    time.Time
+	mu       sync.Mutex
+	last     float64
+	lastSent time.Time
+}
+
+// newProgressReporter 请求携带progressToken时创建进度上报器
+func newProgressReporter(ctx context.Context, request mcp.CallToolRequest) *progressReporter {
+	if request.Params.Meta == nil || request.Params.Meta.ProgressToken == nil {
+		return nil
+	}
+	srv := server.ServerFromContext(ctx)
+	if srv == nil {
+		return nil
+	}
+	now := time.Now()
+	return &progressReporter{
+		ctx:      ctx,
+		srv:      srv,
+		token:    request.Params.Meta.ProgressToken,
+		start:    now,
+		lastSent: now,
+	}
+}
+
+// Report 转发Unity进度，MCP要求进度单调递增，因此忽略回退的值
+func (p *progressReporter) Report(progress float64, message string) {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if progress > 1 {
+		progress = 1
+	}
+	if progress <= p.last {
+		return
+	}
+	p.send(progress, message)
+}
+
+// Done 调用结束时发送最终的1.0
+// mcp-go通过独立的队列发送通知，这条通知可能晚于响应到达客户端
+func (p *progressReporter) Done() {
+	if p == nil {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.last < 1 {
+		p.send(1, "completed")
+	}
+}
+
+// Heartbeat 在Unity没有发送进度时定期发送合成进度，直到ctx结束或stop关闭
+// 合成进度逐步逼近但不会达到1，以满足单调递增的要求
+func (p *progressReporter) Heartbeat(stop <-chan struct{}) {
+	if p == nil {
+		return
+	}
+	ticker := time.NewTicker(progressHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-p.ctx.Done():
+			return
+		case <-ticker.C:
+			p.mu.Lock()
+			if next, ok := heartbeatProgress(p.last); ok && time.Since(p.lastSent) >= progressHeartbeatInterval {
+				p.send(next, fmt.Sprintf("Waiting for Unity (%ds elapsed)", int(time.Since(p.start).Seconds())))
+			}
+			p.mu.Unlock()
+		}
+	}
+}
+
+// heartbeatProgress 返回下一个合成进度: 每次前进剩余部分的10%，始终小于1
+// Unity报告的进度可能已经很接近1，无法再增加时返回false，不发送心跳
+func heartbeatProgress(last float64) (float64, bool) {
+	next := last + (1-last)*0.1
+	if next >= 1 || next <= last {
+		return 0, false
+	}
+	return next, true
+}
+
+// send 发送一条进度通知，调用方需持有锁
+func (p *progressReporter) send(progress float64, message string) {
+	p.last = progress
+	p.lastSent = time.Now()
+
+	params := map[string]any{
+		"progressToken": p.token,
+		"progress":      progress,
+		"total":         1.0,
+	}
+	if message != "" {
+		params["message"] = message
+	}
+	if err := p.srv.SendNotificationToClient(p.ctx, "notifications/progress", params); err != nil {
+		debugLog("Failed to send progress notification: %v", err)
+		return
+	}
+	traceLog("Progress notification sent: %.2f %s", progress, message)
+}
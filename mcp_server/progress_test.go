@@ -0,0 +1,29 @@
+package main
+
+import "testing"
+
+func TestHeartbeatProgressIsMonotonic(t *testing.T) {
+	// 包括Unity已报告高于0.95的进度的情况
+	for _, start := range []float64{0, 0.5, 0.95, 0.97, 0.999} {
+		last := start
+		for i := 0; i < 1000; i++ {
+			next, ok := heartbeatProgress(last)
+			if !ok {
+				break
+			}
+			if next <= last || next >= 1 {
+				t.Fatalf("start %v step %d: heartbeat progress %v after %v is not strictly increasing below 1", start, i, next, last)
+			}
+			last = next
+		}
+	}
+}
+
+func TestHeartbeatProgressStopsAtCompletion(t *testing.T) {
+	if _, ok := heartbeatProgress(1); ok {
+		t.Error("no heartbeat expected after progress reached 1")
+	}
+	if next, ok := heartbeatProgress(0); !ok || next != 0.1 {
+		t.Errorf("heartbeatProgress(0) = %v, %t, want 0.1, true", next, ok)
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// PromptArgument 提示词参数
+type PromptArgument struct {
+	Name        string
+	Description string
+	Required    bool
+}
+
+// PromptDefinition 声明式的MCP提示词定义
+// Tools列出提示词中引用的工具，注册时会检查这些工具是否存在，避免提示词与工具定义脱节
+type PromptDefinition struct {
+	Name        string
+	Description string
+	Arguments   []PromptArgument
+	Tools       []string
+	Render      func(args map[string]string) string
+}
+
+// registerPrompts 将所有提示词注册到MCP服务器
+func registerPrompts(s *server.MCPServer) {
+	for i := range promptDefinitions {
+		def := &promptDefinitions[i]
+		for _, tool := range def.Tools {
+			if _, denied := currentState().Tools.Denied(tool); denied {
+				debugLog("Prompt %s references tool %s which is disabled by policy", def.Name, tool)
+			} else if lookupTool(tool) == nil {
+				warnLog("Prompt %s references unknown tool %s", def.Name, tool)
+			}
+		}
+		s.AddPrompt(def.MCPPrompt(), def.handler())
+	}
+	debugLog("Registered %d prompts", len(promptDefinitions))
+}
+
+// MCPPrompt 根据定义构造mcp.Prompt
+func (d *PromptDefinition) MCPPrompt() mcp.Prompt {
+	opts := []mcp.PromptOption{mcp.WithPromptDescription(d.Description)}
+	for _, arg := range d.Arguments {
+		argOpts := []mcp.ArgumentOption{mcp.ArgumentDescription(arg.Description)}
+		if arg.Required {
+			argOpts = append(argOpts, mcp.RequiredArgument())
+		}
+		opts = append(opts, mcp.WithArgument(arg.Name, argOpts...))
+	}
+	return mcp.NewPrompt(d.Name, opts...)
+}
+
+// handler 校验必需参数后渲染提示词
+func (d *PromptDefinition) handler() server.PromptHandlerFunc {
+	return func(ctx context.Context, request mcp.GetPromptRequest) (*mcp.GetPromptResult, error) {
+		args := request.Params.Arguments
+		if args == nil {
+			args = map[string]string{}
+		}
+		for _, arg := range d.Arguments {
+			if arg.Required && strings.TrimSpace(args[arg.Name]) == "" {
+				return nil, fmt.Errorf("prompt %s requires argument %q", d.Name, arg.Name)
+			}
+		}
+		debugLog("Rendering prompt %s", d.Name)
+		return mcp.NewGetPromptResult(d.Description, []mcp.PromptMessage{
+			mcp.NewPromptMessage(mcp.RoleUser, mcp.NewTextContent(d.Render(args))),
+		}), nil
+	}
+}
+
+// argOr 返回参数值，为空时使用默认值
+func argOr(args map[string]string, name, fallback string) string {
+	if v := strings.TrimSpace(args[name]); v != "" {
+		return v
+	}
+	return fallback
+}
+
+// promptDefinitions 所有提示词，文本中的工具名和参数必须与toolDefinitions保持一致
+var promptDefinitions = []PromptDefinition{
+	{
+		Name:        "create_ui_screen",
+		Description: "Build a new UI screen under a Canvas in the current scene",
+		Arguments: []PromptArgument{
+			{Name: "name", Description: "Name of the screen root GameObject", Required: true},
+			{Name: "elements", Description: "Comma-separated list of elements to create (e.g. title text, start button, background image)"},
+		},
+		Tools: []string{"ui_create_element", "ui_rect_transform_set", "ui_text_set", "ui_image_set", "ui_button_set", "scene_save"},
+		Render: func(args map[string]string) string {
+			return fmt.Sprintf(`Create a UI screen named %q in the current Unity scene containing: %s.
+
+Steps:
+1. Create the screen root with ui_create_element {"elementType": "panel", "name": %q, "rect": "stretch"}. It is placed under the scene's Canvas; a Canvas and EventSystem are created if the scene has none.
+2. For each element, create it with ui_create_element {"elementType": <button/text/image/...>, "name": <element name>, "parentInstanceId": <screen root instanceId>, "text": <label>, "rect": <anchor preset>}.
+3. The result lists the created subtree; use the child instanceIds (e.g. a button's Text) for styling.
+4. Fine-tune layout with ui_rect_transform_set {"instanceId": <id>, ...}, and set content with ui_text_set / ui_image_set; configure buttons with ui_button_set.
+5. Save with scene_save {} once everything is in place.
+
+Keep track of the instanceId returned by each call; all follow-up calls address objects by instanceId. Report the resulting hierarchy when done.`,
+				args["name"], argOr(args, "elements", "a title text and a close button"), args["name"])
+		},
+	},
+	{
+		Name:        "diagnose_console_errors",
+		Description: "Read Unity Console errors, locate the offending scripts and propose fixes",
+		Arguments: []PromptArgument{
+			{Name: "focus", Description: "Optional keyword, script or system to focus on"},
+		},
+		Tools: []string{"script_get_compile_errors", "editor_get_logs", "script_read", "script_write"},
+		Render: func(args map[string]string) string {
+			focus := ""
+			if f := strings.TrimSpace(args["focus"]); f != "" {
+				focus = fmt.Sprintf("\nFocus on errors related to %q and ignore unrelated ones.\n", f)
+			}
+			return fmt.Sprintf(`Diagnose the errors currently in the Unity Console.
+%s
+Steps:
+1. Call script_get_compile_errors for compiler errors, then editor_get_logs with {"logLevel": "error", "includeStackTrace": true, "maxLogs": 50}. Repeat with {"logLevel": "exception"} if the first call returns nothing useful.
+2. Group identical messages and, for each group, find the script path and line from the stack trace.
+3. Read each referenced script with script_read {"path": <path relative to Assets>}.
+4. Explain the root cause of each error and propose a concrete fix as a diff.
+
+Do not call script_write until the fix has been confirmed; then pass "waitForCompile": true to verify that it compiles. Do not pass "clearLogs": true, so the console stays intact for the user.`, focus)
+		},
+	},
+	{
+		Name:        "prefab_from_object",
+		Description: "Turn a GameObject in the current scene into a prefab asset",
+		Arguments: []PromptArgument{
+			{Name: "objectName", Description: "Name of the GameObject to convert", Required: true},
+			{Name: "prefabPath", Description: "Where to save the prefab (default: Assets/Prefabs/<objectName>.prefab)"},
+		},
+		Tools: []string{"scene_find_objects", "scene_transform_get", "prefab_create", "prefab_get_info"},
+		Render: func(args map[string]string) string {
+			name := args["objectName"]
+			path := argOr(args, "prefabPath", "Assets/Prefabs/"+name+".prefab")
+			return fmt.Sprintf(`Create a prefab from the GameObject %q.
+
+Steps:
+1. Call scene_find_objects with {"name": %q, "exactMatch": true}. If several objects match, list them with their instanceId and parent (scene_transform_get {"instanceId": <id>}) and ask which one to use.
+2. Call prefab_create with {"instanceId": <id>, "prefabPath": %q, "overwrite": false}. If the asset already exists, ask before retrying with "overwrite": true.
+3. Verify the result with prefab_get_info {"prefabPath": %q} and report the prefab's components and children.`, name, name, path, path)
+		},
+	},
+	{
+		Name:        "audit_missing_scripts",
+		Description: "Find GameObjects with missing (null) script components in a scene",
+		Arguments: []PromptArgument{
+			{Name: "scenePath", Description: "Scene to audit (default: the active scene)"},
+		},
+		Tools: []string{"scene_load", "scene_get", "scene_get_info"},
+		Render: func(args map[string]string) string {
+			load := "Audit the active scene."
+			if p := strings.TrimSpace(args["scenePath"]); p != "" {
+				load = fmt.Sprintf(`First load the scene with scene_load {"scenePath": %q, "loadMode": "single", "saveCurrentScene": true}.`, p)
+			}
+			return fmt.Sprintf(`Audit a Unity scene for missing script references.
+
+%s
+
+Steps:
+1. Call scene_get with {"includeComponents": true, "includeTransform": false}.
+2. Walk the whole hierarchy and collect every GameObject whose component list contains a null, empty or "Missing" entry.
+3. Call scene_get_info with {"includeComponents": true} for scene-level totals.
+4. Report a table of object path, instanceId and the number of missing components, and suggest which script each one most likely referred to.
+
+This is a read-only audit: do not delete or modify anything.`, load)
+		},
+	},
+}
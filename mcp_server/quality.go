@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// quality_settings_set 修改一个质量等级，键名与Unity的QualitySettings属性对应
+// textureQuality 为纹理的mipmap上限: 0全分辨率，1一半，2四分之一，3八分之一
+var qualitySettingKeys = map[string]settingKey{
+	"shadows":                  {Type: "string", Enum: []string{"Disable", "HardOnly", "All"}, Description: "Which shadows are rendered"},
+	"shadowDistance":           {Type: "number", Minimum: floatPtr(0), Description: "Maximum shadow distance from the camera"},
+	"shadowResolution":         {Type: "string", Enum: []string{"Low", "Medium", "High", "VeryHigh"}, Description: "Shadow map resolution"},
+	"shadowCascades":           {Type: "integer", Minimum: floatPtr(1), Maximum: floatPtr(4), Description: "Number of shadow cascades (1, 2 or 4)"},
+	"antiAliasing":             {Type: "integer", Minimum: floatPtr(0), Maximum: floatPtr(8), Description: "MSAA sample count (0, 2, 4 or 8)"},
+	"vSyncCount":               {Type: "integer", Minimum: floatPtr(0), Maximum: floatPtr(4), Description: "Vertical syncs between frames, 0 disables vSync"},
+	"textureQuality":           {Type: "integer", Minimum: floatPtr(0), Maximum: floatPtr(3), Description: "Texture mipmap limit, 0 is full resolution"},
+	"anisotropicFiltering":     {Type: "string", Enum: []string{"Disable", "Enable", "ForceEnable"}, Description: "Anisotropic texture filtering"},
+	"lodBias":                  {Type: "number", Minimum: floatPtr(0), Description: "LOD bias, higher values keep detailed LODs longer"},
+	"maximumLODLevel":          {Type: "integer", Minimum: floatPtr(0), Maximum: floatPtr(7), Description: "Most detailed LOD level that is used, lower levels are skipped"},
+	"pixelLightCount":          {Type: "integer", Minimum: floatPtr(0), Description: "Maximum per-pixel lights (forward rendering)"},
+	"particleRaycastBudget":    {Type: "integer", Minimum: floatPtr(0), Description: "Maximum particle collision raycasts per frame"},
+	"softParticles":            {Type: "boolean", Description: "Use soft blending for particles"},
+	"realtimeReflectionProbes": {Type: "boolean", Description: "Update realtime reflection probes"},
+}
+
+// normalizeQualitySettingsSetArgs 需要level或index之一，并至少修改设置或切换当前等级
+func normalizeQualitySettingsSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasLevel := arguments["level"]
+	_, hasIndex := arguments["index"]
+	if hasLevel == hasIndex {
+		return nil, fmt.Errorf("exactly one of level (name) or index is required")
+	}
+	settings, _ := arguments["settings"].(map[string]interface{})
+	setActive, _ := arguments["setActive"].(bool)
+	if len(settings) == 0 && !setActive {
+		return nil, fmt.Errorf("nothing to change: give settings and/or setActive=true, valid keys: %s", strings.Join(settingKeyNames(qualitySettingKeys), ", "))
+	}
+
+	problems := checkSettingValues(settings, qualitySettingKeys)
+	if n, ok := settings["antiAliasing"].(int64); ok && n != 0 && n != 2 && n != 4 && n != 8 {
+		problems = append(problems, fmt.Sprintf("antiAliasing must be 0, 2, 4 or 8, got %d", n))
+	}
+	if n, ok := settings["shadowCascades"].(int64); ok && n == 3 {
+		problems = append(problems, "shadowCascades must be 1, 2 or 4")
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid quality settings: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
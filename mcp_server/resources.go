@@ -0,0 +1,239 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// 资源URI
+const (
+	sceneResourceURI       = "unity://scene/current"
+	sceneObjectURITemplate = "unity://scene/current/objects/{instanceId}"
+	scriptURIPrefix        = "unity://script/"
+	scriptURITemplate      = "unity://script/{+path}"
+	scriptListURI          = "unity://scripts"
+	scriptListURITemplate  = "unity://scripts/{+prefix}"
+)
+
+// 脚本资源的大小上限，更大的文件请使用script_read工具
+const scriptResourceMaxBytes = 256 * 1024
+
+// 脚本列表资源最多返回的条目数
+const scriptListMaxResults = 500
+
+// registerResources 注册MCP资源，资源是只读的，读取时转发到对应的Unity action
+func registerResources(s *server.MCPServer) {
+	s.AddResource(
+		mcp.NewResource(sceneResourceURI, "Current scene hierarchy",
+			mcp.WithResourceDescription("Hierarchy of the active Unity scene (same data as the scene_get tool)"),
+			mcp.WithMIMEType("application/json"),
+		),
+		handleSceneResource,
+	)
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(sceneObjectURITemplate, "Scene object",
+			mcp.WithTemplateDescription("Transform, parent and children of a GameObject in the active scene, by instance ID"),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		handleSceneObjectResource,
+	)
+
+	// 脚本资源只读，写入仍使用script_write工具
+	s.AddResource(
+		mcp.NewResource(scriptListURI, "Project scripts",
+			mcp.WithResourceDescription("C# scripts under Assets; read "+scriptListURI+"/<prefix> to filter by path prefix"),
+			mcp.WithMIMEType("application/json"),
+		),
+		handleScriptListResource,
+	)
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(scriptListURITemplate, "Project scripts by prefix",
+			mcp.WithTemplateDescription("C# scripts under Assets whose path starts with prefix"),
+			mcp.WithTemplateMIMEType("application/json"),
+		),
+		handleScriptListResource,
+	)
+	s.AddResourceTemplate(
+		mcp.NewResourceTemplate(scriptURITemplate, "Script file",
+			mcp.WithTemplateDescription("Content of a script file, path relative to Assets"),
+			mcp.WithTemplateMIMEType("text/x-csharp"),
+		),
+		handleScriptResource,
+	)
+}
+
+// handleSceneResource 读取当前场景层级
+func handleSceneResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	data, err := queryUnity(ctx, "scene_get", map[string]interface{}{
+		"includeComponents": false,
+		"includeTransform":  true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jsonResourceContents(request.Params.URI, data)
+}
+
+// handleSceneObjectResource 读取单个场景对象
+func handleSceneObjectResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	raw := resourceArgument(request, "instanceId")
+	instanceID, err := strconv.Atoi(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid instanceId %q in %s", raw, request.Params.URI)
+	}
+	data, err := queryUnity(ctx, "scene_transform_get", map[string]interface{}{
+		"instanceId": instanceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return jsonResourceContents(request.Params.URI, data)
+}
+
+// handleScriptListResource 列出脚本文件，每项附带可直接读取的资源URI
+func handleScriptListResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	data, err := queryUnity(ctx, "script_list", map[string]interface{}{
+		"prefix":     resourceArgument(request, "prefix"),
+		"maxResults": scriptListMaxResults,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if listing, ok := data.(map[string]interface{}); ok {
+		if scripts, ok := listing["scripts"].([]interface{}); ok {
+			for _, item := range scripts {
+				if script, ok := item.(map[string]interface{}); ok {
+					if path, ok := script["path"].(string); ok {
+						script["uri"] = scriptURIPrefix + path
+					}
+				}
+			}
+		}
+	}
+	return jsonResourceContents(request.Params.URI, data)
+}
+
+// handleScriptResource 读取脚本内容，超过大小上限时返回错误
+func handleScriptResource(ctx context.Context, request mcp.ReadResourceRequest) ([]mcp.ResourceContents, error) {
+	path := resourceArgument(request, "path")
+	if path == "" {
+		return nil, fmt.Errorf("missing script path in %s", request.Params.URI)
+	}
+	data, err := queryUnity(ctx, "script_read", map[string]interface{}{"path": path})
+	if err != nil {
+		return nil, err
+	}
+	result, _ := data.(map[string]interface{})
+	content, ok := result["content"].(string)
+	if !ok {
+		return nil, fmt.Errorf("unity returned no content for %s", path)
+	}
+	if len(content) > scriptResourceMaxBytes {
+		return nil, fmt.Errorf("script %s is %d bytes, exceeding the %d byte resource limit; use the script_read tool instead",
+			path, len(content), scriptResourceMaxBytes)
+	}
+
+	mimeType := "text/plain"
+	if strings.HasSuffix(strings.ToLower(path), ".cs") {
+		mimeType = "text/x-csharp"
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: request.Params.URI, MIMEType: mimeType, Text: content},
+	}, nil
+}
+
+// resourceArgument 返回URI模板中匹配到的变量
+func resourceArgument(request mcp.ReadResourceRequest, name string) string {
+	switch v := request.Params.Arguments[name].(type) {
+	case string:
+		return v
+	case []string:
+		if len(v) > 0 {
+			return v[0]
+		}
+	}
+	return ""
+}
+
+// jsonResourceContents 将Unity返回的数据包装为JSON资源内容
+func jsonResourceContents(uri string, data interface{}) ([]mcp.ResourceContents, error) {
+	text, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode resource %s: %w", uri, err)
+	}
+	return []mcp.ResourceContents{
+		mcp.TextResourceContents{URI: uri, MIMEType: "application/json", Text: string(text)},
+	}, nil
+}
+
+// unityActionError Unity收到了请求但执行失败，与通信失败区分
+type unityActionError struct {
+	Action  string
+	Message string
+}
+
+func (e *unityActionError) Error() string {
+	return fmt.Sprintf("unity %s failed: %s", e.Action, e.Message)
+}
+
+// isUnityActionError 判断错误是否为Unity执行失败，而不是通信失败
+func isUnityActionError(err error) bool {
+	var actionErr *unityActionError
+	return errors.As(err, &actionErr)
+}
+
+// queryUnity 发送一次Unity请求并返回data字段，用于资源等不需要工具结果格式的场景
+// 与工具调用不同，这里不重试，失败直接返回错误
+func queryUnity(ctx context.Context, action string, params map[string]interface{}) (interface{}, error) {
+	return queryUnityLevel(ctx, action, params, slog.LevelError)
+}
+
+// queryUnityLevel 与queryUnity相同，通信失败时按failLevel记录日志 (预期会失败的轮询使用Debug)
+// 试运行的工具调用中只发送只读的action，处理函数漏掉试运行检查时也不会修改项目
+func queryUnityLevel(ctx context.Context, action string, params map[string]interface{}, failLevel slog.Level) (interface{}, error) {
+	if isDryRun(ctx) && !readOnlyAction(ctx, action) {
+		callInfoFromContext(ctx).Logger().Warn("Dry run, Unity query not sent", "action", action)
+		return nil, fmt.Errorf("dry run: %s changes the project and was not sent to Unity", action)
+	}
+	requestId := newRequestID()
+	unityMsg := map[string]interface{}{
+		"action":    action,
+		"params":    params,
+		"id":        requestId,
+		"timestamp": time.Now().UnixMilli(),
+	}
+
+	timeout := time.Duration(0)
+	if def := lookupTool(action); def != nil {
+		timeout = def.TimeoutHint
+	}
+
+	start := time.Now()
+	response, err := unityClient.SendMessageContext(ctx, unityMsg, timeout)
+	if err != nil {
+		logger.Log(ctx, failLevel, "Unity query failed", "action", action, "request_id", requestId, "error", err.Error())
+		return nil, fmt.Errorf("unity communication failed: %w", err)
+	}
+	debugLog("Unity query %s completed in %v", action, time.Since(start))
+
+	if success, ok := response["success"].(bool); !ok || !success {
+		errorMsg := "unknown error"
+		if errStr, ok := response["error"].(string); ok {
+			errorMsg = errStr
+		}
+		return nil, &unityActionError{Action: action, Message: errorMsg}
+	}
+	if response["data"] == nil {
+		return map[string]interface{}{}, nil
+	}
+	return response["data"], nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// 工具错误结果中的机器可读错误码
+const (
+	errCodeUnityUnavailable = "unity_unavailable"  // 与Unity通信失败 (连接、超时等)
+	errCodeUnityToolFailed  = "unity_tool_failed"  // Unity执行工具时返回错误
+	errCodeInvalidArguments = "invalid_arguments"  // 工具参数无效，未发送到Unity
+	errCodeToolDenied       = "tool_denied"        // 工具被 -readonly / -allow-tools / -deny-tools 禁用
+	errCodeInternal         = "internal_error"     // 服务器内部错误 (处理函数panic)
+	errCodeResponseTooLarge = "response_too_large" // Unity响应超过 -max-response-size
+)
+
+// toolError 工具错误结果的结构化内容
+type toolError struct {
+	Code        string `json:"code"`
+	Message     string `json:"message"`
+	UnityAction string `json:"unityAction"`
+	RequestID   string `json:"requestId"`
+}
+
+// responseTooLargeMessage 说明响应过大，并提示可以缩小结果的参数
+func responseTooLargeMessage(def *ToolDefinition, toolName string, err *responseTooLargeError) string {
+	message := fmt.Sprintf("Unity response for %s is too large (%d bytes, limit %d bytes)", toolName, err.Size, err.Limit)
+	if def != nil && len(def.NarrowBy) > 0 {
+		return fmt.Sprintf("%s; narrow the request with %s", message, strings.Join(def.NarrowBy, ", "))
+	}
+	return message + "; request less data or raise -max-response-size"
+}
+
+// addResultField 在成功结果中追加一个字段
+// 结构化结果同时更新文本内容，旧文本格式追加一段JSON文本
+func addResultField(result *mcp.CallToolResult, key string, value interface{}) {
+	structured, ok := result.StructuredContent.(map[string]interface{})
+	if !ok {
+		result.Content = append(result.Content, mcp.NewTextContent(fmt.Sprintf("%s:\n%s", key, formatJSON(value))))
+		return
+	}
+	structured[key] = value
+	if text, err := json.Marshal(structured); err == nil {
+		result.Content = []mcp.Content{mcp.NewTextContent(string(text))}
+	}
+}
+
+// addBinaryContent 把Unity响应的二进制附件追加为内容块: 图片使用image内容，其他类型使用嵌入的blob资源
+// MCP传输本身是JSON，因此附件在这里才编码为base64
+func addBinaryContent(result *mcp.CallToolResult, toolName string, attachment binaryAttachment, mimeType string) {
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	encoded := base64.StdEncoding.EncodeToString(attachment)
+	if strings.HasPrefix(mimeType, "image/") {
+		result.Content = append(result.Content, mcp.NewImageContent(encoded, mimeType))
+		return
+	}
+	result.Content = append(result.Content, mcp.NewEmbeddedResource(mcp.BlobResourceContents{
+		URI:      "unity://attachment/" + toolName,
+		MIMEType: mimeType,
+		Blob:     encoded,
+	}))
+}
+
+// toolSuccessResult 将Unity返回的data作为结构化内容返回
+// 文本内容为紧凑的JSON，供不支持structuredContent的客户端使用
+// -legacy-text-results 时保留旧的 "Tool X executed successfully:" 文本格式
+func toolSuccessResult(ctx context.Context, toolName string, data interface{}) *mcp.CallToolResult {
+	if stateFromContext(ctx).Config.LegacyTextResults {
+		return mcp.NewToolResultText(fmt.Sprintf("Tool %s executed successfully:\n%s", toolName, formatJSON(data)))
+	}
+
+	// structuredContent必须是JSON对象，其他类型包装在result字段中
+	structured, ok := data.(map[string]interface{})
+	if !ok {
+		structured = map[string]interface{}{"result": data}
+	}
+	text, err := json.Marshal(structured)
+	if err != nil {
+		return mcp.NewToolResultStructured(structured, fmt.Sprintf("Tool %s executed successfully", toolName))
+	}
+	return mcp.NewToolResultStructured(structured, string(text))
+}
+
+// unityErrorResult 把queryUnity的错误转换为处理函数的返回值: Unity执行失败为unity_tool_failed，
+// context已取消时返回ctx.Err()，其他通信失败为unity_unavailable
+// Unity已经返回的执行失败优先于取消，即使调用在响应到达后被取消也会返回给客户端
+func unityErrorResult(ctx context.Context, toolName string, err error) (*mcp.CallToolResult, error) {
+	var actionErr *unityActionError
+	if errors.As(err, &actionErr) {
+		return toolErrorResult(ctx, errCodeUnityToolFailed, actionErr.Message, toolName), nil
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return toolErrorResult(ctx, errCodeUnityUnavailable, err.Error(), toolName), nil
+}
+
+// toolErrorResult 返回同时包含文本和结构化错误对象的错误结果
+// requestId为当前调用的关联ID，用户报告问题时可以引用
+func toolErrorResult(ctx context.Context, code, message, toolName string) *mcp.CallToolResult {
+	requestId := callInfoFromContext(ctx).ID
+	if stateFromContext(ctx).Config.LegacyTextResults {
+		if requestId != "" {
+			message = fmt.Sprintf("%s (request id %s)", message, requestId)
+		}
+		return mcp.NewToolResultError(message)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{mcp.NewTextContent(message)},
+		StructuredContent: toolError{
+			Code:        code,
+			Message:     message,
+			UnityAction: toolName,
+			RequestID:   requestId,
+		},
+		IsError: true,
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// 工具调用中用于覆盖重试次数的参数名，转发到Unity之前会被移除
+const retriesArgument = "_retries"
+
+// _retries 允许的最大值，避免单次调用长时间占用唯一的Unity连接
+const maxRetriesOverride = 10
+
+// retryPolicy 一次工具调用的重试策略
+type retryPolicy struct {
+	Retries int           // 失败后的重试次数，总尝试次数为Retries+1
+	Delay   time.Duration // 两次尝试之间的等待时间
+}
+
+// Attempts 返回总尝试次数
+func (p retryPolicy) Attempts() int {
+	return p.Retries + 1
+}
+
+// String 用于日志输出
+func (p retryPolicy) String() string {
+	return fmt.Sprintf("%d retries, %v delay", p.Retries, p.Delay)
+}
+
+// defaultRetryPolicy 返回由 -unity-retries 和 -unity-retry-delay 决定的策略
+func defaultRetryPolicy(c ServerConfig) retryPolicy {
+	return retryPolicy{Retries: c.UnityRetries, Delay: c.UnityRetryDelay}
+}
+
+// resolveRetryPolicy 确定工具调用的重试策略，并返回去掉 _retries 后的参数
+// 写操作只尝试一次以避免重复执行；_retries 只能在只读工具上调整重试次数
+func resolveRetryPolicy(st *liveState, toolName string, arguments map[string]interface{}) (retryPolicy, map[string]interface{}, error) {
+	policy := defaultRetryPolicy(st.Config)
+	mutating := false
+	if def := st.Tools.Lookup(toolName); def != nil && !def.ReadOnly {
+		mutating = true
+		policy.Retries = 0
+	}
+
+	override, ok := arguments[retriesArgument]
+	if !ok {
+		return policy, arguments, nil
+	}
+
+	forwarded := make(map[string]interface{}, len(arguments)-1)
+	for key, value := range arguments {
+		if key != retriesArgument {
+			forwarded[key] = value
+		}
+	}
+
+	retries, err := parseRetries(override)
+	if err != nil {
+		return policy, forwarded, err
+	}
+	if !mutating {
+		policy.Retries = retries
+	}
+	return policy, forwarded, nil
+}
+
+// parseRetries 解析 _retries 参数 (JSON数字或字符串形式的整数，范围 0..maxRetriesOverride)
+func parseRetries(value interface{}) (int, error) {
+	var retries int
+	switch v := value.(type) {
+	case float64:
+		if v > maxRetriesOverride {
+			return 0, fmt.Errorf("%s must be at most %d, got %v", retriesArgument, maxRetriesOverride, v)
+		}
+		if v != float64(int(v)) {
+			return 0, fmt.Errorf("%s must be an integer, got %v", retriesArgument, v)
+		}
+		retries = int(v)
+	case int:
+		retries = v
+	case string:
+		parsed, err := strconv.Atoi(v)
+		if err != nil {
+			return 0, fmt.Errorf("%s must be an integer, got %q", retriesArgument, v)
+		}
+		retries = parsed
+	default:
+		return 0, fmt.Errorf("%s must be an integer, got %T", retriesArgument, value)
+	}
+	if retries < 0 {
+		return 0, fmt.Errorf("%s must not be negative, got %d", retriesArgument, retries)
+	}
+	if retries > maxRetriesOverride {
+		return 0, fmt.Errorf("%s must be at most %d, got %d", retriesArgument, maxRetriesOverride, retries)
+	}
+	return retries, nil
+}
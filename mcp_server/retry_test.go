@@ -0,0 +1,185 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCallUnityToolWithoutRetries(t *testing.T) {
+	useState(t, func(c *ServerConfig) {
+		c.UnityRetries = 0
+		c.UnityRetryDelay = 5 * time.Second
+	})
+	unity := startFakeUnity(t, func(n int, request map[string]interface{}) map[string]interface{} {
+		return nil
+	})
+
+	start := time.Now()
+	result, err := callUnityTool(context.Background(), "scene_get", map[string]interface{}{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.IsError {
+		t.Fatal("expected an error result")
+	}
+	if got := unity.Requests(); got != 1 {
+		t.Errorf("Unity received %d requests, want 1", got)
+	}
+	if text := resultText(result); !strings.Contains(text, "after 1 attempts") || !strings.Contains(text, "failed to receive response") {
+		t.Errorf("error should report one attempt and pass the cause through, got %q", text)
+	}
+	if elapsed := time.Since(start); elapsed >= 5*time.Second {
+		t.Errorf("no retry delay expected, call took %v", elapsed)
+	}
+}
+
+func TestCallUnityToolRetriesWithDelay(t *testing.T) {
+	const delay = 100 * time.Millisecond
+	useState(t, func(c *ServerConfig) {
+		c.UnityRetries = 2
+		c.UnityRetryDelay = delay
+	})
+	unity := startFakeUnity(t, func(n int, request map[string]interface{}) map[string]interface{} {
+		if n < 2 {
+			return nil
+		}
+		return map[string]interface{}{"success": true, "data": map[string]interface{}{"ok": true}}
+	})
+
+	result, err := callUnityTool(context.Background(), "scene_get", map[string]interface{}{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.IsError {
+		t.Fatalf("expected success after retries, got %q", resultText(result))
+	}
+	if got := unity.Requests(); got != 3 {
+		t.Fatalf("Unity received %d requests, want 3", got)
+	}
+	unity.mu.Lock()
+	defer unity.mu.Unlock()
+	for i := 1; i < len(unity.times); i++ {
+		if gap := unity.times[i].Sub(unity.times[i-1]); gap < delay {
+			t.Errorf("attempt %d came %v after the previous one, want at least %v", i+1, gap, delay)
+		}
+	}
+}
+
+func TestCallUnityToolDoesNotRetryMutatingTools(t *testing.T) {
+	useState(t, func(c *ServerConfig) {
+		c.UnityRetries = 3
+		c.UnityRetryDelay = time.Millisecond
+	})
+	unity := startFakeUnity(t, func(n int, request map[string]interface{}) map[string]interface{} {
+		return nil
+	})
+
+	result, err := callUnityTool(context.Background(), "scene_create_object", map[string]interface{}{"_retries": 5.0}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.IsError || unity.Requests() != 1 {
+		t.Errorf("mutating tool: IsError=%t requests=%d, want an error after exactly 1 request", result.IsError, unity.Requests())
+	}
+}
+
+func TestResolveRetryPolicy(t *testing.T) {
+	st := useState(t, func(c *ServerConfig) {
+		c.UnityRetries = 2
+		c.UnityRetryDelay = 50 * time.Millisecond
+	})
+
+	tests := []struct {
+		name    string
+		tool    string
+		args    map[string]interface{}
+		retries int
+		wantErr bool
+	}{
+		{"read-only default", "scene_get", map[string]interface{}{}, 2, false},
+		{"read-only override", "scene_get", map[string]interface{}{"_retries": 5.0}, 5, false},
+		{"string override", "scene_get", map[string]interface{}{"_retries": "0"}, 0, false},
+		{"mutating tool ignores default", "scene_create_object", map[string]interface{}{}, 0, false},
+		{"mutating tool ignores override", "scene_create_object", map[string]interface{}{"_retries": 3.0}, 0, false},
+		{"negative override", "scene_get", map[string]interface{}{"_retries": -1.0}, 0, true},
+		{"fractional override", "scene_get", map[string]interface{}{"_retries": 1.5}, 0, true},
+		{"maximum override", "scene_get", map[string]interface{}{"_retries": 10.0}, 10, false},
+		{"override above maximum", "scene_get", map[string]interface{}{"_retries": 11.0}, 0, true},
+		{"huge override", "scene_get", map[string]interface{}{"_retries": 1e30}, 0, true},
+		{"string override above maximum", "scene_get", map[string]interface{}{"_retries": "1000000"}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, forwarded, err := resolveRetryPolicy(st, tt.tool, tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %t", err, tt.wantErr)
+			}
+			if _, ok := forwarded[retriesArgument]; ok {
+				t.Errorf("%s must not be forwarded to Unity", retriesArgument)
+			}
+			if !tt.wantErr && policy.Retries != tt.retries {
+				t.Errorf("Retries = %d, want %d", policy.Retries, tt.retries)
+			}
+			if policy.Delay != 50*time.Millisecond {
+				t.Errorf("Delay = %v, want 50ms", policy.Delay)
+			}
+		})
+	}
+}
+
+func TestRetryFlagsAndConfig(t *testing.T) {
+	load := func(t *testing.T, args ...string) ServerConfig {
+		t.Helper()
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		configPath, _ := defineFlags(fs)
+		if err := fs.Parse(args); err != nil {
+			t.Fatal(err)
+		}
+		cfg, _, _, err := loadConfig(fs, *configPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cfg
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		policy := defaultRetryPolicy(load(t))
+		if policy.Retries != 2 || policy.Delay != time.Second {
+			t.Errorf("default policy = %s, want 2 retries, 1s delay", policy)
+		}
+	})
+	t.Run("flags", func(t *testing.T) {
+		policy := defaultRetryPolicy(load(t, "-unity-retries", "5", "-unity-retry-delay", "250ms"))
+		if policy.Retries != 5 || policy.Delay != 250*time.Millisecond || policy.Attempts() != 6 {
+			t.Errorf("policy = %s (%d attempts), want 5 retries, 250ms delay, 6 attempts", policy, policy.Attempts())
+		}
+	})
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv(envName("unity-retries"), "0")
+		if policy := defaultRetryPolicy(load(t)); policy.Retries != 0 || policy.Attempts() != 1 {
+			t.Errorf("policy = %s, want 0 retries", policy)
+		}
+	})
+	t.Run("config file", func(t *testing.T) {
+		path := t.TempDir() + "/unitymcp.yaml"
+		if err := os.WriteFile(path, []byte("unityRetries: 4\nunityRetryDelay: 2s\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		policy := defaultRetryPolicy(load(t, "-config", path))
+		if policy.Retries != 4 || policy.Delay != 2*time.Second {
+			t.Errorf("policy = %s, want 4 retries, 2s delay", policy)
+		}
+	})
+	t.Run("negative flag rejected", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		configPath, _ := defineFlags(fs)
+		fs.Parse([]string{"-unity-retries", "-1"})
+		if _, _, _, err := loadConfig(fs, *configPath); err == nil {
+			t.Error("expected an error for -unity-retries -1")
+		}
+	})
+}
@@ -0,0 +1,272 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// normalizeCreateObjectArgs scene_create_object 只能从基本几何体或预制体中选择一种来源
+func normalizeCreateObjectArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasPrimitive := arguments["primitive"]
+	_, hasPrefab := arguments["prefabPath"]
+	if hasPrimitive && hasPrefab {
+		return nil, fmt.Errorf("primitive and prefabPath are mutually exclusive")
+	}
+	return arguments, nil
+}
+
+// normalizeSceneGetArgs rootInstanceId和rootPath只能给出一个
+func normalizeSceneGetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasId := arguments["rootInstanceId"]
+	_, hasPath := arguments["rootPath"]
+	if hasId && hasPath {
+		return nil, fmt.Errorf("rootInstanceId and rootPath are mutually exclusive")
+	}
+	return arguments, nil
+}
+
+// normalizeGetComponentsArgs 需要instanceId或path中的一个
+func normalizeGetComponentsArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasId := arguments["instanceId"]
+	_, hasPath := arguments["path"]
+	switch {
+	case hasId && hasPath:
+		return nil, fmt.Errorf("instanceId and path are mutually exclusive")
+	case !hasId && !hasPath:
+		return nil, fmt.Errorf("instanceId or path is required")
+	}
+	return arguments, nil
+}
+
+// scene_find_objects 的搜索条件，至少需要一个
+var sceneFindCriteria = []string{"name", "nameRegex", "path", "tag", "componentType", "componentTypes", "layer"}
+
+// normalizeSceneFindArgs 检查搜索条件和正则，并检查组件类型列表
+func normalizeSceneFindArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	hasCriteria := false
+	for _, name := range sceneFindCriteria {
+		switch v := arguments[name].(type) {
+		case string:
+			hasCriteria = hasCriteria || v != ""
+		case []interface{}:
+			hasCriteria = hasCriteria || len(v) > 0
+		}
+	}
+	if !hasCriteria {
+		return nil, fmt.Errorf("at least one search criterion is required: name, nameRegex, path, tag, componentTypes or layer")
+	}
+	if pattern, ok := arguments["nameRegex"].(string); ok {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid nameRegex: %w", err)
+		}
+	}
+	if types, ok := arguments["componentTypes"].([]interface{}); ok {
+		for i, t := range types {
+			if s, ok := t.(string); !ok || s == "" {
+				return nil, fmt.Errorf("componentTypes[%d] must be a non-empty string", i)
+			}
+		}
+	}
+	return arguments, nil
+}
+
+// normalizeResolvePathArgs 路径不能为空，去掉首尾的/
+func normalizeResolvePathArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	path, _ := arguments["path"].(string)
+	path = strings.Trim(strings.TrimSpace(path), "/")
+	if path == "" {
+		return nil, fmt.Errorf("path must not be empty")
+	}
+	arguments["path"] = path
+	return arguments, nil
+}
+
+// maxPathLookups scene_get_path 一次最多查询的对象数
+const maxPathLookups = 1000
+
+// normalizeGetPathArgs 需要instanceId或instanceIds，instanceIds中的元素必须是整数
+func normalizeGetPathArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	ids, hasIds := arguments["instanceIds"].([]interface{})
+	_, hasId := arguments["instanceId"]
+	switch {
+	case hasId && hasIds:
+		return nil, fmt.Errorf("instanceId and instanceIds are mutually exclusive")
+	case !hasId && len(ids) == 0:
+		return nil, fmt.Errorf("instanceId or instanceIds is required")
+	case len(ids) > maxPathLookups:
+		return nil, fmt.Errorf("instanceIds has %d elements, at most %d can be looked up in one call", len(ids), maxPathLookups)
+	}
+	for i, id := range ids {
+		n, err := toNumber(id)
+		if err == nil {
+			ids[i], err = toInteger(n)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("instanceIds[%d]: %w", i, err)
+		}
+	}
+	return arguments, nil
+}
+
+// maxDeleteObjects scene_delete_object 一次最多删除的对象数
+const maxDeleteObjects = 500
+
+// normalizeDeleteObjectArgs 需要instanceId或instanceIds，instanceIds中的元素必须是整数
+func normalizeDeleteObjectArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	ids, hasIds := arguments["instanceIds"].([]interface{})
+	if _, ok := arguments["instanceId"]; !ok && len(ids) == 0 {
+		return nil, fmt.Errorf("instanceId or instanceIds is required")
+	}
+	if len(ids) > maxDeleteObjects {
+		return nil, fmt.Errorf("instanceIds has %d elements, at most %d can be deleted in one call", len(ids), maxDeleteObjects)
+	}
+	if !hasIds {
+		return arguments, nil
+	}
+	normalized := make([]interface{}, len(ids))
+	for i, id := range ids {
+		n, err := toNumber(id)
+		if err == nil {
+			normalized[i], err = toInteger(n)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("instanceIds[%d]: %w", i, err)
+		}
+	}
+	arguments["instanceIds"] = normalized
+	return arguments, nil
+}
+
+// normalizeSetTagArgs 标签不能为空，也不能包含首尾空白
+func normalizeSetTagArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	tag, _ := arguments["tag"].(string)
+	if tag == "" || strings.TrimSpace(tag) != tag {
+		return nil, fmt.Errorf("tag must be non-empty without leading or trailing spaces, got %q", tag)
+	}
+	return arguments, nil
+}
+
+// normalizeSetLayerArgs 数字形式的layer必须是0到31之间的索引
+func normalizeSetLayerArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	layer, _ := arguments["layer"].(string)
+	layer = strings.TrimSpace(layer)
+	if layer == "" {
+		return nil, fmt.Errorf("layer must be a layer name or an index between 0 and 31")
+	}
+	if index, err := strconv.Atoi(layer); err == nil && (index < 0 || index > 31) {
+		return nil, fmt.Errorf("layer index must be between 0 and 31, got %d", index)
+	}
+	arguments["layer"] = layer
+	return arguments, nil
+}
+
+// normalizeRenameArgs 名称不能为空
+func normalizeRenameArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	name, _ := arguments["name"].(string)
+	if strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("name must not be empty")
+	}
+	return arguments, nil
+}
+
+// normalizeSetParentArgs 对象不能成为自己的父对象 (子对象的检查由Unity完成)
+func normalizeSetParentArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	id, _ := arguments["instanceId"].(int64)
+	if parent, ok := arguments["parentInstanceId"].(int64); ok && parent == id {
+		return nil, fmt.Errorf("a GameObject cannot be its own parent (instanceId %d)", id)
+	}
+	return arguments, nil
+}
+
+// normalizeDuplicateArgs 多个副本使用同一个名称时提示使用{n}
+func normalizeDuplicateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	name, hasName := arguments["newName"].(string)
+	if hasName && strings.TrimSpace(name) == "" {
+		return nil, fmt.Errorf("newName must not be empty")
+	}
+	if count, _ := arguments["count"].(int64); hasName && count > 1 && !strings.Contains(name, "{n}") {
+		return nil, fmt.Errorf("newName %q has no {n} placeholder, so all %d copies would get the same name", name, count)
+	}
+	return arguments, nil
+}
+
+// sceneValidateTimeout scene_validate 两个进度帧之间等待Unity的最长时间 (打开大场景可能很慢)
+const sceneValidateTimeout = 10 * time.Minute
+
+// sceneValidateScopes scene_validate 的检查范围: 当前场景、指定场景或Build Settings中的全部场景
+var sceneValidateScopes = []string{"active", "scene", "build"}
+
+// sceneIssueCategories scene_validate 的问题类别
+var sceneIssueCategories = []string{"missingScript", "missingReference", "nullReference", "missingPrefab", "uiOutsideCanvas"}
+
+// normalizeSceneValidateArgs scope=scene时需要scenePath，其他范围不接受scenePath；检查categories
+func normalizeSceneValidateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	scope, _ := arguments["scope"].(string)
+	scenePath, hasPath := arguments["scenePath"].(string)
+	switch {
+	case scope == "scene" && !hasPath:
+		return nil, fmt.Errorf("scope=scene requires scenePath")
+	case scope != "scene" && hasPath:
+		return nil, fmt.Errorf("scenePath is only used with scope=scene")
+	case hasPath && (!strings.HasPrefix(scenePath, "Assets/") || !strings.HasSuffix(scenePath, ".unity")):
+		return nil, fmt.Errorf("scenePath must be a scene path like Assets/Scenes/Main.unity, got %q", scenePath)
+	}
+	if list, ok := arguments["categories"].([]interface{}); ok {
+		names := make([]interface{}, 0, len(list))
+		for i, item := range list {
+			name, _ := item.(string)
+			canonical, found := canonicalName(sceneIssueCategories, name)
+			if !found {
+				return nil, fmt.Errorf("categories[%d] %v is not valid, expected one of: %s", i, item, strings.Join(sceneIssueCategories, ", "))
+			}
+			names = append(names, canonical)
+		}
+		arguments["categories"] = names
+	}
+	return arguments, nil
+}
+
+// staticEditorFlags gameobject_set_static 可设置的静态标志，与Unity的StaticEditorFlags枚举名一致
+var staticEditorFlags = []string{"ContributeGI", "OccluderStatic", "OccludeeStatic", "BatchingStatic",
+	"NavigationStatic", "OffMeshLinkGeneration", "ReflectionProbeStatic"}
+
+// staticFlagModes gameobject_set_static 的flags用法: 替换、添加或移除
+var staticFlagModes = []string{"set", "add", "remove"}
+
+// normalizeSetStaticArgs 需要flags或allStatic之一，flags规范为StaticEditorFlags名称
+func normalizeSetStaticArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	list, hasFlags := arguments["flags"].([]interface{})
+	_, hasAll := arguments["allStatic"]
+	if hasFlags == hasAll {
+		return nil, fmt.Errorf("exactly one of flags or allStatic is required")
+	}
+	if hasAll {
+		if mode, _ := arguments["mode"].(string); mode != "set" {
+			return nil, fmt.Errorf("mode %s can only be used with flags", mode)
+		}
+		return arguments, nil
+	}
+
+	var problems []string
+	names := make([]interface{}, 0, len(list))
+	for i, item := range list {
+		name, _ := item.(string)
+		canonical, found := canonicalName(staticEditorFlags, name)
+		if !found {
+			problems = append(problems, fmt.Sprintf("flags[%d] %v is not a static flag, expected one of: %s", i, item, strings.Join(staticEditorFlags, ", ")))
+			continue
+		}
+		if !slices.Contains(names, interface{}(canonical)) {
+			names = append(names, canonical)
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid static flags: %s", strings.Join(problems, "; "))
+	}
+	arguments["flags"] = names
+	return arguments, nil
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// sceneViewOperations scene_view_control 的操作
+var sceneViewOperations = []string{"get", "frame", "look_at", "set_mode"}
+
+// sceneViewOperationParams 每个操作可以使用的参数，其他参数视为错误，避免参数被静默忽略
+var sceneViewOperationParams = map[string][]string{
+	"get":      {},
+	"frame":    {"instanceId"},
+	"look_at":  {"position", "rotation", "size", "orthographic"},
+	"set_mode": {"in2DMode", "orthographic"},
+}
+
+// normalizeSceneViewArgs 检查每个操作需要的参数
+func normalizeSceneViewArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	operation, _ := arguments["operation"].(string)
+	allowed := sceneViewOperationParams[operation]
+	var problems []string
+	for _, name := range []string{"instanceId", "position", "rotation", "size", "in2DMode", "orthographic"} {
+		if _, ok := arguments[name]; ok && !slices.Contains(allowed, name) {
+			problems = append(problems, fmt.Sprintf("%s is not used by operation %s", name, operation))
+		}
+	}
+
+	_, hasId := arguments["instanceId"]
+	_, hasPosition := arguments["position"]
+	_, hasRotation := arguments["rotation"]
+	_, hasSize := arguments["size"]
+	_, has2D := arguments["in2DMode"]
+	_, hasOrtho := arguments["orthographic"]
+	switch {
+	case operation == "frame" && !hasId:
+		problems = append(problems, "frame requires instanceId")
+	case operation == "look_at" && !hasPosition && !hasRotation && !hasSize:
+		problems = append(problems, "look_at requires position, rotation and/or size")
+	case operation == "set_mode" && !has2D && !hasOrtho:
+		problems = append(problems, "set_mode requires in2DMode and/or orthographic")
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid scene view arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// editor_take_screenshot: 未指定savePath时PNG作为二进制附件从Unity返回 (见unity_client.go)，
+// 在工具结果中成为image内容
+
+// maxScreenshotResolution 截图的最大边长，超采样截图也受此限制
+const maxScreenshotResolution = 4096
+
+// screenshotViews editor_take_screenshot 支持的视图
+var screenshotViews = []string{"game", "scene"}
+
+// normalizeScreenshotArgs 检查savePath: 项目内的相对路径，扩展名为.png
+func normalizeScreenshotArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	savePath, ok := arguments["savePath"].(string)
+	if !ok {
+		return arguments, nil
+	}
+	savePath = strings.ReplaceAll(strings.TrimSpace(savePath), "\\", "/")
+	if savePath == "" {
+		delete(arguments, "savePath")
+		return arguments, nil
+	}
+	if path.IsAbs(savePath) || strings.Contains(savePath, ":") {
+		return nil, fmt.Errorf("savePath must be relative to the project root, got %q", savePath)
+	}
+	if clean := path.Clean(savePath); clean == ".." || strings.HasPrefix(clean, "../") {
+		return nil, fmt.Errorf("savePath must stay inside the project, got %q", savePath)
+	}
+	if !strings.EqualFold(path.Ext(savePath), ".png") {
+		return nil, fmt.Errorf("savePath must end with .png, got %q", savePath)
+	}
+	arguments["savePath"] = path.Clean(savePath)
+	return arguments, nil
+}
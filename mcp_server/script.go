@@ -0,0 +1,152 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// normalizeScriptReadArgs 检查行范围
+func normalizeScriptReadArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	start, hasStart := arguments["startLine"].(int64)
+	end, hasEnd := arguments["endLine"].(int64)
+	if hasStart && hasEnd && start > end {
+		return nil, fmt.Errorf("startLine (%d) must not be greater than endLine (%d)", start, end)
+	}
+	return arguments, nil
+}
+
+// inspectScriptRead 检查script_read返回的内容是否为有效的UTF-8
+// Unity无法识别编码时会用替换字符解码 (JSON解析同样会替换无效字节)，此时在结果中给出提示
+func inspectScriptRead(data map[string]interface{}) {
+	content, ok := data["content"].(string)
+	if !ok {
+		return
+	}
+	encoding, _ := data["encoding"].(string)
+	if encoding == "" {
+		encoding = "unknown"
+	}
+	if !utf8.ValidString(content) || strings.ContainsRune(content, utf8.RuneError) {
+		data["encodingWarning"] = fmt.Sprintf("content contains invalid or replaced characters; the file is probably not UTF-8 (detected encoding: %s)", encoding)
+		debugLog("script_read content of %v is not valid UTF-8 (detected encoding: %s)", data["path"], encoding)
+	}
+}
+
+// script_write 的写入模式
+var scriptWriteModes = []string{"overwrite", "patch", "append", "insert"}
+
+// normalizeScriptWriteArgs 检查写入模式与参数的组合，patch模式先在Go端校验diff格式
+func normalizeScriptWriteArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	mode, _ := arguments["mode"].(string)
+	_, hasInsertAt := arguments["insertAt"]
+	switch {
+	case mode == "insert" && !hasInsertAt:
+		return nil, fmt.Errorf("mode=insert requires insertAt")
+	case mode != "insert" && hasInsertAt:
+		return nil, fmt.Errorf("insertAt can only be used with mode=insert")
+	case mode == "patch":
+		content, _ := arguments["content"].(string)
+		hunks, err := parseUnifiedDiff(content)
+		if err != nil {
+			return nil, fmt.Errorf("invalid patch: %w", err)
+		}
+		debugLog("script_write patch for %v has %d hunks", arguments["path"], len(hunks))
+	}
+	return arguments, nil
+}
+
+// diffHunk unified diff中的一个hunk
+type diffHunk struct {
+	OldStart, OldLines int
+	NewStart, NewLines int
+	Lines              []string // 带前缀 ' '、'-'、'+' 的行
+}
+
+// parseUnifiedDiff 解析unified diff并检查每个hunk的行数与头部一致
+// 文件头 (---/+++) 可以省略，"\ No newline at end of file" 会被忽略
+func parseUnifiedDiff(diff string) ([]diffHunk, error) {
+	var hunks []diffHunk
+	var current *diffHunk
+	lines := strings.Split(strings.TrimSuffix(strings.ReplaceAll(diff, "\r\n", "\n"), "\n"), "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			var h diffHunk
+			if err := parseHunkHeader(line, &h); err != nil {
+				return nil, fmt.Errorf("line %d: %w", i+1, err)
+			}
+			hunks = append(hunks, h)
+			current = &hunks[len(hunks)-1]
+		case current == nil:
+			// hunk之前的文件头和说明
+			continue
+		case strings.HasPrefix(line, `\`):
+			continue
+		case line == "" || line[0] == ' ' || line[0] == '-' || line[0] == '+':
+			if line == "" {
+				// 部分编辑器会去掉空上下文行的前导空格
+				line = " "
+			}
+			current.Lines = append(current.Lines, line)
+		default:
+			return nil, fmt.Errorf("line %d: unexpected line in hunk: %q", i+1, line)
+		}
+	}
+	if len(hunks) == 0 {
+		return nil, fmt.Errorf("no hunks found; expected unified diff with @@ -a,b +c,d @@ headers")
+	}
+	for i, h := range hunks {
+		var oldCount, newCount int
+		for _, line := range h.Lines {
+			switch line[0] {
+			case ' ':
+				oldCount++
+				newCount++
+			case '-':
+				oldCount++
+			case '+':
+				newCount++
+			}
+		}
+		if oldCount != h.OldLines || newCount != h.NewLines {
+			return nil, fmt.Errorf("hunk %d: header says -%d +%d lines but body has -%d +%d", i+1, h.OldLines, h.NewLines, oldCount, newCount)
+		}
+	}
+	return hunks, nil
+}
+
+// parseHunkHeader 解析 "@@ -a,b +c,d @@"，省略的行数为1
+func parseHunkHeader(line string, h *diffHunk) error {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || fields[3] != "@@" && !strings.HasPrefix(fields[3], "@@") {
+		return fmt.Errorf("malformed hunk header %q", line)
+	}
+	var err error
+	if h.OldStart, h.OldLines, err = parseHunkRange(fields[1], '-'); err != nil {
+		return fmt.Errorf("malformed hunk header %q: %w", line, err)
+	}
+	if h.NewStart, h.NewLines, err = parseHunkRange(fields[2], '+'); err != nil {
+		return fmt.Errorf("malformed hunk header %q: %w", line, err)
+	}
+	return nil
+}
+
+// parseHunkRange 解析 "-a,b" 或 "+c"
+func parseHunkRange(field string, prefix byte) (start, count int, err error) {
+	if len(field) < 2 || field[0] != prefix {
+		return 0, 0, fmt.Errorf("expected range starting with %c, got %q", prefix, field)
+	}
+	count = 1
+	startText, countText, hasCount := strings.Cut(field[1:], ",")
+	if start, err = strconv.Atoi(startText); err != nil || start < 0 {
+		return 0, 0, fmt.Errorf("invalid start line %q", startText)
+	}
+	if hasCount {
+		if count, err = strconv.Atoi(countText); err != nil || count < 0 {
+			return 0, 0, fmt.Errorf("invalid line count %q", countText)
+		}
+	}
+	return start, count, nil
+}
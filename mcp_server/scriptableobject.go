@@ -0,0 +1,146 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// scriptable_object_create 的类型名在Unity中解析；找不到时Unity返回typeNotFound和可创建的类型列表，
+// 这里按名称相似度挑出最接近的几个作为 "did you mean" 建议
+
+// maxScriptableObjectFields 一次调用最多设置的字段数
+const maxScriptableObjectFields = 200
+
+// maxTypeSuggestions 类型名建议的最大数量
+const maxTypeSuggestions = 3
+
+// handleScriptableObjectCreate 转发给Unity，类型不存在时返回带建议的错误
+func handleScriptableObjectCreate(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "scriptable_object_create"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+
+	data, err := queryUnity(ctx, toolName, arguments)
+	if err != nil {
+		return unityErrorResult(ctx, toolName, err)
+	}
+	result, _ := data.(map[string]interface{})
+	if typeName, ok := result["typeNotFound"].(string); ok {
+		var candidates []string
+		list, _ := result["candidates"].([]interface{})
+		for _, item := range list {
+			if name, ok := item.(string); ok {
+				candidates = append(candidates, name)
+			}
+		}
+		message := fmt.Sprintf("ScriptableObject type %q not found", typeName)
+		if suggestions := suggestTypeNames(typeName, candidates); len(suggestions) > 0 {
+			message += "; did you mean " + strings.Join(suggestions, ", ") + "?"
+		}
+		return toolErrorResult(ctx, errCodeInvalidArguments, message, toolName), nil
+	}
+	return toolSuccessResult(ctx, toolName, data), nil
+}
+
+// suggestTypeNames 按编辑距离挑选与name最接近的类型名；短名和完整类型名都参与比较，
+// 包含name的类型名优先，距离过大的不作为建议
+func suggestTypeNames(name string, candidates []string) []string {
+	type scored struct {
+		name     string
+		distance int
+	}
+	lower := strings.ToLower(name)
+	var matches []scored
+	for _, candidate := range candidates {
+		full := strings.ToLower(candidate)
+		short := full[strings.LastIndex(full, ".")+1:]
+		distance := min(editDistance(lower, short), editDistance(lower, full))
+		if strings.Contains(short, lower) || strings.Contains(lower, short) {
+			distance = min(distance, 1)
+		}
+		if distance <= max(2, len(lower)/3) {
+			matches = append(matches, scored{candidate, distance})
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b scored) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.name, b.name)
+	})
+	var names []string
+	for _, match := range matches[:min(len(matches), maxTypeSuggestions)] {
+		names = append(names, match.name)
+	}
+	return names
+}
+
+// editDistance 两个字符串之间的Levenshtein距离
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// normalizeScriptableObjectCreateArgs 检查savePath和初始字段
+func normalizeScriptableObjectCreateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if err := normalizeSavePath(arguments, ".asset"); err != nil {
+		return nil, err
+	}
+	if fields, ok := arguments["fields"].(map[string]interface{}); ok {
+		if err := checkScriptableObjectFields(fields); err != nil {
+			return nil, err
+		}
+	}
+	return arguments, nil
+}
+
+// normalizeScriptableObjectSetArgs 需要.asset资源路径和至少一个字段
+func normalizeScriptableObjectSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	assetPath, _ := arguments["assetPath"].(string)
+	if !strings.HasSuffix(strings.ToLower(assetPath), ".asset") {
+		return nil, fmt.Errorf("assetPath must be a .asset file, got %q", assetPath)
+	}
+	fields, _ := arguments["fields"].(map[string]interface{})
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("fields must contain at least one property path")
+	}
+	if err := checkScriptableObjectFields(fields); err != nil {
+		return nil, err
+	}
+	return arguments, nil
+}
+
+// checkScriptableObjectFields 字段路径不能为空，数量有上限
+func checkScriptableObjectFields(fields map[string]interface{}) error {
+	if len(fields) > maxScriptableObjectFields {
+		return fmt.Errorf("too many fields (%d), at most %d per call", len(fields), maxScriptableObjectFields)
+	}
+	for path := range fields {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("fields must not contain an empty property path")
+		}
+	}
+	return nil
+}
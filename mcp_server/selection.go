@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxSelectionObjects editor_selection_set 一次最多选中的对象数
+const maxSelectionObjects = 1000
+
+// normalizeSelectionSetArgs 检查instanceIds和assetPaths: 至少提供其中一个 (空数组配合additive=false清空选择)，
+// 数量不超过上限，资源路径位于Assets或Packages下
+func normalizeSelectionSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	ids, hasIds := arguments["instanceIds"].([]interface{})
+	paths, hasPaths := arguments["assetPaths"].([]interface{})
+	if !hasIds && !hasPaths {
+		return nil, fmt.Errorf("instanceIds or assetPaths is required")
+	}
+	if len(ids)+len(paths) > maxSelectionObjects {
+		return nil, fmt.Errorf("at most %d objects can be selected in one call, got %d", maxSelectionObjects, len(ids)+len(paths))
+	}
+	for i, id := range ids {
+		n, err := toNumber(id)
+		if err == nil {
+			ids[i], err = toInteger(n)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("instanceIds[%d]: %w", i, err)
+		}
+	}
+	for i, p := range paths {
+		s, ok := p.(string)
+		s = strings.ReplaceAll(s, "\\", "/")
+		if !ok || !(strings.HasPrefix(s, "Assets/") || strings.HasPrefix(s, "Packages/")) {
+			return nil, fmt.Errorf("assetPaths[%d] must be a path under Assets/ or Packages/, got %v", i, p)
+		}
+		paths[i] = s
+	}
+	return arguments, nil
+}
@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+)
+
+// 设置类工具 (player_settings_set、quality_settings_set、particle_system_set) 的设置对象
+// 只接受声明表中的键，键名、值类型、枚举和范围都在发送到Unity之前检查
+
+// settingKey 一个可设置的键
+type settingKey struct {
+	Type        string   // string / number / integer / boolean / array / range / colorRange
+	Enum        []string // 非空时值必须是其中之一 (大小写不敏感)
+	Minimum     *float64
+	Maximum     *float64
+	PerPlatform bool // 需要platform (player_settings_set)
+	Confirm     bool // 需要confirm=true (player_settings_set)
+	Description string
+}
+
+// settingKeyNames 按字母排序的键名，用于错误信息和工具说明
+func settingKeyNames(keys map[string]settingKey) []string {
+	return slices.Sorted(maps.Keys(keys))
+}
+
+// describeSettingKeys 工具说明中列出的可设置键
+func describeSettingKeys(keys map[string]settingKey) string {
+	var lines []string
+	for _, name := range settingKeyNames(keys) {
+		key := keys[name]
+		line := fmt.Sprintf("%s (%s", name, key.Type)
+		if len(key.Enum) > 0 {
+			line += ": " + strings.Join(key.Enum, "/")
+		}
+		if key.Minimum != nil && key.Maximum != nil {
+			line += fmt.Sprintf(" %g-%g", *key.Minimum, *key.Maximum)
+		}
+		if key.PerPlatform {
+			line += ", per platform"
+		}
+		if key.Confirm {
+			line += ", requires confirm"
+		}
+		lines = append(lines, line+") - "+key.Description)
+	}
+	return strings.Join(lines, "; ")
+}
+
+// checkSettingValues 按声明表检查settings，把枚举规范为声明的名称、整数转换为int64
+// 返回发现的问题；有未知键时附上全部有效键
+func checkSettingValues(settings map[string]interface{}, keys map[string]settingKey) []string {
+	var problems []string
+	unknown := false
+	for _, name := range slices.Sorted(maps.Keys(settings)) {
+		key, ok := keys[name]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown key %q", name))
+			unknown = true
+			continue
+		}
+		if problem := checkSettingValue(settings, name, key); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	if unknown {
+		problems = append(problems, "valid keys: "+strings.Join(settingKeyNames(keys), ", "))
+	}
+	return problems
+}
+
+func checkSettingValue(settings map[string]interface{}, name string, key settingKey) string {
+	value := settings[name]
+	switch key.Type {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return name + " must be a string"
+		}
+		if len(key.Enum) > 0 {
+			canonical, found := canonicalName(key.Enum, s)
+			if !found {
+				return fmt.Sprintf("%s %q is not valid, expected one of: %s", name, s, strings.Join(key.Enum, ", "))
+			}
+			settings[name] = canonical
+		}
+		return ""
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return name + " must be a boolean"
+		}
+		return ""
+	case "array":
+		if _, ok := value.([]interface{}); !ok {
+			return name + " must be an array"
+		}
+		return ""
+	case "range":
+		return checkRangeSetting(settings, name, key)
+	case "colorRange":
+		return checkColorRangeSetting(settings, name)
+	}
+
+	n, ok := value.(float64)
+	if !ok {
+		return fmt.Sprintf("%s must be a %s", name, key.Type)
+	}
+	if key.Type == "integer" {
+		if n != math.Trunc(n) {
+			return name + " must be an integer"
+		}
+		settings[name] = int64(n)
+	}
+	if (key.Minimum != nil && n < *key.Minimum) || (key.Maximum != nil && n > *key.Maximum) {
+		return fmt.Sprintf("%s %g is out of range %s", name, n, settingRange(key))
+	}
+	return ""
+}
+
+func settingRange(key settingKey) string {
+	switch {
+	case key.Minimum != nil && key.Maximum != nil:
+		return fmt.Sprintf("%g-%g", *key.Minimum, *key.Maximum)
+	case key.Minimum != nil:
+		return fmt.Sprintf(">= %g", *key.Minimum)
+	default:
+		return fmt.Sprintf("<= %g", *key.Maximum)
+	}
+}
+
+// checkRangeSetting range类型: 常量数字或 {min, max} 两个常量
+func checkRangeSetting(settings map[string]interface{}, name string, key settingKey) string {
+	bound := settingKey{Type: "number", Minimum: key.Minimum, Maximum: key.Maximum}
+	check := func(n float64) string {
+		return checkSettingValue(map[string]interface{}{name: n}, name, bound)
+	}
+	switch value := settings[name].(type) {
+	case float64:
+		return check(value)
+	case map[string]interface{}:
+		lo, okMin := value["min"].(float64)
+		hi, okMax := value["max"].(float64)
+		if !okMin || !okMax || len(value) != 2 {
+			return name + " range must be {min, max} with two numbers"
+		}
+		if lo > hi {
+			return fmt.Sprintf("%s min (%g) must not exceed max (%g)", name, lo, hi)
+		}
+		if problem := check(lo); problem != "" {
+			return problem
+		}
+		return check(hi)
+	}
+	return name + " must be a number or {min, max}"
+}
+
+// checkColorRangeSetting colorRange类型: 一个颜色或 {min, max} 两个颜色，颜色转换为 {r,g,b,a}
+func checkColorRangeSetting(settings map[string]interface{}, name string) string {
+	value := settings[name]
+	if pair, ok := value.(map[string]interface{}); ok {
+		if _, isRange := pair["min"]; isRange {
+			lo, errMin := parseColor(pair["min"])
+			hi, errMax := parseColor(pair["max"])
+			if errMin != nil || errMax != nil || len(pair) != 2 {
+				return name + " color range must be {min, max} with two colors"
+			}
+			settings[name] = map[string]interface{}{"min": lo, "max": hi}
+			return ""
+		}
+	}
+	color, err := parseColor(value)
+	if err != nil {
+		return fmt.Sprintf("%s: %v", name, err)
+	}
+	settings[name] = color
+	return ""
+}
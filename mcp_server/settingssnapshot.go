@@ -0,0 +1,324 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// project_settings_snapshot: Unity只负责导出 (capture) 和写回 (restore) 设置的序列化属性，
+// 快照保存在本地目录中，diff在服务器端比较快照和当前值，restore只写回有差异的属性
+
+// settingsSnapshotOperations project_settings_snapshot 的操作
+var settingsSnapshotOperations = []string{"capture", "diff", "restore"}
+
+// settingsSnapshotSections 快照包含的设置，与Unity端的ProjectSettings资源对应
+var settingsSnapshotSections = []string{"player", "quality", "physics", "time", "tags"}
+
+// snapshotIDPattern 快照ID: UTC时间加随机后缀，也是文件名，限制格式以免访问目录外的文件
+var snapshotIDPattern = regexp.MustCompile(`^[0-9]{8}-[0-9]{6}-[0-9a-f]{6}$`)
+
+// settingsSnapshot 保存到磁盘的快照，Settings按设置分组，键为序列化属性路径
+type settingsSnapshot struct {
+	ID         string                            `json:"id"`
+	CapturedAt time.Time                         `json:"capturedAt"`
+	Label      string                            `json:"label,omitempty"`
+	Settings   map[string]map[string]interface{} `json:"settings"`
+}
+
+// settingsChange 快照和当前值之间的一处差异，缺失的一侧为nil
+type settingsChange struct {
+	Section  string      `json:"section"`
+	Path     string      `json:"path"`
+	Snapshot interface{} `json:"snapshot"`
+	Current  interface{} `json:"current"`
+}
+
+// snapshotDirectory 快照目录: 配置的snapshotDir，未配置时为用户缓存目录下的 unitymcp/settings-snapshots
+func snapshotDirectory(cfg ServerConfig) (string, error) {
+	if cfg.SnapshotDir != "" {
+		return cfg.SnapshotDir, nil
+	}
+	cache, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("no snapshot directory configured and no user cache directory: %w", err)
+	}
+	return filepath.Join(cache, "unitymcp", "settings-snapshots"), nil
+}
+
+// saveSettingsSnapshot 把快照写入目录，返回文件路径
+func saveSettingsSnapshot(dir string, snapshot *settingsSnapshot) (string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", fmt.Errorf("failed to create snapshot directory %s: %w", dir, err)
+	}
+	encoded, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+	file := filepath.Join(dir, snapshot.ID+".json")
+	if err := os.WriteFile(file, encoded, 0o600); err != nil {
+		return "", fmt.Errorf("failed to write snapshot %s: %w", file, err)
+	}
+	return file, nil
+}
+
+// loadSettingsSnapshot 按ID读取快照
+func loadSettingsSnapshot(dir, id string) (*settingsSnapshot, error) {
+	encoded, err := os.ReadFile(filepath.Join(dir, id+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("snapshot %s not found in %s", id, dir)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot %s: %w", id, err)
+	}
+	var snapshot settingsSnapshot
+	if err := json.Unmarshal(encoded, &snapshot); err != nil {
+		return nil, fmt.Errorf("snapshot %s is corrupt: %w", id, err)
+	}
+	return &snapshot, nil
+}
+
+// inlineSettingsSnapshot 把参数中的快照 (capture的结果或只有settings部分) 转换为快照
+func inlineSettingsSnapshot(value map[string]interface{}) (*settingsSnapshot, error) {
+	if settings, ok := value["settings"].(map[string]interface{}); ok {
+		value = settings
+	}
+	snapshot := &settingsSnapshot{Settings: make(map[string]map[string]interface{}, len(value))}
+	for section, values := range value {
+		if !slices.Contains(settingsSnapshotSections, section) {
+			return nil, fmt.Errorf("snapshot has unknown section %q, expected: %s", section, strings.Join(settingsSnapshotSections, ", "))
+		}
+		properties, ok := values.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("snapshot section %s must be an object of property paths to values", section)
+		}
+		snapshot.Settings[section] = properties
+	}
+	return snapshot, nil
+}
+
+// diffSettings 比较快照和当前值，按设置和属性路径排序
+func diffSettings(snapshot, current map[string]map[string]interface{}) []settingsChange {
+	changes := []settingsChange{}
+	for _, section := range slices.Sorted(maps.Keys(snapshot)) {
+		before, after := snapshot[section], current[section]
+		paths := slices.Collect(maps.Keys(before))
+		for path := range after {
+			if _, ok := before[path]; !ok {
+				paths = append(paths, path)
+			}
+		}
+		slices.Sort(paths)
+		for _, path := range paths {
+			old, inSnapshot := before[path]
+			now, inCurrent := after[path]
+			if inSnapshot && inCurrent && reflect.DeepEqual(old, now) {
+				continue
+			}
+			changes = append(changes, settingsChange{Section: section, Path: path, Snapshot: old, Current: now})
+		}
+	}
+	return changes
+}
+
+// handleProjectSettingsSnapshot capture从Unity导出并保存快照；diff和restore读取快照 (ID或内联) 并与当前值比较，
+// restore把有差异的属性写回Unity
+func handleProjectSettingsSnapshot(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "project_settings_snapshot"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	dir, err := snapshotDirectory(stateFromContext(ctx).Config)
+	if err != nil {
+		return toolErrorResult(ctx, errCodeInternal, err.Error(), toolName), nil
+	}
+	operation, _ := arguments["operation"].(string)
+
+	var snapshot *settingsSnapshot
+	switch {
+	case operation == "capture":
+	case arguments["snapshotId"] != nil:
+		id, _ := arguments["snapshotId"].(string)
+		if snapshot, err = loadSettingsSnapshot(dir, id); err != nil {
+			return toolErrorResult(ctx, errCodeInvalidArguments, err.Error(), toolName), nil
+		}
+	default:
+		inline, _ := arguments["snapshot"].(map[string]interface{})
+		if snapshot, err = inlineSettingsSnapshot(inline); err != nil {
+			return toolErrorResult(ctx, errCodeInvalidArguments, err.Error(), toolName), nil
+		}
+	}
+
+	// diff和restore只比较快照中有的设置
+	sections, _ := arguments["sections"].([]interface{})
+	if snapshot != nil {
+		var inSnapshot []interface{}
+		for _, section := range settingsSnapshotSections {
+			if _, ok := snapshot.Settings[section]; ok && (len(sections) == 0 || slices.Contains(sections, interface{}(section))) {
+				inSnapshot = append(inSnapshot, section)
+			}
+		}
+		if len(inSnapshot) == 0 {
+			return toolErrorResult(ctx, errCodeInvalidArguments, "the snapshot contains none of the requested sections", toolName), nil
+		}
+		sections = inSnapshot
+	}
+
+	params := map[string]interface{}{"operation": "capture"}
+	if len(sections) > 0 {
+		params["sections"] = sections
+	}
+	current, result := querySettingsSnapshot(ctx, toolName, params)
+	if result != nil {
+		return result, nil
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	switch operation {
+	case "capture":
+		label, _ := arguments["label"].(string)
+		snapshot = &settingsSnapshot{
+			ID:         time.Now().UTC().Format("20060102-150405") + "-" + strings.ReplaceAll(uuid.NewString(), "-", "")[:6],
+			CapturedAt: time.Now().UTC(),
+			Label:      label,
+			Settings:   current,
+		}
+		file, err := saveSettingsSnapshot(dir, snapshot)
+		if err != nil {
+			return toolErrorResult(ctx, errCodeInternal, err.Error(), toolName), nil
+		}
+		callInfoFromContext(ctx).Logger().Info("Project settings snapshot captured", "snapshot_id", snapshot.ID, "file", file)
+		counts := make(map[string]int, len(current))
+		for section, values := range current {
+			counts[section] = len(values)
+		}
+		data := map[string]interface{}{
+			"snapshotId": snapshot.ID,
+			"file":       file,
+			"capturedAt": snapshot.CapturedAt.Format(time.RFC3339),
+			"counts":     counts,
+		}
+		if label != "" {
+			data["label"] = label
+		}
+		if include, set := arguments["includeSettings"].(bool); include || !set {
+			data["settings"] = current
+		}
+		return toolSuccessResult(ctx, toolName, data), nil
+
+	case "diff":
+		changes := diffSettings(snapshot.Settings, current)
+		data := map[string]interface{}{"changes": changes, "changeCount": len(changes), "sections": sections}
+		if snapshot.ID != "" {
+			data["snapshotId"] = snapshot.ID
+		}
+		return toolSuccessResult(ctx, toolName, data), nil
+	}
+
+	// restore: 写回快照中与当前值不同或当前不存在的属性；只存在于当前值的属性 (如增加的数组元素) 通过数组长度恢复
+	changes := diffSettings(snapshot.Settings, current)
+	restore := make(map[string]interface{})
+	for _, change := range changes {
+		if change.Snapshot == nil {
+			continue
+		}
+		values, _ := restore[change.Section].(map[string]interface{})
+		if values == nil {
+			values = make(map[string]interface{})
+			restore[change.Section] = values
+		}
+		values[change.Path] = change.Snapshot
+	}
+	data := map[string]interface{}{"changes": changes, "changeCount": len(changes)}
+	if snapshot.ID != "" {
+		data["snapshotId"] = snapshot.ID
+	}
+	if len(restore) == 0 {
+		data["restored"] = map[string]interface{}{}
+		return toolSuccessResult(ctx, toolName, data), nil
+	}
+	callInfoFromContext(ctx).Logger().Info("Restoring project settings snapshot", "snapshot_id", snapshot.ID, "changes", len(changes))
+	restored, err := queryUnity(ctx, toolName, map[string]interface{}{"operation": "restore", "settings": restore})
+	if err != nil {
+		return unityErrorResult(ctx, toolName, err)
+	}
+	if details, ok := restored.(map[string]interface{}); ok {
+		maps.Copy(data, details)
+	}
+	return toolSuccessResult(ctx, toolName, data), nil
+}
+
+// querySettingsSnapshot 从Unity导出当前设置，失败时返回错误结果
+func querySettingsSnapshot(ctx context.Context, toolName string, params map[string]interface{}) (map[string]map[string]interface{}, *mcp.CallToolResult) {
+	data, err := queryUnityLevel(ctx, toolName, params, slog.LevelDebug)
+	if err != nil {
+		result, _ := unityErrorResult(ctx, toolName, err)
+		return nil, result
+	}
+	info, _ := data.(map[string]interface{})
+	settings, _ := info["settings"].(map[string]interface{})
+	current := make(map[string]map[string]interface{}, len(settings))
+	for section, values := range settings {
+		if properties, ok := values.(map[string]interface{}); ok {
+			current[section] = properties
+		}
+	}
+	return current, nil
+}
+
+// normalizeProjectSettingsSnapshotArgs 检查设置名称；diff和restore需要snapshotId或snapshot之一，restore需要confirm=true
+func normalizeProjectSettingsSnapshotArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	operation, _ := arguments["operation"].(string)
+	if sections, ok := arguments["sections"].([]interface{}); ok {
+		for i, item := range sections {
+			name, _ := item.(string)
+			canonical, found := canonicalName(settingsSnapshotSections, name)
+			if !found {
+				problems = append(problems, fmt.Sprintf("sections[%d] %v is not valid, expected one of: %s", i, item, strings.Join(settingsSnapshotSections, ", ")))
+				continue
+			}
+			sections[i] = canonical
+		}
+	}
+
+	id, hasID := arguments["snapshotId"].(string)
+	_, hasInline := arguments["snapshot"]
+	switch {
+	case operation == "capture" && (hasID || hasInline):
+		problems = append(problems, "capture does not take snapshotId or snapshot")
+	case operation != "capture" && hasID == hasInline:
+		problems = append(problems, operation+" requires exactly one of snapshotId or snapshot")
+	case hasID && !snapshotIDPattern.MatchString(id):
+		problems = append(problems, fmt.Sprintf("snapshotId %q is not a snapshot ID returned by capture", id))
+	}
+	for _, name := range []string{"label", "includeSettings"} {
+		if _, ok := arguments[name]; ok && operation != "capture" {
+			problems = append(problems, name+" only applies to capture")
+		}
+	}
+	if confirm, _ := arguments["confirm"].(bool); operation == "restore" && !confirm {
+		problems = append(problems, "restore requires confirm=true")
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid snapshot arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
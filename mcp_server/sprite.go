@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// spriteModes sprite_configure 的spriteMode，与Unity的SpriteImportMode一致
+var spriteModes = []string{"Single", "Multiple"}
+
+// maxSpriteRects sprite_configure 一次最多定义的切片数
+const maxSpriteRects = 4096
+
+// handleSpriteConfigure 有切片时先通过texture_get_info查询纹理尺寸，检查切片不超出纹理，然后转发给Unity
+func handleSpriteConfigure(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "sprite_configure"
+	arguments := request.GetArguments()
+	_, hasRects := arguments["rects"]
+	_, hasGrid := arguments["gridCellSize"]
+	if (!hasRects && !hasGrid) || isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+
+	data, err := queryUnityLevel(ctx, "texture_get_info", map[string]interface{}{"assetPath": arguments["assetPath"]}, slog.LevelDebug)
+	var actionErr *unityActionError
+	switch {
+	case errors.As(err, &actionErr):
+		return toolErrorResult(ctx, errCodeInvalidArguments, actionErr.Message, toolName), nil
+	case err != nil:
+		return unityErrorResult(ctx, toolName, err)
+	}
+	info, _ := data.(map[string]interface{})
+	width, errWidth := toNumber(info["sourceWidth"])
+	height, errHeight := toNumber(info["sourceHeight"])
+	if errWidth != nil || errHeight != nil {
+		return toolErrorResult(ctx, errCodeUnityToolFailed, "texture_get_info returned no texture size", toolName), nil
+	}
+	if problems := checkSpriteBounds(arguments, width, height); len(problems) > 0 {
+		message := fmt.Sprintf("sprite slices do not fit the %gx%g texture: %s", width, height, strings.Join(problems, "; "))
+		return toolErrorResult(ctx, errCodeInvalidArguments, message, toolName), nil
+	}
+	return forwardToUnity(ctx, toolName, arguments, request)
+}
+
+// checkSpriteBounds 检查切片矩形和网格是否位于纹理范围内
+func checkSpriteBounds(arguments map[string]interface{}, width, height float64) []string {
+	var problems []string
+	rects, _ := arguments["rects"].([]interface{})
+	for i, item := range rects {
+		rect, _ := item.(map[string]interface{})
+		x, _ := rect["x"].(float64)
+		y, _ := rect["y"].(float64)
+		w, _ := rect["width"].(float64)
+		h, _ := rect["height"].(float64)
+		if x+w > width || y+h > height {
+			problems = append(problems, fmt.Sprintf("rects[%d] %q (%g,%g %gx%g) extends past the texture", i, rect["name"], x, y, w, h))
+		}
+	}
+	if cell, ok := arguments["gridCellSize"].(map[string]interface{}); ok {
+		offset, _ := arguments["gridOffset"].(map[string]interface{})
+		cellX, _ := cell["x"].(float64)
+		cellY, _ := cell["y"].(float64)
+		offsetX, _ := offset["x"].(float64)
+		offsetY, _ := offset["y"].(float64)
+		if offsetX+cellX > width || offsetY+cellY > height {
+			problems = append(problems, fmt.Sprintf("gridCellSize %gx%g with gridOffset %g,%g leaves no complete cell", cellX, cellY, offsetX, offsetY))
+		}
+	}
+	return problems
+}
+
+// normalizeSpriteConfigureArgs 检查切片参数: 网格和矩形互斥，切片需要Multiple模式 (未指定时自动使用)，
+// 每个矩形需要唯一的名称、正的尺寸和0到1之间的pivot
+func normalizeSpriteConfigureArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	rects, hasRects := arguments["rects"].([]interface{})
+	cell, hasGrid := arguments["gridCellSize"].(map[string]interface{})
+	mode, hasMode := arguments["spriteMode"].(string)
+	switch {
+	case hasRects && hasGrid:
+		problems = append(problems, "rects and gridCellSize are mutually exclusive")
+	case (hasRects || hasGrid) && hasMode && mode != "Multiple":
+		problems = append(problems, "slicing with rects or gridCellSize requires spriteMode Multiple")
+	case hasRects || hasGrid:
+		arguments["spriteMode"] = "Multiple"
+	}
+	for _, name := range []string{"gridOffset", "gridPadding"} {
+		if _, ok := arguments[name]; ok && !hasGrid {
+			problems = append(problems, name+" requires gridCellSize")
+		}
+	}
+
+	if hasGrid {
+		x, okX := cell["x"].(float64)
+		y, okY := cell["y"].(float64)
+		if !okX || !okY || x < 1 || y < 1 {
+			problems = append(problems, "gridCellSize needs x and y of at least 1 pixel")
+		}
+	}
+
+	if hasRects && len(rects) == 0 {
+		problems = append(problems, "rects must not be empty")
+	}
+	if len(rects) > maxSpriteRects {
+		problems = append(problems, fmt.Sprintf("rects has %d elements, at most %d are allowed", len(rects), maxSpriteRects))
+	}
+	names := make(map[string]bool, len(rects))
+	for i, item := range rects {
+		rect, ok := item.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("rects[%d] must be an object", i))
+			continue
+		}
+		name, _ := rect["name"].(string)
+		switch {
+		case strings.TrimSpace(name) == "":
+			problems = append(problems, fmt.Sprintf("rects[%d] needs a name", i))
+		case names[name]:
+			problems = append(problems, fmt.Sprintf("rects[%d] name %q is used more than once", i, name))
+		}
+		names[name] = true
+		for _, key := range []string{"x", "y", "width", "height"} {
+			n, err := toNumber(rect[key])
+			switch {
+			case err != nil:
+				problems = append(problems, fmt.Sprintf("rects[%d].%s: %v", i, key, err))
+			case n < 0 || ((key == "width" || key == "height") && n == 0):
+				problems = append(problems, fmt.Sprintf("rects[%d].%s must be positive, got %g", i, key, n))
+			default:
+				rect[key] = n
+			}
+		}
+		if pivot, ok := rect["pivot"].(map[string]interface{}); ok {
+			for _, key := range []string{"x", "y"} {
+				if n, err := toNumber(pivot[key]); err != nil || n < 0 || n > 1 {
+					problems = append(problems, fmt.Sprintf("rects[%d].pivot.%s must be between 0 and 1", i, key))
+				}
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid sprite arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// spriteAtlasPackTimeout 打包图集等待Unity响应的最长时间，大图集打包需要几分钟
+const spriteAtlasPackTimeout = 30 * time.Minute
+
+// spriteAtlasPaddings 图集打包支持的精灵间距 (像素)
+var spriteAtlasPaddings = []float64{2, 4, 8}
+
+// normalizeSpriteAtlasCreateArgs 检查图集路径和初始打包对象
+func normalizeSpriteAtlasCreateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	savePath, _ := arguments["savePath"].(string)
+	if !strings.HasPrefix(savePath, "Assets/") || !strings.HasSuffix(savePath, ".spriteatlas") {
+		problems = append(problems, fmt.Sprintf("savePath %q must be a path like Assets/Atlases/UI.spriteatlas", savePath))
+	}
+	if padding, ok := arguments["padding"].(int64); ok && !slices.Contains(spriteAtlasPaddings, float64(padding)) {
+		problems = append(problems, fmt.Sprintf("padding must be 2, 4 or 8, got %d", padding))
+	}
+	problems = append(problems, checkPackablePaths(arguments, "packables")...)
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid sprite atlas arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// normalizeSpriteAtlasModifyArgs 检查添加和移除的路径，同一路径不能同时添加和移除
+func normalizeSpriteAtlasModifyArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	atlasPath, _ := arguments["atlasPath"].(string)
+	if !strings.HasSuffix(atlasPath, ".spriteatlas") {
+		problems = append(problems, fmt.Sprintf("atlasPath %q must be a .spriteatlas asset", atlasPath))
+	}
+	problems = append(problems, checkPackablePaths(arguments, "add")...)
+	problems = append(problems, checkPackablePaths(arguments, "remove")...)
+	added, _ := arguments["add"].([]interface{})
+	removed, _ := arguments["remove"].([]interface{})
+	for _, item := range added {
+		if slices.Contains(removed, item) {
+			problems = append(problems, fmt.Sprintf("%v is both added and removed", item))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid sprite atlas arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// checkPackablePaths 图集打包对象是Assets下的文件夹、纹理或Sprite的资源路径
+func checkPackablePaths(arguments map[string]interface{}, name string) []string {
+	var problems []string
+	items, _ := arguments[name].([]interface{})
+	for i, item := range items {
+		p, ok := item.(string)
+		if !ok || !strings.HasPrefix(p, "Assets/") {
+			problems = append(problems, fmt.Sprintf("%s[%d] %v must be an asset path under Assets/", name, i, item))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"reflect"
+	"strings"
+	"sync/atomic"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// liveState 可热更新的运行状态，SIGHUP重新加载配置时整体原子替换
+// 工具调用在开始时取一次快照，整个调用过程只看到旧配置或新配置中的一个
+type liveState struct {
+	Config ServerConfig
+	Tools  *toolSet
+}
+
+var live atomic.Pointer[liveState]
+
+// liveStateKey 在工具调用的context中保存状态快照
+type liveStateKey struct{}
+
+// newLiveState 根据配置创建运行状态
+func newLiveState(c ServerConfig) *liveState {
+	return &liveState{Config: c, Tools: newToolSet(newToolPolicy(c))}
+}
+
+// currentState 返回当前运行状态
+func currentState() *liveState {
+	return live.Load()
+}
+
+// withState 把状态快照保存到context中
+func withState(ctx context.Context, st *liveState) context.Context {
+	return context.WithValue(ctx, liveStateKey{}, st)
+}
+
+// stateFromContext 返回工具调用开始时的状态快照，不在工具调用中时返回当前状态
+func stateFromContext(ctx context.Context) *liveState {
+	if st, ok := ctx.Value(liveStateKey{}).(*liveState); ok {
+		return st
+	}
+	return currentState()
+}
+
+// applyConfig 应用可热更新的配置并替换运行状态
+func applyConfig(c ServerConfig) error {
+	if err := audit.Configure(c.AuditLog, c.AuditAll); err != nil {
+		return err
+	}
+	level, _ := parseLogLevel(c.LogLevel)
+	logLevel.Set(level)
+	setPayloadLimit(c.LogPayloadLimit)
+	history.Resize(c.HistorySize)
+	live.Store(newLiveState(c))
+	return nil
+}
+
+// reloadConfig 重新读取配置文件 (SIGHUP)
+// 只应用带有reload标签的字段，其他字段的变化需要重启，保留旧值并给出警告
+func reloadConfig(s *server.MCPServer, fs *flag.FlagSet, configPath string) {
+	next, _, warnings, err := loadConfig(fs, configPath)
+	if err != nil {
+		errorLog("Configuration reload failed, keeping current configuration: %v", err)
+		return
+	}
+	for _, warning := range warnings {
+		warnLog("%s", warning)
+	}
+
+	current := currentState().Config
+	cur := reflect.ValueOf(current)
+	nv := reflect.ValueOf(&next).Elem()
+	var changed, restartRequired []string
+	for _, field := range configFields() {
+		if reflect.DeepEqual(cur.FieldByName(field.Name).Interface(), nv.FieldByName(field.Name).Interface()) {
+			continue
+		}
+		name := field.Tag.Get("flag")
+		if field.Tag.Get("reload") != "true" {
+			restartRequired = append(restartRequired, name)
+			nv.FieldByName(field.Name).Set(cur.FieldByName(field.Name))
+			continue
+		}
+		changed = append(changed, name)
+	}
+
+	if len(restartRequired) > 0 {
+		warnLog("Configuration changes that require a restart were ignored: %s", strings.Join(restartRequired, ", "))
+	}
+	if len(changed) == 0 {
+		infoLog("Configuration reloaded, no hot-reloadable settings changed")
+		return
+	}
+	if err := applyConfig(next); err != nil {
+		errorLog("Configuration reload failed, keeping current configuration: %v", err)
+		return
+	}
+	infoLog("Configuration reloaded, changed: %s", strings.Join(changed, ", "))
+	logEffectiveConfig(next, nil)
+
+	// 工具策略变化时通知客户端重新获取工具列表
+	if current.ReadOnly != next.ReadOnly || current.AllowTools != next.AllowTools || current.DenyTools != next.DenyTools {
+		s.SendNotificationToAllClients(mcp.MethodNotificationToolsListChanged, nil)
+	}
+}
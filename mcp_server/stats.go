@@ -0,0 +1,214 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// 每个工具保留的最近耗时样本数，用于计算p95
+const latencySampleSize = 1000
+
+// toolStats 单个工具的调用统计
+type toolStats struct {
+	calls         int64
+	errors        int64
+	canceled      int64 // 被客户端取消的调用，不计入calls和errors
+	panics        int64 // 处理函数panic的次数，同时计入calls和errors
+	invalid       int64 // 参数校验失败的调用，未发送到Unity，不计入calls和errors
+	totalDuration time.Duration
+	samples       []time.Duration // 环形缓冲区
+	next          int
+	lastCall      time.Time
+	lastError     string
+	lastErrorTime time.Time
+}
+
+// statsCollector 内存中的工具调用统计，供 /status 使用
+type statsCollector struct {
+	mu        sync.Mutex
+	tools     map[string]*toolStats
+	startTime time.Time
+	resetTime time.Time
+	// 最近一次成功的工具调用时间，不受Reset影响
+	lastSuccess time.Time
+}
+
+// 全局统计收集器
+var stats = newStatsCollector()
+
+func newStatsCollector() *statsCollector {
+	now := time.Now()
+	return &statsCollector{
+		tools:     make(map[string]*toolStats),
+		startTime: now,
+		resetTime: now,
+	}
+}
+
+// Record 记录一次工具调用，errMsg为空表示成功
+func (s *statsCollector) Record(toolName string, duration time.Duration, errMsg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, ok := s.tools[toolName]
+	if !ok {
+		ts = &toolStats{}
+		s.tools[toolName] = ts
+	}
+
+	ts.calls++
+	ts.totalDuration += duration
+	ts.lastCall = time.Now()
+	if len(ts.samples) < latencySampleSize {
+		ts.samples = append(ts.samples, duration)
+	} else {
+		ts.samples[ts.next] = duration
+		ts.next = (ts.next + 1) % latencySampleSize
+	}
+
+	if errMsg != "" {
+		ts.errors++
+		ts.lastError = errMsg
+		ts.lastErrorTime = ts.lastCall
+	} else {
+		s.lastSuccess = ts.lastCall
+	}
+}
+
+// LastSuccess 返回最近一次成功的工具调用时间，从未成功时返回零值
+func (s *statsCollector) LastSuccess() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lastSuccess
+}
+
+// RecordCanceled 记录一次被取消的调用
+func (s *statsCollector) RecordCanceled(toolName string, duration time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, ok := s.tools[toolName]
+	if !ok {
+		ts = &toolStats{}
+		s.tools[toolName] = ts
+	}
+	ts.canceled++
+	ts.lastCall = time.Now()
+}
+
+// RecordPanic 记录一次处理函数panic，调用本身仍通过Record记录
+func (s *statsCollector) RecordPanic(toolName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, ok := s.tools[toolName]
+	if !ok {
+		ts = &toolStats{}
+		s.tools[toolName] = ts
+	}
+	ts.panics++
+}
+
+// RecordInvalid 记录一次参数校验失败的调用
+func (s *statsCollector) RecordInvalid(toolName string, errMsg string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ts, ok := s.tools[toolName]
+	if !ok {
+		ts = &toolStats{}
+		s.tools[toolName] = ts
+	}
+	ts.invalid++
+	ts.lastCall = time.Now()
+	ts.lastError = errMsg
+	ts.lastErrorTime = ts.lastCall
+}
+
+// Reset 清空所有统计
+func (s *statsCollector) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tools = make(map[string]*toolStats)
+	s.resetTime = time.Now()
+}
+
+// Snapshot 返回当前统计的JSON友好表示
+func (s *statsCollector) Snapshot() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tools := make(map[string]interface{}, len(s.tools))
+	var totalCalls, totalErrors, totalCanceled, totalPanics, totalInvalid int64
+	for name, ts := range s.tools {
+		totalCalls += ts.calls
+		totalErrors += ts.errors
+		totalCanceled += ts.canceled
+		totalPanics += ts.panics
+		totalInvalid += ts.invalid
+
+		entry := map[string]interface{}{
+			"calls":        ts.calls,
+			"errors":       ts.errors,
+			"canceled":     ts.canceled,
+			"panics":       ts.panics,
+			"invalid":      ts.invalid,
+			"errorRate":    0.0,
+			"avgLatencyMs": 0.0,
+			"p95LatencyMs": float64(percentile(ts.samples, 0.95).Microseconds()) / 1000,
+			"lastCall":     ts.lastCall.Format(time.RFC3339),
+		}
+		if ts.calls > 0 {
+			entry["errorRate"] = float64(ts.errors) / float64(ts.calls)
+			entry["avgLatencyMs"] = float64(ts.totalDuration.Microseconds()) / float64(ts.calls) / 1000
+		}
+		if ts.lastError != "" {
+			entry["lastError"] = ts.lastError
+			entry["lastErrorTime"] = ts.lastErrorTime.Format(time.RFC3339)
+		}
+		tools[name] = entry
+	}
+
+	return map[string]interface{}{
+		"uptimeSeconds": int64(time.Since(s.startTime).Seconds()),
+		"since":         s.resetTime.Format(time.RFC3339),
+		"totalCalls":    totalCalls,
+		"totalErrors":   totalErrors,
+		"totalCanceled": totalCanceled,
+		"totalPanics":   totalPanics,
+		"totalInvalid":  totalInvalid,
+		"tools":         tools,
+	}
+}
+
+// percentile 计算耗时样本的百分位数
+func percentile(samples []time.Duration, p float64) time.Duration {
+	if len(samples) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	index := int(float64(len(sorted)-1) * p)
+	return sorted[index]
+}
+
+// toolResultError 从工具结果中提取错误信息，成功时返回空字符串
+func toolResultError(result *mcp.CallToolResult, err error) string {
+	if err != nil {
+		return err.Error()
+	}
+	if result == nil || !result.IsError {
+		return ""
+	}
+	for _, content := range result.Content {
+		if text, ok := mcp.AsTextContent(content); ok {
+			return text.Text
+		}
+	}
+	return "unknown error"
+}
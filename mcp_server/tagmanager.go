@@ -0,0 +1,212 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// 标签、层和排序层保存在 ProjectSettings/TagManager.asset
+// 修改工具在Unity写入后用对应的get工具重新读取，确认修改已持久化，并返回完整的最新列表
+
+// builtinTags Unity内置标签，不能添加或删除
+var builtinTags = []string{"Untagged", "Respawn", "Finish", "EditorOnly", "MainCamera", "Player", "GameController"}
+
+// 用户层的序号范围，0~7为内置层
+const (
+	firstUserLayer = 8
+	lastUserLayer  = 31
+)
+
+// tagManagerVerifier 检查get工具返回的数据是否包含所做的修改
+type tagManagerVerifier func(arguments map[string]interface{}, data map[string]interface{}) error
+
+// tagManagerHandler 执行修改后用getAction重新读取并校验
+func tagManagerHandler(toolName, getAction string, verify tagManagerVerifier) func(context.Context, mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		arguments := request.GetArguments()
+		if isDryRun(ctx) {
+			return forwardToUnity(ctx, toolName, arguments, request)
+		}
+
+		changed, err := queryUnity(ctx, toolName, arguments)
+		if err != nil {
+			return unityErrorResult(ctx, toolName, err)
+		}
+
+		data, err := queryUnityLevel(ctx, getAction, map[string]interface{}{}, slog.LevelDebug)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return toolErrorResult(ctx, errCodeUnityUnavailable, fmt.Sprintf("change was sent but could not be verified with %s: %s", getAction, err.Error()), toolName), nil
+		}
+		current, _ := data.(map[string]interface{})
+		if err := verify(arguments, current); err != nil {
+			return toolErrorResult(ctx, errCodeUnityToolFailed, fmt.Sprintf("TagManager change did not persist: %s", err.Error()), toolName), nil
+		}
+
+		if m, ok := changed.(map[string]interface{}); ok {
+			for key, value := range m {
+				if _, exists := current[key]; !exists {
+					current[key] = value
+				}
+			}
+		}
+		current["verified"] = true
+		return toolSuccessResult(ctx, toolName, current), nil
+	}
+}
+
+// stringList 把 []interface{} 中的字符串取出
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	list := make([]string, 0, len(items))
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// sortingLayerNames get结果中排序层的名称，按渲染顺序
+func sortingLayerNames(data map[string]interface{}) []string {
+	items, _ := data["sortingLayers"].([]interface{})
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		if layer, ok := item.(map[string]interface{}); ok {
+			name, _ := layer["name"].(string)
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// validateLayerName 标签、层和排序层名称不能为空或首尾有空白
+func validateLayerName(kind string, value interface{}) (string, error) {
+	name, ok := value.(string)
+	if !ok || name == "" || strings.TrimSpace(name) != name {
+		return "", fmt.Errorf("%s must be a non-empty name without leading or trailing spaces, got %q", kind, value)
+	}
+	return name, nil
+}
+
+// normalizeTagsArgs tags中的名称必须有效且不重复，不能是内置标签
+func normalizeTagsArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	tags, _ := arguments["tags"].([]interface{})
+	if len(tags) == 0 {
+		return nil, fmt.Errorf("tags must contain at least one tag")
+	}
+	seen := map[string]bool{}
+	for i, item := range tags {
+		tag, err := validateLayerName(fmt.Sprintf("tags[%d]", i), item)
+		if err != nil {
+			return nil, err
+		}
+		if slices.Contains(builtinTags, tag) {
+			return nil, fmt.Errorf("tags[%d] %q is a built-in tag and cannot be added or removed", i, tag)
+		}
+		if seen[tag] {
+			return nil, fmt.Errorf("tags lists %q more than once", tag)
+		}
+		seen[tag] = true
+	}
+	return arguments, nil
+}
+
+// normalizeLayerSetArgs name为空字符串表示清除该层的名称
+func normalizeLayerSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if name, _ := arguments["name"].(string); name != "" {
+		if _, err := validateLayerName("name", name); err != nil {
+			return nil, err
+		}
+	}
+	return arguments, nil
+}
+
+// normalizeSortingLayerAddArgs 检查排序层名称
+func normalizeSortingLayerAddArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if _, err := validateLayerName("name", arguments["name"]); err != nil {
+		return nil, err
+	}
+	return arguments, nil
+}
+
+// normalizeSortingLayerReorderArgs order必须是不重复的排序层名称，是否与现有排序层一致由Unity检查
+func normalizeSortingLayerReorderArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	order, _ := arguments["order"].([]interface{})
+	seen := map[string]bool{}
+	for i, item := range order {
+		name, err := validateLayerName(fmt.Sprintf("order[%d]", i), item)
+		if err != nil {
+			return nil, err
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("order lists %q more than once", name)
+		}
+		seen[name] = true
+	}
+	return arguments, nil
+}
+
+// verifyTagsAdded 添加的标签都在列表中
+func verifyTagsAdded(arguments map[string]interface{}, data map[string]interface{}) error {
+	current := stringList(data["tags"])
+	for _, tag := range stringList(arguments["tags"]) {
+		if !slices.Contains(current, tag) {
+			return fmt.Errorf("tag %q is missing after adding", tag)
+		}
+	}
+	return nil
+}
+
+// verifyTagsRemoved 删除的标签都不在列表中
+func verifyTagsRemoved(arguments map[string]interface{}, data map[string]interface{}) error {
+	current := stringList(data["tags"])
+	for _, tag := range stringList(arguments["tags"]) {
+		if slices.Contains(current, tag) {
+			return fmt.Errorf("tag %q is still present after removing", tag)
+		}
+	}
+	return nil
+}
+
+// verifyLayerSet 指定序号的层名称与设置的一致
+func verifyLayerSet(arguments map[string]interface{}, data map[string]interface{}) error {
+	index, _ := arguments["index"].(int64)
+	name, _ := arguments["name"].(string)
+	layers, _ := data["layers"].([]interface{})
+	for _, item := range layers {
+		layer, _ := item.(map[string]interface{})
+		if i, _ := layer["index"].(float64); int64(i) == index {
+			if current, _ := layer["name"].(string); current != name {
+				return fmt.Errorf("layer %d is named %q, expected %q", index, current, name)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("layer %d is missing from the layer list", index)
+}
+
+// verifySortingLayerAdded 新排序层在列表中
+func verifySortingLayerAdded(arguments map[string]interface{}, data map[string]interface{}) error {
+	name, _ := arguments["name"].(string)
+	if !slices.Contains(sortingLayerNames(data), name) {
+		return fmt.Errorf("sorting layer %q is missing after adding", name)
+	}
+	return nil
+}
+
+// verifySortingLayerOrder 排序层顺序与请求的一致
+func verifySortingLayerOrder(arguments map[string]interface{}, data map[string]interface{}) error {
+	order := stringList(arguments["order"])
+	if current := sortingLayerNames(data); !slices.Equal(current, order) {
+		return fmt.Errorf("sorting layer order is %v, expected %v", current, order)
+	}
+	return nil
+}
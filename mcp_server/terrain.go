@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// terrain_modify 的heightmap随请求帧 (最大1MB) 一起发送，只接受降采样后的小数组，
+// Unity端双线性插值到地形的高度图分辨率
+
+// maxTerrainHeightmapSize heightmap每边最多的采样数，129x129个数字远小于帧大小限制
+const maxTerrainHeightmapSize = 129
+
+// terrainResolutions TerrainData支持的高度图分辨率 (2^n+1)
+var terrainResolutions = []int64{33, 65, 129, 257, 513, 1025, 2049, 4097}
+
+// terrainOperations terrain_modify 的操作
+var terrainOperations = []string{"set_heights", "add_layer", "paint_layer"}
+
+// terrainHeightFunctions set_heights 的程序化高度函数
+var terrainHeightFunctions = []string{"flat", "slope", "noise"}
+
+// rectComponents 矩形参数的分量
+var rectComponents = []string{"x", "y", "width", "height"}
+
+// terrainOperationParams 每个操作可以使用的参数，其他参数视为错误，避免参数被静默忽略
+var terrainOperationParams = map[string][]string{
+	"set_heights": {"function", "height", "from", "to", "direction", "seed", "scale", "amplitude", "heightmap"},
+	"add_layer":   {"texturePath", "normalMapPath", "tileSize", "layerPath"},
+	"paint_layer": {"layerIndex", "rect", "opacity"},
+}
+
+// terrainFunctionParams 每个高度函数使用的参数
+var terrainFunctionParams = map[string][]string{
+	"flat":  {"height"},
+	"slope": {"from", "to", "direction"},
+	"noise": {"height", "seed", "scale", "amplitude"},
+}
+
+// normalizeTerrainCreateArgs 检查高度图分辨率和TerrainData的保存路径
+func normalizeTerrainCreateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	if resolution, ok := arguments["heightmapResolution"].(int64); ok && !slices.Contains(terrainResolutions, resolution) {
+		problems = append(problems, fmt.Sprintf("heightmapResolution must be one of 33, 65, 129, 257, 513, 1025, 2049, 4097, got %d", resolution))
+	}
+	if size, ok := arguments["size"].(map[string]interface{}); ok {
+		for _, key := range vectorXYZ {
+			if n, ok := size[key].(float64); ok && n <= 0 {
+				problems = append(problems, fmt.Sprintf("size.%s must be positive, got %g", key, n))
+			}
+		}
+	}
+	if savePath, ok := arguments["savePath"].(string); ok && (!strings.HasPrefix(savePath, "Assets/") || !strings.HasSuffix(savePath, ".asset")) {
+		problems = append(problems, fmt.Sprintf("savePath %q must be a path like Assets/Terrains/Level1.asset", savePath))
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid terrain arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// normalizeTerrainModifyArgs 检查每个操作需要的参数；heightmap必须是矩形的二维数组，值为0到1之间的归一化高度
+func normalizeTerrainModifyArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	operation, _ := arguments["operation"].(string)
+	allowed := terrainOperationParams[operation]
+	var problems []string
+	for _, op := range terrainOperations {
+		for _, name := range terrainOperationParams[op] {
+			if _, ok := arguments[name]; ok && !slices.Contains(allowed, name) {
+				problems = append(problems, fmt.Sprintf("%s is not used by operation %s", name, operation))
+			}
+		}
+	}
+
+	switch operation {
+	case "set_heights":
+		problems = append(problems, checkTerrainHeights(arguments)...)
+	case "add_layer":
+		if _, ok := arguments["texturePath"]; !ok {
+			problems = append(problems, "add_layer requires texturePath")
+		}
+		if layerPath, ok := arguments["layerPath"].(string); ok && (!strings.HasPrefix(layerPath, "Assets/") || !strings.HasSuffix(layerPath, ".terrainlayer")) {
+			problems = append(problems, fmt.Sprintf("layerPath %q must be a path like Assets/Terrains/Grass.terrainlayer", layerPath))
+		}
+	case "paint_layer":
+		if _, ok := arguments["layerIndex"]; !ok {
+			problems = append(problems, "paint_layer requires layerIndex")
+		}
+		if rect, ok := arguments["rect"].(map[string]interface{}); ok {
+			x, okX := rect["x"].(float64)
+			y, okY := rect["y"].(float64)
+			w, okW := rect["width"].(float64)
+			h, okH := rect["height"].(float64)
+			switch {
+			case !okX || !okY || !okW || !okH:
+				problems = append(problems, "rect needs x, y, width and height")
+			case x < 0 || y < 0 || w <= 0 || h <= 0 || x+w > 1+1e-9 || y+h > 1+1e-9:
+				problems = append(problems, fmt.Sprintf("rect (%g,%g %gx%g) must lie within the terrain, 0-1 on both axes", x, y, w, h))
+			}
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid terrain arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// checkTerrainHeights set_heights 需要function或heightmap之一
+func checkTerrainHeights(arguments map[string]interface{}) []string {
+	function, hasFunction := arguments["function"].(string)
+	rows, hasHeightmap := arguments["heightmap"].([]interface{})
+	if hasFunction == hasHeightmap {
+		return []string{"set_heights requires exactly one of function or heightmap"}
+	}
+
+	var problems []string
+	if hasFunction {
+		for _, name := range terrainOperationParams["set_heights"] {
+			if _, ok := arguments[name]; ok && name != "function" && !slices.Contains(terrainFunctionParams[function], name) {
+				problems = append(problems, fmt.Sprintf("%s is not used by function %s", name, function))
+			}
+		}
+		return problems
+	}
+	for _, name := range terrainOperationParams["set_heights"] {
+		if _, ok := arguments[name]; ok && name != "heightmap" {
+			problems = append(problems, name+" is not used with heightmap")
+		}
+	}
+
+	if len(rows) < 2 || len(rows) > maxTerrainHeightmapSize {
+		return append(problems, fmt.Sprintf("heightmap must have 2-%d rows, got %d; downsample larger heightmaps", maxTerrainHeightmapSize, len(rows)))
+	}
+	columns := -1
+	for i, item := range rows {
+		row, ok := item.([]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("heightmap[%d] must be an array of numbers", i))
+			continue
+		}
+		switch {
+		case columns < 0 && (len(row) < 2 || len(row) > maxTerrainHeightmapSize):
+			return append(problems, fmt.Sprintf("heightmap rows must have 2-%d values, got %d; downsample larger heightmaps", maxTerrainHeightmapSize, len(row)))
+		case columns >= 0 && len(row) != columns:
+			return append(problems, fmt.Sprintf("heightmap[%d] has %d values, expected %d like the first row", i, len(row), columns))
+		}
+		columns = len(row)
+		for j, value := range row {
+			n, err := toNumber(value)
+			if err != nil || n < 0 || n > 1 {
+				problems = append(problems, fmt.Sprintf("heightmap[%d][%d] must be a number between 0 and 1", i, j))
+				if len(problems) >= 10 {
+					return problems
+				}
+				continue
+			}
+			row[j] = n
+		}
+	}
+	return problems
+}
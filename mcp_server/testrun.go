@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// editor_run_tests 在Unity中异步运行: 先发送start，再轮询status直到运行结束或超时，
+// 每次轮询的进度转发为 notifications/progress
+// PlayMode测试会重载脚本域并断开连接，轮询期间的通信失败只记录调试日志，Unity恢复后继续轮询
+// 超时时取消运行并返回已完成测试的部分结果
+
+// testPollInterval 轮询测试运行状态的间隔
+const testPollInterval = time.Second
+
+// testModes editor_run_tests 支持的测试模式
+var testModes = []string{"editmode", "playmode"}
+
+// handleRunTests 启动测试运行并等待结果
+func handleRunTests(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "editor_run_tests"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	log := callInfoFromContext(ctx).Logger()
+
+	progress := newProgressReporter(ctx, request)
+	stop := make(chan struct{})
+	go progress.Heartbeat(stop)
+	defer close(stop)
+
+	params := map[string]interface{}{"operation": "start"}
+	for _, name := range []string{"mode", "testFilter"} {
+		if v, ok := arguments[name]; ok {
+			params[name] = v
+		}
+	}
+	data, err := queryUnity(ctx, toolName, params)
+	if err != nil {
+		return unityErrorResult(ctx, toolName, err)
+	}
+	started, ok := data.(map[string]interface{})
+	if !ok {
+		return toolErrorResult(ctx, errCodeUnityToolFailed, "unity returned no test run status", toolName), nil
+	}
+	runID, _ := started["runId"].(string)
+	log.Info("Test run started", "run_id", runID, "mode", arguments["mode"])
+
+	timeoutSeconds, _ := arguments["timeoutSeconds"].(int64)
+	deadline := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+	status := started
+	for {
+		select {
+		case <-time.After(testPollInterval):
+		case <-ctx.Done():
+			cancelTestRun(runID)
+			return nil, ctx.Err()
+		}
+
+		latest, err := queryUnityLevel(ctx, toolName, map[string]interface{}{"operation": "status", "runId": runID}, slog.LevelDebug)
+		switch {
+		case isUnityActionError(err):
+			// 运行被新的运行取代等情况
+			return unityErrorResult(ctx, toolName, err)
+		case err != nil:
+			log.Debug("Waiting for Unity during test run", "error", err.Error())
+		default:
+			if m, ok := latest.(map[string]interface{}); ok {
+				status = m
+				reportTestProgress(progress, status)
+			}
+		}
+
+		if state, _ := status["status"].(string); state != "running" {
+			break
+		}
+		if time.Now().After(deadline) {
+			log.Warn("Test run timed out, canceling", "run_id", runID, "timeout_seconds", timeoutSeconds)
+			if canceled := cancelTestRun(runID); canceled != nil {
+				status = canceled
+			}
+			status["status"] = "timeout"
+			status["aborted"] = true
+			status["abortReason"] = fmt.Sprintf("test run exceeded timeoutSeconds=%d; results are partial", timeoutSeconds)
+			break
+		}
+	}
+
+	progress.Done()
+	log.Info("Test run finished", "run_id", runID, "status", status["status"],
+		"passed", status["passed"], "failed", status["failed"], "skipped", status["skipped"])
+	return toolSuccessResult(ctx, toolName, status), nil
+}
+
+// reportTestProgress 把已完成/总数转发为进度
+func reportTestProgress(progress *progressReporter, status map[string]interface{}) {
+	total, _ := status["total"].(float64)
+	completed, _ := status["completed"].(float64)
+	if total <= 0 {
+		return
+	}
+	message := fmt.Sprintf("%d/%d tests", int(completed), int(total))
+	if current, _ := status["currentTest"].(string); current != "" {
+		message += ": " + current
+	}
+	// 留出最后一步给Done
+	progress.Report(completed/total*0.99, message)
+}
+
+// cancelTestRun 取消测试运行，返回取消后的状态；调用方的ctx可能已取消，因此使用独立的超时
+func cancelTestRun(runID string) map[string]interface{} {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	data, err := queryUnityLevel(ctx, "editor_run_tests", map[string]interface{}{"operation": "cancel", "runId": runID}, slog.LevelWarn)
+	if err != nil {
+		return nil
+	}
+	status, _ := data.(map[string]interface{})
+	return status
+}
+
+// normalizeRunTestsArgs testFilter中的条目必须是非空字符串
+func normalizeRunTestsArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if filters, ok := arguments["testFilter"].([]interface{}); ok {
+		for i, filter := range filters {
+			if s, ok := filter.(string); !ok || s == "" || s == "category:" {
+				return nil, fmt.Errorf("testFilter[%d] must be a test name prefix or category:<name>", i)
+			}
+		}
+	}
+	return arguments, nil
+}
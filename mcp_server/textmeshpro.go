@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// tmp_text_set 在Unity端通过反射访问TextMeshPro，未安装TMP包的项目也能编译；
+// 此时Unity返回以 tmpMissingMarker 开头的错误，这里转换为可操作的提示
+
+// tmpMissingMarker Unity端表示未安装TextMeshPro包的错误前缀
+const tmpMissingMarker = "TMP_NOT_INSTALLED"
+
+// tmpNotInstalledMessage 未安装TextMeshPro包时返回给客户端的错误
+const tmpNotInstalledMessage = "TextMeshPro package not installed; add com.unity.textmeshpro with package_add, or use ui_text_set for legacy UI Text"
+
+// TextMeshPro的枚举，与TMPro中的枚举名一致
+var (
+	tmpAlignments = []string{
+		"TopLeft", "Top", "TopRight", "TopJustified", "TopFlush", "TopGeoAligned",
+		"Left", "Center", "Right", "Justified", "Flush", "CenterGeoAligned",
+		"BottomLeft", "Bottom", "BottomRight", "BottomJustified", "BottomFlush", "BottomGeoAligned",
+		"BaselineLeft", "Baseline", "BaselineRight", "BaselineJustified", "BaselineFlush", "BaselineGeoAligned",
+		"MidlineLeft", "Midline", "MidlineRight", "MidlineJustified", "MidlineFlush", "MidlineGeoAligned",
+		"CaplineLeft", "Capline", "CaplineRight", "CaplineJustified", "CaplineFlush", "CaplineGeoAligned",
+	}
+	tmpOverflowModes = []string{"Overflow", "Ellipsis", "Masking", "Truncate", "ScrollRect", "Page", "Linked"}
+)
+
+// handleTMPTextSet 转发到Unity，并把未安装TextMeshPro包的错误转换为明确的提示
+func handleTMPTextSet(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "tmp_text_set"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+
+	data, err := queryUnity(ctx, toolName, arguments)
+	var actionErr *unityActionError
+	switch {
+	case errors.As(err, &actionErr) && strings.HasPrefix(actionErr.Message, tmpMissingMarker):
+		return toolErrorResult(ctx, errCodeUnityToolFailed, tmpNotInstalledMessage, toolName), nil
+	case err != nil:
+		return unityErrorResult(ctx, toolName, err)
+	}
+	return toolSuccessResult(ctx, toolName, data), nil
+}
+
+// normalizeTMPTextArgs 检查自动字号范围和边距
+func normalizeTMPTextArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	lo, hasMin := arguments["autoSizeMin"].(float64)
+	hi, hasMax := arguments["autoSizeMax"].(float64)
+	if hasMin && hasMax && lo > hi {
+		return nil, fmt.Errorf("autoSizeMin (%v) must not be greater than autoSizeMax (%v)", lo, hi)
+	}
+	if autoSize, ok := arguments["autoSize"].(bool); ok && !autoSize && (hasMin || hasMax) {
+		return nil, fmt.Errorf("autoSizeMin/autoSizeMax have no effect when autoSize is false")
+	}
+	return arguments, nil
+}
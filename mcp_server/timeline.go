@@ -0,0 +1,241 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// timeline_create / timeline_get_info 在Unity端通过反射访问Timeline包，未安装Timeline的项目也能编译；
+// 此时Unity返回以 timelineMissingMarker 开头的错误，这里转换为可操作的提示
+
+// timelineMissingMarker Unity端表示未安装Timeline包的错误前缀
+const timelineMissingMarker = "TIMELINE_NOT_INSTALLED"
+
+// timelineNotInstalledMessage 未安装Timeline包时返回给客户端的错误
+const timelineNotInstalledMessage = "Timeline package not installed; add com.unity.timeline with package_add"
+
+// timelineTrackTypes timeline_create 支持的轨道类型
+var timelineTrackTypes = []string{"animation", "activation", "audio"}
+
+// timelineTrackFields 每种轨道可以使用的字段，其他字段视为错误
+var timelineTrackFields = map[string][]string{
+	"animation":  {"type", "name", "binding", "clipPath", "start"},
+	"activation": {"type", "name", "binding", "ranges"},
+	"audio":      {"type", "name", "binding", "clipPath", "start"},
+}
+
+// timelineTrackItems tracks数组元素的JSON Schema
+var timelineTrackItems = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"type":     map[string]interface{}{"type": "string", "enum": timelineTrackTypes},
+		"name":     map[string]interface{}{"type": "string", "description": "Track name (default: the type name)"},
+		"binding":  map[string]interface{}{"type": "integer", "description": "InstanceID of the bound GameObject; an Animator or AudioSource is added when missing (optional for audio)"},
+		"clipPath": map[string]interface{}{"type": "string", "description": "AnimationClip (animation) or AudioClip (audio) asset path"},
+		"start":    map[string]interface{}{"type": "number", "description": "Clip start time in seconds (animation, audio)"},
+		"ranges": map[string]interface{}{
+			"type":        "array",
+			"description": "Active ranges in seconds, in order (activation)",
+			"items": map[string]interface{}{
+				"type":       "object",
+				"properties": map[string]interface{}{"start": map[string]interface{}{"type": "number"}, "end": map[string]interface{}{"type": "number"}},
+				"required":   []string{"start", "end"},
+			},
+		},
+	},
+	"required": []string{"type"},
+}
+
+// maxTimelineTracks timeline_create 一次最多创建的轨道数
+const maxTimelineTracks = 64
+
+// handleTimelineCreate 先通过scene_get_path确认director和所有绑定对象存在，再转发给Unity
+func handleTimelineCreate(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "timeline_create"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+
+	ids := []interface{}{arguments["director"]}
+	tracks, _ := arguments["tracks"].([]interface{})
+	for _, item := range tracks {
+		track, _ := item.(map[string]interface{})
+		if binding, ok := track["binding"]; ok && !slices.Contains(ids, binding) {
+			ids = append(ids, binding)
+		}
+	}
+	data, err := queryUnityLevel(ctx, "scene_get_path", map[string]interface{}{"instanceIds": ids}, slog.LevelDebug)
+	var actionErr *unityActionError
+	switch {
+	case errors.As(err, &actionErr):
+		return toolErrorResult(ctx, errCodeInvalidArguments, actionErr.Message, toolName), nil
+	case err != nil:
+		return unityErrorResult(ctx, toolName, err)
+	}
+	info, _ := data.(map[string]interface{})
+	objects, _ := info["objects"].([]interface{})
+	var missing []string
+	for _, item := range objects {
+		object, _ := item.(map[string]interface{})
+		if _, notFound := object["error"]; notFound {
+			missing = append(missing, fmt.Sprint(object["instanceId"]))
+		}
+	}
+	if len(missing) > 0 {
+		message := fmt.Sprintf("no GameObject with instanceId %s in the open scenes (director and track bindings must be scene objects)", strings.Join(missing, ", "))
+		return toolErrorResult(ctx, errCodeInvalidArguments, message, toolName), nil
+	}
+	return queryTimeline(ctx, toolName, arguments), nil
+}
+
+// handleTimelineGetInfo 转发到Unity，并把未安装Timeline包的错误转换为明确的提示
+func handleTimelineGetInfo(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "timeline_get_info"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	result := queryTimeline(ctx, toolName, arguments)
+	if result == nil {
+		return nil, ctx.Err()
+	}
+	return result, nil
+}
+
+// queryTimeline 执行Timeline工具，context取消时返回nil
+func queryTimeline(ctx context.Context, toolName string, arguments map[string]interface{}) *mcp.CallToolResult {
+	data, err := queryUnity(ctx, toolName, arguments)
+	var actionErr *unityActionError
+	switch {
+	case errors.As(err, &actionErr) && strings.HasPrefix(actionErr.Message, timelineMissingMarker):
+		return toolErrorResult(ctx, errCodeUnityToolFailed, timelineNotInstalledMessage, toolName)
+	case err != nil:
+		result, _ := unityErrorResult(ctx, toolName, err)
+		return result
+	}
+	return toolSuccessResult(ctx, toolName, data)
+}
+
+// normalizeTimelineCreateArgs 检查保存路径和每条轨道: 类型名称、绑定的instanceId、片段路径和激活区间
+func normalizeTimelineCreateArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	var problems []string
+	savePath, _ := arguments["savePath"].(string)
+	if !strings.HasPrefix(savePath, "Assets/") || !strings.HasSuffix(savePath, ".playable") {
+		problems = append(problems, fmt.Sprintf("savePath %q must be a path like Assets/Timelines/Intro.playable", savePath))
+	}
+
+	tracks, _ := arguments["tracks"].([]interface{})
+	switch {
+	case len(tracks) == 0:
+		problems = append(problems, "tracks must not be empty")
+	case len(tracks) > maxTimelineTracks:
+		problems = append(problems, fmt.Sprintf("tracks has %d elements, at most %d are allowed", len(tracks), maxTimelineTracks))
+	}
+	for i, item := range tracks {
+		track, ok := item.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("tracks[%d] must be an object", i))
+			continue
+		}
+		problems = append(problems, checkTimelineTrack(fmt.Sprintf("tracks[%d]", i), track)...)
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid timeline arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// checkTimelineTrack 检查一条轨道，把类型规范为小写名称、binding转换为整数
+func checkTimelineTrack(label string, track map[string]interface{}) []string {
+	name, _ := track["type"].(string)
+	trackType, found := canonicalName(timelineTrackTypes, name)
+	if !found {
+		return []string{fmt.Sprintf("%s.type %q is not a track type, expected one of: %s", label, track["type"], strings.Join(timelineTrackTypes, ", "))}
+	}
+	track["type"] = trackType
+
+	var problems []string
+	for _, key := range slices.Sorted(maps.Keys(track)) {
+		if !slices.Contains(timelineTrackFields[trackType], key) {
+			problems = append(problems, fmt.Sprintf("%s.%s is not used by %s tracks (fields: %s)", label, key, trackType, strings.Join(timelineTrackFields[trackType], ", ")))
+		}
+	}
+	if binding, ok := track["binding"]; ok {
+		n, err := toNumber(binding)
+		if err == nil {
+			track["binding"], err = toInteger(n)
+		}
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s.binding must be a GameObject instanceId: %v", label, err))
+		}
+	} else if trackType != "audio" {
+		problems = append(problems, fmt.Sprintf("%s: %s tracks need a binding (GameObject instanceId)", label, trackType))
+	}
+	if name, ok := track["name"]; ok {
+		if s, isString := name.(string); !isString || strings.TrimSpace(s) == "" {
+			problems = append(problems, label+".name must be a non-empty string")
+		}
+	}
+	if start, ok := track["start"]; ok {
+		if n, err := toNumber(start); err != nil || n < 0 {
+			problems = append(problems, label+".start must be a non-negative number of seconds")
+		} else {
+			track["start"] = n
+		}
+	}
+
+	if trackType == "activation" {
+		return append(problems, checkActivationRanges(label, track)...)
+	}
+	clip, _ := track["clipPath"].(string)
+	if !strings.HasPrefix(clip, "Assets/") && !strings.HasPrefix(clip, "Packages/") {
+		problems = append(problems, fmt.Sprintf("%s.clipPath %q must be an asset path for the %s clip", label, clip, trackType))
+	}
+	return problems
+}
+
+// checkActivationRanges 激活区间 {start, end} 以秒为单位，按时间顺序且互不重叠
+func checkActivationRanges(label string, track map[string]interface{}) []string {
+	ranges, _ := track["ranges"].([]interface{})
+	if len(ranges) == 0 {
+		return []string{label + ".ranges needs at least one {start, end} range in seconds"}
+	}
+	var problems []string
+	previousEnd := -1.0
+	for i, item := range ranges {
+		r, _ := item.(map[string]interface{})
+		start, errStart := toNumber(r["start"])
+		end, errEnd := toNumber(r["end"])
+		switch {
+		case errStart != nil || errEnd != nil:
+			problems = append(problems, fmt.Sprintf("%s.ranges[%d] must be {start, end} in seconds", label, i))
+			continue
+		case start < 0 || end <= start:
+			problems = append(problems, fmt.Sprintf("%s.ranges[%d] needs 0 <= start < end, got %g-%g", label, i, start, end))
+		case start < previousEnd:
+			problems = append(problems, fmt.Sprintf("%s.ranges[%d] overlaps the previous range or is out of order", label, i))
+		}
+		r["start"], r["end"] = start, end
+		previousEnd = end
+	}
+	return problems
+}
+
+// normalizeTimelineGetInfoArgs 需要assetPath或director之一 (可以同时给出，用director读取绑定)
+func normalizeTimelineGetInfoArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasPath := arguments["assetPath"]
+	_, hasDirector := arguments["director"]
+	if !hasPath && !hasDirector {
+		return nil, fmt.Errorf("assetPath or director is required")
+	}
+	return arguments, nil
+}
@@ -0,0 +1,2095 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// ParamSpec 工具参数规格
+type ParamSpec struct {
+	Name        string
+	Type        string // string/number/integer/boolean/object/array/vector/color/any
+	Description string
+	Required    bool
+	Default     interface{}
+	Enum        []string
+	Items       map[string]interface{} // array类型参数的元素Schema
+	Minimum     *float64               // number类型参数的取值范围，nil表示不限制
+	Maximum     *float64
+	Components  []string // vector类型参数的分量名
+}
+
+// vector类型参数的分量
+// vector参数可以是只包含部分分量的对象 ({"x": 1}) 或按顺序给出全部分量的数组 ([1, 2, 3])，
+// 转发到Unity前统一转换为对象，未提供的分量由Unity保持当前值
+var (
+	vectorXYZ = []string{"x", "y", "z"}
+	vectorXY  = []string{"x", "y"}
+)
+
+// ToolDefinition 声明式工具定义，MCP注册、/tools 和 /health 都从这里生成
+type ToolDefinition struct {
+	Name        string
+	Category    string
+	Description string
+	Params      []ParamSpec
+	ReadOnly    bool                   // 只读工具可以安全重试
+	TimeoutHint time.Duration          // 单次Unity通信超时，0表示使用客户端默认值
+	Handler     server.ToolHandlerFunc // 为空时直接转发到Unity
+	NarrowBy    []string               // 响应过大时建议用来缩小结果的参数
+	// Unity返回成功后检查或补充data (编码检查等)
+	Inspect func(data map[string]interface{})
+	// 参数校验通过后的工具专用处理 (展开预设、跨参数检查等)，批处理中的步骤同样适用
+	Normalize func(arguments map[string]interface{}) (map[string]interface{}, error)
+}
+
+// 注册所有Unity工具
+// 所有工具定义都会注册到MCP服务器，被工具策略拒绝的工具在tools/list中隐藏，调用时返回策略错误，
+// 这样重新加载配置时只需替换工具集合
+func registerTools(s *server.MCPServer) {
+	for i := range toolDefinitions {
+		def := &toolDefinitions[i]
+		s.AddTool(def.MCPTool(), def.handler())
+		debugLog("Registered tool: %s (%s, readOnly=%t)", def.Name, def.Category, def.ReadOnly)
+	}
+
+	set := currentState().Tools
+	for name, reason := range set.denied {
+		debugLog("Tool disabled by policy: %s (%s)", name, reason)
+	}
+	if len(set.denied) > 0 {
+		infoLog("Registered %d tools, %d disabled by policy", len(set.registry), len(set.denied))
+	} else {
+		infoLog("Registered %d tools", len(set.registry))
+	}
+}
+
+// toolCategoryCounts 统计启用工具的分类数量
+func (t *toolSet) toolCategoryCounts() map[string]int {
+	counts := make(map[string]int)
+	for i := range t.registry {
+		counts[t.registry[i].Category]++
+	}
+	return counts
+}
+
+// lookupTool 按名称查找当前启用的工具定义
+func lookupTool(name string) *ToolDefinition {
+	return currentState().Tools.Lookup(name)
+}
+
+// readOnlyAction 判断Unity action是否为启用的只读工具；不是工具或被策略拒绝的action按修改项目处理
+func readOnlyAction(ctx context.Context, action string) bool {
+	def := stateFromContext(ctx).Tools.Lookup(action)
+	return def != nil && def.ReadOnly
+}
+
+// MCPTool 生成mcp-go的工具描述
+func (d *ToolDefinition) MCPTool() mcp.Tool {
+	tool := mcp.NewTool(d.Name, mcp.WithDescription(d.Description))
+	for _, p := range d.params() {
+		tool.InputSchema.Properties[p.Name] = p.schema()
+		if p.Required {
+			tool.InputSchema.Required = append(tool.InputSchema.Required, p.Name)
+		}
+	}
+	return tool
+}
+
+// handler 返回工具处理函数 (未指定时转发到Unity)，并记录调用统计和审计日志
+func (d *ToolDefinition) handler() server.ToolHandlerFunc {
+	def := d
+	toolName := d.Name
+	inner := d.Handler
+	if inner == nil {
+		inner = func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return forwardToUnity(ctx, toolName, request.GetArguments(), request)
+		}
+	}
+	return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		// 整个调用使用同一个配置快照和关联ID
+		info := newCallInfo(ctx, request)
+		ctx = withCallInfo(ctx, info)
+		st := currentState()
+		if reason, denied := st.Tools.Denied(toolName); denied {
+			return deniedResult(ctx, toolName, reason), nil
+		}
+		ctx = withState(ctx, st)
+		dryRun, err := dryRunRequested(st.Config, request.GetArguments())
+		if err != nil {
+			return toolErrorResult(ctx, errCodeInvalidArguments, err.Error(), toolName), nil
+		}
+		info.DryRun = dryRun
+		ctx = withCallInfo(ctx, info)
+
+		ctx, release := inflight.Track(ctx, request)
+		defer release()
+
+		start := time.Now()
+		var result *mcp.CallToolResult
+		arguments, invalid := def.validateArguments(request.GetArguments())
+		if invalid != nil {
+			// 参数错误不发送到Unity
+			info.Logger().Warn("Invalid tool arguments", "tool", toolName, "error", invalid.Error())
+			result = toolErrorResult(ctx, errCodeInvalidArguments, invalid.Error(), toolName)
+		} else {
+			request.Params.Arguments = arguments
+			autoWaitForIdle(ctx, def)
+			result, err = callRecovered(ctx, toolName, inner, request)
+		}
+		canceled := errors.Is(err, context.Canceled)
+		errMsg := toolResultError(result, err)
+		if audit.Enabled(def.ReadOnly) {
+			audit.Record(newAuditRecord(def, info, request.GetArguments(), start, errMsg, canceled))
+		}
+		history.Add(newHistoryEntry(toolName, info, request.GetArguments(), result, start, errMsg, canceled))
+		if canceled {
+			// 被取消的调用不计入错误率
+			stats.RecordCanceled(toolName, time.Since(start))
+			return result, err
+		}
+		if invalid != nil {
+			// 参数错误是客户端的问题，不计入Unity的错误率
+			stats.RecordInvalid(toolName, errMsg)
+			return result, nil
+		}
+		stats.Record(toolName, time.Since(start), errMsg)
+		return result, err
+	}
+}
+
+// callRecovered 调用工具处理函数，把panic转换为错误结果，避免一个调用拖垮整个服务器
+func callRecovered(ctx context.Context, toolName string, inner server.ToolHandlerFunc, request mcp.CallToolRequest) (result *mcp.CallToolResult, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			callInfoFromContext(ctx).Logger().Error("Tool handler panicked",
+				"tool", toolName,
+				"panic", fmt.Sprint(r),
+				"stack", string(debug.Stack()))
+			stats.RecordPanic(toolName)
+			result = toolErrorResult(ctx, errCodeInternal, "Internal server error, see server logs", toolName)
+			err = nil
+		}
+	}()
+	return inner(ctx, request)
+}
+
+// forwardToUnity 将工具调用转发到Unity，并把Unity的进度转发给MCP客户端
+func forwardToUnity(ctx context.Context, toolName string, arguments map[string]interface{}, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	progress := newProgressReporter(ctx, request)
+	stop := make(chan struct{})
+	go progress.Heartbeat(stop)
+	defer close(stop)
+
+	result, err := callUnityTool(ctx, toolName, arguments, progress.Report)
+	if err == nil {
+		progress.Done()
+	}
+	return result, err
+}
+
+// matches 判断工具是否满足/tools的过滤条件
+func (d *ToolDefinition) matches(category string, readOnly *bool, query string) bool {
+	if category != "" && !strings.EqualFold(d.Category, category) {
+		return false
+	}
+	if readOnly != nil && d.ReadOnly != *readOnly {
+		return false
+	}
+	if query != "" {
+		query = strings.ToLower(query)
+		if !strings.Contains(strings.ToLower(d.Name), query) &&
+			!strings.Contains(strings.ToLower(d.Description), query) {
+			return false
+		}
+	}
+	return true
+}
+
+// schema 生成参数的JSON Schema
+func (p ParamSpec) schema() map[string]interface{} {
+	var schema map[string]interface{}
+	switch p.Type {
+	case "vector":
+		schema = p.vectorSchema()
+	case "color":
+		schema = colorSchema()
+	case "any":
+		// 任意JSON值，类型由工具自己检查
+		schema = map[string]interface{}{}
+	default:
+		schema = map[string]interface{}{"type": p.Type}
+	}
+	if p.Description != "" {
+		schema["description"] = p.Description
+	}
+	if p.Default != nil {
+		schema["default"] = p.Default
+	}
+	if len(p.Enum) > 0 {
+		schema["enum"] = p.Enum
+	}
+	if p.Items != nil {
+		schema["items"] = p.Items
+	}
+	if p.Minimum != nil {
+		schema["minimum"] = *p.Minimum
+	}
+	if p.Maximum != nil {
+		schema["maximum"] = *p.Maximum
+	}
+	return schema
+}
+
+// vectorSchema 生成vector参数的JSON Schema: 部分分量的对象，或全部分量的数组
+func (p ParamSpec) vectorSchema() map[string]interface{} {
+	properties := make(map[string]interface{}, len(p.Components))
+	for _, c := range p.Components {
+		properties[c] = map[string]interface{}{"type": "number"}
+	}
+	return map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"type": "object", "properties": properties, "additionalProperties": false},
+			map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "number"},
+				"minItems": len(p.Components), "maxItems": len(p.Components)},
+		},
+	}
+}
+
+// 所有工具定义，新增工具只需在此处添加
+var toolDefinitions = []ToolDefinition{
+	// 脚本读取工具
+	{
+		Name:        "script_read",
+		Category:    "file",
+		Description: "Read script file content from Unity project. Large files can be read in line ranges; the result reports totalLines, fileSize, the detected encoding and whether the content was truncated by maxBytes",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "path", Type: "string", Description: "Script file path to read (relative to Assets directory)", Required: true},
+			{Name: "startLine", Type: "integer", Description: "First line to return (1-based)", Minimum: floatPtr(1)},
+			{Name: "endLine", Type: "integer", Description: "Last line to return (1-based, inclusive)", Minimum: floatPtr(1)},
+			{Name: "includeLineNumbers", Type: "boolean", Description: "Prefix each line with its line number", Default: false},
+			{Name: "maxBytes", Type: "integer", Description: "Maximum bytes of content to return; longer content is cut at a line boundary and truncated is set", Default: 262144, Minimum: floatPtr(1)},
+		},
+		Normalize: normalizeScriptReadArgs,
+		Inspect:   inspectScriptRead,
+	},
+
+	// 脚本写入工具
+	{
+		Name:        "script_write",
+		Category:    "file",
+		Description: "Create or update script file in Unity project. mode=patch applies a unified diff and is rejected without writing anything if its context does not match the current file",
+		Params: []ParamSpec{
+			{Name: "path", Type: "string", Description: "Script file path (relative to Assets directory)", Required: true},
+			{Name: "content", Type: "string", Description: "Script file content; for mode=patch a unified diff against the current file", Required: true},
+			{Name: "mode", Type: "string", Description: "overwrite replaces the file, patch applies a unified diff, append adds content at the end, insert adds content before line insertAt", Enum: scriptWriteModes, Default: "overwrite"},
+			{Name: "insertAt", Type: "integer", Description: "Line number (1-based) before which content is inserted in mode=insert; use totalLines+1 to insert at the end", Minimum: floatPtr(1)},
+			{Name: "overwrite", Type: "boolean", Description: "Whether to overwrite existing file (mode=overwrite)", Default: true},
+			{Name: "backup", Type: "boolean", Description: "Copy the previous version to path + \".bak\" before writing", Default: false},
+			{Name: "waitForCompile", Type: "boolean", Description: "Wait for Unity to compile after writing and include compileSucceeded and compiler errors in the result", Default: false},
+			{Name: "compileTimeout", Type: "integer", Description: "Seconds to wait for compilation (default: server -compile-wait-timeout)", Minimum: floatPtr(1), Maximum: floatPtr(600)},
+		},
+		Handler:   handleScriptWrite,
+		Normalize: normalizeScriptWriteArgs,
+	},
+
+	// 编译错误工具
+	{
+		Name:        "script_get_compile_errors",
+		Category:    "file",
+		Description: "Get the current script compilation state and compiler errors (file, line, column, message)",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "includeWarnings", Type: "boolean", Description: "Also return compiler warnings", Default: false},
+		},
+	},
+
+	// 场景获取工具
+	{
+		Name:        "scene_get",
+		Category:    "scene",
+		Description: "Get Unity current scene hierarchy data. Large scenes should be narrowed with maxDepth, rootInstanceId/rootPath, nameFilter or page/pageSize, or surveyed first with summaryOnly",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "includeComponents", Type: "boolean", Description: "Whether to include component information", Default: false},
+			{Name: "includeTransform", Type: "boolean", Description: "Whether to include Transform information", Default: true},
+			{Name: "maxDepth", Type: "integer", Description: "Maximum hierarchy depth to return (0 = top-level objects only); deeper children are reported only as childCount", Minimum: floatPtr(0)},
+			{Name: "rootInstanceId", Type: "integer", Description: "Return only the subtree under this object's InstanceID"},
+			{Name: "rootPath", Type: "string", Description: "Return only the subtree under this hierarchy path, e.g. Canvas/Panel"},
+			{Name: "nameFilter", Type: "string", Description: "Only return top-level objects whose subtree contains a name containing this text (case-insensitive)"},
+			{Name: "page", Type: "integer", Description: "Page of the top-level object list, starting at 1", Default: 1, Minimum: floatPtr(1)},
+			{Name: "pageSize", Type: "integer", Description: "Top-level objects per page (0 = all)", Default: 0, Minimum: floatPtr(0), Maximum: floatPtr(1000)},
+			{Name: "summaryOnly", Type: "boolean", Description: "Return only object and component counts per top-level object instead of full trees", Default: false},
+		},
+		NarrowBy:  []string{"maxDepth", "rootInstanceId", "rootPath", "nameFilter", "page/pageSize", "summaryOnly"},
+		Normalize: normalizeSceneGetArgs,
+	},
+
+	// 场景创建对象工具
+	{
+		Name:        "scene_create_object",
+		Category:    "scene",
+		Description: "Create a GameObject in Unity scene: an empty object, a primitive or a prefab instance, with its initial transform; returns instanceId, hierarchy path and the applied transform",
+		Params: []ParamSpec{
+			{Name: "name", Type: "string", Description: "GameObject name (defaults to the primitive or prefab name, or \"New GameObject\")"},
+			{Name: "primitive", Type: "string", Description: "Create a primitive mesh object; cannot be combined with prefabPath", Enum: []string{"cube", "sphere", "plane", "capsule", "cylinder", "quad"}},
+			{Name: "prefabPath", Type: "string", Description: "Instantiate this prefab asset instead of an empty object; cannot be combined with primitive"},
+			{Name: "parentId", Type: "integer", Description: "Parent object's InstanceID"},
+			{Name: "position", Type: "vector", Components: vectorXYZ, Description: "World position"},
+			{Name: "rotation", Type: "vector", Components: vectorXYZ, Description: "World rotation as Euler angles in degrees"},
+			{Name: "scale", Type: "vector", Components: vectorXYZ, Description: "Local scale"},
+			{Name: "tag", Type: "string", Description: "Tag to assign"},
+			{Name: "layer", Type: "integer", Description: "Layer index (0-31)", Minimum: floatPtr(0), Maximum: floatPtr(31)},
+			{Name: "active", Type: "boolean", Description: "Whether the object starts active (default true)"},
+		},
+		Normalize: normalizeCreateObjectArgs,
+	},
+
+	// 场景对象添加组件工具
+	{
+		Name:        "scene_object_add_component",
+		Category:    "scene",
+		Description: "Add component to GameObject in Unity scene. Returns the component's componentInstanceId and resolved componentFullType for follow-up property calls; if the component already exists its data is returned unchanged unless allowDuplicate is set",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "componentType", Type: "string", Description: "Component type to add: short name (Rigidbody), full name (MyGame.PlayerController) or assembly-qualified name (MyGame.PlayerController, Assembly-CSharp)", Required: true},
+			{Name: "properties", Type: "object", Description: "Serialized field names (m_Mass, or mass) to values, applied right after adding. Object references take an asset path or InstanceID"},
+			{Name: "allowDuplicate", Type: "boolean", Description: "Add another instance when the GameObject already has this component type", Default: false},
+		},
+	},
+
+	// 对象组件列表工具
+	{
+		Name:        "gameobject_get_components",
+		Category:    "scene",
+		Description: "List the components of one GameObject with their type, instanceId and enabled state; use the instanceId with component_get or component_set_property",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID"},
+			{Name: "path", Type: "string", Description: "Hierarchy path of the GameObject, e.g. Canvas/Panel/Button"},
+			{Name: "includeProperties", Type: "boolean", Description: "Include a shallow summary of each component's top-level serialized properties", Default: false},
+			{Name: "includeInherited", Type: "boolean", Description: "Include properties declared by base classes in the summary, and list each component's base types", Default: false},
+		},
+		Normalize: normalizeGetComponentsArgs,
+	},
+
+	// 组件属性读取工具
+	{
+		Name:        "component_get",
+		Category:    "component",
+		Description: "Dump the serialized properties of any component with their paths, types and values. Identify the component by componentInstanceId, or by the GameObject's instanceId plus componentType. The returned paths and jsonType values are what component_set_property accepts",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "componentInstanceId", Type: "integer", Description: "Component's InstanceID (componentInstanceId from scene_object_add_component or a previous component_get)"},
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID, used with componentType"},
+			{Name: "componentType", Type: "string", Description: "Component type name or full type name on the GameObject; the first match is used"},
+			{Name: "maxProperties", Type: "integer", Description: "Maximum number of properties to return", Default: 500, Minimum: floatPtr(1), Maximum: floatPtr(5000)},
+			{Name: "maxArrayElements", Type: "integer", Description: "Maximum number of elements listed per array", Default: 50, Minimum: floatPtr(0), Maximum: floatPtr(1000)},
+		},
+		NarrowBy:  []string{"maxProperties", "maxArrayElements"},
+		Normalize: normalizeComponentGetArgs,
+		Inspect:   inspectComponentGet,
+	},
+
+	// 组件属性设置工具
+	{
+		Name:        "component_set_property",
+		Category:    "component",
+		Description: "Set one serialized property of any component by property path (undoable). Nested paths such as m_Colors.m_NormalColor or m_Materials.Array.data[2] are supported; resize arrays via <path>.Array.size. Object references take an asset path, an InstanceID, or null to clear",
+		Params: []ParamSpec{
+			{Name: "componentInstanceId", Type: "integer", Description: "Component's InstanceID", Required: true},
+			{Name: "propertyPath", Type: "string", Description: "Serialized property path as reported by component_get, e.g. m_Mass", Required: true},
+			{Name: "value", Type: "any", Description: "New value: number, boolean, string, enum name or index, {x,y,z} / {r,g,b,a} object (missing fields keep their value), or an asset path / InstanceID for object references"},
+		},
+		Normalize: normalizeComponentSetArgs,
+	},
+
+	// Transform获取工具
+	{
+		Name:        "scene_transform_get",
+		Category:    "transform",
+		Description: "Get Transform information of GameObject in Unity scene",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "worldSpace", Type: "boolean", Description: "Whether to use world coordinate system", Default: true},
+		},
+	},
+
+	// Transform设置工具
+	{
+		Name:        "scene_transform_set",
+		Category:    "transform",
+		Description: "Set Transform information of GameObject in Unity scene",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "position", Type: "vector", Components: vectorXYZ, Description: "Position; omitted components keep their current value"},
+			{Name: "rotation", Type: "vector", Components: vectorXYZ, Description: "Rotation as Euler angles in degrees; omitted components keep their current value"},
+			{Name: "scale", Type: "vector", Components: vectorXYZ, Description: "Local scale; omitted components keep their current value"},
+			{Name: "worldSpace", Type: "boolean", Description: "Whether position and rotation are in world space", Default: true},
+			{Name: "relative", Type: "boolean", Description: "Add the given values to the current transform instead of replacing them", Default: false},
+		},
+	},
+
+	// Transform批量设置工具
+	{
+		Name:        "transform_batch_set",
+		Category:    "transform",
+		Description: fmt.Sprintf("Set the transforms of many GameObjects in one call, e.g. to lay out a grid or distribute spawn points. All entries are applied in one pass and one Undo group; an entry whose target is not found fails without stopping the others. Returns per-entry success or error and the final transforms. At most %d entries per call", maxTransformBatch),
+		Params: []ParamSpec{
+			{Name: "entries", Type: "array", Description: "Transforms to set: each entry has instanceId or path, and at least one of position, rotation (Euler degrees), scale, plus an optional worldSpace override", Required: true, Items: transformBatchItems},
+			{Name: "worldSpace", Type: "boolean", Description: "Whether position and rotation are in world space, for entries that do not set worldSpace", Default: true},
+		},
+		Normalize: normalizeTransformBatchSetArgs,
+	},
+
+	// =================== UI工具 ===================
+
+	// UI RectTransform设置工具
+	{
+		Name:        "ui_rect_transform_set",
+		Category:    "ui",
+		Description: "Set UI element RectTransform properties (position, size, anchors)",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "preset", Type: "string", Description: "Anchor preset, expanded to anchorMin/anchorMax (explicit anchor components take precedence)", Enum: rectPresetNames()},
+			{Name: "anchorMin", Type: "vector", Components: vectorXY, Description: "Normalized lower-left anchor, must not exceed anchorMax"},
+			{Name: "anchorMax", Type: "vector", Components: vectorXY, Description: "Normalized upper-right anchor"},
+			{Name: "pivot", Type: "vector", Components: vectorXY, Description: "Normalized pivot"},
+			{Name: "anchoredPosition", Type: "vector", Components: vectorXY, Description: "Pivot position relative to the anchors"},
+			{Name: "sizeDelta", Type: "vector", Components: vectorXY, Description: "Size relative to the anchors [width, height]"},
+			{Name: "offsetMin", Type: "vector", Components: vectorXY, Description: "Lower-left corner offset from anchorMin"},
+			{Name: "offsetMax", Type: "vector", Components: vectorXY, Description: "Upper-right corner offset from anchorMax"},
+			{Name: "rotation", Type: "vector", Components: vectorXYZ, Description: "Euler angles in degrees"},
+			{Name: "scale", Type: "vector", Components: vectorXYZ, Description: "Local scale"},
+		},
+		Normalize: normalizeRectTransformArgs,
+	},
+
+	// UI RectTransform获取工具
+	{
+		Name:        "ui_rect_transform_get",
+		Category:    "ui",
+		Description: "Get UI element RectTransform information",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "includeWorldSpace", Type: "boolean", Description: "Whether to include world space information", Default: true},
+		},
+	},
+
+	// UI Image组件工具
+	{
+		Name:        "ui_image_set",
+		Category:    "ui",
+		Description: "Set UI Image component properties (sprite, color, material)",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "spritePath", Type: "string", Description: "Sprite asset path"},
+			{Name: "spriteName", Type: "string", Description: "Name of a sprite inside a sliced spritesheet at spritePath (see sprite_configure); defaults to the first sprite"},
+			{Name: "color", Type: "color", Description: "Tint color as #RRGGBB, #RRGGBBAA, [r, g, b(, a)] or {r, g, b, a} with components in 0-1"},
+			{Name: "materialPath", Type: "string", Description: "Material asset path"},
+			{Name: "raycastTarget", Type: "boolean", Description: "Whether the image receives raycasts"},
+			{Name: "imageType", Type: "string", Description: "Image type", Enum: []string{"simple", "sliced", "tiled", "filled"}},
+			{Name: "preserveAspect", Type: "boolean", Description: "Whether to preserve the sprite aspect ratio"},
+			{Name: "fillMethod", Type: "string", Description: "Fill method for Filled images (Horizontal/Vertical/Radial90/Radial180/Radial360)"},
+			{Name: "fillAmount", Type: "number", Description: "Fill amount for Filled images", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "useSpriteMesh", Type: "boolean", Description: "Whether to use the sprite mesh for Sliced images"},
+			{Name: "alphaHitTestMinimumThreshold", Type: "number", Description: "Minimum alpha for raycast hits", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+		},
+	},
+
+	// UI Text组件工具
+	{
+		Name:        "ui_text_set",
+		Category:    "ui",
+		Description: "Set legacy UI Text component properties (text content, font, color); use tmp_text_set for TextMeshPro text",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "text", Type: "string", Description: "Text content"},
+			{Name: "fontSize", Type: "integer", Description: "Font size", Minimum: floatPtr(1)},
+			{Name: "color", Type: "color", Description: "Text color as #RRGGBB, #RRGGBBAA, [r, g, b(, a)] or {r, g, b, a} with components in 0-1"},
+			{Name: "fontPath", Type: "string", Description: "Font asset path"},
+			{Name: "alignment", Type: "string", Description: "Text anchor (UpperLeft/MiddleCenter/LowerRight, etc.)"},
+			{Name: "lineSpacing", Type: "number", Description: "Line spacing"},
+			{Name: "richText", Type: "boolean", Description: "Whether to enable rich text"},
+			{Name: "fontStyle", Type: "string", Description: "Font style", Enum: []string{"normal", "bold", "italic", "boldanditalic"}},
+			{Name: "horizontalOverflow", Type: "string", Description: "Horizontal overflow", Enum: []string{"wrap", "overflow"}},
+			{Name: "verticalOverflow", Type: "string", Description: "Vertical overflow", Enum: []string{"truncate", "overflow"}},
+			{Name: "resizeTextForBestFit", Type: "boolean", Description: "Whether to resize text to fit"},
+			{Name: "resizeTextMinSize", Type: "integer", Description: "Minimum font size for best fit", Minimum: floatPtr(1)},
+			{Name: "resizeTextMaxSize", Type: "integer", Description: "Maximum font size for best fit", Minimum: floatPtr(1)},
+		},
+	},
+
+	// TextMeshPro文本设置工具
+	{
+		Name:        "tmp_text_set",
+		Category:    "ui",
+		Description: "Set TextMeshPro text properties (TextMeshProUGUI or 3D TextMeshPro): text with rich text tags, font size and auto size range, color, font asset, alignment, wrapping, overflow, character spacing and margins. Returns the applied values and the preferred text size after layout. Fails with \"TextMeshPro package not installed\" when the project has no TMP package",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "text", Type: "string", Description: "Text content; rich text tags such as <b> and <color=#FF0000> are parsed when richText is on"},
+			{Name: "richText", Type: "boolean", Description: "Whether to parse rich text tags"},
+			{Name: "fontSize", Type: "number", Description: "Font size", Minimum: floatPtr(0)},
+			{Name: "autoSize", Type: "boolean", Description: "Whether to size the font automatically between autoSizeMin and autoSizeMax"},
+			{Name: "autoSizeMin", Type: "number", Description: "Minimum font size for auto size", Minimum: floatPtr(0)},
+			{Name: "autoSizeMax", Type: "number", Description: "Maximum font size for auto size", Minimum: floatPtr(0)},
+			{Name: "color", Type: "color", Description: "Text color as #RRGGBB, #RRGGBBAA, [r, g, b(, a)] or {r, g, b, a} with components in 0-1"},
+			{Name: "fontAssetPath", Type: "string", Description: "TMP_FontAsset asset path"},
+			{Name: "alignment", Type: "string", Description: "Text alignment", Enum: tmpAlignments},
+			{Name: "wrapping", Type: "boolean", Description: "Whether to wrap text at the margins"},
+			{Name: "overflow", Type: "string", Description: "Overflow mode", Enum: tmpOverflowModes},
+			{Name: "characterSpacing", Type: "number", Description: "Additional spacing between characters"},
+			{Name: "margins", Type: "vector", Components: paddingComponents, Description: "Text margins as {left, right, top, bottom}; omitted sides keep their value"},
+		},
+		Handler:   handleTMPTextSet,
+		Normalize: normalizeTMPTextArgs,
+	},
+
+	// UI元素创建工具
+	{
+		Name:        "ui_create_element",
+		Category:    "ui",
+		Description: "Create a standard UI control in one call, matching the GameObject > UI menu items (e.g. a button with its Image, Button and child Text). Without parentInstanceId the element goes under the scene's first root Canvas; a Canvas and EventSystem are created when missing if createCanvasIfMissing is true. Returns the created subtree with instanceIds, hierarchy paths and components so follow-up calls can style children",
+		Params: []ParamSpec{
+			{Name: "elementType", Type: "string", Description: "UI element to create", Required: true, Enum: uiElementTypes},
+			{Name: "parentInstanceId", Type: "integer", Description: "Parent GameObject's InstanceID; defaults to the first root Canvas in the scene"},
+			{Name: "createCanvasIfMissing", Type: "boolean", Description: "Create a Canvas (and EventSystem) when the parent is not under a Canvas or the scene has none", Default: true},
+			{Name: "name", Type: "string", Description: "Name of the created GameObject (defaults to the Unity menu name)"},
+			{Name: "text", Type: "string", Description: "Label text for button/toggle, content for text, placeholder for inputfield"},
+			{Name: "spritePath", Type: "string", Description: "Sprite asset path for the Image of panel/button/image"},
+			{Name: "rect", Type: "string", Description: "Anchor preset; stretched axes fill the parent, other axes are aligned to the anchor", Enum: rectPresetNames()},
+		},
+		Normalize: normalizeUICreateElementArgs,
+	},
+
+	// UI Button组件设置工具
+	{
+		Name:        "ui_button_set",
+		Category:    "ui",
+		Description: "Set Button properties (interactable, transition colors, navigation) and wire onClick to a persistent listener: a public void method on a component of the target GameObject, with no parameter or one string/int/float/bool argument. Returns the resulting Button state including persistent onClick listeners",
+		Params: selectableParams(
+			ParamSpec{Name: "onClickTargetInstanceId", Type: "integer", Description: "InstanceID of the GameObject whose component receives the click"},
+			ParamSpec{Name: "onClickMethod", Type: "string", Description: "Public method name, optionally qualified by component type as Type.Method"},
+			ParamSpec{Name: "onClickArgument", Type: "any", Description: "Static argument passed to a one-parameter method (string, number or boolean)"},
+			ParamSpec{Name: "clearOnClick", Type: "boolean", Description: "Remove existing persistent onClick listeners before adding", Default: false},
+		),
+		Normalize: normalizeButtonSetArgs,
+	},
+
+	// UI Toggle组件设置工具
+	{
+		Name:        "ui_toggle_set",
+		Category:    "ui",
+		Description: "Set Toggle properties (isOn, ToggleGroup, interactable, transition colors, navigation). Returns the resulting Toggle state",
+		Params: selectableParams(
+			ParamSpec{Name: "isOn", Type: "boolean", Description: "Whether the toggle is on"},
+			ParamSpec{Name: "groupInstanceId", Type: "integer", Description: "InstanceID of a GameObject with a ToggleGroup; 0 removes the toggle from its group", Minimum: floatPtr(0)},
+		),
+	},
+
+	// UI Slider组件设置工具
+	{
+		Name:        "ui_slider_set",
+		Category:    "ui",
+		Description: "Set Slider properties (minValue, maxValue, value, wholeNumbers, direction, interactable, transition colors, navigation). Returns the resulting Slider state",
+		Params: selectableParams(
+			ParamSpec{Name: "minValue", Type: "number", Description: "Minimum value"},
+			ParamSpec{Name: "maxValue", Type: "number", Description: "Maximum value"},
+			ParamSpec{Name: "value", Type: "number", Description: "Current value, clamped to the range by Unity"},
+			ParamSpec{Name: "wholeNumbers", Type: "boolean", Description: "Restrict values to whole numbers"},
+			ParamSpec{Name: "direction", Type: "string", Description: "Fill direction", Enum: sliderDirections},
+		),
+		Normalize: normalizeSliderSetArgs,
+	},
+
+	// UI Dropdown组件设置工具
+	{
+		Name:        "ui_dropdown_set",
+		Category:    "ui",
+		Description: "Set Dropdown or TMP_Dropdown properties (options, selected value, interactable, transition colors, navigation). options replaces all existing options. Returns the resulting Dropdown state",
+		Params: selectableParams(
+			ParamSpec{Name: "options", Type: "array", Description: "Option texts, replacing the existing options", Items: map[string]interface{}{"type": "string"}},
+			ParamSpec{Name: "value", Type: "integer", Description: "Index of the selected option", Minimum: floatPtr(0)},
+		),
+		Normalize: normalizeDropdownSetArgs,
+	},
+
+	// UI InputField组件设置工具
+	{
+		Name:        "ui_inputfield_set",
+		Category:    "ui",
+		Description: "Set InputField or TMP_InputField properties (text, placeholder text, characterLimit, contentType, interactable, transition colors, navigation). Returns the resulting InputField state",
+		Params: selectableParams(
+			ParamSpec{Name: "text", Type: "string", Description: "Input text"},
+			ParamSpec{Name: "placeholder", Type: "string", Description: "Text of the placeholder graphic"},
+			ParamSpec{Name: "characterLimit", Type: "integer", Description: "Maximum number of characters, 0 for unlimited", Minimum: floatPtr(0)},
+			ParamSpec{Name: "contentType", Type: "string", Description: "Content type", Enum: inputContentTypes},
+		),
+	},
+
+	// UI布局组设置工具
+	{
+		Name:        "ui_layout_set",
+		Category:    "ui",
+		Description: "Configure a HorizontalLayoutGroup, VerticalLayoutGroup or GridLayoutGroup and a ContentSizeFitter on a UI element, adding the components when absent. Changing layoutType replaces the existing layout group; layoutType none removes it. Returns the applied layout group and fitter values",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "layoutType", Type: "string", Description: "Layout group to use; omit to keep the existing group", Enum: layoutTypes},
+			{Name: "padding", Type: "vector", Components: paddingComponents, Description: "Padding in pixels as {left, right, top, bottom}; omitted sides keep their value"},
+			{Name: "spacing", Type: "any", Description: "Spacing between children: a number for horizontal/vertical, [x, y], {x, y} or a number for grid"},
+			{Name: "childAlignment", Type: "string", Description: "Alignment of children within the group", Enum: textAnchors},
+			{Name: "childForceExpandWidth", Type: "boolean", Description: "Horizontal/vertical only: expand children to fill available width"},
+			{Name: "childForceExpandHeight", Type: "boolean", Description: "Horizontal/vertical only: expand children to fill available height"},
+			{Name: "childControlWidth", Type: "boolean", Description: "Horizontal/vertical only: the group controls child widths"},
+			{Name: "childControlHeight", Type: "boolean", Description: "Horizontal/vertical only: the group controls child heights"},
+			{Name: "childScaleWidth", Type: "boolean", Description: "Horizontal/vertical only: use child scale for width"},
+			{Name: "childScaleHeight", Type: "boolean", Description: "Horizontal/vertical only: use child scale for height"},
+			{Name: "cellSize", Type: "vector", Components: vectorXY, Description: "Grid only: cell size in pixels"},
+			{Name: "startCorner", Type: "string", Description: "Grid only: corner of the first cell", Enum: gridCorners},
+			{Name: "startAxis", Type: "string", Description: "Grid only: axis along which cells are placed first", Enum: gridAxes},
+			{Name: "constraint", Type: "string", Description: "Grid only: constrain the number of columns or rows", Enum: gridConstraints},
+			{Name: "constraintCount", Type: "integer", Description: "Grid only: column or row count for a fixed constraint", Minimum: floatPtr(1)},
+			{Name: "horizontalFit", Type: "string", Description: "ContentSizeFitter horizontal fit; adds a ContentSizeFitter when absent", Enum: contentFitModes},
+			{Name: "verticalFit", Type: "string", Description: "ContentSizeFitter vertical fit; adds a ContentSizeFitter when absent", Enum: contentFitModes},
+			{Name: "removeContentSizeFitter", Type: "boolean", Description: "Remove the ContentSizeFitter", Default: false},
+		},
+		Normalize: normalizeLayoutSetArgs,
+	},
+
+	// =================== 资源管理工具 ===================
+
+	// 资源查找工具
+	{
+		Name:        "asset_find",
+		Category:    "asset",
+		Description: "Find project assets by path, type, name, labels, GUID, modification time and size. Results are ordered by asset path (ordinal) and paginated; follow nextPage until it is null to iterate all matches",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "path", Type: "string", Description: "Search path relative to Assets directory", Default: "Assets"},
+			{Name: "type", Type: "string", Description: "Asset type name (Texture2D, AudioClip, etc.)"},
+			{Name: "name", Type: "string", Description: "Asset name (supports wildcards)"},
+			{Name: "nameRegex", Type: "string", Description: "Regular expression matched against the asset name without extension"},
+			{Name: "extension", Type: "string", Description: "File extension"},
+			{Name: "labels", Type: "array", Description: "Asset labels; an asset must have all of them", Items: map[string]interface{}{"type": "string"}},
+			{Name: "guid", Type: "string", Description: "Only match the asset with this GUID"},
+			{Name: "modifiedAfter", Type: "string", Description: "Only match files modified at or after this ISO 8601 timestamp or date"},
+			{Name: "modifiedBefore", Type: "string", Description: "Only match files modified before this ISO 8601 timestamp or date"},
+			{Name: "sizeMin", Type: "number", Description: "Minimum file size in bytes", Minimum: floatPtr(0)},
+			{Name: "sizeMax", Type: "number", Description: "Maximum file size in bytes", Minimum: floatPtr(0)},
+			{Name: "recursive", Type: "boolean", Description: "Whether to search subdirectories", Default: true},
+			{Name: "page", Type: "integer", Description: "Page number, starting at 1", Default: 1, Minimum: floatPtr(1)},
+			{Name: "pageSize", Type: "integer", Description: "Results per page (default 100)", Minimum: floatPtr(1), Maximum: floatPtr(1000)},
+			{Name: "maxResults", Type: "integer", Description: "Deprecated alias for pageSize", Minimum: floatPtr(1), Maximum: floatPtr(1000)},
+		},
+		NarrowBy:  []string{"path", "type", "name", "labels", "modifiedAfter", "pageSize"},
+		Normalize: normalizeAssetFindArgs,
+	},
+
+	// 资源信息获取工具
+	{
+		Name:        "asset_get_info",
+		Category:    "asset",
+		Description: "Get detailed asset information (metadata, import settings; the target type of Preset assets)",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Asset path", Required: true},
+			{Name: "includeMetadata", Type: "boolean", Description: "Whether to include metadata", Default: true},
+			{Name: "includeImportSettings", Type: "boolean", Description: "Whether to include import settings", Default: false},
+		},
+	},
+
+	// 资源依赖关系工具
+	{
+		Name:        "asset_get_dependencies",
+		Category:    "asset",
+		Description: "Get asset dependency relationships",
+		ReadOnly:    true,
+		TimeoutHint: 30 * time.Second,
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Asset path", Required: true},
+			{Name: "recursive", Type: "boolean", Description: "Whether to get dependencies recursively", Default: false},
+			{Name: "includeImplicit", Type: "boolean", Description: "Whether to include implicit dependencies", Default: true},
+		},
+	},
+
+	// 资源引用查找工具
+	{
+		Name:        "asset_find_references",
+		Category:    "asset",
+		Description: "Find assets (prefabs, scenes, materials, ...) that reference the given asset, the inverse of asset_get_dependencies. Use before deleting or replacing an asset. Scans the whole project, so narrow with assetTypes on large projects",
+		ReadOnly:    true,
+		TimeoutHint: 120 * time.Second,
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Path of the referenced asset, e.g. Assets/Textures/Brick.png", Required: true},
+			{Name: "recursive", Type: "boolean", Description: "Also include assets that reference it indirectly; each result has direct=true|false", Default: false},
+			{Name: "assetTypes", Type: "array", Description: "Only scan assets of these types, e.g. Prefab, SceneAsset, Material", Items: map[string]interface{}{"type": "string"}},
+			{Name: "maxResults", Type: "integer", Description: "Stop after this many references; the result has truncated=true when more exist", Default: 200, Minimum: floatPtr(1), Maximum: floatPtr(5000)},
+		},
+		Normalize: normalizeAssetReferencesArgs,
+	},
+
+	// 未使用资源查找工具
+	{
+		Name:        "asset_find_unused",
+		Category:    "asset",
+		Description: "Report assets under rootPath that no enabled build scene, Resources folder, Addressables entry, AssetBundle or project setting depends on, with file sizes. Candidates that can still be loaded dynamically (scripts, shaders, editor-only assets) have confidence=low and a note. Walks the whole dependency graph and sends progress; double-check candidates with asset_find_references and asset_get_dependencies before deleting",
+		ReadOnly:    true,
+		TimeoutHint: assetFindUnusedTimeout,
+		Params: []ParamSpec{
+			{Name: "rootPath", Type: "string", Description: "Folder to report on", Default: "Assets"},
+			{Name: "assetTypes", Type: "array", Description: "Only report assets of these types, e.g. Texture2D, Material, Prefab, AudioClip", Items: map[string]interface{}{"type": "string"}},
+			{Name: "excludePatterns", Type: "array", Description: "Path wildcards to skip; * and ? stay within a folder, ** matches any depth, e.g. Assets/ThirdParty/** or **/*.txt", Items: map[string]interface{}{"type": "string"}},
+			{Name: "includeScripts", Type: "boolean", Description: "Also report scripts, assembly definitions and plugins, which are referenced by code rather than by assets", Default: false},
+			{Name: "page", Type: "integer", Description: "Page number, starting at 1", Default: 1, Minimum: floatPtr(1)},
+			{Name: "pageSize", Type: "integer", Description: "Candidates per page", Default: 100, Minimum: floatPtr(1), Maximum: floatPtr(1000)},
+		},
+		NarrowBy:  []string{"rootPath", "assetTypes", "excludePatterns", "pageSize"},
+		Normalize: normalizeAssetFindUnusedArgs,
+	},
+
+	// 资源重新导入工具
+	{
+		Name:        "asset_reimport",
+		Category:    "asset",
+		Description: "Reimport an asset, or every asset in a folder, optionally applying importer settings first (texture: textureType, maxSize, compression, sRGB, ...; model: scaleFactor, importMaterials, ...; audio: loadType, compressionFormat, ...). Use after files were changed outside Unity. Returns the settings applied to each asset and the import duration",
+		TimeoutHint: 300 * time.Second,
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Asset or folder path, e.g. Assets/Textures/Brick.png or Assets/Textures", Required: true},
+			{Name: "recursive", Type: "boolean", Description: "For folders, also reimport assets in subfolders", Default: false},
+			{Name: "importerSettings", Type: "object", Description: "Importer settings to apply before reimporting; keys depend on the importer type"},
+			{Name: "importerType", Type: "string", Description: "Importer type of importerSettings; inferred from the file extension for single assets, required for folders (only assets with this importer are changed)", Enum: importerTypes},
+			{Name: "maxAssets", Type: "integer", Description: "Maximum number of assets to reimport from a folder", Default: 500, Minimum: floatPtr(1), Maximum: floatPtr(10000)},
+		},
+		NarrowBy:  []string{"recursive", "maxAssets"},
+		Normalize: normalizeAssetReimportArgs,
+	},
+
+	// 预设工具
+	{
+		Name:        "preset_apply",
+		Category:    "asset",
+		Description: "Apply a Preset asset: an importer preset to one asset (assetPath) or to every matching asset in a folder (folder, with include/exclude wildcards), or a component preset to a component (instanceId or path, plus componentType). The preset's target type is checked against the target first. dryRun lists the affected targets without changing them. Returns per-target success and whether the asset was reimported or the component's properties modified",
+		TimeoutHint: 300 * time.Second,
+		Params: []ParamSpec{
+			{Name: "presetPath", Type: "string", Description: "Preset asset path, e.g. Assets/Presets/UITexture.preset", Required: true},
+			{Name: "assetPath", Type: "string", Description: "Asset to apply an importer preset to, e.g. Assets/UI/Icon.png"},
+			{Name: "folder", Type: "string", Description: "Folder whose assets (including subfolders) get the importer preset; assets with another importer type are skipped"},
+			{Name: "include", Type: "array", Description: "With folder: only assets matching one of these path wildcards; * and ? stay within a folder, ** matches any depth, e.g. **/*.png", Items: map[string]interface{}{"type": "string"}},
+			{Name: "exclude", Type: "array", Description: "With folder: skip assets matching one of these path wildcards, e.g. Assets/UI/Atlas/**", Items: map[string]interface{}{"type": "string"}},
+			{Name: "instanceId", Type: "integer", Description: "GameObject instance ID for a component preset"},
+			{Name: "path", Type: "string", Description: "Hierarchy path of the GameObject instead of instanceId, e.g. Player/Camera"},
+			{Name: "componentType", Type: "string", Description: "Component type the preset is applied to, e.g. Rigidbody or AudioSource"},
+			{Name: "dryRun", Type: "boolean", Description: "Only list the targets the preset would be applied to", Default: false},
+		},
+		Handler:   handlePresetApply,
+		Normalize: normalizePresetApplyArgs,
+	},
+
+	// 材质工具
+	{
+		Name:        "material_create",
+		Category:    "material",
+		Description: "Create a material asset with the given shader and optional initial properties. Returns the resolved property values and any property names the shader does not have",
+		Params: []ParamSpec{
+			{Name: "savePath", Type: "string", Description: "Material path, e.g. Assets/Materials/Red.mat", Required: true},
+			{Name: "shaderName", Type: "string", Description: "Shader name, e.g. Universal Render Pipeline/Lit (default: the render pipeline's default shader)"},
+			{Name: "properties", Type: "object", Description: "Initial properties, see material_set_properties"},
+			{Name: "overwrite", Type: "boolean", Description: "Replace an existing material at savePath", Default: false},
+		},
+		Normalize: normalizeMaterialCreateArgs,
+	},
+	{
+		Name:        "material_set_properties",
+		Category:    "material",
+		Description: "Set material properties and keywords. properties maps shader property names (the _ prefix may be omitted) to values: #RRGGBB[AA] or {r,g,b,a} for colors, numbers for floats and ranges, {x,y,z,w} or [x,y,z,w] for vectors, a texture asset path (or null to clear) for textures. Unknown property names are reported per key instead of failing the call",
+		Params: []ParamSpec{
+			{Name: "materialPath", Type: "string", Description: "Material asset path"},
+			{Name: "instanceId", Type: "integer", Description: "Instance ID of the material (alternative to materialPath)"},
+			{Name: "properties", Type: "object", Description: "Property name to value"},
+			{Name: "enableKeywords", Type: "array", Description: "Shader keywords to enable, e.g. _EMISSION", Items: map[string]interface{}{"type": "string"}},
+			{Name: "disableKeywords", Type: "array", Description: "Shader keywords to disable", Items: map[string]interface{}{"type": "string"}},
+		},
+		Normalize: normalizeMaterialSetArgs,
+	},
+
+	// 渲染器工具
+	{
+		Name:        "renderer_set",
+		Category:    "material",
+		Description: "Assign materials and render settings to an object's Renderer (MeshRenderer, SkinnedMeshRenderer, SpriteRenderer, ...). Referenced materials are checked before anything is changed. Returns the resulting material slots",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "Instance ID of the GameObject with the Renderer", Required: true},
+			{Name: "materialPaths", Type: "array", Description: "Material path for each slot; the array length sets the slot count, null keeps a slot's current material", Items: map[string]interface{}{"type": []string{"string", "null"}}},
+			{Name: "materialPath", Type: "string", Description: "Material path for a single slot (alternative to materialPaths)"},
+			{Name: "slot", Type: "integer", Description: "Slot for materialPath (default 0); equal to the slot count appends a slot", Minimum: floatPtr(0)},
+			{Name: "castShadows", Type: "string", Description: "Shadow casting mode", Enum: rendererShadowModes},
+			{Name: "receiveShadows", Type: "boolean", Description: "Whether the renderer receives shadows"},
+			{Name: "enabled", Type: "boolean", Description: "Enable or disable the renderer"},
+			{Name: "sortingLayer", Type: "string", Description: "Sorting layer name (2D)"},
+			{Name: "orderInLayer", Type: "integer", Description: "Order in the sorting layer (2D)", Minimum: floatPtr(-32768), Maximum: floatPtr(32767)},
+		},
+		Handler:   handleRendererSet,
+		Normalize: normalizeRendererSetArgs,
+	},
+
+	// 相机信息工具
+	{
+		Name:        "camera_get",
+		Category:    "camera",
+		Description: "Get a Camera's settings (projection, field of view, clear flags, background color, culling mask layer names, clipping planes, depth, target display, rendering path, HDR/MSAA)",
+		ReadOnly:    true,
+		Params:      cameraTargetParams(),
+		Normalize:   normalizeCameraTarget,
+	},
+
+	// 相机设置工具
+	{
+		Name:        "camera_set",
+		Category:    "camera",
+		Description: "Set Camera properties on the given object or the main camera. cullingMask takes layer names (an empty array renders nothing); unknown layer names are reported individually and nothing is changed. Returns the resulting camera state",
+		Params: cameraTargetParams(
+			ParamSpec{Name: "orthographic", Type: "boolean", Description: "Orthographic instead of perspective projection"},
+			ParamSpec{Name: "fieldOfView", Type: "number", Description: "Vertical field of view in degrees (perspective)", Minimum: floatPtr(1), Maximum: floatPtr(179)},
+			ParamSpec{Name: "orthographicSize", Type: "number", Description: "Half the vertical view size in world units (orthographic)", Minimum: floatPtr(0.0001)},
+			ParamSpec{Name: "clearFlags", Type: "string", Description: "What to clear the background with", Enum: cameraClearFlags},
+			ParamSpec{Name: "backgroundColor", Type: "color", Description: "Background color for SolidColor clear flags as #RRGGBB, #RRGGBBAA, [r, g, b(, a)] or {r, g, b, a} with components in 0-1"},
+			ParamSpec{Name: "cullingMask", Type: "array", Description: "Names of the layers the camera renders", Items: map[string]interface{}{"type": "string"}},
+			ParamSpec{Name: "nearClipPlane", Type: "number", Description: "Near clipping plane distance", Minimum: floatPtr(0.0001)},
+			ParamSpec{Name: "farClipPlane", Type: "number", Description: "Far clipping plane distance", Minimum: floatPtr(0.0001)},
+			ParamSpec{Name: "depth", Type: "number", Description: "Render order; cameras with higher depth draw on top"},
+			ParamSpec{Name: "targetDisplay", Type: "integer", Description: "Target display index (0 is Display 1)", Minimum: floatPtr(0), Maximum: floatPtr(7)},
+			ParamSpec{Name: "renderingPath", Type: "string", Description: "Rendering path (built-in render pipeline only)", Enum: cameraRenderingPaths},
+			ParamSpec{Name: "allowHDR", Type: "boolean", Description: "Whether HDR rendering is allowed"},
+			ParamSpec{Name: "allowMSAA", Type: "boolean", Description: "Whether MSAA is allowed"},
+		),
+		Normalize: normalizeCameraSetArgs,
+	},
+
+	// 灯光设置工具
+	{
+		Name:        "light_set",
+		Category:    "lighting",
+		Description: "Set Light properties (type, color, intensity, range, spot angle, shadows, bounce intensity, baked/mixed/realtime mode). Returns the applied light state",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "InstanceID of the GameObject with the Light", Required: true},
+			{Name: "type", Type: "string", Description: "Light type", Enum: lightTypes},
+			{Name: "color", Type: "color", Description: "Light color" + colorFormatHint},
+			{Name: "intensity", Type: "number", Description: "Light intensity", Minimum: floatPtr(0)},
+			{Name: "range", Type: "number", Description: "Range in world units (Point and Spot)", Minimum: floatPtr(0)},
+			{Name: "spotAngle", Type: "number", Description: "Spot cone angle in degrees (Spot)", Minimum: floatPtr(1), Maximum: floatPtr(179)},
+			{Name: "shadows", Type: "string", Description: "Shadow type", Enum: lightShadowModes},
+			{Name: "shadowStrength", Type: "number", Description: "Shadow strength", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "bounceIntensity", Type: "number", Description: "Indirect light multiplier", Minimum: floatPtr(0)},
+			{Name: "mode", Type: "string", Description: "Lightmap bake mode", Enum: lightModes},
+		},
+		Normalize: normalizeLightSetArgs,
+	},
+
+	// 场景光照设置工具
+	{
+		Name:        "render_settings_set",
+		Category:    "lighting",
+		Description: "Set the active scene's lighting settings: skybox material, ambient light (mode, color, intensity), fog (enabled, color, mode, density, distances) and realtime/baked global illumination. The skybox material is checked before anything is changed. Returns the applied settings",
+		Params: []ParamSpec{
+			{Name: "skyboxMaterialPath", Type: "string", Description: "Skybox material path; an empty string removes the skybox"},
+			{Name: "ambientMode", Type: "string", Description: "Ambient light source", Enum: ambientModes},
+			{Name: "ambientColor", Type: "color", Description: "Flat ambient color" + colorFormatHint},
+			{Name: "ambientSkyColor", Type: "color", Description: "Trilight sky color" + colorFormatHint},
+			{Name: "ambientEquatorColor", Type: "color", Description: "Trilight equator color" + colorFormatHint},
+			{Name: "ambientGroundColor", Type: "color", Description: "Trilight ground color" + colorFormatHint},
+			{Name: "ambientIntensity", Type: "number", Description: "Skybox ambient intensity multiplier", Minimum: floatPtr(0), Maximum: floatPtr(8)},
+			{Name: "fog", Type: "boolean", Description: "Whether fog is enabled"},
+			{Name: "fogColor", Type: "color", Description: "Fog color" + colorFormatHint},
+			{Name: "fogMode", Type: "string", Description: "Fog falloff", Enum: fogModes},
+			{Name: "fogDensity", Type: "number", Description: "Fog density (Exponential modes)", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "fogStartDistance", Type: "number", Description: "Fog start distance (Linear)", Minimum: floatPtr(0)},
+			{Name: "fogEndDistance", Type: "number", Description: "Fog end distance (Linear)", Minimum: floatPtr(0)},
+			{Name: "realtimeGI", Type: "boolean", Description: "Realtime global illumination (requires a Lighting Settings asset on the scene)"},
+			{Name: "bakedGI", Type: "boolean", Description: "Baked global illumination (requires a Lighting Settings asset on the scene)"},
+		},
+		Handler:   handleRenderSettingsSet,
+		Normalize: normalizeRenderSettingsArgs,
+	},
+
+	// 光照烘焙工具
+	{
+		Name:        "lighting_bake",
+		Category:    "lighting",
+		Description: "Bake lightmaps and baked GI for the active scene or all open scenes. By default the bake runs in the background with progress notifications and is canceled when the call is canceled or lighting_bake_cancel is called; async=false bakes synchronously and cannot be canceled. Only one bake runs at a time. Returns the total bake time, lightmap count and total lightmap memory",
+		TimeoutHint: lightingBakeTimeout,
+		Params: []ParamSpec{
+			{Name: "async", Type: "boolean", Description: "Bake in the background with progress and cancellation", Default: true},
+			{Name: "clear", Type: "boolean", Description: "Clear the baked data before baking", Default: false},
+			{Name: "scope", Type: "string", Description: "active bakes the active scene only, all bakes every open scene together", Default: "active", Enum: lightingScopes},
+		},
+		Handler: handleLightingBake,
+	},
+
+	// 光照烘焙取消工具
+	{
+		Name:        "lighting_bake_cancel",
+		Category:    "lighting",
+		Description: "Cancel the running background lighting bake. The waiting lighting_bake call then returns a canceled error. Returns the bake status",
+		Handler:     handleLightingBakeCancel,
+	},
+
+	// 刚体设置工具
+	{
+		Name:        "rigidbody_set",
+		Category:    "physics",
+		Description: "Configure the Rigidbody (or Rigidbody2D with is2D) on an object, adding it when absent: mass, drag, gravity, kinematic, interpolation, collision detection and constraints. Returns the resulting component state",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "is2D", Type: "boolean", Description: "Configure Rigidbody2D instead of Rigidbody", Default: false},
+			{Name: "mass", Type: "number", Description: "Mass in kilograms", Minimum: floatPtr(0.0000001)},
+			{Name: "drag", Type: "number", Description: "Linear drag", Minimum: floatPtr(0)},
+			{Name: "angularDrag", Type: "number", Description: "Angular drag", Minimum: floatPtr(0)},
+			{Name: "useGravity", Type: "boolean", Description: "3D only: whether gravity affects the body"},
+			{Name: "gravityScale", Type: "number", Description: "2D only: gravity multiplier"},
+			{Name: "isKinematic", Type: "boolean", Description: "Whether the body is kinematic (moved only by script)"},
+			{Name: "interpolation", Type: "string", Description: "Interpolation mode", Enum: rigidbodyInterpolations},
+			{Name: "collisionDetection", Type: "string", Description: "Collision detection: Discrete, Continuous, ContinuousDynamic or ContinuousSpeculative (3D); Discrete or Continuous (2D)"},
+			{Name: "constraints", Type: "array", Description: "Constraint flags replacing the current ones, e.g. [\"FreezePositionY\", \"FreezeRotationX\"]; an empty array removes all constraints", Items: map[string]interface{}{"type": "string"}},
+		},
+		Normalize: normalizeRigidbodySetArgs,
+	},
+
+	// 碰撞体设置工具
+	{
+		Name:        "collider_set",
+		Category:    "physics",
+		Description: "Configure a Collider (or Collider2D with is2D). Without colliderType the object's existing collider is used; with colliderType that collider is used or added. Shape fields apply per type: center, size (Box), radius (Sphere/Circle/Capsule), height and direction (Capsule), convex (Mesh). Returns the resulting collider state",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "is2D", Type: "boolean", Description: "Configure a 2D collider", Default: false},
+			{Name: "colliderType", Type: "string", Description: "Box, Sphere, Capsule or Mesh (3D); Box, Circle, Capsule, Polygon or Edge (2D)"},
+			{Name: "center", Type: "vector", Components: vectorXYZ, Description: "Collider center (offset for 2D, x and y only)"},
+			{Name: "size", Type: "vector", Components: vectorXYZ, Description: "Box size, or 2D capsule size (x and y only)"},
+			{Name: "radius", Type: "number", Description: "Sphere, circle or 3D capsule radius", Minimum: floatPtr(0)},
+			{Name: "height", Type: "number", Description: "3D capsule height", Minimum: floatPtr(0)},
+			{Name: "direction", Type: "string", Description: "Capsule direction: X, Y or Z (3D); Vertical or Horizontal (2D)", Enum: capsuleDirections},
+			{Name: "convex", Type: "boolean", Description: "Mesh collider convexity"},
+			{Name: "isTrigger", Type: "boolean", Description: "Whether the collider is a trigger"},
+			{Name: "physicMaterialPath", Type: "string", Description: "Physic material asset path (PhysicsMaterial2D for 2D); an empty string clears it"},
+		},
+		Normalize: normalizeColliderSetArgs,
+	},
+
+	// =================== 精灵工具 ===================
+
+	// 纹理信息工具
+	{
+		Name:        "texture_get_info",
+		Category:    "sprite",
+		Description: "Get a texture's source and imported size, format, mipmaps, import type and sprite mode, the sprite sub-assets it contains, and per-platform import overrides with an estimated compressed size for each platform",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Texture asset path, e.g. Assets/Sprites/Hero.png", Required: true},
+		},
+	},
+
+	// 精灵设置工具
+	{
+		Name:        "sprite_configure",
+		Category:    "sprite",
+		Description: "Import a texture as Sprite and optionally slice a spritesheet, either on a grid (gridCellSize, gridOffset, gridPadding) or into explicit named rects. Rects are in source texture pixels with the origin at the bottom-left and are checked against the texture size before anything is changed. Returns the generated sprite sub-asset names, usable as spriteName with ui_image_set",
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Texture asset path", Required: true},
+			{Name: "spriteMode", Type: "string", Description: "Single sprite or a spritesheet with several sprites; slicing implies Multiple", Enum: spriteModes},
+			{Name: "pixelsPerUnit", Type: "number", Description: "Sprite pixels per world unit", Minimum: floatPtr(0.01)},
+			{Name: "pivot", Type: "vector", Components: vectorXY, Description: "Pivot of a Single sprite, 0-1 (0.5, 0.5 is the center)"},
+			{Name: "gridCellSize", Type: "vector", Components: vectorXY, Description: "Slice into cells of this size in pixels"},
+			{Name: "gridOffset", Type: "vector", Components: vectorXY, Description: "Pixel offset of the first cell from the top-left corner"},
+			{Name: "gridPadding", Type: "vector", Components: vectorXY, Description: "Pixel gap between cells"},
+			{Name: "rects", Type: "array", Description: "Explicit slices: {name, x, y, width, height, pivot: {x, y}}", Items: map[string]interface{}{"type": "object"}},
+		},
+		Handler:   handleSpriteConfigure,
+		Normalize: normalizeSpriteConfigureArgs,
+	},
+
+	// 精灵图集创建工具
+	{
+		Name:        "sprite_atlas_create",
+		Category:    "sprite",
+		Description: "Create a SpriteAtlas asset with packing settings and optional initial packables (folders, textures or sprites), optionally packing it right away with progress notifications. Returns the atlas contents and pack status (page count and page sizes)",
+		TimeoutHint: spriteAtlasPackTimeout,
+		Params: []ParamSpec{
+			{Name: "savePath", Type: "string", Description: "Atlas path, e.g. Assets/Atlases/UI.spriteatlas", Required: true},
+			{Name: "includeInBuild", Type: "boolean", Description: "Include the atlas in builds; turn off for atlases loaded as variants or through late binding", Default: true},
+			{Name: "padding", Type: "integer", Description: "Pixels between packed sprites (2, 4 or 8)", Default: 4},
+			{Name: "allowRotation", Type: "boolean", Description: "Allow sprites to be rotated when packing", Default: true},
+			{Name: "tightPacking", Type: "boolean", Description: "Pack by sprite outline instead of rect", Default: true},
+			{Name: "maxTextureSize", Type: "integer", Description: "Maximum atlas page size in pixels", Minimum: floatPtr(32), Maximum: floatPtr(16384)},
+			{Name: "packables", Type: "array", Description: "Folders, textures or sprites to pack, as asset paths", Items: map[string]interface{}{"type": "string"}},
+			{Name: "pack", Type: "boolean", Description: "Pack the atlas after creating it", Default: false},
+		},
+		Normalize: normalizeSpriteAtlasCreateArgs,
+	},
+
+	// 精灵图集修改工具
+	{
+		Name:        "sprite_atlas_modify",
+		Category:    "sprite",
+		Description: "Add or remove a SpriteAtlas's packables (folders, textures or sprites by asset path) and optionally repack it with progress notifications. Without changes it just reports the atlas. Returns the packables, sprite count and pack status: page count and the size and format of each packed page",
+		TimeoutHint: spriteAtlasPackTimeout,
+		Params: []ParamSpec{
+			{Name: "atlasPath", Type: "string", Description: "SpriteAtlas asset path", Required: true},
+			{Name: "add", Type: "array", Description: "Asset paths of folders, textures or sprites to add", Items: map[string]interface{}{"type": "string"}},
+			{Name: "remove", Type: "array", Description: "Asset paths of packables to remove", Items: map[string]interface{}{"type": "string"}},
+			{Name: "includeInBuild", Type: "boolean", Description: "Change whether the atlas is included in builds"},
+			{Name: "pack", Type: "boolean", Description: "Repack the atlas after the changes", Default: false},
+		},
+		Normalize: normalizeSpriteAtlasModifyArgs,
+	},
+
+	// =================== 动画工具 ===================
+
+	// Animator控制器创建工具
+	{
+		Name:        "animator_controller_create",
+		Category:    "animation",
+		Description: "Create an AnimatorController asset with states (each optionally playing a clip), parameters, a default state and transitions with conditions. Condition parameters must be declared in parameters; Float supports Greater/Less, Int also Equals/NotEqual, Bool If/IfNot, Trigger If. Returns the controller's states, transitions and parameters",
+		Params: []ParamSpec{
+			{Name: "savePath", Type: "string", Description: "Controller path, e.g. Assets/Animations/Player.controller", Required: true},
+			{Name: "states", Type: "array", Description: "States of the base layer", Required: true, Items: animatorStateItems},
+			{Name: "defaultState", Type: "string", Description: "Default state name (default: the first state)"},
+			{Name: "parameters", Type: "array", Description: "Parameters to declare", Items: animatorParameterItems},
+			{Name: "transitions", Type: "array", Description: "Transitions between states; from may be Any for Any State", Items: animatorTransitionItems},
+			{Name: "overwrite", Type: "boolean", Description: "Replace an existing asset at savePath", Default: false},
+		},
+		Normalize: normalizeAnimatorControllerCreateArgs,
+	},
+
+	// Animator控制器设置工具
+	{
+		Name:        "animator_set_controller",
+		Category:    "animation",
+		Description: "Assign an AnimatorController to a GameObject's Animator, adding the Animator if missing. Returns the controller's states and parameters",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "controllerPath", Type: "string", Description: "Controller asset path; empty string removes the controller", Required: true},
+		},
+		Normalize: normalizeAnimatorSetControllerArgs,
+	},
+
+	// Animator参数工具
+	{
+		Name:        "animator_set_parameters",
+		Category:    "animation",
+		Description: "Define or update parameters on an AnimatorController (by asset path or through a GameObject's Animator), and in play mode set runtime parameter values on the Animator. Returns the controller's parameters and, in play mode, their current values",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject with an Animator"},
+			{Name: "controllerPath", Type: "string", Description: "Controller asset path (alternative to instanceId)"},
+			{Name: "parameters", Type: "array", Description: "Parameters to add, or update when the name exists (type and default)", Items: animatorParameterItems},
+			{Name: "values", Type: "object", Description: "Runtime values by parameter name, play mode only: number for Float/Int, boolean for Bool, true to set a Trigger or false to reset it"},
+		},
+		Normalize: normalizeAnimatorSetParametersArgs,
+	},
+
+	// 动画片段创建工具
+	{
+		Name:        "animation_clip_create",
+		Category:    "animation",
+		Description: "Create an AnimationClip asset from simple keyframe curves, e.g. UI tweens or basic motion. Supported properties: localPosition/localEulerAngles/localScale.x|y|z, anchoredPosition/sizeDelta.x|y, color.r|g|b|a, alpha (CanvasGroup), active (GameObject), intensity (Light), or their m_ binding names. Keyframe times must increase; tangents default to smooth. Returns the clip length and curve count",
+		Params: []ParamSpec{
+			{Name: "savePath", Type: "string", Description: "Clip path, e.g. Assets/Animations/FadeIn.anim", Required: true},
+			{Name: "frameRate", Type: "number", Description: "Sample rate in frames per second", Default: 60, Minimum: floatPtr(1), Maximum: floatPtr(240)},
+			{Name: "loop", Type: "boolean", Description: "Loop the clip", Default: false},
+			{Name: "curves", Type: "array", Description: fmt.Sprintf("Curves, at most %d with %d keyframes each and %d in total", maxClipCurves, maxCurveKeyframes, maxClipKeyframes), Required: true, Items: animationCurveItems},
+			{Name: "overwrite", Type: "boolean", Description: "Replace an existing asset at savePath", Default: false},
+		},
+		Normalize: normalizeAnimationClipCreateArgs,
+	},
+
+	// Timeline创建工具
+	{
+		Name:        "timeline_create",
+		Category:    "animation",
+		Description: "Create a TimelineAsset for a simple cutscene and assign it to a PlayableDirector on the director GameObject (added when missing). Tracks: animation (plays clipPath on the bound object's Animator), activation (shows the bound object during ranges) and audio (plays clipPath, optionally through the bound object's AudioSource). All bindings are checked to exist before anything is created. Returns the asset path, duration, tracks with their clips and bindings. Fails when the Timeline package is not installed",
+		Params: []ParamSpec{
+			{Name: "savePath", Type: "string", Description: "Timeline asset path, e.g. Assets/Timelines/Intro.playable", Required: true},
+			{Name: "director", Type: "integer", Description: "InstanceID of the GameObject that gets or already has the PlayableDirector", Required: true},
+			{Name: "tracks", Type: "array", Description: fmt.Sprintf("Tracks to create, at most %d", maxTimelineTracks), Required: true, Items: timelineTrackItems},
+		},
+		Handler:   handleTimelineCreate,
+		Normalize: normalizeTimelineCreateArgs,
+	},
+
+	// Timeline信息工具
+	{
+		Name:        "timeline_get_info",
+		Category:    "animation",
+		Description: "Read a TimelineAsset's tracks and clips (type, name, clip asset, start and duration). With director the timeline assigned to that PlayableDirector is read and each track's binding is included. Fails when the Timeline package is not installed",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Timeline asset path"},
+			{Name: "director", Type: "integer", Description: "InstanceID of a GameObject with a PlayableDirector, to read its timeline and bindings"},
+		},
+		Handler:   handleTimelineGetInfo,
+		Normalize: normalizeTimelineGetInfoArgs,
+	},
+
+	// =================== 音频工具 ===================
+
+	// AudioSource设置工具
+	{
+		Name:        "audio_source_set",
+		Category:    "audio",
+		Description: "Set AudioSource properties (clip, volume, pitch, loop, play on awake, spatial blend, output mixer group, rolloff distances), adding the AudioSource if missing. preview plays the clip once in the editor. Returns the applied AudioSource state",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "clipPath", Type: "string", Description: "AudioClip asset path; an empty string removes the clip"},
+			{Name: "volume", Type: "number", Description: "Volume", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "pitch", Type: "number", Description: "Pitch", Minimum: floatPtr(-3), Maximum: floatPtr(3)},
+			{Name: "loop", Type: "boolean", Description: "Loop the clip"},
+			{Name: "playOnAwake", Type: "boolean", Description: "Play when the object awakes"},
+			{Name: "spatialBlend", Type: "number", Description: "0 is 2D, 1 is fully 3D", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "mixerGroupPath", Type: "string", Description: "Output mixer group as mixer asset path plus group path, e.g. Assets/Audio/Main.mixer/Master/SFX; an empty string outputs directly to the listener"},
+			{Name: "rolloffMode", Type: "string", Description: "Distance rolloff", Enum: audioRolloffModes},
+			{Name: "minDistance", Type: "number", Description: "Distance where attenuation starts", Minimum: floatPtr(0)},
+			{Name: "maxDistance", Type: "number", Description: "Distance where attenuation stops", Minimum: floatPtr(0)},
+			{Name: "preview", Type: "boolean", Description: "Play the clip once after the change: an editor preview in edit mode, AudioSource.Play in play mode", Default: false},
+		},
+		Normalize: normalizeAudioSourceSetArgs,
+	},
+
+	// 音频片段信息工具
+	{
+		Name:        "audio_clip_info",
+		Category:    "audio",
+		Description: "Get an AudioClip's length, channels, frequency and sample count, and its import settings (load type, compression format, quality, force to mono, load in background)",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "AudioClip asset path", Required: true},
+		},
+	},
+
+	// =================== 粒子工具 ===================
+
+	// 粒子系统读取工具
+	{
+		Name:        "particle_system_get",
+		Category:    "particles",
+		Description: "Get a ParticleSystem's main, emission and shape module properties in the same {module: {property: value}} structure particle_system_set accepts. Values driven by curves or gradients are reported with their mode only",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+		},
+	},
+
+	// 粒子系统设置工具
+	{
+		Name:     "particle_system_set",
+		Category: "particles",
+		Description: "Set common ParticleSystem properties as {module: {property: value}}. Unknown modules or properties fail before anything changes. range values take a constant or {min, max}; colorRange takes a color or {min, max} colors" + colorFormatHint + ". restart replays the effect. Modules: " +
+			describeParticleModules(),
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "modules", Type: "object", Description: "Module name to properties, e.g. {\"main\": {\"startSize\": {\"min\": 1, \"max\": 2}}}"},
+			{Name: "restart", Type: "boolean", Description: "Clear and replay the effect after the change", Default: false},
+		},
+		Normalize: normalizeParticleSystemSetArgs,
+	},
+
+	// =================== 地形工具 ===================
+
+	// 地形创建工具
+	{
+		Name:        "terrain_create",
+		Category:    "terrain",
+		Description: "Create a flat Terrain GameObject with a new TerrainData asset and a TerrainCollider (undoable). Returns instanceId, the terrain's world bounds, heightmap resolution, TerrainData asset path and its layers",
+		Params: []ParamSpec{
+			{Name: "name", Type: "string", Description: "GameObject name", Default: "Terrain"},
+			{Name: "size", Type: "vector", Components: vectorXYZ, Description: "Terrain size in world units: width (x), maximum height (y) and length (z)", Default: map[string]interface{}{"x": 500.0, "y": 100.0, "z": 500.0}},
+			{Name: "heightmapResolution", Type: "integer", Description: "Heightmap resolution: 33, 65, 129, 257, 513, 1025, 2049 or 4097", Default: 513},
+			{Name: "position", Type: "vector", Components: vectorXYZ, Description: "World position of the terrain's corner"},
+			{Name: "savePath", Type: "string", Description: "TerrainData asset path, e.g. Assets/Terrains/Level1.asset (default: a new asset under Assets/Terrains named after the GameObject)"},
+		},
+		Normalize: normalizeTerrainCreateArgs,
+	},
+
+	// 地形修改工具
+	{
+		Name:     "terrain_modify",
+		Category: "terrain",
+		Description: fmt.Sprintf("Modify a terrain (undoable). set_heights replaces the heights either from a procedural function (flat at height; slope from one height to another along x or z; noise: Perlin noise with seed and feature scale in world units, amplitude around height) or from a small heightmap array that is interpolated to the terrain resolution. "+
+			"Heights are 0-1 fractions of the terrain height. The heightmap is sent in the request, so it is limited to %dx%d values: downsample larger data. add_layer adds a TerrainLayer for a texture (creating the .terrainlayer asset); paint_layer paints a layer at opacity over a rect in normalized terrain coordinates (0-1, x along width, y along length). Returns the terrain bounds and layer list", maxTerrainHeightmapSize, maxTerrainHeightmapSize),
+		TimeoutHint: 60 * time.Second,
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "InstanceID of the Terrain GameObject", Required: true},
+			{Name: "operation", Type: "string", Description: "Operation to perform", Required: true, Enum: terrainOperations},
+			{Name: "function", Type: "string", Description: "Procedural height function (set_heights)", Enum: terrainHeightFunctions},
+			{Name: "height", Type: "number", Description: "Height for flat, base height for noise, 0-1 (set_heights)", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "from", Type: "number", Description: "Slope start height, 0-1 (set_heights)", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "to", Type: "number", Description: "Slope end height, 0-1 (set_heights)", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "direction", Type: "string", Description: "Axis the slope rises along (set_heights)", Enum: []string{"x", "z"}},
+			{Name: "seed", Type: "integer", Description: "Noise seed (set_heights)"},
+			{Name: "scale", Type: "number", Description: "Noise feature size in world units (set_heights)", Minimum: floatPtr(0.01)},
+			{Name: "amplitude", Type: "number", Description: "Noise amplitude, 0-1 of the terrain height (set_heights)", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+			{Name: "heightmap", Type: "array", Description: fmt.Sprintf("Rows (along length) of heights (along width), 0-1, at most %d per side (set_heights)", maxTerrainHeightmapSize), Items: map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "number"}}},
+			{Name: "texturePath", Type: "string", Description: "Diffuse texture of the new layer (add_layer)"},
+			{Name: "normalMapPath", Type: "string", Description: "Normal map of the new layer (add_layer)"},
+			{Name: "tileSize", Type: "vector", Components: vectorXY, Description: "Texture tile size in world units (add_layer)"},
+			{Name: "layerPath", Type: "string", Description: "Path of the .terrainlayer asset to create (add_layer, default: next to the TerrainData)"},
+			{Name: "layerIndex", Type: "integer", Description: "Index of the layer to paint (paint_layer)", Minimum: floatPtr(0)},
+			{Name: "rect", Type: "vector", Components: rectComponents, Description: "Area to paint as {x, y, width, height} in 0-1 terrain coordinates (paint_layer, default: the whole terrain)"},
+			{Name: "opacity", Type: "number", Description: "Paint strength, 0-1 (paint_layer)", Minimum: floatPtr(0), Maximum: floatPtr(1)},
+		},
+		Normalize: normalizeTerrainModifyArgs,
+	},
+
+	// =================== 导航工具 ===================
+
+	// NavMesh烘焙工具
+	{
+		Name:        "navmesh_bake",
+		Category:    "navigation",
+		Description: "Bake the NavMesh of the active scene. Agent overrides are written to the scene's navigation bake settings before baking. Runs synchronously by default; async=true bakes in the background with progress notifications and cancels the bake if the call is canceled. Returns the bake duration, agent settings and triangulation stats (vertices, triangles, areas)",
+		TimeoutHint: navmeshBakeTimeout,
+		Params: []ParamSpec{
+			{Name: "agentRadius", Type: "number", Description: "Agent radius", Minimum: floatPtr(0.01)},
+			{Name: "agentHeight", Type: "number", Description: "Agent height", Minimum: floatPtr(0.01)},
+			{Name: "maxSlope", Type: "number", Description: "Maximum walkable slope in degrees", Minimum: floatPtr(0), Maximum: floatPtr(60)},
+			{Name: "stepHeight", Type: "number", Description: "Maximum step height the agent can climb", Minimum: floatPtr(0)},
+			{Name: "async", Type: "boolean", Description: "Bake in the background and report progress", Default: false},
+		},
+		Handler:   handleNavMeshBake,
+		Normalize: normalizeNavMeshBakeArgs,
+	},
+
+	// NavMesh采样工具
+	{
+		Name:        "navmesh_sample_position",
+		Category:    "navigation",
+		Description: "Find the nearest point on the baked NavMesh within maxDistance of a position. Returns found=false when there is none",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "position", Type: "vector", Components: vectorXYZ, Description: "World position to sample from", Required: true},
+			{Name: "maxDistance", Type: "number", Description: "Search radius", Default: 1.0, Minimum: floatPtr(0.001)},
+			{Name: "areaMask", Type: "integer", Description: "NavMesh area mask, -1 for all areas", Default: -1},
+		},
+	},
+
+	// NavMesh路径工具
+	{
+		Name:        "navmesh_calculate_path",
+		Category:    "navigation",
+		Description: "Calculate a NavMesh path between two world positions. Returns the status (PathComplete, PathPartial or PathInvalid), corner points and path length. Both positions should be on the NavMesh; use navmesh_sample_position to snap them first",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "from", Type: "vector", Components: vectorXYZ, Description: "Start position", Required: true},
+			{Name: "to", Type: "vector", Components: vectorXYZ, Description: "End position", Required: true},
+			{Name: "areaMask", Type: "integer", Description: "NavMesh area mask, -1 for all areas", Default: -1},
+		},
+	},
+
+	// =================== ScriptableObject工具 ===================
+
+	// ScriptableObject创建工具
+	{
+		Name:        "scriptable_object_create",
+		Category:    "scriptableobject",
+		Description: "Create a ScriptableObject asset of the given type with optional initial field values. Unknown type names fail with suggestions of similar types. Returns the full serialized field dump of the new asset",
+		Params: []ParamSpec{
+			{Name: "typeName", Type: "string", Description: "ScriptableObject class name or full type name, e.g. GameConfig or MyGame.Data.GameConfig", Required: true},
+			{Name: "savePath", Type: "string", Description: "Asset path, e.g. Assets/Data/GameConfig.asset", Required: true},
+			{Name: "fields", Type: "object", Description: "Initial values by serialized property path, see scriptable_object_set"},
+			{Name: "overwrite", Type: "boolean", Description: "Replace an existing asset at savePath", Default: false},
+		},
+		Handler:   handleScriptableObjectCreate,
+		Normalize: normalizeScriptableObjectCreateArgs,
+	},
+
+	// ScriptableObject读取工具
+	{
+		Name:        "scriptable_object_get",
+		Category:    "scriptableobject",
+		Description: "Dump the serialized fields of a ScriptableObject asset with their paths, types and values, in the same format as component_get. The paths are what scriptable_object_set accepts",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "ScriptableObject asset path", Required: true},
+			{Name: "maxProperties", Type: "integer", Description: "Maximum number of properties to return", Default: 500, Minimum: floatPtr(1), Maximum: floatPtr(5000)},
+			{Name: "maxArrayElements", Type: "integer", Description: "Maximum number of elements listed per array", Default: 50, Minimum: floatPtr(0), Maximum: floatPtr(1000)},
+		},
+		NarrowBy: []string{"maxProperties", "maxArrayElements"},
+	},
+
+	// ScriptableObject设置工具
+	{
+		Name:        "scriptable_object_set",
+		Category:    "scriptableobject",
+		Description: "Set serialized fields of a ScriptableObject asset (undoable). fields maps property paths to values as in component_set_property: nested paths such as stats.maxHealth or items.Array.data[0].name, <path>.Array.size to resize arrays, and asset paths for object references. If any field fails nothing is changed. Returns the previous values and the full serialized field dump after the change",
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "ScriptableObject asset path", Required: true},
+			{Name: "fields", Type: "object", Description: "Property path to new value, e.g. {\"maxHealth\": 100, \"icon\": \"Assets/Art/Icon.png\"}", Required: true},
+		},
+		Normalize: normalizeScriptableObjectSetArgs,
+	},
+
+	// 项目结构工具
+	{
+		Name:        "project_get_structure",
+		Category:    "project",
+		Description: "Get project directory structure and statistics",
+		ReadOnly:    true,
+		TimeoutHint: 30 * time.Second,
+		Params: []ParamSpec{
+			{Name: "rootPath", Type: "string", Description: "Root directory path", Default: "Assets"},
+			{Name: "maxDepth", Type: "integer", Description: "Maximum directory depth", Minimum: floatPtr(1), Maximum: floatPtr(20)},
+			{Name: "includeFiles", Type: "boolean", Description: "Whether to include files", Default: true},
+			{Name: "fileTypes", Type: "array", Description: "File extensions to include", Items: map[string]interface{}{"type": "string"}},
+		},
+	},
+
+	// 标签列表工具
+	{
+		Name:        "project_tags_get",
+		Category:    "project",
+		Description: "List the project's tags, including the built-in ones",
+		ReadOnly:    true,
+	},
+
+	// 标签添加工具
+	{
+		Name:        "project_tags_add",
+		Category:    "project",
+		Description: "Add tags to the project's TagManager. Tags that already exist are skipped. The change is verified with a follow-up read and the full updated tag list is returned",
+		Params: []ParamSpec{
+			{Name: "tags", Type: "array", Description: "Tag names to add", Required: true, Items: map[string]interface{}{"type": "string"}},
+		},
+		Handler:   tagManagerHandler("project_tags_add", "project_tags_get", verifyTagsAdded),
+		Normalize: normalizeTagsArgs,
+	},
+
+	// 标签删除工具
+	{
+		Name:        "project_tags_remove",
+		Category:    "project",
+		Description: "Remove tags from the project's TagManager; built-in tags cannot be removed and objects still using a removed tag keep a dangling tag. The change is verified with a follow-up read and the full updated tag list is returned",
+		Params: []ParamSpec{
+			{Name: "tags", Type: "array", Description: "Tag names to remove", Required: true, Items: map[string]interface{}{"type": "string"}},
+		},
+		Handler:   tagManagerHandler("project_tags_remove", "project_tags_get", verifyTagsRemoved),
+		Normalize: normalizeTagsArgs,
+	},
+
+	// 层列表工具
+	{
+		Name:        "project_layers_get",
+		Category:    "project",
+		Description: "List all 32 layers with their index and name; layers 0-7 are built-in, 8-31 are user layers",
+		ReadOnly:    true,
+	},
+
+	// 层设置工具
+	{
+		Name:        "project_layers_set",
+		Category:    "project",
+		Description: "Name a user layer (index 8-31); an empty name clears it. The change is verified with a follow-up read and the full updated layer list is returned",
+		Params: []ParamSpec{
+			{Name: "index", Type: "integer", Description: "User layer index", Required: true, Minimum: floatPtr(firstUserLayer), Maximum: floatPtr(lastUserLayer)},
+			{Name: "name", Type: "string", Description: "Layer name, or an empty string to clear the layer", Required: true},
+		},
+		Handler:   tagManagerHandler("project_layers_set", "project_layers_get", verifyLayerSet),
+		Normalize: normalizeLayerSetArgs,
+	},
+
+	// 排序层列表工具
+	{
+		Name:        "project_sorting_layers_get",
+		Category:    "project",
+		Description: "List the 2D sorting layers in render order (first is drawn at the back)",
+		ReadOnly:    true,
+	},
+
+	// 排序层添加工具
+	{
+		Name:        "project_sorting_layers_add",
+		Category:    "project",
+		Description: "Append a 2D sorting layer. The change is verified with a follow-up read and the full updated sorting layer list is returned",
+		Params: []ParamSpec{
+			{Name: "name", Type: "string", Description: "Sorting layer name", Required: true},
+		},
+		Handler:   tagManagerHandler("project_sorting_layers_add", "project_sorting_layers_get", verifySortingLayerAdded),
+		Normalize: normalizeSortingLayerAddArgs,
+	},
+
+	// 排序层排序工具
+	{
+		Name:        "project_sorting_layers_reorder",
+		Category:    "project",
+		Description: "Reorder the 2D sorting layers. order must list every existing sorting layer exactly once, back to front. The change is verified with a follow-up read and the full updated sorting layer list is returned",
+		Params: []ParamSpec{
+			{Name: "order", Type: "array", Description: "All sorting layer names in the new render order", Required: true, Items: map[string]interface{}{"type": "string"}},
+		},
+		Handler:   tagManagerHandler("project_sorting_layers_reorder", "project_sorting_layers_get", verifySortingLayerOrder),
+		Normalize: normalizeSortingLayerReorderArgs,
+	},
+
+	// PlayerSettings读取工具
+	{
+		Name:        "player_settings_get",
+		Category:    "project",
+		Description: "Get a snapshot of common PlayerSettings: company/product name, version, default orientation, resolution, and per platform bundle identifier, scripting backend, API compatibility level, IL2CPP configuration, define symbols and whether icons are set",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "platforms", Type: "array", Description: "Platforms to include in the per platform section (default: Standalone, Android, iOS, WebGL)", Items: map[string]interface{}{"type": "string", "enum": playerSettingPlatforms}},
+		},
+	},
+
+	// PlayerSettings设置工具
+	{
+		Name:     "player_settings_set",
+		Category: "project",
+		Description: "Change PlayerSettings. These are project-wide; per platform keys need platform, and bundleIdentifier/scriptingBackend need confirm=true. Returns the updated snapshot. Keys: " +
+			describeSettingKeys(playerSettingKeys),
+		Params: []ParamSpec{
+			{Name: "settings", Type: "object", Description: "Setting keys to new values", Required: true},
+			{Name: "platform", Type: "string", Description: "Platform for per platform keys", Enum: playerSettingPlatforms},
+			{Name: "confirm", Type: "boolean", Description: "Must be true to change bundleIdentifier or scriptingBackend"},
+		},
+		Normalize: normalizePlayerSettingsSetArgs,
+	},
+
+	// 质量设置读取工具
+	{
+		Name:        "quality_settings_get",
+		Category:    "project",
+		Description: "List the quality levels with their shadow, anti-aliasing, vSync, texture, LOD and particle settings, the active level and the default level per platform",
+		ReadOnly:    true,
+	},
+
+	// 质量设置工具
+	{
+		Name:     "quality_settings_set",
+		Category: "project",
+		Description: "Change the settings of one quality level, chosen by name or index, and/or make it the active level. Returns the updated level snapshot. Keys: " +
+			describeSettingKeys(qualitySettingKeys),
+		Params: []ParamSpec{
+			{Name: "level", Type: "string", Description: "Quality level name, e.g. Low"},
+			{Name: "index", Type: "integer", Description: "Quality level index", Minimum: floatPtr(0)},
+			{Name: "settings", Type: "object", Description: "Setting keys to new values"},
+			{Name: "setActive", Type: "boolean", Description: "Make this the active quality level in the editor", Default: false},
+		},
+		Normalize: normalizeQualitySettingsSetArgs,
+	},
+
+	// 项目设置快照工具
+	{
+		Name:        "project_settings_snapshot",
+		Category:    "project",
+		Description: "Snapshot Player, Quality, Physics, Time and Tags/Layers settings as serialized property paths to values, grouped by section. capture saves a snapshot on the server and returns its snapshotId; diff compares a snapshot (by snapshotId, or inline as returned by capture) with the current values and lists the changed paths; restore writes back every value that differs from the snapshot (requires confirm=true) and reports paths that could not be restored. Object references such as icons are not included",
+		TimeoutHint: 60 * time.Second,
+		Params: []ParamSpec{
+			{Name: "operation", Type: "string", Description: "capture, diff or restore", Required: true, Enum: settingsSnapshotOperations},
+			{Name: "sections", Type: "array", Description: "Sections to include (default: all, or all in the snapshot)", Items: map[string]interface{}{"type": "string", "enum": settingsSnapshotSections}},
+			{Name: "snapshotId", Type: "string", Description: "ID of a snapshot returned by capture (diff/restore)"},
+			{Name: "snapshot", Type: "object", Description: "Inline snapshot: a capture result or its settings object (diff/restore)"},
+			{Name: "label", Type: "string", Description: "Note stored with the snapshot (capture)"},
+			{Name: "includeSettings", Type: "boolean", Description: "Return the captured values, not only the snapshotId and counts (capture, default true)"},
+			{Name: "confirm", Type: "boolean", Description: "Must be true for restore"},
+		},
+		Handler:   handleProjectSettingsSnapshot,
+		Normalize: normalizeProjectSettingsSnapshotArgs,
+	},
+
+	// =================== 扩展Prefab工具 ===================
+
+	// 预制体创建工具
+	{
+		Name:        "prefab_create",
+		Category:    "prefab",
+		Description: "Create prefab from scene GameObject",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "prefabPath", Type: "string", Description: "Prefab save path", Required: true},
+			{Name: "overwrite", Type: "boolean", Description: "Whether to overwrite existing prefab", Default: false},
+		},
+	},
+
+	// 预制体信息工具
+	{
+		Name:        "prefab_get_info",
+		Category:    "prefab",
+		Description: "Get detailed prefab information",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "prefabPath", Type: "string", Description: "Prefab asset path"},
+			{Name: "instanceId", Type: "integer", Description: "Prefab instance ID"},
+			{Name: "includeInstances", Type: "boolean", Description: "Whether to include scene instances", Default: false},
+			{Name: "includeVariants", Type: "boolean", Description: "Whether to include variant information", Default: false},
+		},
+	},
+
+	// 预制体修改工具
+	{
+		Name:     "prefab_modify",
+		Category: "prefab",
+		Description: "Manage prefab instance modifications. Operations: apply (push overrides to the prefab asset), revert (discard overrides), " +
+			"unpack (turn the instance into plain GameObjects), disconnect (unpack the outermost prefab only), check_overrides (list overrides)",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "Prefab instance ID", Required: true},
+			{Name: "operation", Type: "string", Description: "Operation to perform", Required: true, Enum: prefabOperations},
+			{Name: "mode", Type: "string", Description: "apply/revert only: all overrides, or the single property given by propertyPath", Enum: []string{"all", "property"}},
+			{Name: "propertyPath", Type: "string", Description: "apply/revert only: serialized property path of the override, e.g. m_LocalPosition.x"},
+			{Name: "completely", Type: "boolean", Description: "unpack only: also unpack nested prefabs (default true)"},
+			{Name: "includeDefaultOverrides", Type: "boolean", Description: "check_overrides only: include default overrides such as the root position and name (default false)"},
+		},
+		Normalize: normalizePrefabModifyArgs,
+	},
+
+	// =================== 场景管理工具 ===================
+
+	// 场景保存工具
+	{
+		Name:        "scene_save",
+		Category:    "scene",
+		Description: "Save current or specified scene",
+		TimeoutHint: 30 * time.Second,
+		Params: []ParamSpec{
+			{Name: "scenePath", Type: "string", Description: "Scene file path to save"},
+			{Name: "saveAsNew", Type: "boolean", Description: "Whether to save as new file", Default: false},
+			{Name: "saveAll", Type: "boolean", Description: "Whether to save all open scenes", Default: false},
+		},
+	},
+
+	// 场景加载工具
+	{
+		Name:        "scene_load",
+		Category:    "scene",
+		Description: "Load specified scene file",
+		TimeoutHint: 30 * time.Second,
+		Params: []ParamSpec{
+			{Name: "scenePath", Type: "string", Description: "Scene file path to load", Required: true},
+			{Name: "loadMode", Type: "string", Description: "Load mode (single/additive)", Default: "single"},
+			{Name: "saveCurrentScene", Type: "boolean", Description: "Whether to save current scene before loading", Default: true},
+		},
+	},
+
+	// 场景信息工具
+	{
+		Name:        "scene_get_info",
+		Category:    "scene",
+		Description: "Get detailed scene information",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "scenePath", Type: "string", Description: "Scene file path"},
+			{Name: "includeObjects", Type: "boolean", Description: "Whether to include object list", Default: false},
+			{Name: "includeComponents", Type: "boolean", Description: "Whether to include component analysis", Default: false},
+			{Name: "analyzePerformance", Type: "boolean", Description: "Whether to analyze performance", Default: false},
+		},
+	},
+
+	// 场景统计工具
+	{
+		Name:        "scene_stats",
+		Category:    "scene",
+		Description: "Performance-oriented statistics for the active scene or a subtree: object counts by depth, vertex/triangle counts by renderer type, material and shader usage, light counts, an estimate of the memory of referenced textures, canvas and UI element counts, and the heaviest meshes and textures with their asset paths. Usage lists are capped by maxEntries and marked truncated",
+		ReadOnly:    true,
+		TimeoutHint: 60 * time.Second,
+		Params: []ParamSpec{
+			{Name: "rootInstanceId", Type: "integer", Description: "Only count this GameObject and its children"},
+			{Name: "includeInactive", Type: "boolean", Description: "Also count inactive objects", Default: true},
+			{Name: "topN", Type: "integer", Description: "Number of heaviest meshes and textures to list", Default: 10, Minimum: floatPtr(0), Maximum: floatPtr(100)},
+			{Name: "maxEntries", Type: "integer", Description: "Maximum entries in the material and shader usage lists", Default: 50, Minimum: floatPtr(1), Maximum: floatPtr(500)},
+		},
+		NarrowBy: []string{"rootInstanceId", "topN", "maxEntries"},
+	},
+
+	// 场景对象查找工具
+	{
+		Name:        "scene_find_objects",
+		Category:    "scene",
+		Description: "Find GameObjects in scene by criteria. All given criteria must match; each hit includes its hierarchy path, active state and a component summary",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "name", Type: "string", Description: "Object name to search for (substring, or wildcard pattern with * and ?)"},
+			{Name: "nameRegex", Type: "string", Description: "Regular expression the object name must match"},
+			{Name: "path", Type: "string", Description: "Hierarchy path pattern, e.g. Level/Spawners/** for everything under Level/Spawners; * and ? match within one level, ** matches any number of levels"},
+			{Name: "tag", Type: "string", Description: "Object tag to filter by"},
+			{Name: "componentType", Type: "string", Description: "Component type to filter by (same as a single-element componentTypes)"},
+			{Name: "componentTypes", Type: "array", Description: "Component types the object must have", Items: map[string]interface{}{"type": "string"}},
+			{Name: "anyComponent", Type: "boolean", Description: "Match objects with any of componentTypes instead of all of them", Default: false},
+			{Name: "layer", Type: "string", Description: "Layer name or number to filter by"},
+			{Name: "activeOnly", Type: "boolean", Description: "Only include objects whose own active flag is set (activeSelf)", Default: false},
+			{Name: "includeInactive", Type: "boolean", Description: "Include objects that are inactive in the hierarchy, e.g. under a disabled parent", Default: true},
+			{Name: "exactMatch", Type: "boolean", Description: "Whether to use exact name matching", Default: false},
+			{Name: "maxResults", Type: "integer", Description: "Maximum number of results (default 100)", Default: 100, Minimum: floatPtr(1), Maximum: floatPtr(1000)},
+			{Name: "scenePath", Type: "string", Description: "Scene path to search in"},
+		},
+		Normalize: normalizeSceneFindArgs,
+	},
+
+	// 层级路径解析工具
+	{
+		Name:        "scene_resolve_path",
+		Category:    "scene",
+		Description: "Resolve a hierarchy path such as Canvas/MainMenu/PlayButton to instanceIds, searching every loaded scene. Tools that require instanceId also accept path directly, so this is only needed to inspect matches or to pick among several",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "path", Type: "string", Description: "Hierarchy path from a root object; * and ? match within one level, ** matches any number of levels", Required: true},
+			{Name: "ambiguity", Type: "string", Description: "What to do when several objects match: error lists the matches, first returns the first in hierarchy order, all returns every match", Default: "error", Enum: []string{"error", "first", "all"}},
+			{Name: "includeInactive", Type: "boolean", Description: "Also match objects that are inactive in the hierarchy", Default: true},
+			{Name: "maxResults", Type: "integer", Description: "Maximum number of matches returned with ambiguity=all", Default: 100, Minimum: floatPtr(1), Maximum: floatPtr(1000)},
+		},
+		Normalize: normalizeResolvePathArgs,
+	},
+
+	// 对象路径查询工具
+	{
+		Name:        "scene_get_path",
+		Category:    "scene",
+		Description: "Get the hierarchy path, scene and sibling index of GameObjects (or the GameObjects of components) by instanceId; unique=false means the path also matches other objects",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "InstanceID of a GameObject or component"},
+			{Name: "instanceIds", Type: "array", Description: "Several InstanceIDs to look up at once", Items: map[string]interface{}{"type": "integer"}},
+		},
+		Normalize: normalizeGetPathArgs,
+	},
+
+	// 场景检查工具
+	{
+		Name:        "scene_validate",
+		Category:    "scene",
+		Description: "Check scenes for common problems: GameObjects with missing scripts, broken or empty object references on project scripts, prefab instances whose source asset is gone, and UI elements outside any Canvas. Scope is the active scene, one scene path, or all scenes in Build Settings (scenes that are not open are opened additively and closed again, so their instanceIds are not usable afterwards). Streams progress per scene. Returns issues with object paths, instanceIds and suggested fixes, plus counts per category",
+		ReadOnly:    true,
+		TimeoutHint: sceneValidateTimeout,
+		Params: []ParamSpec{
+			{Name: "scope", Type: "string", Description: "active, scene (with scenePath) or build", Default: "active", Enum: sceneValidateScopes},
+			{Name: "scenePath", Type: "string", Description: "Scene to check with scope=scene, e.g. Assets/Scenes/Main.unity"},
+			{Name: "categories", Type: "array", Description: "Issue categories to check (default: all): " + strings.Join(sceneIssueCategories, ", "), Items: map[string]interface{}{"type": "string"}},
+			{Name: "maxIssues", Type: "integer", Description: "Maximum number of issues to list; counts always cover every issue", Default: 200, Minimum: floatPtr(1), Maximum: floatPtr(5000)},
+		},
+		NarrowBy:  []string{"maxIssues", "categories"},
+		Normalize: normalizeSceneValidateArgs,
+	},
+
+	// 场景删除对象工具
+	{
+		Name:        "scene_delete_object",
+		Category:    "scene",
+		Description: "Delete one or more GameObjects from scene as a single undoable operation. Returns the deleted hierarchy paths; unknown instanceIds are reported in notFound without failing the others",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID"},
+			{Name: "instanceIds", Type: "array", Description: "InstanceIDs of several GameObjects to delete", Items: map[string]interface{}{"type": "integer"}},
+			{Name: "deleteChildren", Type: "boolean", Description: "Whether to delete children; when false children are moved to the deleted object's parent", Default: true},
+			{Name: "dryRun", Type: "boolean", Description: "Only return the names and paths of the objects that would be deleted", Default: false},
+		},
+		Normalize: normalizeDeleteObjectArgs,
+	},
+
+	// 对象激活状态工具
+	{
+		Name:        "scene_object_set_active",
+		Category:    "scene",
+		Description: "Activate or deactivate a GameObject (undoable). Returns activeSelf and activeInHierarchy",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "active", Type: "boolean", Description: "New active state", Required: true},
+			{Name: "includeChildren", Type: "boolean", Description: "Also set activeSelf on all descendants", Default: false},
+		},
+	},
+
+	// 对象标签工具
+	{
+		Name:        "scene_object_set_tag",
+		Category:    "scene",
+		Description: "Set a GameObject's tag (undoable). Fails for undefined tags unless createIfMissing is set",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "tag", Type: "string", Description: "Tag name, e.g. Player", Required: true},
+			{Name: "createIfMissing", Type: "boolean", Description: "Add the tag to the project's Tag Manager when it does not exist", Default: false},
+		},
+		Normalize: normalizeSetTagArgs,
+	},
+
+	// 对象层级工具
+	{
+		Name:        "scene_object_set_layer",
+		Category:    "scene",
+		Description: "Set a GameObject's layer (undoable) by layer name or index 0-31",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "layer", Type: "string", Description: "Layer name (e.g. UI) or index (e.g. 5)", Required: true},
+			{Name: "includeChildren", Type: "boolean", Description: "Also set the layer of all descendants", Default: false},
+		},
+		Normalize: normalizeSetLayerArgs,
+	},
+
+	// 对象静态标志工具
+	{
+		Name:        "gameobject_set_static",
+		Category:    "scene",
+		Description: "Set a GameObject's static editor flags (undoable) for lightmapping, occlusion culling, batching and navigation: replace, add or remove the given flags, or turn every flag on or off with allStatic. Returns the resulting flags of each changed object",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "flags", Type: "array", Description: "Static flags: " + strings.Join(staticEditorFlags, ", ") + "; an empty array clears all flags in set mode", Items: map[string]interface{}{"type": "string"}},
+			{Name: "mode", Type: "string", Description: "How flags are applied: set replaces the current flags, add and remove change only the given ones", Default: "set", Enum: staticFlagModes},
+			{Name: "allStatic", Type: "boolean", Description: "true sets every static flag, false clears them all; cannot be combined with flags"},
+			{Name: "includeChildren", Type: "boolean", Description: "Also change all descendants", Default: false},
+		},
+		Normalize: normalizeSetStaticArgs,
+	},
+
+	// 对象包围盒工具
+	{
+		Name:        "gameobject_get_bounds",
+		Category:    "scene",
+		Description: "Get the combined world-space bounds (center, size, min, max) of a GameObject's renderers and/or colliders, optionally including its children. Useful for placing objects next to or on top of each other",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "includeChildren", Type: "boolean", Description: "Include the renderers and colliders of all descendants", Default: true},
+			{Name: "source", Type: "string", Description: "Which bounds to combine", Default: "both", Enum: []string{"renderers", "colliders", "both"}},
+			{Name: "includeInactive", Type: "boolean", Description: "Also include the renderers of inactive objects and disabled renderers (disabled colliders have no bounds)", Default: false},
+		},
+	},
+
+	// 对象重命名工具
+	{
+		Name:        "scene_object_rename",
+		Category:    "scene",
+		Description: "Rename a GameObject (undoable). Returns the new name and hierarchy path",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+			{Name: "name", Type: "string", Description: "New name", Required: true},
+			{Name: "makeUnique", Type: "boolean", Description: "Append a counter, e.g. Enemy (1), when a sibling already has this name", Default: false},
+		},
+		Normalize: normalizeRenameArgs,
+	},
+
+	// 对象父级工具
+	{
+		Name:        "scene_object_set_parent",
+		Category:    "scene",
+		Description: "Move a GameObject under a new parent, or to the scene root (undoable). Returns the new hierarchy path and local transform",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "InstanceID of the GameObject to move", Required: true},
+			{Name: "parentInstanceId", Type: "integer", Description: "InstanceID of the new parent; omit, null or 0 for the scene root"},
+			{Name: "worldPositionStays", Type: "boolean", Description: "Keep the world position, rotation and scale; when false the local values are kept instead", Default: true},
+			{Name: "siblingIndex", Type: "integer", Description: "Position among the new siblings (0 = first); defaults to last", Minimum: floatPtr(0)},
+		},
+		Normalize: normalizeSetParentArgs,
+	},
+
+	// 对象复制工具
+	{
+		Name:        "scene_object_duplicate",
+		Category:    "scene",
+		Description: "Duplicate a GameObject like Edit > Duplicate (undoable), keeping prefab connections and overrides. Returns the instanceIds and paths of the copies, with isPrefabInstance for each",
+		Params: []ParamSpec{
+			{Name: "instanceId", Type: "integer", Description: "InstanceID of the GameObject to duplicate", Required: true},
+			{Name: "count", Type: "integer", Description: "Number of copies", Default: 1, Minimum: floatPtr(1), Maximum: floatPtr(100)},
+			{Name: "newName", Type: "string", Description: "Name of the copies; {n} is replaced with the copy number starting at 1, e.g. Spawner_{n}. Defaults to Unity's naming"},
+			{Name: "parentInstanceId", Type: "integer", Description: "InstanceID of the parent for the copies (0 = scene root); defaults to the source's parent"},
+			{Name: "offset", Type: "vector", Components: vectorXYZ, Description: "World offset per copy: copy n is placed at source position + n × offset"},
+		},
+		Normalize: normalizeDuplicateArgs,
+	},
+
+	// =================== 批处理工具 ===================
+
+	// 批量执行工具
+	{
+		Name:        batchToolName,
+		Category:    "batch",
+		Description: fmt.Sprintf("Execute up to %d Unity tool calls in one round trip; returns per-step results, the failed step and skipped steps. Tools the server handles itself (builds, test runs, play mode, script_write, lighting and NavMesh bakes, tag and layer changes, ...) cannot be batched; call them directly", maxBatchSteps),
+		TimeoutHint: 60 * time.Second,
+		Handler:     handleBatch,
+		Params: []ParamSpec{
+			{Name: "steps", Type: "array", Description: "Ordered steps, each {\"action\": tool name, \"params\": tool arguments}", Required: true,
+				Items: map[string]interface{}{
+					"type":     "object",
+					"required": []string{"action"},
+					"properties": map[string]interface{}{
+						"action": map[string]interface{}{"type": "string"},
+						"params": map[string]interface{}{"type": "object"},
+					},
+				}},
+			{Name: "atomic", Type: "boolean", Description: "Wrap all steps in a single Undo group and revert it if a step fails", Default: false},
+			{Name: "stopOnError", Type: "boolean", Description: "Skip remaining steps after a failure (always true when atomic)", Default: true},
+		},
+	},
+
+	// =================== 其他工具 ===================
+
+	// Editor日志工具
+	{
+		Name:        "editor_get_logs",
+		Category:    "editor",
+		Description: "Read Unity Editor Console logs. Each entry has a sequence number; pass the returned nextCursor as afterSequence on the next call to receive only new entries",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "maxLogs", Type: "integer", Description: "Maximum number of logs to retrieve", Minimum: floatPtr(1)},
+			{Name: "logLevel", Type: "string", Description: "Log level filter (all/error/warning/log/exception)", Default: "all"},
+			{Name: "clearLogs", Type: "boolean", Description: "Whether to clear logs after reading", Default: false},
+			{Name: "includeStackTrace", Type: "boolean", Description: "Whether to include stack trace", Default: false},
+			{Name: "afterSequence", Type: "integer", Description: "Cursor: only return entries with a larger sequence number, oldest first", Minimum: floatPtr(0)},
+			{Name: "sinceTimestamp", Type: "string", Description: "Only return entries logged at or after this ISO 8601 timestamp"},
+			{Name: "search", Type: "string", Description: "Only return entries whose message contains this text (case-insensitive)"},
+			{Name: "searchRegex", Type: "boolean", Description: "Treat search as a regular expression", Default: false},
+			{Name: "collapse", Type: "boolean", Description: "Merge identical messages into one entry with a count", Default: false},
+			{Name: "excludeBridgeLogs", Type: "boolean", Description: "Skip messages logged by the MCP bridge itself", Default: false},
+		},
+		Normalize: normalizeEditorLogsArgs,
+	},
+
+	// 编辑器信息工具
+	{
+		Name:        "unity_info",
+		Category:    "editor",
+		Description: "Get Unity version, project name, editor platform, active build target, render pipeline (Built-in/URP/HDRP), play mode state and the versions of key packages (TextMeshPro, Input System, URP, HDRP, ...). Cheap: the result is cached for the session and refreshed after reconnects and play mode changes. Call it first to choose APIs that match the editor version and pipeline",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "refresh", Type: "boolean", Description: "Query Unity even when a cached result exists", Default: false},
+		},
+		Handler: handleUnityInfo,
+	},
+
+	// Console统计工具
+	{
+		Name:        "console_stats",
+		Category:    "editor",
+		Description: "Count errors, warnings and logs in the Unity Console and report the most recent error, without fetching log bodies. Much cheaper than editor_get_logs for \"are there new errors?\" checks: pass a previous lastSequence as afterSequence to get newErrors/newWarnings/newLogs since then",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "afterSequence", Type: "integer", Description: "Also count entries logged after this sequence number (lastSequence of an earlier call, or nextCursor of editor_get_logs)", Minimum: floatPtr(0)},
+		},
+		Inspect: recordConsoleStats,
+	},
+	{
+		Name:        "console_clear",
+		Category:    "editor",
+		Description: "Clear the Unity Console and the server's log buffer (editor_get_logs sequence numbers keep increasing). With collapseOnly, nothing is cleared: duplicate entries are dropped from the log buffer and the Console's Collapse option is turned on. Returns the counts after clearing",
+		Params: []ParamSpec{
+			{Name: "collapseOnly", Type: "boolean", Description: "Only merge repeated messages instead of clearing everything", Default: false},
+		},
+		Inspect: recordConsoleStats,
+	},
+
+	// 播放模式工具
+	{
+		Name:        "editor_play_mode",
+		Category:    "editor",
+		Description: "Control Unity play mode: play, stop, pause, resume, step (one frame) or status. play and stop wait until the change has completed, reconnecting after the domain reload that entering play mode usually triggers, and report domainReloaded",
+		TimeoutHint: 120 * time.Second,
+		Params: []ParamSpec{
+			{Name: "operation", Type: "string", Description: "Operation to perform", Required: true, Enum: playModeOperations},
+		},
+		Handler: handlePlayMode,
+	},
+
+	// 测试运行工具
+	{
+		Name:        "editor_run_tests",
+		Category:    "editor",
+		Description: "Run Unity Test Runner tests and wait for the result: passed/failed/skipped counts and each failure's test name, message and stack trace. Progress is reported while tests run; on timeout the run is canceled and partial results are returned with aborted=true",
+		TimeoutHint: 120 * time.Second,
+		Params: []ParamSpec{
+			{Name: "mode", Type: "string", Description: "Test mode", Default: "editmode", Enum: testModes},
+			{Name: "testFilter", Type: "array", Description: "Tests to run: full-name prefixes (namespace, class or test), or category:<name> for test categories. Runs all tests when omitted", Items: map[string]interface{}{"type": "string"}},
+			{Name: "timeoutSeconds", Type: "integer", Description: "Maximum time to wait for the run", Default: 600, Minimum: floatPtr(5), Maximum: floatPtr(7200)},
+		},
+		Handler:   handleRunTests,
+		Normalize: normalizeRunTestsArgs,
+	},
+
+	// 编辑器忙碌状态工具
+	{
+		Name:        "editor_get_busy_state",
+		Category:    "editor",
+		Description: "Report whether the Unity editor is busy compiling scripts, importing assets or changing play mode, with idle=true when none of these is in progress",
+		ReadOnly:    true,
+	},
+	{
+		Name:        "editor_wait_for_idle",
+		Category:    "editor",
+		Description: "Wait until the Unity editor has finished compiling, importing and domain reloading. Returns idle, elapsedMs and timedOut; when a compilation finished during the wait, compile holds its errors. Use after changes that trigger recompilation or reimport",
+		ReadOnly:    true,
+		TimeoutHint: 600 * time.Second,
+		Params: []ParamSpec{
+			{Name: "maxWaitSeconds", Type: "integer", Description: "Maximum time to wait", Default: 60, Minimum: floatPtr(1), Maximum: floatPtr(600)},
+		},
+		Handler: handleWaitForIdle,
+	},
+
+	// 截图工具
+	{
+		Name:        "editor_take_screenshot",
+		Category:    "editor",
+		Description: "Capture the Game view or Scene view as PNG. Without savePath the image is returned as image content; with savePath it is written inside the project. Returns the actual resolution and the camera used. The game view is rendered from its camera, so Screen Space - Overlay UI is not included. Not available in read-only mode, because savePath writes into the project",
+		TimeoutHint: 60 * time.Second,
+		Params: []ParamSpec{
+			{Name: "view", Type: "string", Description: "View to capture", Default: "game", Enum: screenshotViews},
+			{Name: "width", Type: "integer", Description: "Image width in pixels; defaults to the view size, or keeps the view's aspect ratio when only height is given. Larger than the view supersamples the game view", Minimum: floatPtr(16), Maximum: floatPtr(maxScreenshotResolution)},
+			{Name: "height", Type: "integer", Description: "Image height in pixels; defaults to the view size, or keeps the view's aspect ratio when only width is given", Minimum: floatPtr(16), Maximum: floatPtr(maxScreenshotResolution)},
+			{Name: "camera", Type: "string", Description: "Name of the camera to render for the game view (default: MainCamera, else the enabled camera with the highest depth)"},
+			{Name: "savePath", Type: "string", Description: "PNG path relative to the project root (e.g. Assets/Screenshots/menu.png); when omitted the image is returned in the result"},
+		},
+		Normalize: normalizeScreenshotArgs,
+	},
+
+	// 选择工具
+	{
+		Name:        "editor_selection_get",
+		Category:    "editor",
+		Description: "Get the objects selected in the Unity editor: instanceId, name and type of each, with the hierarchy path for scene objects and the asset path for Project window selections",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "maxResults", Type: "integer", Description: "Maximum number of selected objects to describe", Default: 200, Minimum: floatPtr(1), Maximum: floatPtr(maxSelectionObjects)},
+		},
+	},
+	{
+		Name:        "editor_selection_set",
+		Category:    "editor",
+		Description: "Select objects in the Unity editor by instance ID and/or asset path. Replaces the selection unless additive is true; an empty list with additive=false clears it. frame centers scene objects in the Scene view and pings assets in the Project window",
+		Params: []ParamSpec{
+			{Name: "instanceIds", Type: "array", Description: "Instance IDs of scene objects or assets to select", Items: map[string]interface{}{"type": "integer"}},
+			{Name: "assetPaths", Type: "array", Description: "Asset paths to select, e.g. Assets/Prefabs/Player.prefab", Items: map[string]interface{}{"type": "string"}},
+			{Name: "additive", Type: "boolean", Description: "Add to the current selection instead of replacing it", Default: false},
+			{Name: "frame", Type: "boolean", Description: "Frame the selection in the Scene view", Default: false},
+		},
+		Normalize: normalizeSelectionSetArgs,
+	},
+
+	// Scene视图控制工具
+	{
+		Name:        "scene_view_control",
+		Category:    "editor",
+		Description: "Control the Scene view camera so the user can see what changed: get reports the current pivot, rotation, size and mode; frame centers a GameObject (like pressing F); look_at moves the pivot, rotation and/or zoom size; set_mode switches 2D/3D and perspective/orthographic. Every operation returns the resulting view state. Follow with editor_take_screenshot view=scene to check the result visually",
+		Params: []ParamSpec{
+			{Name: "operation", Type: "string", Description: "Operation to perform", Required: true, Enum: sceneViewOperations},
+			{Name: "instanceId", Type: "integer", Description: "GameObject to frame (frame)"},
+			{Name: "position", Type: "vector", Components: vectorXYZ, Description: "New pivot, the point the view orbits around (look_at); omitted components keep their current value"},
+			{Name: "rotation", Type: "vector", Components: vectorXYZ, Description: "New view rotation as Euler angles in degrees (look_at); omitted components keep their current value"},
+			{Name: "size", Type: "number", Description: "Zoom: half the visible height around the pivot (look_at)", Minimum: floatPtr(0.001)},
+			{Name: "in2DMode", Type: "boolean", Description: "Switch the Scene view to 2D (true) or 3D (false) (set_mode)"},
+			{Name: "orthographic", Type: "boolean", Description: "Use an orthographic (true) or perspective (false) camera (set_mode, look_at)"},
+			{Name: "instant", Type: "boolean", Description: "Jump to the new view instead of animating", Default: true},
+		},
+		Normalize: normalizeSceneViewArgs,
+	},
+
+	// 打开资源工具
+	{
+		Name:        "editor_open_asset",
+		Category:    "editor",
+		Description: "Open an asset the way double-clicking it in the Project window does: scripts and text files open in the user's external code editor (optionally at line/column), scenes open in the editor and prefabs open in prefab mode. Returns opened=true when Unity handled it. Refuses to switch scenes while the open scenes have unsaved changes",
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Asset path, e.g. Assets/Scripts/Player.cs or Assets/Scenes/Main.unity", Required: true},
+			{Name: "line", Type: "integer", Description: "Line to place the cursor on (scripts and text files)", Minimum: floatPtr(1)},
+			{Name: "column", Type: "integer", Description: "Column to place the cursor on, requires line", Minimum: floatPtr(1)},
+		},
+		Handler:   handleOpenAsset,
+		Normalize: normalizeOpenAssetArgs,
+	},
+
+	// 包管理工具
+	{
+		Name:        "package_list",
+		Category:    "package",
+		Description: "List installed Unity Package Manager packages with version, source and whether a newer compatible version is available",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "includeIndirect", Type: "boolean", Description: "Include packages installed only as dependencies of other packages", Default: false},
+			{Name: "includeBuiltIn", Type: "boolean", Description: "Include built-in modules (com.unity.modules.*)", Default: false},
+		},
+	},
+	{
+		Name:        "package_add",
+		Category:    "package",
+		Description: "Install a package with the Unity Package Manager by name (optionally with version) or git URL, and wait until it is installed and Unity has recompiled. Returns the installed version; Package Manager errors are returned as tool errors",
+		TimeoutHint: 600 * time.Second,
+		Params: []ParamSpec{
+			{Name: "name", Type: "string", Description: "Package name, e.g. com.unity.inputsystem"},
+			{Name: "version", Type: "string", Description: "Package version, e.g. 1.7.0 (default: the version recommended for this editor)"},
+			{Name: "gitUrl", Type: "string", Description: "Git or local package URL, e.g. https://github.com/user/repo.git#v1.0.0"},
+			{Name: "timeoutSeconds", Type: "integer", Description: "Maximum time to wait for the installation and recompilation", Default: 300, Minimum: floatPtr(10), Maximum: floatPtr(1800)},
+		},
+		Handler:   packageOperationHandler("package_add"),
+		Normalize: normalizePackageAddArgs,
+	},
+	{
+		Name:        "package_remove",
+		Category:    "package",
+		Description: "Remove an installed package with the Unity Package Manager and wait until Unity has recompiled. Requires confirm=true",
+		TimeoutHint: 600 * time.Second,
+		Params: []ParamSpec{
+			{Name: "name", Type: "string", Description: "Package name, e.g. com.unity.cinemachine", Required: true},
+			{Name: "confirm", Type: "boolean", Description: "Must be true to remove the package", Required: true},
+			{Name: "timeoutSeconds", Type: "integer", Description: "Maximum time to wait for the removal and recompilation", Default: 300, Minimum: floatPtr(10), Maximum: floatPtr(1800)},
+		},
+		Handler:   packageOperationHandler("package_remove"),
+		Normalize: normalizePackageRemoveArgs,
+	},
+
+	// =================== 构建工具 ===================
+
+	// 构建播放器工具
+	{
+		Name:        "build_player",
+		Category:    "build",
+		Description: "Build the player for a target platform. Runs synchronously in Unity and can take many minutes; progress is reported per build stage. Returns the BuildReport summary: result, total size, duration, warnings and errors per step, and the output path. Only one build runs at a time. Requires confirm=true",
+		TimeoutHint: buildPlayerTimeout,
+		Params: []ParamSpec{
+			{Name: "target", Type: "string", Description: "Build target", Required: true, Enum: buildTargets},
+			{Name: "outputPath", Type: "string", Description: "Output location relative to the project folder or absolute, e.g. Builds/Win/Game.exe; Windows needs .exe, macOS .app, Android .apk or .aab, other targets a folder", Required: true},
+			{Name: "development", Type: "boolean", Description: "Development build", Default: false},
+			{Name: "scenes", Type: "array", Description: "Scene paths to build, in order (default: the scenes enabled in Build Settings)", Items: map[string]interface{}{"type": "string"}},
+			{Name: "options", Type: "array", Description: "Extra BuildOptions flags: " + strings.Join(buildOptions, ", "), Items: map[string]interface{}{"type": "string"}},
+			{Name: "confirm", Type: "boolean", Description: "Must be true to start the build", Required: true},
+		},
+		Handler:   handleBuildPlayer,
+		Normalize: normalizeBuildPlayerArgs,
+	},
+
+	// AssetBundle工具
+	{
+		Name:        "assetbundle_set_name",
+		Category:    "build",
+		Description: "Assign an asset or folder to an AssetBundle (with optional variant), or clear the assignment with an empty bundleName. Names are stored lowercase. Returns the previous and new assignment",
+		Params: []ParamSpec{
+			{Name: "assetPath", Type: "string", Description: "Asset or folder path, e.g. Assets/Prefabs/Enemy.prefab", Required: true},
+			{Name: "bundleName", Type: "string", Description: "AssetBundle name, may contain / groups, e.g. characters/enemy; empty string clears the assignment", Required: true},
+			{Name: "variant", Type: "string", Description: "AssetBundle variant, e.g. hd"},
+		},
+		Normalize: normalizeAssetBundleSetNameArgs,
+	},
+	{
+		Name:        "assetbundle_list",
+		Category:    "build",
+		Description: "List the AssetBundle names in the project with the asset paths assigned to each and their bundle dependencies, plus names no asset uses any more",
+		ReadOnly:    true,
+		Params: []ParamSpec{
+			{Name: "bundleName", Type: "string", Description: "Only list this bundle (name or name.variant)"},
+		},
+	},
+	{
+		Name:        "assetbundle_build",
+		Category:    "build",
+		Description: "Build AssetBundles for a target platform. Progress is reported while building. Returns a manifest summary: each bundle's name, size, hash and dependencies, and the total size. Shares the build lock with build_player, so only one build runs at a time. Requires confirm=true",
+		TimeoutHint: assetBundleBuildTimeout,
+		Params: []ParamSpec{
+			{Name: "outputPath", Type: "string", Description: "Output folder relative to the project folder or absolute, e.g. AssetBundles/Android; must not be inside Assets/", Required: true},
+			{Name: "target", Type: "string", Description: "Build target", Required: true, Enum: buildTargets},
+			{Name: "compression", Type: "string", Description: "Compression: LZ4 (chunk based, fast loading), LZMA (smallest) or Uncompressed", Default: "LZ4", Enum: assetBundleCompressions},
+			{Name: "bundles", Type: "array", Description: "Bundle names to build (default: every bundle in the project)", Items: map[string]interface{}{"type": "string"}},
+			{Name: "forceRebuild", Type: "boolean", Description: "Rebuild every bundle even if nothing changed", Default: false},
+			{Name: "strictMode", Type: "boolean", Description: "Fail the build if any error is reported", Default: false},
+			{Name: "confirm", Type: "boolean", Description: "Must be true to start the build", Required: true},
+		},
+		Handler:   handleAssetBundleBuild,
+		Normalize: normalizeAssetBundleBuildArgs,
+	},
+}
@@ -0,0 +1,269 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/mark3labs/mcp-go/mcp"
+	"github.com/mark3labs/mcp-go/server"
+)
+
+// callResult tools/call结果中测试关心的字段
+type callResult struct {
+	IsError bool `json:"isError"`
+	Content []struct {
+		Text string `json:"text"`
+	} `json:"content"`
+	StructuredContent toolError `json:"structuredContent"`
+}
+
+// callTool 通过MCP服务器调用工具
+func callTool(t *testing.T, s *server.MCPServer, name string, arguments map[string]interface{}) callResult {
+	t.Helper()
+	var result callResult
+	raw := mcpCall(t, s, "tools/call", map[string]interface{}{"name": name, "arguments": arguments})
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestHandlerPanicIsRecovered(t *testing.T) {
+	useState(t, nil)
+	s := newTestMCPServer()
+	panicking := &ToolDefinition{
+		Name:     "test_panic",
+		Category: "test",
+		ReadOnly: true,
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var m map[string]int
+			m["boom"]++ // 写入nil map触发panic
+			return nil, nil
+		},
+	}
+	healthy := &ToolDefinition{
+		Name:     "test_ok",
+		Category: "test",
+		ReadOnly: true,
+		Handler: func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			return mcp.NewToolResultText("ok"), nil
+		},
+	}
+	s.AddTool(panicking.MCPTool(), panicking.handler())
+	s.AddTool(healthy.MCPTool(), healthy.handler())
+
+	for i := 0; i < 2; i++ {
+		result := callTool(t, s, "test_panic", nil)
+		if !result.IsError {
+			t.Fatalf("call %d: panicking handler should return an error result", i)
+		}
+		if result.StructuredContent.Code != errCodeInternal || result.StructuredContent.UnityAction != "test_panic" {
+			t.Errorf("call %d: unexpected error %+v", i, result.StructuredContent)
+		}
+	}
+
+	// panic之后服务器继续处理其他调用
+	if result := callTool(t, s, "test_ok", nil); result.IsError || len(result.Content) == 0 || result.Content[0].Text != "ok" {
+		t.Errorf("server stopped serving after a panic: %+v", result)
+	}
+	var list struct {
+		Tools []mcp.Tool `json:"tools"`
+	}
+	if err := json.Unmarshal(mcpCall(t, s, "tools/list", map[string]interface{}{}), &list); err != nil || len(list.Tools) == 0 {
+		t.Errorf("tools/list failed after a panic: %v", err)
+	}
+
+	stats.mu.Lock()
+	panics := stats.tools["test_panic"].panics
+	stats.mu.Unlock()
+	if panics != 2 {
+		t.Errorf("recorded %d panics, want 2", panics)
+	}
+}
+
+func TestSceneTransformSetSchema(t *testing.T) {
+	// 检查客户端实际收到的JSON，而不是内部的map
+	payload, err := json.Marshal(lookupDefinition(t, "scene_transform_set").MCPTool())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var tool struct {
+		InputSchema struct {
+			Properties map[string]struct {
+				Type    string        `json:"type"`
+				Default interface{}   `json:"default"`
+				AnyOf   []vectorShape `json:"anyOf"`
+			} `json:"properties"`
+			Required []string `json:"required"`
+		} `json:"inputSchema"`
+	}
+	if err := json.Unmarshal(payload, &tool); err != nil {
+		t.Fatal(err)
+	}
+	schema := tool.InputSchema
+
+	// instanceId和path二选一，由validateArguments检查，schema中都不是必需的
+	if len(schema.Required) != 0 {
+		t.Errorf("required = %v, want none since instanceId or path identifies the target", schema.Required)
+	}
+	if got := schema.Properties["instanceId"].Type; got != "integer" {
+		t.Errorf("instanceId type = %q, want integer", got)
+	}
+	if got := schema.Properties["path"].Type; got != "string" {
+		t.Errorf("path type = %q, want string", got)
+	}
+	if got := schema.Properties["worldSpace"].Default; got != true {
+		t.Errorf("worldSpace default = %v, want true", got)
+	}
+
+	for _, name := range []string{"position", "rotation", "scale"} {
+		anyOf := schema.Properties[name].AnyOf
+		if len(anyOf) != 2 {
+			t.Fatalf("%s: anyOf has %d alternatives, want object and array", name, len(anyOf))
+		}
+		object, array := anyOf[0], anyOf[1]
+		if object.Type != "object" || object.AdditionalProperties == nil || *object.AdditionalProperties {
+			t.Errorf("%s: first alternative should be a closed object, got %+v", name, object)
+		}
+		if len(object.Properties) != 3 {
+			t.Errorf("%s: object has components %v, want x, y, z", name, object.Properties)
+		}
+		for _, c := range vectorXYZ {
+			if object.Properties[c].Type != "number" {
+				t.Errorf("%s.%s type = %q, want number", name, c, object.Properties[c].Type)
+			}
+		}
+		// 省略的分量保持原值，所以对象形式不能要求分量
+		if len(object.Required) != 0 {
+			t.Errorf("%s: object should not require components, got %v", name, object.Required)
+		}
+		if array.Type != "array" || array.Items.Type != "number" || array.MinItems != 3 || array.MaxItems != 3 {
+			t.Errorf("%s: second alternative should be an array of 3 numbers, got %+v", name, array)
+		}
+	}
+}
+
+func TestSceneTransformSetArguments(t *testing.T) {
+	def := lookupDefinition(t, "scene_transform_set")
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		wantErr   bool
+	}{
+		{"instanceId", map[string]interface{}{"instanceId": 1.0, "position": map[string]interface{}{"x": 1.0}}, false},
+		{"path", map[string]interface{}{"path": "Player", "scale": []interface{}{1.0, 2.0, 3.0}}, false},
+		{"no target", map[string]interface{}{"position": map[string]interface{}{"x": 1.0}}, true},
+		{"both targets", map[string]interface{}{"instanceId": 1.0, "path": "Player"}, true},
+		{"unknown component", map[string]interface{}{"instanceId": 1.0, "position": map[string]interface{}{"w": 1.0}}, true},
+		{"short array", map[string]interface{}{"instanceId": 1.0, "rotation": []interface{}{1.0, 2.0}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := def.validateArguments(tt.arguments)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateArguments(%v) error = %v, wantErr %v", tt.arguments, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSceneTransformSetForwardsVectorObjects(t *testing.T) {
+	useState(t, nil)
+	unity := startFakeUnity(t, func(n int, request map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{"success": true, "data": map[string]interface{}{}}
+	})
+
+	handler := lookupDefinition(t, "scene_transform_set").handler()
+	result, err := handler(context.Background(), toolRequest("scene_transform_set", map[string]interface{}{
+		"instanceId": 42.0,
+		"position":   []interface{}{1.0, 2.0, 3.0},
+		"rotation":   map[string]interface{}{"y": "90"},
+		"relative":   true,
+	}))
+	if err != nil || result.IsError {
+		t.Fatalf("call failed: %v %s", err, resultText(result))
+	}
+
+	// Unity端按 {x, y, z} 对象读取向量，数组形式和字符串分量必须在Go端转换
+	var params map[string]interface{}
+	unity.mu.Lock()
+	for _, request := range unity.requests {
+		if request["action"] == "scene_transform_set" {
+			params, _ = request["params"].(map[string]interface{})
+		}
+	}
+	unity.mu.Unlock()
+	if params == nil {
+		t.Fatal("Unity did not receive scene_transform_set")
+	}
+	want := map[string]interface{}{
+		"instanceId": 42.0,
+		"position":   map[string]interface{}{"x": 1.0, "y": 2.0, "z": 3.0},
+		"rotation":   map[string]interface{}{"y": 90.0},
+		"relative":   true,
+	}
+	for key, value := range want {
+		if !reflect.DeepEqual(params[key], value) {
+			t.Errorf("params[%s] = %#v, want %#v", key, params[key], value)
+		}
+	}
+	if _, ok := params["scale"]; ok {
+		t.Error("omitted scale must not be sent, Unity keeps the current value")
+	}
+}
+
+func TestUnityErrorResult(t *testing.T) {
+	useState(t, nil)
+	actionErr := &unityActionError{Action: "scene_get_info", Message: "scene not loaded"}
+	tests := []struct {
+		name    string
+		err     error
+		code    string
+		message string
+	}{
+		{"tool failed", actionErr, errCodeUnityToolFailed, "scene not loaded"},
+		{"wrapped tool failure", fmt.Errorf("query: %w", actionErr), errCodeUnityToolFailed, "scene not loaded"},
+		{"communication failed", errors.New("connection refused"), errCodeUnityUnavailable, "connection refused"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := unityErrorResult(context.Background(), "scene_get_info", tt.err)
+			if err != nil {
+				t.Fatal(err)
+			}
+			structured, _ := result.StructuredContent.(toolError)
+			if !result.IsError || structured.Code != tt.code || structured.Message != tt.message {
+				t.Errorf("unityErrorResult(%v) = %+v, want code %s message %q", tt.err, structured, tt.code, tt.message)
+			}
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// Unity已返回的执行失败优先于取消
+	if result, err := unityErrorResult(ctx, "scene_get_info", actionErr); err != nil || !result.IsError {
+		t.Errorf("tool failure after cancellation = %+v, %v, want an error result", result, err)
+	}
+	if result, err := unityErrorResult(ctx, "scene_get_info", errors.New("connection closed")); result != nil || err != context.Canceled {
+		t.Errorf("communication failure after cancellation = %+v, %v, want nil, context.Canceled", result, err)
+	}
+}
+
+// vectorShape 向量参数schema中anyOf的一个分支
+type vectorShape struct {
+	Type       string `json:"type"`
+	Properties map[string]struct {
+		Type string `json:"type"`
+	} `json:"properties"`
+	Required             []string `json:"required"`
+	AdditionalProperties *bool    `json:"additionalProperties"`
+	Items                struct {
+		Type string `json:"type"`
+	} `json:"items"`
+	MinItems int `json:"minItems"`
+	MaxItems int `json:"maxItems"`
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// maxTransformBatch transform_batch_set 一次最多设置的条目数，Unity在一帧内完成全部修改
+const maxTransformBatch = 500
+
+// transformBatchFields transform_batch_set 条目可用的字段
+var transformBatchFields = []string{"instanceId", "path", "position", "rotation", "scale", "worldSpace"}
+
+// transformBatchItems entries数组元素的JSON Schema
+var transformBatchItems = map[string]interface{}{
+	"type": "object",
+	"properties": map[string]interface{}{
+		"instanceId": map[string]interface{}{"type": "integer", "description": "GameObject's InstanceID"},
+		"path":       map[string]interface{}{"type": "string", "description": "Hierarchy path instead of instanceId; must match exactly one object"},
+		"position":   map[string]interface{}{"type": "object", "description": "Position {x,y,z}; omitted components keep their current value"},
+		"rotation":   map[string]interface{}{"type": "object", "description": "Rotation as Euler angles {x,y,z} in degrees; omitted components keep their current value"},
+		"scale":      map[string]interface{}{"type": "object", "description": "Local scale {x,y,z}; omitted components keep their current value"},
+		"worldSpace": map[string]interface{}{"type": "boolean", "description": "Overrides the call's worldSpace for this entry"},
+	},
+}
+
+// normalizeTransformBatchSetArgs 检查每个条目: instanceId或path之一、至少一个position/rotation/scale，
+// 向量转换为 {x,y,z}；超过maxTransformBatch时拒绝并提示分批
+func normalizeTransformBatchSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	entries, _ := arguments["entries"].([]interface{})
+	switch {
+	case len(entries) == 0:
+		return nil, fmt.Errorf("entries must not be empty")
+	case len(entries) > maxTransformBatch:
+		return nil, fmt.Errorf("entries has %d elements, at most %d can be set in one call; split them into several calls", len(entries), maxTransformBatch)
+	}
+
+	vector := ParamSpec{Type: "vector", Components: vectorXYZ}
+	var problems []string
+	for i, item := range entries {
+		label := fmt.Sprintf("entries[%d]", i)
+		entry, ok := item.(map[string]interface{})
+		if !ok {
+			problems = append(problems, label+" must be an object")
+			continue
+		}
+		for _, key := range slices.Sorted(maps.Keys(entry)) {
+			if !slices.Contains(transformBatchFields, key) {
+				problems = append(problems, fmt.Sprintf("%s.%s is not a field (fields: %s)", label, key, strings.Join(transformBatchFields, ", ")))
+			}
+		}
+
+		id, hasId := entry["instanceId"]
+		targetPath, hasPath := entry["path"]
+		switch {
+		case hasId == hasPath:
+			problems = append(problems, label+" needs exactly one of instanceId or path")
+		case hasId:
+			n, err := toNumber(id)
+			if err == nil {
+				entry["instanceId"], err = toInteger(n)
+			}
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s.instanceId: %v", label, err))
+			}
+		default:
+			if s, isString := targetPath.(string); !isString || strings.Trim(s, "/ ") == "" {
+				problems = append(problems, label+".path must be a non-empty hierarchy path")
+			}
+		}
+
+		changes := 0
+		for _, key := range []string{"position", "rotation", "scale"} {
+			value, ok := entry[key]
+			if !ok {
+				continue
+			}
+			changes++
+			coerced, err := vector.coerce(value)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s.%s: %v", label, key, err))
+				continue
+			}
+			entry[key] = coerced
+		}
+		if changes == 0 {
+			problems = append(problems, label+" needs at least one of position, rotation or scale")
+		}
+		if worldSpace, ok := entry["worldSpace"]; ok {
+			if _, isBool := worldSpace.(bool); !isBool {
+				problems = append(problems, label+".worldSpace must be a boolean")
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid transform_batch_set entries: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
@@ -0,0 +1,412 @@
+package main
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// rectPreset RectTransform的锚点预设，对应Unity Inspector中的Anchor Presets
+type rectPreset struct {
+	Name      string
+	AnchorMin [2]float64
+	AnchorMax [2]float64
+}
+
+// ui_rect_transform_set 的 preset 参数，在Go端展开为anchorMin/anchorMax后再发送到Unity
+var rectPresets = []rectPreset{
+	{"top-left", [2]float64{0, 1}, [2]float64{0, 1}},
+	{"top", [2]float64{0.5, 1}, [2]float64{0.5, 1}},
+	{"top-right", [2]float64{1, 1}, [2]float64{1, 1}},
+	{"left", [2]float64{0, 0.5}, [2]float64{0, 0.5}},
+	{"center", [2]float64{0.5, 0.5}, [2]float64{0.5, 0.5}},
+	{"right", [2]float64{1, 0.5}, [2]float64{1, 0.5}},
+	{"bottom-left", [2]float64{0, 0}, [2]float64{0, 0}},
+	{"bottom", [2]float64{0.5, 0}, [2]float64{0.5, 0}},
+	{"bottom-right", [2]float64{1, 0}, [2]float64{1, 0}},
+	{"stretch", [2]float64{0, 0}, [2]float64{1, 1}},
+	{"stretch-horizontal", [2]float64{0, 0.5}, [2]float64{1, 0.5}},
+	{"stretch-vertical", [2]float64{0.5, 0}, [2]float64{0.5, 1}},
+}
+
+// rectPresetNames 返回所有预设名称，用于参数的enum
+func rectPresetNames() []string {
+	names := make([]string, len(rectPresets))
+	for i, p := range rectPresets {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// findRectPreset 按名称查找锚点预设
+func findRectPreset(name string) (rectPreset, bool) {
+	for _, preset := range rectPresets {
+		if preset.Name == name {
+			return preset, true
+		}
+	}
+	return rectPreset{}, false
+}
+
+// normalizeRectTransformArgs 展开preset并检查锚点
+// 同时给出preset和anchorMin/anchorMax时，显式给出的分量优先
+func normalizeRectTransformArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	if name, ok := arguments["preset"].(string); ok {
+		delete(arguments, "preset")
+		if preset, ok := findRectPreset(name); ok {
+			arguments["anchorMin"] = mergeVector2(preset.AnchorMin, arguments["anchorMin"])
+			arguments["anchorMax"] = mergeVector2(preset.AnchorMax, arguments["anchorMax"])
+		}
+	}
+
+	anchorMin, _ := arguments["anchorMin"].(map[string]interface{})
+	anchorMax, _ := arguments["anchorMax"].(map[string]interface{})
+	for _, c := range vectorXY {
+		lo, okMin := anchorMin[c].(float64)
+		hi, okMax := anchorMax[c].(float64)
+		if okMin && okMax && lo > hi {
+			return nil, fmt.Errorf("anchorMin.%s (%v) must not be greater than anchorMax.%s (%v)", c, lo, c, hi)
+		}
+	}
+	return arguments, nil
+}
+
+// mergeVector2 用显式给出的分量覆盖预设值
+func mergeVector2(base [2]float64, override interface{}) map[string]interface{} {
+	merged := map[string]interface{}{"x": base[0], "y": base[1]}
+	if values, ok := override.(map[string]interface{}); ok {
+		for key, value := range values {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// colorComponents 颜色分量，Unity的Color取值为0~1
+var colorComponents = []string{"r", "g", "b", "a"}
+
+// colorFormatHint 颜色参数说明中接受的格式
+const colorFormatHint = " as #RRGGBB, #RRGGBBAA, [r, g, b(, a)] or {r, g, b, a} with components in 0-1"
+
+// colorSchema 颜色参数的JSON Schema: 十六进制字符串、RGBA数组或部分分量的对象
+func colorSchema() map[string]interface{} {
+	properties := make(map[string]interface{}, len(colorComponents))
+	for _, c := range colorComponents {
+		properties[c] = map[string]interface{}{"type": "number", "minimum": 0, "maximum": 1}
+	}
+	return map[string]interface{}{
+		"anyOf": []interface{}{
+			map[string]interface{}{"type": "string", "pattern": "^#([0-9A-Fa-f]{6}|[0-9A-Fa-f]{8})$"},
+			map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "number", "minimum": 0, "maximum": 1},
+				"minItems": 3, "maxItems": 4},
+			map[string]interface{}{"type": "object", "properties": properties, "additionalProperties": false},
+		},
+	}
+}
+
+// parseColor 把颜色参数转换为Unity端使用的 {"r", "g", "b", "a"} 对象
+// 未给出alpha (#RRGGBB、三元素数组) 或对象中省略的分量由Unity保持当前值
+func parseColor(value interface{}) (map[string]interface{}, error) {
+	color := make(map[string]interface{}, len(colorComponents))
+	switch v := value.(type) {
+	case string:
+		hex := strings.TrimPrefix(v, "#")
+		if len(hex) != 6 && len(hex) != 8 || len(hex) == len(v) {
+			return nil, fmt.Errorf("expected #RRGGBB or #RRGGBBAA, got %q", v)
+		}
+		for i := 0; i < len(hex); i += 2 {
+			n, err := strconv.ParseUint(hex[i:i+2], 16, 8)
+			if err != nil {
+				return nil, fmt.Errorf("expected #RRGGBB or #RRGGBBAA, got %q", v)
+			}
+			color[colorComponents[i/2]] = float64(n) / 255
+		}
+	case []interface{}:
+		if len(v) != 3 && len(v) != 4 {
+			return nil, fmt.Errorf("expected [r, g, b] or [r, g, b, a], got %d elements", len(v))
+		}
+		for i, component := range v {
+			if err := setColorComponent(color, colorComponents[i], component); err != nil {
+				return nil, err
+			}
+		}
+	case map[string]interface{}:
+		for key, component := range v {
+			if !slices.Contains(colorComponents, key) {
+				return nil, fmt.Errorf("unknown color component %q, expected r, g, b, a", key)
+			}
+			if err := setColorComponent(color, key, component); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("expected color string, array or object, got %s", jsonTypeName(value))
+	}
+	return color, nil
+}
+
+// setColorComponent 检查并设置一个0~1范围内的颜色分量
+func setColorComponent(color map[string]interface{}, name string, value interface{}) error {
+	n, err := toNumber(value)
+	if err != nil {
+		return fmt.Errorf("color component %s: %w", name, err)
+	}
+	if n < 0 || n > 1 {
+		return fmt.Errorf("color component %s must be within 0-1, got %v (use #RRGGBB for 0-255 values)", name, n)
+	}
+	color[name] = n
+	return nil
+}
+
+// Selectable (Button、Toggle、Slider、Dropdown、InputField) 的通用枚举，与Unity的枚举名一致
+var (
+	selectableTransitions = []string{"None", "ColorTint", "SpriteSwap", "Animation"}
+	navigationModes       = []string{"None", "Horizontal", "Vertical", "Automatic", "Explicit"}
+	sliderDirections      = []string{"LeftToRight", "RightToLeft", "BottomToTop", "TopToBottom"}
+	inputContentTypes     = []string{"Standard", "Autocorrected", "IntegerNumber", "DecimalNumber",
+		"Alphanumeric", "Name", "EmailAddress", "Password", "Pin", "Custom"}
+)
+
+// selectableParams 返回UI控件工具共用的参数: 目标对象和Selectable的交互、过渡颜色、导航设置
+func selectableParams(extra ...ParamSpec) []ParamSpec {
+	params := []ParamSpec{
+		{Name: "instanceId", Type: "integer", Description: "GameObject's InstanceID", Required: true},
+	}
+	params = append(params, extra...)
+	return append(params,
+		ParamSpec{Name: "interactable", Type: "boolean", Description: "Whether the control accepts input"},
+		ParamSpec{Name: "transition", Type: "string", Description: "Transition applied on state changes", Enum: selectableTransitions},
+		ParamSpec{Name: "normalColor", Type: "color", Description: "ColorTint normal color" + colorFormatHint},
+		ParamSpec{Name: "highlightedColor", Type: "color", Description: "ColorTint highlighted color" + colorFormatHint},
+		ParamSpec{Name: "pressedColor", Type: "color", Description: "ColorTint pressed color" + colorFormatHint},
+		ParamSpec{Name: "selectedColor", Type: "color", Description: "ColorTint selected color" + colorFormatHint},
+		ParamSpec{Name: "disabledColor", Type: "color", Description: "ColorTint disabled color" + colorFormatHint},
+		ParamSpec{Name: "colorMultiplier", Type: "number", Description: "ColorTint color multiplier", Minimum: floatPtr(1), Maximum: floatPtr(5)},
+		ParamSpec{Name: "fadeDuration", Type: "number", Description: "ColorTint fade duration in seconds", Minimum: floatPtr(0)},
+		ParamSpec{Name: "navigation", Type: "string", Description: "Keyboard/gamepad navigation mode", Enum: navigationModes},
+	)
+}
+
+// normalizeButtonSetArgs onClick的目标对象和方法必须同时给出
+func normalizeButtonSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	_, hasTarget := arguments["onClickTargetInstanceId"]
+	_, hasMethod := arguments["onClickMethod"]
+	_, hasArgument := arguments["onClickArgument"]
+	if hasTarget != hasMethod {
+		return nil, fmt.Errorf("onClickTargetInstanceId and onClickMethod must be given together")
+	}
+	if hasArgument && !hasTarget {
+		return nil, fmt.Errorf("onClickArgument requires onClickTargetInstanceId and onClickMethod")
+	}
+	if method, _ := arguments["onClickMethod"].(string); hasMethod && strings.TrimSpace(method) == "" {
+		return nil, fmt.Errorf("onClickMethod must not be empty")
+	}
+	if argument, ok := arguments["onClickArgument"]; ok {
+		switch argument.(type) {
+		case string, float64, bool:
+		default:
+			return nil, fmt.Errorf("onClickArgument must be a string, number or boolean, got %s", jsonTypeName(argument))
+		}
+	}
+	return arguments, nil
+}
+
+// normalizeSliderSetArgs 同时给出时检查 minValue < maxValue 且value在范围内；
+// 只给出部分时由Unity按当前值检查
+func normalizeSliderSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	lo, hasMin := arguments["minValue"].(float64)
+	hi, hasMax := arguments["maxValue"].(float64)
+	if hasMin && hasMax && lo >= hi {
+		return nil, fmt.Errorf("minValue (%v) must be less than maxValue (%v)", lo, hi)
+	}
+	value, hasValue := arguments["value"].(float64)
+	if hasValue && hasMin && value < lo {
+		return nil, fmt.Errorf("value (%v) must not be less than minValue (%v)", value, lo)
+	}
+	if hasValue && hasMax && value > hi {
+		return nil, fmt.Errorf("value (%v) must not be greater than maxValue (%v)", value, hi)
+	}
+	if whole, _ := arguments["wholeNumbers"].(bool); whole {
+		for _, name := range []string{"minValue", "maxValue", "value"} {
+			if n, ok := arguments[name].(float64); ok && n != float64(int64(n)) {
+				return nil, fmt.Errorf("%s (%v) must be a whole number when wholeNumbers is true", name, n)
+			}
+		}
+	}
+	return arguments, nil
+}
+
+// normalizeDropdownSetArgs 同时给出options和value时，value必须是有效的选项下标
+func normalizeDropdownSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	options, hasOptions := arguments["options"].([]interface{})
+	for i, option := range options {
+		if _, ok := option.(string); !ok {
+			return nil, fmt.Errorf("options[%d] must be a string, got %s", i, jsonTypeName(option))
+		}
+	}
+	if value, ok := arguments["value"].(int64); ok && hasOptions && value >= int64(len(options)) {
+		return nil, fmt.Errorf("value (%d) must be less than the number of options (%d)", value, len(options))
+	}
+	return arguments, nil
+}
+
+// uiElementTypes ui_create_element 支持的元素类型，与GameObject→UI菜单项对应
+var uiElementTypes = []string{"canvas", "panel", "button", "text", "image", "slider", "toggle", "dropdown", "inputfield", "scrollview"}
+
+// ui_create_element 中text和spritePath适用的元素类型
+var (
+	uiTextElements   = []string{"button", "text", "toggle", "inputfield"}
+	uiSpriteElements = []string{"panel", "button", "image"}
+)
+
+// normalizeUICreateElementArgs 检查text/spritePath/rect是否适用于elementType，
+// 并把rect预设展开为anchorMin/anchorMax
+func normalizeUICreateElementArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	elementType, _ := arguments["elementType"].(string)
+	if _, ok := arguments["text"]; ok && !slices.Contains(uiTextElements, elementType) {
+		return nil, fmt.Errorf("text is not supported for %s elements, only for %s", elementType, strings.Join(uiTextElements, ", "))
+	}
+	if _, ok := arguments["spritePath"]; ok && !slices.Contains(uiSpriteElements, elementType) {
+		return nil, fmt.Errorf("spritePath is not supported for %s elements, only for %s", elementType, strings.Join(uiSpriteElements, ", "))
+	}
+	if name, ok := arguments["rect"].(string); ok {
+		if elementType == "canvas" {
+			return nil, fmt.Errorf("rect is not supported for canvas elements; a root Canvas always fills the screen")
+		}
+		delete(arguments, "rect")
+		if preset, ok := findRectPreset(name); ok {
+			arguments["anchorMin"] = mergeVector2(preset.AnchorMin, nil)
+			arguments["anchorMax"] = mergeVector2(preset.AnchorMax, nil)
+		}
+	}
+	return arguments, nil
+}
+
+// ui_layout_set 的布局类型和枚举，枚举与Unity的枚举名一致
+var (
+	layoutTypes       = []string{"horizontal", "vertical", "grid", "none"}
+	textAnchors       = []string{"UpperLeft", "UpperCenter", "UpperRight", "MiddleLeft", "MiddleCenter", "MiddleRight", "LowerLeft", "LowerCenter", "LowerRight"}
+	gridCorners       = []string{"UpperLeft", "UpperRight", "LowerLeft", "LowerRight"}
+	gridAxes          = []string{"Horizontal", "Vertical"}
+	gridConstraints   = []string{"Flexible", "FixedColumnCount", "FixedRowCount"}
+	contentFitModes   = []string{"Unconstrained", "MinSize", "PreferredSize"}
+	paddingComponents = []string{"left", "right", "top", "bottom"}
+)
+
+// ui_layout_set 中只适用于某一类布局组的参数
+var (
+	linearLayoutParams = []string{"childForceExpandWidth", "childForceExpandHeight", "childControlWidth", "childControlHeight", "childScaleWidth", "childScaleHeight"}
+	gridLayoutParams   = []string{"cellSize", "startCorner", "startAxis", "constraint", "constraintCount"}
+	commonLayoutParams = []string{"padding", "spacing", "childAlignment"}
+)
+
+// normalizeLayoutSetArgs 按layoutType逐项检查参数是否适用，并把spacing转换为Unity端的类型:
+// 水平/垂直布局为数字，网格布局为 {x, y} (数字表示两个方向相同)
+// 未给出layoutType时沿用对象上已有的布局组，由Unity检查参数是否适用
+func normalizeLayoutSetArgs(arguments map[string]interface{}) (map[string]interface{}, error) {
+	layoutType, hasType := arguments["layoutType"].(string)
+	var problems []string
+	has := func(name string) bool { _, ok := arguments[name]; return ok }
+
+	switch {
+	case layoutType == "none":
+		for _, name := range slices.Concat(commonLayoutParams, linearLayoutParams, gridLayoutParams) {
+			if has(name) {
+				problems = append(problems, fmt.Sprintf("%s cannot be set when layoutType is none (the layout group is removed)", name))
+			}
+		}
+	case layoutType == "grid":
+		for _, name := range linearLayoutParams {
+			if has(name) {
+				problems = append(problems, fmt.Sprintf("%s is only valid for horizontal and vertical layouts", name))
+			}
+		}
+	case hasType:
+		for _, name := range gridLayoutParams {
+			if has(name) {
+				problems = append(problems, fmt.Sprintf("%s is only valid for grid layouts", name))
+			}
+		}
+	default:
+		if slices.ContainsFunc(linearLayoutParams, has) && slices.ContainsFunc(gridLayoutParams, has) {
+			problems = append(problems, "horizontal/vertical properties and grid properties cannot be combined; set layoutType to pick one")
+		}
+	}
+
+	if padding, ok := arguments["padding"].(map[string]interface{}); ok {
+		for _, side := range paddingComponents {
+			if n, ok := padding[side].(float64); ok && (n < 0 || n != float64(int64(n))) {
+				problems = append(problems, fmt.Sprintf("padding.%s must be a non-negative integer, got %v", side, n))
+			}
+		}
+	}
+	if spacing, ok := arguments["spacing"]; ok {
+		grid := layoutType == "grid" || !hasType && slices.ContainsFunc(gridLayoutParams, has)
+		value, err := layoutSpacing(spacing, grid)
+		if err != nil {
+			problems = append(problems, err.Error())
+		} else {
+			arguments["spacing"] = value
+		}
+	}
+	if has("constraintCount") {
+		if constraint, _ := arguments["constraint"].(string); constraint == "Flexible" {
+			problems = append(problems, "constraintCount requires constraint FixedColumnCount or FixedRowCount")
+		}
+	}
+	if remove, _ := arguments["removeContentSizeFitter"].(bool); remove {
+		for _, name := range []string{"horizontalFit", "verticalFit"} {
+			if has(name) {
+				problems = append(problems, fmt.Sprintf("%s cannot be combined with removeContentSizeFitter", name))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("invalid layout arguments: %s", strings.Join(problems, "; "))
+	}
+	return arguments, nil
+}
+
+// layoutSpacing 网格布局的spacing为 {x, y}，可以用数字表示两个方向相同；水平/垂直布局只接受数字
+func layoutSpacing(value interface{}, grid bool) (interface{}, error) {
+	if !grid {
+		n, err := toNumber(value)
+		if err != nil {
+			return nil, fmt.Errorf("spacing must be a number for horizontal and vertical layouts")
+		}
+		return n, nil
+	}
+	if n, err := toNumber(value); err == nil {
+		return map[string]interface{}{"x": n, "y": n}, nil
+	}
+	spacing := map[string]interface{}{}
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, component := range v {
+			if !slices.Contains(vectorXY, key) {
+				return nil, fmt.Errorf("spacing has unknown component %q, expected x, y", key)
+			}
+			n, err := toNumber(component)
+			if err != nil {
+				return nil, fmt.Errorf("spacing.%s: %w", key, err)
+			}
+			spacing[key] = n
+		}
+	case []interface{}:
+		if len(v) != 2 {
+			return nil, fmt.Errorf("spacing must be [x, y] for grid layouts, got %d elements", len(v))
+		}
+		for i, component := range v {
+			n, err := toNumber(component)
+			if err != nil {
+				return nil, fmt.Errorf("spacing[%d]: %w", i, err)
+			}
+			spacing[vectorXY[i]] = n
+		}
+	default:
+		return nil, fmt.Errorf("spacing must be a number, [x, y] or {x, y} for grid layouts, got %s", jsonTypeName(value))
+	}
+	return spacing, nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    map[string]interface{}
+		wantErr string
+	}{
+		{"hex", "#FF8000", map[string]interface{}{"r": 1.0, "g": 128.0 / 255, "b": 0.0}, ""},
+		{"hex lowercase", "#ff8000", map[string]interface{}{"r": 1.0, "g": 128.0 / 255, "b": 0.0}, ""},
+		{"hex with alpha", "#00000080", map[string]interface{}{"r": 0.0, "g": 0.0, "b": 0.0, "a": 128.0 / 255}, ""},
+		{"rgb array", []interface{}{0.1, 0.2, 0.3}, map[string]interface{}{"r": 0.1, "g": 0.2, "b": 0.3}, ""},
+		{"rgba array", []interface{}{0.1, 0.2, 0.3, 1.0}, map[string]interface{}{"r": 0.1, "g": 0.2, "b": 0.3, "a": 1.0}, ""},
+		{"rgba array with strings", []interface{}{"0", "0.5", 1.0, "1"}, map[string]interface{}{"r": 0.0, "g": 0.5, "b": 1.0, "a": 1.0}, ""},
+		{"partial object", map[string]interface{}{"a": 0.5}, map[string]interface{}{"a": 0.5}, ""},
+		{"full object", map[string]interface{}{"r": 1.0, "g": 0.0, "b": 0.0, "a": 1.0}, map[string]interface{}{"r": 1.0, "g": 0.0, "b": 0.0, "a": 1.0}, ""},
+		// 颜色名称不是受支持的格式
+		{"named color", "red", nil, "expected #RRGGBB"},
+		{"named color with hash", "#red", nil, "expected #RRGGBB"},
+		{"hex without hash", "FF8000", nil, "expected #RRGGBB"},
+		{"short hex", "#F80", nil, "expected #RRGGBB"},
+		{"invalid hex digit", "#GG0000", nil, "expected #RRGGBB"},
+		{"too few components", []interface{}{0.1, 0.2}, nil, "got 2 elements"},
+		{"too many components", []interface{}{0.1, 0.2, 0.3, 0.4, 0.5}, nil, "got 5 elements"},
+		{"0-255 component", []interface{}{255.0, 0.0, 0.0}, nil, "must be within 0-1"},
+		{"negative component", map[string]interface{}{"g": -0.1}, nil, "must be within 0-1"},
+		{"non-numeric component", []interface{}{"red", 0.0, 0.0}, nil, "color component r"},
+		{"unknown component", map[string]interface{}{"h": 0.5}, nil, "unknown color component"},
+		{"wrong type", true, nil, "got boolean"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseColor(tt.value)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseColor(%#v) error = %v, want error containing %q", tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseColor(%#v): %v", tt.value, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseColor(%#v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeRectTransformArgs(t *testing.T) {
+	vec := func(x, y float64) map[string]interface{} { return map[string]interface{}{"x": x, "y": y} }
+	tests := []struct {
+		name      string
+		arguments map[string]interface{}
+		want      map[string]interface{}
+		wantErr   bool
+	}{
+		{
+			"preset expands to anchors",
+			map[string]interface{}{"instanceId": 1.0, "preset": "top-right"},
+			map[string]interface{}{"instanceId": 1.0, "anchorMin": vec(1, 1), "anchorMax": vec(1, 1)},
+			false,
+		},
+		{
+			"partial anchor overrides preset component",
+			map[string]interface{}{"preset": "stretch", "anchorMin": map[string]interface{}{"y": 0.2}},
+			map[string]interface{}{"anchorMin": vec(0, 0.2), "anchorMax": vec(1, 1)},
+			false,
+		},
+		{
+			"partial anchors on both sides",
+			map[string]interface{}{"preset": "stretch-horizontal", "anchorMin": map[string]interface{}{"x": 0.1}, "anchorMax": map[string]interface{}{"x": 0.9}},
+			map[string]interface{}{"anchorMin": vec(0.1, 0.5), "anchorMax": vec(0.9, 0.5)},
+			false,
+		},
+		{
+			"partial anchors without preset are left to Unity",
+			map[string]interface{}{"anchorMin": map[string]interface{}{"x": 0.5}},
+			map[string]interface{}{"anchorMin": map[string]interface{}{"x": 0.5}},
+			false,
+		},
+		{
+			"override inverts preset anchors",
+			map[string]interface{}{"preset": "left", "anchorMin": map[string]interface{}{"x": 0.8}},
+			nil,
+			true,
+		},
+		{
+			"explicit anchors inverted",
+			map[string]interface{}{"anchorMin": vec(0.6, 0), "anchorMax": vec(0.4, 1)},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeRectTransformArgs(tt.arguments)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("normalizeRectTransformArgs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
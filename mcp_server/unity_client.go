@@ -1,123 +1,270 @@
 package main
 
 import (
+	"context"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net"
+	"sync/atomic"
 	"time"
 )
 
-// debugMode在main.go中定义
-
 // UnityTCPClient Unity TCP客户端
+// 同一连接上一次只能进行一个请求/响应交换，busy用于串行化请求
+// conn只能在持有busy时访问；状态查询读取addrs，不与进行中的请求竞争
 type UnityTCPClient struct {
-	host    string
-	port    string
-	conn    net.Conn
-	timeout time.Duration
+	host       string
+	port       string
+	conn       net.Conn
+	addrs      atomic.Pointer[connAddrs] // 当前连接的地址，未连接时为nil
+	retryAfter atomic.Int64              // 连接断开后，下次连接前需等待到的时间 (UnixNano)
+	timeout    time.Duration
+	maxSize    int // 响应的最大字节数
+	busy       chan struct{}
+}
+
+// connAddrs 连接的本地和远程地址
+type connAddrs struct {
+	Local  string
+	Remote string
+}
+
+// 连接断开后再次连接前的等待时间
+const reconnectDelay = time.Second
+
+// responseTooLargeError Unity响应超过大小上限
+// 消息体未被读取，连接随后会被重建
+type responseTooLargeError struct {
+	Size  int
+	Limit int
+}
+
+func (e *responseTooLargeError) Error() string {
+	return fmt.Sprintf("response too large: %d bytes (limit %d bytes)", e.Size, e.Limit)
+}
+
+// 二进制附件: Unity在JSON响应中用binaryLength声明附件长度，随后发送一帧原始字节，
+// 避免把图片等数据以base64写入JSON。附件不受 -max-response-size 限制，使用单独的上限
+const (
+	binaryLengthKey     = "binaryLength"
+	binaryMimeTypeKey   = "binaryMimeType"
+	binaryAttachmentKey = "_binary" // 读取后的附件在响应中的键
+	maxAttachmentSize   = 64 * 1024 * 1024
+)
+
+// binaryAttachment 响应附带的原始字节
+// 日志中序列化响应时只输出长度，不输出内容
+type binaryAttachment []byte
+
+func (b binaryAttachment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(fmt.Sprintf("<%d bytes>", len(b)))
 }
 
 // NewUnityTCPClient 创建新的Unity TCP客户端
-func NewUnityTCPClient(host, port string) *UnityTCPClient {
+func NewUnityTCPClient(host, port string, timeout time.Duration, maxSize int) *UnityTCPClient {
 	return &UnityTCPClient{
 		host:    host,
 		port:    port,
-		timeout: 10 * time.Second,
+		timeout: timeout,
+		maxSize: maxSize,
+		busy:    make(chan struct{}, 1),
+	}
+}
+
+// acquire 等待独占连接，ctx取消时放弃等待
+func (c *UnityTCPClient) acquire(ctx context.Context) error {
+	select {
+	case c.busy <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release 释放连接
+func (c *UnityTCPClient) release() {
+	<-c.busy
+}
+
+// setConn 替换当前连接并更新地址快照，调用方需持有busy
+func (c *UnityTCPClient) setConn(conn net.Conn) {
+	c.conn = conn
+	if conn == nil {
+		c.addrs.Store(nil)
+		return
+	}
+	c.addrs.Store(&connAddrs{Local: conn.LocalAddr().String(), Remote: conn.RemoteAddr().String()})
+}
+
+// waitReconnectDelay 连接刚断开时等待reconnectDelay结束再连接，等待期间不持有busy
+func (c *UnityTCPClient) waitReconnectDelay(ctx context.Context) error {
+	wait := time.Until(time.Unix(0, c.retryAfter.Load()))
+	if wait <= 0 {
+		return nil
+	}
+	traceLog("Waiting %v before reconnection attempt...", wait)
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Reconnect 等待进行中的请求结束后关闭当前连接并重新连接
+func (c *UnityTCPClient) Reconnect(ctx context.Context) error {
+	if err := c.acquire(ctx); err != nil {
+		return fmt.Errorf("timed out waiting for in-flight request: %w", err)
+	}
+	defer c.release()
+
+	logger.Info("Reconnecting to Unity server on request", "addr", net.JoinHostPort(c.host, c.port))
+	c.Close()
+	return c.Connect()
+}
+
+// Disconnect 等待进行中的请求结束后关闭当前连接，下次发送时重新连接
+// 用于预期Unity会断开连接的操作 (域重载)，避免在失效的连接上发送下一个请求
+func (c *UnityTCPClient) Disconnect(ctx context.Context) error {
+	if err := c.acquire(ctx); err != nil {
+		return err
+	}
+	defer c.release()
+	return c.Close()
+}
+
+// ConnectionInfo 返回当前连接的本地和远程地址，未连接时为空
+func (c *UnityTCPClient) ConnectionInfo() (local, remote string) {
+	if addrs := c.addrs.Load(); addrs != nil {
+		return addrs.Local, addrs.Remote
 	}
+	return "", ""
 }
 
 // Connect 连接到Unity服务器
 func (c *UnityTCPClient) Connect() error {
 	connectStart := time.Now()
-	addr := fmt.Sprintf("%s:%s", c.host, c.port)
-	
-	if debugMode {
-		fmt.Printf("[DEBUG] === TCP CONNECTION START ===\n")
-		fmt.Printf("[DEBUG] Target address: %s\n", addr)
-		fmt.Printf("[DEBUG] Connection timeout: %v\n", c.timeout)
-		fmt.Printf("[DEBUG] Connection attempt start time: %s\n", connectStart.Format("15:04:05.000"))
-	}
+	addr := net.JoinHostPort(c.host, c.port)
+
+	traceLog("=== TCP CONNECTION START ===")
+	traceLog("Target address: %s", addr)
+	traceLog("Connection timeout: %v", c.timeout)
+	traceLog("Connection attempt start time: %s", connectStart.Format("15:04:05.000"))
 
 	conn, err := net.DialTimeout("tcp", addr, c.timeout)
 	connectDuration := time.Since(connectStart)
-	
+
 	if err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] === TCP CONNECTION FAILED ===\n")
-			fmt.Printf("[DEBUG] Target: %s\n", addr)
-			fmt.Printf("[DEBUG] Connect duration: %v\n", connectDuration)
-			fmt.Printf("[DEBUG] Error type: %T\n", err)
-			fmt.Printf("[DEBUG] Error details: %v\n", err)
-		}
+		traceLog("=== TCP CONNECTION FAILED ===")
+		traceLog("Target: %s", addr)
+		traceLog("Connect duration: %v", connectDuration)
+		traceLog("Error type: %T", err)
+		traceLog("Error details: %v", err)
 		return fmt.Errorf("failed to connect to Unity server %s: %w", addr, err)
 	}
 
-	c.conn = conn
-	
-	if debugMode {
-		fmt.Printf("[DEBUG] === TCP CONNECTION SUCCESS ===\n")
-		fmt.Printf("[DEBUG] Target: %s\n", addr)
-		fmt.Printf("[DEBUG] Connect duration: %v\n", connectDuration)
-		fmt.Printf("[DEBUG] Local address: %s\n", conn.LocalAddr())
-		fmt.Printf("[DEBUG] Remote address: %s\n", conn.RemoteAddr())
-		fmt.Printf("[DEBUG] Connection type: %s\n", conn.RemoteAddr().Network())
-	}
-	
-	fmt.Printf("✓ Successfully connected to Unity server %s (took %v)\n", addr, connectDuration)
+	c.setConn(conn)
+	c.retryAfter.Store(0)
+	// 新连接可能意味着域重载或编辑器重启，编辑器信息需要重新查询
+	invalidateUnityInfo()
+
+	traceLog("=== TCP CONNECTION SUCCESS ===")
+	traceLog("Target: %s", addr)
+	traceLog("Connect duration: %v", connectDuration)
+	traceLog("Local address: %s", conn.LocalAddr())
+	traceLog("Remote address: %s", conn.RemoteAddr())
+	traceLog("Connection type: %s", conn.RemoteAddr().Network())
+
+	logger.Info("Connected to Unity server", "addr", addr, "duration_ms", connectDuration.Milliseconds())
 	return nil
 }
 
 // Close 关闭连接
 func (c *UnityTCPClient) Close() error {
 	if c.conn != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] === TCP CONNECTION CLOSE ===\n")
-			fmt.Printf("[DEBUG] Closing connection to: %s\n", c.conn.RemoteAddr())
-			fmt.Printf("[DEBUG] Local address: %s\n", c.conn.LocalAddr())
-		}
-		
+		traceLog("=== TCP CONNECTION CLOSE ===")
+		traceLog("Closing connection to: %s", c.conn.RemoteAddr())
+		traceLog("Local address: %s", c.conn.LocalAddr())
+
 		err := c.conn.Close()
-		c.conn = nil
-		
-		if debugMode {
-			if err != nil {
-				fmt.Printf("[DEBUG] Connection close error: %v\n", err)
-			} else {
-				fmt.Printf("[DEBUG] Connection closed successfully\n")
-			}
+		c.setConn(nil)
+
+		if err != nil {
+			traceLog("Connection close error: %v", err)
+		} else {
+			traceLog("Connection closed successfully")
 		}
-		
+
 		return err
 	} else {
-		if debugMode {
-			fmt.Printf("[DEBUG] Close() called but connection is already nil\n")
-		}
+		traceLog("Close() called but connection is already nil")
 	}
 	return nil
 }
 
 // SendMessage 发送消息到Unity并接收响应
 func (c *UnityTCPClient) SendMessage(message map[string]interface{}) (map[string]interface{}, error) {
+	return c.SendMessageWithTimeout(message, 0)
+}
+
+// SendMessageWithTimeout 使用指定超时发送消息，timeout为0时使用默认超时
+func (c *UnityTCPClient) SendMessageWithTimeout(message map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+	return c.SendMessageContext(context.Background(), message, timeout)
+}
+
+// SendMessageContext 发送消息，ctx取消时立即中断读写
+// 中断后连接上可能残留未读取的响应，因此直接关闭连接，下次发送时重新建立
+func (c *UnityTCPClient) SendMessageContext(ctx context.Context, message map[string]interface{}, timeout time.Duration) (map[string]interface{}, error) {
+	return c.SendMessageWithProgress(ctx, message, timeout, nil)
+}
+
+// SendMessageWithProgress 发送消息并把最终响应之前的进度帧交给onProgress
+// 每收到一帧都会重新计算读取超时，因此持续报告进度的长时间操作不会超时
+func (c *UnityTCPClient) SendMessageWithProgress(ctx context.Context, message map[string]interface{}, timeout time.Duration, onProgress progressFunc) (map[string]interface{}, error) {
 	sendStart := time.Now()
-	
+	if timeout <= 0 {
+		timeout = c.timeout
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := c.waitReconnectDelay(ctx); err != nil {
+		return nil, err
+	}
+	if err := c.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer c.release()
+
 	// 确保连接存在
 	if c.conn == nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] No existing connection, establishing new connection\n")
-		}
+		debugLog("No existing connection, establishing new connection")
 		if err := c.Connect(); err != nil {
 			return nil, err
 		}
 	}
 
+	// ctx取消时关闭底层连接，使阻塞中的IO立即返回
+	conn := c.conn
+	stop := context.AfterFunc(ctx, func() {
+		conn.Close()
+	})
+	defer func() {
+		// 回调已执行说明连接已被关闭，丢弃它
+		if !stop() && c.conn == conn {
+			c.setConn(nil)
+		}
+	}()
+
 	// 序列化消息
 	jsonData, err := json.Marshal(message)
 	if err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] JSON serialization failed: %v\n", err)
-		}
+		traceLog("JSON serialization failed: %v", err)
 		return nil, fmt.Errorf("failed to serialize message: %w", err)
 	}
 
@@ -126,127 +273,163 @@ func (c *UnityTCPClient) SendMessage(message map[string]interface{}) (map[string
 		messageId = fmt.Sprintf("%v", id)
 	}
 
-	if debugMode {
-		fmt.Printf("[DEBUG] === TCP SEND START === (ID: %s)\n", messageId)
-		fmt.Printf("[DEBUG] Message size: %d bytes\n", len(jsonData))
-		fmt.Printf("→ Sending to Unity: %s\n", string(jsonData))
-	}
+	traceLog("=== TCP SEND START === (ID: %s)", messageId)
+	traceLog("Message size: %d bytes", len(jsonData))
+	traceLog("→ Sending to Unity: %s", truncatePayload(jsonData))
 
 	// 创建4字节长度头（大端序）
 	messageLen := uint32(len(jsonData))
 	lengthHeader := make([]byte, 4)
 	binary.BigEndian.PutUint32(lengthHeader, messageLen)
 
-	if debugMode {
-		fmt.Printf("[DEBUG] Message length header: %d bytes\n", messageLen)
-	}
+	traceLog("Message length header: %d bytes", messageLen)
 
 	// 设置写入超时
-	writeDeadline := time.Now().Add(c.timeout)
+	writeDeadline := time.Now().Add(timeout)
 	if err := c.conn.SetWriteDeadline(writeDeadline); err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] Failed to set write deadline: %v\n", err)
-		}
+		traceLog("Failed to set write deadline: %v", err)
 		return nil, fmt.Errorf("failed to set write deadline: %w", err)
 	}
 
 	// 发送长度头
 	headerStart := time.Now()
 	if _, err := c.conn.Write(lengthHeader); err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] Failed to send header after %v: %v\n", time.Since(headerStart), err)
-		}
-		c.reconnect()
-		return nil, fmt.Errorf("failed to send message header: %w", err)
-	}
-	
-	if debugMode {
-		fmt.Printf("[DEBUG] Header sent successfully in %v\n", time.Since(headerStart))
+		traceLog("Failed to send header after %v: %v", time.Since(headerStart), err)
+		return nil, c.ioFailed(ctx, "failed to send message header", err)
 	}
 
+	traceLog("Header sent successfully in %v", time.Since(headerStart))
+
 	// 发送消息体
 	bodyStart := time.Now()
 	if _, err := c.conn.Write(jsonData); err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] Failed to send body after %v: %v\n", time.Since(bodyStart), err)
-		}
-		c.reconnect()
-		return nil, fmt.Errorf("failed to send message body: %w", err)
-	}
-	
-	if debugMode {
-		fmt.Printf("[DEBUG] Body sent successfully in %v\n", time.Since(bodyStart))
-		fmt.Printf("[DEBUG] Total send time: %v\n", time.Since(sendStart))
+		traceLog("Failed to send body after %v: %v", time.Since(bodyStart), err)
+		return nil, c.ioFailed(ctx, "failed to send message body", err)
 	}
 
+	traceLog("Body sent successfully in %v", time.Since(bodyStart))
+	traceLog("Total send time: %v", time.Since(sendStart))
+
 	// 接收响应
-	if debugMode {
-		fmt.Printf("[DEBUG] === TCP RECEIVE START === (ID: %s)\n", messageId)
+	traceLog("=== TCP RECEIVE START === (ID: %s)", messageId)
+
+	var response map[string]interface{}
+	for {
+		response, err = c.receiveMessage(timeout)
+		if err != nil {
+			traceLog("Failed to receive response: %v", err)
+			return nil, c.ioFailed(ctx, "failed to receive response", err)
+		}
+		progress, progressMessage, ok := progressFrame(response, messageId)
+		if !ok {
+			break
+		}
+		traceLog("Progress frame received (ID: %s): %.2f %s", messageId, progress, progressMessage)
+		if onProgress != nil {
+			onProgress(progress, progressMessage)
+		}
 	}
-	
-	response, err := c.receiveMessage()
-	if err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] Failed to receive response: %v\n", err)
+
+	// 带二进制附件的响应 (如截图) 之后紧跟一帧原始字节
+	if length, ok := response[binaryLengthKey].(float64); ok && length > 0 {
+		attachment, err := c.readFrame(timeout, maxAttachmentSize)
+		if err != nil {
+			traceLog("Failed to receive binary attachment: %v", err)
+			return nil, c.ioFailed(ctx, "failed to receive binary attachment", err)
+		}
+		if len(attachment) != int(length) {
+			c.scheduleReconnect()
+			return nil, fmt.Errorf("binary attachment is %d bytes, response announced %d", len(attachment), int(length))
 		}
-		c.reconnect()
-		return nil, fmt.Errorf("failed to receive response: %w", err)
+		response[binaryAttachmentKey] = binaryAttachment(attachment)
 	}
 
 	totalTime := time.Since(sendStart)
-	if debugMode {
-		fmt.Printf("[DEBUG] === TCP COMPLETE === (ID: %s, Total: %v)\n", messageId, totalTime)
-	}
+	traceLog("=== TCP COMPLETE === (ID: %s, Total: %v)", messageId, totalTime)
 
 	return response, nil
 }
 
+// progressFrame 判断消息是否为指定请求的进度帧
+func progressFrame(msg map[string]interface{}, messageId string) (float64, string, bool) {
+	if msgType, _ := msg["type"].(string); msgType != "progress" {
+		return 0, "", false
+	}
+	if id, exists := msg["id"]; exists && messageId != "" && fmt.Sprintf("%v", id) != messageId {
+		return 0, "", false
+	}
+	progress, _ := msg["progress"].(float64)
+	message, _ := msg["message"].(string)
+	return progress, message, true
+}
+
+// ioFailed 处理读写失败: ctx已取消时关闭连接并返回取消错误，否则安排重连
+func (c *UnityTCPClient) ioFailed(ctx context.Context, op string, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		debugLog("Unity request aborted (%s), connection closed", op)
+		c.setConn(nil)
+		return fmt.Errorf("%s: %w", op, ctxErr)
+	}
+	c.scheduleReconnect()
+	return fmt.Errorf("%s: %w", op, err)
+}
+
 // receiveMessage 接收Unity响应消息
-func (c *UnityTCPClient) receiveMessage() (map[string]interface{}, error) {
+func (c *UnityTCPClient) receiveMessage(timeout time.Duration) (map[string]interface{}, error) {
 	receiveStart := time.Now()
-	
+	messageData, err := c.readFrame(timeout, c.maxSize)
+	if err != nil {
+		return nil, err
+	}
+	traceLog("← Received Unity response: %s", truncatePayload(messageData))
+
+	// 解析JSON响应
+	parseStart := time.Now()
+	var response map[string]interface{}
+	if err := json.Unmarshal(messageData, &response); err != nil {
+		traceLog("JSON parsing failed after %v: %v", time.Since(parseStart), err)
+		traceLog("Raw response data: %s", truncatePayload(messageData))
+		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
+	}
+
+	traceLog("JSON parsed in %v", time.Since(parseStart))
+	traceLog("Total receive time: %v", time.Since(receiveStart))
+
+	return response, nil
+}
+
+// readFrame 读取一帧: 4字节长度头 (大端序) 加消息体，消息体超过limit时返回responseTooLargeError
+func (c *UnityTCPClient) readFrame(timeout time.Duration, limit int) ([]byte, error) {
 	// 设置读取超时
-	readDeadline := time.Now().Add(c.timeout)
+	readDeadline := time.Now().Add(timeout)
 	if err := c.conn.SetReadDeadline(readDeadline); err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] Failed to set read deadline: %v\n", err)
-		}
+		traceLog("Failed to set read deadline: %v", err)
 		return nil, fmt.Errorf("failed to set read deadline: %w", err)
 	}
 
 	// 读取4字节长度头
 	headerStart := time.Now()
 	lengthHeader := make([]byte, 4)
-	if _, err := c.conn.Read(lengthHeader); err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] Failed to read header after %v: %v\n", time.Since(headerStart), err)
-		}
+	if _, err := io.ReadFull(c.conn, lengthHeader); err != nil {
+		traceLog("Failed to read header after %v: %v", time.Since(headerStart), err)
 		return nil, fmt.Errorf("failed to read message header: %w", err)
 	}
 
-	if debugMode {
-		fmt.Printf("[DEBUG] Header received in %v\n", time.Since(headerStart))
-	}
+	traceLog("Header received in %v", time.Since(headerStart))
 
 	// 解析消息长度
 	messageLen := binary.BigEndian.Uint32(lengthHeader)
 	if messageLen == 0 {
-		if debugMode {
-			fmt.Printf("[DEBUG] Received empty message (length=0)\n")
-		}
+		traceLog("Received empty message (length=0)")
 		return nil, errors.New("received empty message")
 	}
 
-	if messageLen > 1024*1024 { // 限制消息大小为1MB
-		if debugMode {
-			fmt.Printf("[DEBUG] Message too large: %d bytes (max 1MB)\n", messageLen)
-		}
-		return nil, fmt.Errorf("message too large: %d bytes", messageLen)
+	if int64(messageLen) > int64(limit) {
+		traceLog("Message too large: %d bytes (max %d)", messageLen, limit)
+		return nil, &responseTooLargeError{Size: int(messageLen), Limit: limit}
 	}
 
-	if debugMode {
-		fmt.Printf("← Response length: %d bytes\n", messageLen)
-	}
+	traceLog("← Frame length: %d bytes", messageLen)
 
 	// 读取消息体
 	bodyStart := time.Now()
@@ -255,146 +438,94 @@ func (c *UnityTCPClient) receiveMessage() (map[string]interface{}, error) {
 	for totalRead < int(messageLen) {
 		n, err := c.conn.Read(messageData[totalRead:])
 		if err != nil {
-			if debugMode {
-				fmt.Printf("[DEBUG] Failed to read body at %d/%d bytes after %v: %v\n", 
-					totalRead, messageLen, time.Since(bodyStart), err)
-			}
+			traceLog("Failed to read body at %d/%d bytes after %v: %v",
+				totalRead, messageLen, time.Since(bodyStart), err)
 			return nil, fmt.Errorf("failed to read message body: %w", err)
 		}
 		totalRead += n
-		
-		if debugMode && totalRead > 0 {
-			fmt.Printf("[DEBUG] Read %d/%d bytes (%d%% complete)\n", 
-				totalRead, messageLen, (totalRead*100)/int(messageLen))
-		}
-	}
-
-	if debugMode {
-		fmt.Printf("[DEBUG] Body received in %v\n", time.Since(bodyStart))
-		fmt.Printf("← Received Unity response: %s\n", string(messageData))
-	}
 
-	// 解析JSON响应
-	parseStart := time.Now()
-	var response map[string]interface{}
-	if err := json.Unmarshal(messageData, &response); err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] JSON parsing failed after %v: %v\n", time.Since(parseStart), err)
-			fmt.Printf("[DEBUG] Raw response data: %s\n", string(messageData))
+		if totalRead > 0 {
+			traceLog("Read %d/%d bytes (%d%% complete)",
+				totalRead, messageLen, (totalRead*100)/int(messageLen))
 		}
-		return nil, fmt.Errorf("failed to parse JSON response: %w", err)
 	}
 
-	if debugMode {
-		fmt.Printf("[DEBUG] JSON parsed in %v\n", time.Since(parseStart))
-		fmt.Printf("[DEBUG] Total receive time: %v\n", time.Since(receiveStart))
-	}
-
-	return response, nil
+	traceLog("Body received in %v", time.Since(bodyStart))
+	return messageData, nil
 }
 
-// reconnect 重新连接到Unity服务器
-func (c *UnityTCPClient) reconnect() {
-	reconnectStart := time.Now()
-	
-	if debugMode {
-		fmt.Printf("[DEBUG] === TCP RECONNECTION START ===\n")
-		fmt.Printf("[DEBUG] Reconnection triggered at: %s\n", reconnectStart.Format("15:04:05.000"))
-		fmt.Printf("[DEBUG] Target server: %s:%s\n", c.host, c.port)
-	}
-	
-	fmt.Println("⚠ Connection lost detected, attempting to reconnect...")
-	
-	// 关闭现有连接
-	closeStart := time.Now()
-	c.Close()
-	closeDuration := time.Since(closeStart)
-	
-	if debugMode {
-		fmt.Printf("[DEBUG] Existing connection closed in %v\n", closeDuration)
-		fmt.Printf("[DEBUG] Waiting 1 second before reconnection attempt...\n")
-	}
+// scheduleReconnect 关闭失效的连接，下一次发送在reconnectDelay后重新连接
+// 等待发生在获取busy之前，不阻塞其他调用方查询连接状态或放弃等待
+func (c *UnityTCPClient) scheduleReconnect() {
+	traceLog("=== TCP RECONNECTION SCHEDULED ===")
+	traceLog("Target server: %s:%s", c.host, c.port)
+	traceLog("Next connection attempt after: %v", reconnectDelay)
 
-	// 等待1秒后重试
-	time.Sleep(time.Second)
+	logger.Warn("Connection lost, reconnecting on next request", "addr", net.JoinHostPort(c.host, c.port))
 
-	// 重连尝试
-	connectStart := time.Now()
-	if err := c.Connect(); err != nil {
-		connectDuration := time.Since(connectStart)
-		totalDuration := time.Since(reconnectStart)
-		
-		fmt.Printf("✗ Reconnection failed: %v\n", err)
-		if debugMode {
-			fmt.Printf("[DEBUG] === TCP RECONNECTION FAILED ===\n")
-			fmt.Printf("[DEBUG] Connect attempt duration: %v\n", connectDuration)
-			fmt.Printf("[DEBUG] Total reconnection duration: %v\n", totalDuration)
-			fmt.Printf("[DEBUG] Error: %v\n", err)
-		}
-	} else {
-		connectDuration := time.Since(connectStart)
-		totalDuration := time.Since(reconnectStart)
-		
-		fmt.Printf("✓ Successfully reconnected to Unity server\n")
-		if debugMode {
-			fmt.Printf("[DEBUG] === TCP RECONNECTION SUCCESS ===\n")
-			fmt.Printf("[DEBUG] Connect duration: %v\n", connectDuration)
-			fmt.Printf("[DEBUG] Total reconnection duration: %v\n", totalDuration)
-		}
-	}
+	c.Close()
+	c.retryAfter.Store(time.Now().Add(reconnectDelay).UnixNano())
 }
 
 // IsConnected 检查是否已连接
+// 有请求进行中时不等待，直接返回地址快照表示的状态，该请求的结果会更新快照
 func (c *UnityTCPClient) IsConnected() bool {
 	checkStart := time.Now()
-	
-	if c.conn == nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] IsConnected: connection is nil\n")
-		}
+
+	if c.addrs.Load() == nil {
+		traceLog("IsConnected: connection is nil")
 		return false
 	}
 
-	if debugMode {
-		fmt.Printf("[DEBUG] === CONNECTION CHECK START ===\n")
-		fmt.Printf("[DEBUG] Remote address: %s\n", c.conn.RemoteAddr())
-		fmt.Printf("[DEBUG] Performing write test to check connection status...\n")
+	select {
+	case c.busy <- struct{}{}:
+		defer c.release()
+	default:
+		traceLog("IsConnected: request in flight, using last known state")
+		return true
 	}
+	if c.conn == nil {
+		traceLog("IsConnected: connection is nil")
+		return false
+	}
+
+	traceLog("=== CONNECTION CHECK START ===")
+	traceLog("Remote address: %s", c.conn.RemoteAddr())
+	traceLog("Performing write test to check connection status...")
 
 	// 尝试写入一个空的测试包来检测连接状态
 	c.conn.SetWriteDeadline(time.Now().Add(time.Second))
 	_, err := c.conn.Write([]byte{})
 	checkDuration := time.Since(checkStart)
-	
+
 	if err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] === CONNECTION CHECK FAILED ===\n")
-			fmt.Printf("[DEBUG] Check duration: %v\n", checkDuration)
-			fmt.Printf("[DEBUG] Write test error: %v\n", err)
-		}
+		traceLog("=== CONNECTION CHECK FAILED ===")
+		traceLog("Check duration: %v", checkDuration)
+		traceLog("Write test error: %v", err)
 		return false
 	}
 
-	if debugMode {
-		fmt.Printf("[DEBUG] === CONNECTION CHECK SUCCESS ===\n")
-		fmt.Printf("[DEBUG] Check duration: %v\n", checkDuration)
-		fmt.Printf("[DEBUG] Connection is alive\n")
-	}
+	traceLog("=== CONNECTION CHECK SUCCESS ===")
+	traceLog("Check duration: %v", checkDuration)
+	traceLog("Connection is alive")
 
 	return true
 }
 
 // TestConnection 测试与Unity的连接
 func (c *UnityTCPClient) TestConnection() error {
+	return c.TestConnectionWithTimeout(0)
+}
+
+// TestConnectionWithTimeout 使用指定超时发送ping，timeout为0时使用默认超时
+func (c *UnityTCPClient) TestConnectionWithTimeout(timeout time.Duration) error {
 	testStart := time.Now()
 	testId := fmt.Sprintf("test_connection_%d", time.Now().UnixNano())
-	
-	if debugMode {
-		fmt.Printf("[DEBUG] === CONNECTION TEST START ===\n")
-		fmt.Printf("[DEBUG] Test ID: %s\n", testId)
-		fmt.Printf("[DEBUG] Test start time: %s\n", testStart.Format("15:04:05.000"))
-	}
-	
+
+	traceLog("=== CONNECTION TEST START ===")
+	traceLog("Test ID: %s", testId)
+	traceLog("Test start time: %s", testStart.Format("15:04:05.000"))
+
 	testMessage := map[string]interface{}{
 		"action":    "ping",
 		"params":    map[string]interface{}{},
@@ -402,49 +533,39 @@ func (c *UnityTCPClient) TestConnection() error {
 		"timestamp": time.Now().UnixMilli(),
 	}
 
-	if debugMode {
-		fmt.Printf("[DEBUG] Test message: %s\n", formatJSON(testMessage))
-	}
+	traceLog("Test message: %s", summarizePayload(testMessage))
 
-	response, err := c.SendMessage(testMessage)
+	response, err := c.SendMessageWithTimeout(testMessage, timeout)
 	testDuration := time.Since(testStart)
-	
+
 	if err != nil {
-		if debugMode {
-			fmt.Printf("[DEBUG] === CONNECTION TEST FAILED ===\n")
-			fmt.Printf("[DEBUG] Test duration: %v\n", testDuration)
-			fmt.Printf("[DEBUG] Send message error: %v\n", err)
-		}
+		traceLog("=== CONNECTION TEST FAILED ===")
+		traceLog("Test duration: %v", testDuration)
+		traceLog("Send message error: %v", err)
 		return err
 	}
 
-	if debugMode {
-		fmt.Printf("[DEBUG] Test response received: %s\n", formatJSON(response))
-	}
+	traceLog("Test response received: %s", summarizePayload(response))
 
 	if success, ok := response["success"].(bool); !ok || !success {
 		errorMsg := "unknown error"
 		if errStr, ok := response["error"].(string); ok {
 			errorMsg = errStr
 		}
-		
-		if debugMode {
-			fmt.Printf("[DEBUG] === CONNECTION TEST FAILED ===\n")
-			fmt.Printf("[DEBUG] Test duration: %v\n", testDuration)
-			fmt.Printf("[DEBUG] Success field validation failed\n")
-			fmt.Printf("[DEBUG] Success value: %v (type: %T)\n", response["success"], response["success"])
-			fmt.Printf("[DEBUG] Error message: %s\n", errorMsg)
-		}
-		
+
+		traceLog("=== CONNECTION TEST FAILED ===")
+		traceLog("Test duration: %v", testDuration)
+		traceLog("Success field validation failed")
+		traceLog("Success value: %v (type: %T)", response["success"], response["success"])
+		traceLog("Error message: %s", errorMsg)
+
 		return fmt.Errorf("unity connection test failed: %s", errorMsg)
 	}
 
-	if debugMode {
-		fmt.Printf("[DEBUG] === CONNECTION TEST SUCCESS ===\n")
-		fmt.Printf("[DEBUG] Test duration: %v\n", testDuration)
-		fmt.Printf("[DEBUG] Response validation passed\n")
-	}
+	traceLog("=== CONNECTION TEST SUCCESS ===")
+	traceLog("Test duration: %v", testDuration)
+	traceLog("Response validation passed")
 
-	fmt.Printf("✓ Unity connection test successful (took %v)\n", testDuration)
+	logger.Info("Unity connection test succeeded", "duration_ms", testDuration.Milliseconds())
 	return nil
 }
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/mark3labs/mcp-go/mcp"
+)
+
+// unity_info 的结果 (Unity版本、构建目标、渲染管线、关键包等) 在会话内缓存，
+// 重新连接Unity (域重载、编辑器重启) 和 editor_play_mode 切换播放模式后失效，/health 中附带最近一次的快照
+var unityInfoCache = struct {
+	sync.Mutex
+	data map[string]interface{}
+	at   time.Time
+}{}
+
+// cachedUnityInfo 返回缓存的快照副本，没有缓存时返回nil
+func cachedUnityInfo() (map[string]interface{}, time.Time) {
+	unityInfoCache.Lock()
+	defer unityInfoCache.Unlock()
+	if unityInfoCache.data == nil {
+		return nil, time.Time{}
+	}
+	return maps.Clone(unityInfoCache.data), unityInfoCache.at
+}
+
+// invalidateUnityInfo 丢弃缓存，下一次 unity_info 重新查询Unity
+func invalidateUnityInfo() {
+	unityInfoCache.Lock()
+	defer unityInfoCache.Unlock()
+	if unityInfoCache.data != nil {
+		debugLog("Unity info cache invalidated")
+	}
+	unityInfoCache.data = nil
+}
+
+// handleUnityInfo 有缓存且未要求refresh时直接返回缓存，否则查询Unity并更新缓存
+func handleUnityInfo(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	const toolName = "unity_info"
+	arguments := request.GetArguments()
+	if isDryRun(ctx) {
+		return forwardToUnity(ctx, toolName, arguments, request)
+	}
+	if refresh, _ := arguments["refresh"].(bool); !refresh {
+		if info, at := cachedUnityInfo(); info != nil {
+			info["cached"] = true
+			info["cachedAt"] = at.Format(time.RFC3339)
+			return toolSuccessResult(ctx, toolName, info), nil
+		}
+	}
+
+	data, err := queryUnity(ctx, toolName, map[string]interface{}{})
+	if err != nil {
+		return unityErrorResult(ctx, toolName, err)
+	}
+	info, _ := data.(map[string]interface{})
+	if info == nil {
+		return toolErrorResult(ctx, errCodeUnityToolFailed, "unity returned no editor info", toolName), nil
+	}
+
+	unityInfoCache.Lock()
+	unityInfoCache.data = maps.Clone(info)
+	unityInfoCache.at = time.Now()
+	unityInfoCache.Unlock()
+	info["cached"] = false
+	return toolSuccessResult(ctx, toolName, info), nil
+}
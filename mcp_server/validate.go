@@ -0,0 +1,338 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 工具参数在转发到Unity之前按ParamSpec校验和规范化，
+// 错误的参数直接返回invalid_arguments，不占用Unity连接，也不会触发重试
+
+// reservedArguments 服务器自身使用的参数，在工具参数之外对所有工具有效
+// 其他以下划线开头的参数与普通参数一样按未声明参数拒绝
+var reservedArguments = []string{retriesArgument, dryRunArgument}
+
+// reservedArgument 判断是否为服务器自身使用的参数，这些参数不做校验
+func reservedArgument(name string) bool {
+	return slices.Contains(reservedArguments, name)
+}
+
+// validateArguments 校验参数并返回规范化后的副本:
+// 拒绝未声明的参数，检查必填参数，补充默认值，把字符串形式的数字和布尔值转换为对应类型
+func (d *ToolDefinition) validateArguments(arguments map[string]interface{}) (map[string]interface{}, error) {
+	params := d.params()
+	specs := make(map[string]*ParamSpec, len(params))
+	for i := range params {
+		specs[params[i].Name] = &params[i]
+	}
+
+	normalized := make(map[string]interface{}, len(arguments)+len(params))
+	for name, value := range arguments {
+		if reservedArgument(name) {
+			normalized[name] = value
+			continue
+		}
+		spec, ok := specs[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown parameter %q for tool %s; valid parameters: %s", name, d.Name, d.paramNames())
+		}
+		if value == nil {
+			// null等同于未提供
+			continue
+		}
+		coerced, err := spec.coerce(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid parameter %q: %w", name, err)
+		}
+		if fmt.Sprintf("%T", coerced) != fmt.Sprintf("%T", value) {
+			debugLog("Coerced parameter %s of %s from %s %v to %s", name, d.Name, jsonTypeName(value), value, spec.Type)
+		}
+		normalized[name] = coerced
+	}
+
+	for _, spec := range params {
+		if _, ok := normalized[spec.Name]; ok {
+			continue
+		}
+		if spec.Required {
+			return nil, fmt.Errorf("missing required parameter %q for tool %s", spec.Name, d.Name)
+		}
+		if spec.Default != nil {
+			// 默认值同样规范化 (如整数默认值转换为int64)，处理函数不必区分参数是否由客户端提供
+			normalized[spec.Name] = spec.Default
+			if coerced, err := spec.coerce(spec.Default); err == nil {
+				normalized[spec.Name] = coerced
+			}
+		}
+	}
+	if d.pathTarget() {
+		if err := resolveTargetPath(normalized); err != nil {
+			return nil, err
+		}
+	}
+	if d.Normalize != nil {
+		return d.Normalize(normalized)
+	}
+	return normalized, nil
+}
+
+// pathTarget 必填instanceId的工具可以用层级路径 (path) 代替instanceId指定目标对象，
+// 已经声明了path参数的工具按自己的方式处理
+func (d *ToolDefinition) pathTarget() bool {
+	hasPath := false
+	required := false
+	for _, p := range d.Params {
+		hasPath = hasPath || p.Name == "path"
+		required = required || (p.Name == "instanceId" && p.Required)
+	}
+	return required && !hasPath
+}
+
+// params 工具的完整参数列表；pathTarget工具增加path参数，instanceId变为可选
+func (d *ToolDefinition) params() []ParamSpec {
+	if !d.pathTarget() {
+		return d.Params
+	}
+	params := make([]ParamSpec, 0, len(d.Params)+1)
+	for _, p := range d.Params {
+		if p.Name == "instanceId" {
+			p.Required = false
+			p.Description += " (or give path)"
+		}
+		params = append(params, p)
+	}
+	return append(params, hierarchyPathParam)
+}
+
+// hierarchyPathParam pathTarget工具的path参数
+var hierarchyPathParam = ParamSpec{Name: "path", Type: "string", Description: "Hierarchy path of the target GameObject instead of instanceId, e.g. Canvas/MainMenu/PlayButton; * and ? match within one level, ** matches any number of levels, and the path must match exactly one object (see scene_resolve_path)"}
+
+// targetPathArgument 转发到Unity时path使用的参数名，Unity在执行工具前把它解析为instanceId
+const targetPathArgument = "_targetPath"
+
+// resolveTargetPath 需要instanceId或path中的一个，path改用targetPathArgument转发，
+// 避免与Unity工具自己的参数混淆
+func resolveTargetPath(arguments map[string]interface{}) error {
+	_, hasId := arguments["instanceId"]
+	targetPath, hasPath := arguments["path"].(string)
+	switch {
+	case hasId && hasPath:
+		return fmt.Errorf("instanceId and path are mutually exclusive")
+	case !hasId && !hasPath:
+		return fmt.Errorf("instanceId or path is required")
+	case hasPath && strings.Trim(targetPath, "/ ") == "":
+		return fmt.Errorf("path must not be empty")
+	}
+	if hasPath {
+		delete(arguments, "path")
+		arguments[targetPathArgument] = targetPath
+	}
+	return nil
+}
+
+// paramNames 返回排序后的参数名列表，用于错误信息
+func (d *ToolDefinition) paramNames() string {
+	params := d.params()
+	if len(params) == 0 {
+		return "(none)"
+	}
+	names := make([]string, 0, len(params))
+	for _, p := range params {
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ", ")
+}
+
+// coerce 检查参数类型，必要时转换为声明的类型
+func (p ParamSpec) coerce(value interface{}) (interface{}, error) {
+	switch p.Type {
+	case "number", "integer":
+		n, err := toNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		if p.Minimum != nil && n < *p.Minimum {
+			return nil, fmt.Errorf("must be >= %v, got %v", *p.Minimum, n)
+		}
+		if p.Maximum != nil && n > *p.Maximum {
+			return nil, fmt.Errorf("must be <= %v, got %v", *p.Maximum, n)
+		}
+		if p.Type == "integer" {
+			return toInteger(n)
+		}
+		return n, nil
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return v, nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("expected boolean, got %q", v)
+			}
+			return b, nil
+		}
+		return nil, fmt.Errorf("expected boolean, got %s", jsonTypeName(value))
+	case "string":
+		var s string
+		switch v := value.(type) {
+		case string:
+			s = v
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			s = strconv.FormatBool(v)
+		default:
+			return nil, fmt.Errorf("expected string, got %s", jsonTypeName(value))
+		}
+		if len(p.Enum) > 0 {
+			// 枚举值不区分大小写，统一转换为声明的写法
+			i := slices.IndexFunc(p.Enum, func(e string) bool { return strings.EqualFold(e, s) })
+			if i < 0 {
+				return nil, fmt.Errorf("must be one of %s, got %q", strings.Join(p.Enum, ", "), s)
+			}
+			s = p.Enum[i]
+		}
+		return s, nil
+	case "object":
+		if _, ok := value.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("expected object, got %s", jsonTypeName(value))
+		}
+	case "array":
+		if _, ok := value.([]interface{}); !ok {
+			return nil, fmt.Errorf("expected array, got %s", jsonTypeName(value))
+		}
+	case "vector":
+		return p.coerceVector(value)
+	case "color":
+		return parseColor(value)
+	}
+	return value, nil
+}
+
+// coerceVector 把vector参数转换为 {"x": ..., "y": ...} 形式的对象
+func (p ParamSpec) coerceVector(value interface{}) (map[string]interface{}, error) {
+	vector := make(map[string]interface{}, len(p.Components))
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, component := range v {
+			if !slices.Contains(p.Components, key) {
+				return nil, fmt.Errorf("unknown component %q, expected %s", key, strings.Join(p.Components, ", "))
+			}
+			n, err := toNumber(component)
+			if err != nil {
+				return nil, fmt.Errorf("component %s: %w", key, err)
+			}
+			vector[key] = n
+		}
+	case []interface{}:
+		if len(v) != len(p.Components) {
+			return nil, fmt.Errorf("expected an array of %d numbers [%s], got %d", len(p.Components), strings.Join(p.Components, ", "), len(v))
+		}
+		for i, component := range v {
+			n, err := toNumber(component)
+			if err != nil {
+				return nil, fmt.Errorf("component %s: %w", p.Components[i], err)
+			}
+			vector[p.Components[i]] = n
+		}
+	default:
+		return nil, fmt.Errorf("expected object or array, got %s", jsonTypeName(value))
+	}
+	return vector, nil
+}
+
+// toNumber 把JSON数字或字符串形式的数字转换为float64
+func toNumber(value interface{}) (float64, error) {
+	var n float64
+	switch v := value.(type) {
+	case float64:
+		n = v
+	case int:
+		n = float64(v)
+	case int64:
+		n = float64(v)
+	case string:
+		parsed, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return 0, fmt.Errorf("expected number, got %q", v)
+		}
+		n = parsed
+	default:
+		return 0, fmt.Errorf("expected number, got %s", jsonTypeName(value))
+	}
+	if math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, fmt.Errorf("expected a finite number, got %v", value)
+	}
+	return n, nil
+}
+
+// toInteger 把整数值的浮点数 (如12345.0、1.2345e4) 转换为int64
+// Unity端使用32位int (InstanceID、数量等)，超出范围的值直接拒绝，避免在Unity中溢出
+func toInteger(n float64) (int64, error) {
+	if n != math.Trunc(n) {
+		return 0, fmt.Errorf("expected integer, got %v", n)
+	}
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return 0, fmt.Errorf("integer %v is out of the 32-bit range", n)
+	}
+	return int64(n), nil
+}
+
+// jsonTypeName 返回值对应的JSON类型名
+func jsonTypeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64, int, int64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// 时间参数接受的格式，统一转换为RFC3339 (UTC) 后发送到Unity
+var timestampLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"}
+
+// parseTimestamp 解析ISO 8601时间，不带时区的时间按UTC处理
+func parseTimestamp(value string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("expected an ISO 8601 timestamp such as 2024-05-01 or 2024-05-01T12:00:00Z, got %q", value)
+}
+
+// normalizeSavePath 检查新建资源的savePath: 位于Assets/下、扩展名为ext、路径已规范化
+func normalizeSavePath(arguments map[string]interface{}, ext string) error {
+	savePath, _ := arguments["savePath"].(string)
+	savePath = strings.ReplaceAll(savePath, "\\", "/")
+	if !strings.HasPrefix(savePath, "Assets/") || !strings.EqualFold(path.Ext(savePath), ext) {
+		return fmt.Errorf("savePath must be under Assets/ and end with %s, got %q", ext, savePath)
+	}
+	if clean := path.Clean(savePath); clean != savePath {
+		return fmt.Errorf("savePath must be a clean path such as %q, got %q", clean, savePath)
+	}
+	arguments["savePath"] = savePath
+	return nil
+}
+
+// floatPtr 用于ParamSpec的Minimum/Maximum
+func floatPtr(v float64) *float64 {
+	return &v
+}
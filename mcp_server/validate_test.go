@@ -0,0 +1,113 @@
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestToNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    float64
+		wantErr bool
+	}{
+		{"float", 1.5, 1.5, false},
+		{"int", 42, 42, false},
+		{"int64", int64(-7), -7, false},
+		{"exponent float", 1e3, 1000, false},
+		{"exponent string", "1e3", 1000, false},
+		{"string with spaces", " 12.5 ", 12.5, false},
+		{"negative string", "-3", -3, false},
+		{"not a number", "abc", 0, true},
+		{"empty string", "", 0, true},
+		{"NaN string", "NaN", 0, true},
+		{"infinity string", "Inf", 0, true},
+		{"overflowing string", "1e400", 0, true},
+		{"boolean", true, 0, true},
+		{"null", nil, 0, true},
+		{"array", []interface{}{1.0}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toNumber(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toNumber(%#v) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("toNumber(%#v) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToInteger(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    int64
+		wantErr bool
+	}{
+		{"integer float", 12345.0, 12345, false},
+		{"exponent float", 1.2345e4, 12345, false},
+		{"exponent string", "1e3", 1000, false},
+		{"integer string", "-42", -42, false},
+		{"negative zero", math.Copysign(0, -1), 0, false},
+		{"max int32", float64(math.MaxInt32), math.MaxInt32, false},
+		{"min int32", float64(math.MinInt32), math.MinInt32, false},
+		{"fraction", 1.5, 0, true},
+		{"fraction string", "2.25", 0, true},
+		{"fractional exponent", "1.5e-1", 0, true},
+		{"above int32", float64(math.MaxInt32) + 1, 0, true},
+		{"below int32", float64(math.MinInt32) - 1, 0, true},
+		{"int64 overflow", "9223372036854775808", 0, true},
+		{"int64 overflow exponent", 1e19, 0, true},
+		{"max int64", int64(math.MaxInt64), 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := toNumber(tt.value)
+			if err != nil {
+				t.Fatalf("toNumber(%#v): %v", tt.value, err)
+			}
+			got, err := toInteger(n)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("toInteger(%v) error = %v, wantErr %v", n, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("toInteger(%v) = %d, want %d", n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateArgumentsReservedNames(t *testing.T) {
+	tests := []struct {
+		name      string
+		tool      string
+		arguments map[string]interface{}
+		wantErr   bool
+	}{
+		{"retries", "scene_get", map[string]interface{}{"_retries": 3.0}, false},
+		{"dry run", "scene_get", map[string]interface{}{"_dryRun": true}, false},
+		{"unknown underscore name", "scene_get", map[string]interface{}{"_foo": 1.0}, true},
+		{"internal target path", "scene_get", map[string]interface{}{"_targetPath": "Player"}, true},
+		{"prefab path target", "prefab_modify", map[string]interface{}{"path": "Player", "operation": "apply"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := lookupDefinition(t, tt.tool)
+			normalized, err := def.validateArguments(tt.arguments)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateArguments(%v) error = %v, wantErr %v", tt.arguments, err, tt.wantErr)
+			}
+			if err == nil {
+				for name := range tt.arguments {
+					if _, ok := normalized[name]; !ok && name != "path" {
+						t.Errorf("%s missing from the normalized arguments", name)
+					}
+				}
+			}
+		})
+	}
+}
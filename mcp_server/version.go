@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+)
+
+// 构建信息，发布构建通过 -ldflags "-X main.version=... -X main.commit=... -X main.buildDate=..." 注入
+var (
+	version   = "dev"
+	commit    = ""
+	buildDate = ""
+)
+
+func init() {
+	// 未注入commit时 (例如 go build / go install)，尝试使用Go记录的VCS信息
+	if commit != "" && buildDate != "" {
+		return
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return
+	}
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			if commit == "" {
+				commit = setting.Value
+				if len(commit) > 12 {
+					commit = commit[:12]
+				}
+			}
+		case "vcs.time":
+			if buildDate == "" {
+				buildDate = setting.Value
+			}
+		}
+	}
+}
+
+// versionInfo 返回构建信息，用于 /health
+func versionInfo() map[string]interface{} {
+	return map[string]interface{}{
+		"version":   version,
+		"commit":    valueOrUnknown(commit),
+		"buildDate": valueOrUnknown(buildDate),
+		"goVersion": runtime.Version(),
+	}
+}
+
+// versionString 返回单行构建信息，用于 -version 和启动日志
+func versionString() string {
+	return fmt.Sprintf("unity-mcp-server %s (commit %s, built %s, %s %s/%s)",
+		version, valueOrUnknown(commit), valueOrUnknown(buildDate), runtime.Version(), runtime.GOOS, runtime.GOARCH)
+}
+
+func valueOrUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
@@ -0,0 +1,145 @@
+using System.Collections.Generic;
+using System.IO;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 动画片段创建工具 - 用简单的关键帧曲线创建AnimationClip资源
+/// 属性白名单和关键帧顺序由Go端检查，Go端已把属性转换为绑定名 (propertyName) 和组件类型 (componentType)
+/// </summary>
+public class AnimationClipCreateTool : IMCPTool
+{
+    public string ToolName => "animation_clip_create";
+    
+    public string Description => "创建动画片段";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string savePath = parameters["savePath"].ToString();
+            bool overwrite = parameters.ContainsKey("overwrite") && System.Convert.ToBoolean(parameters["overwrite"]);
+            if (!overwrite && AssetDatabase.LoadAssetAtPath<Object>(savePath) != null)
+            {
+                return MCPResponse.Error($"资源已存在: {savePath}，设置overwrite=true以替换");
+            }
+            
+            // 先解析所有组件类型，任何一个无效都不创建资源
+            List<Dictionary<string, object>> curves = AnimatorControllerHelper.ReadObjects(parameters, "curves");
+            var types = new List<System.Type>();
+            foreach (var curve in curves)
+            {
+                string typeName = curve["componentType"].ToString();
+                System.Type type = FindType(typeName);
+                if (type == null)
+                {
+                    return MCPResponse.Error($"未找到组件类型: {typeName}");
+                }
+                types.Add(type);
+            }
+            
+            var clip = new AnimationClip
+            {
+                frameRate = parameters.ContainsKey("frameRate") ? System.Convert.ToSingle(parameters["frameRate"]) : 60f
+            };
+            for (int i = 0; i < curves.Count; i++)
+            {
+                string targetPath = curves[i].ContainsKey("targetPath") ? curves[i]["targetPath"].ToString() : "";
+                var binding = EditorCurveBinding.FloatCurve(targetPath, types[i], curves[i]["propertyName"].ToString());
+                AnimationUtility.SetEditorCurve(clip, binding, BuildCurve(AnimatorControllerHelper.ReadObjects(curves[i], "keyframes")));
+            }
+            
+            AnimationClipSettings settings = AnimationUtility.GetAnimationClipSettings(clip);
+            settings.loopTime = parameters.ContainsKey("loop") && System.Convert.ToBoolean(parameters["loop"]);
+            AnimationUtility.SetAnimationClipSettings(clip, settings);
+            
+            string directory = Path.GetDirectoryName(savePath);
+            if (!string.IsNullOrEmpty(directory) && !Directory.Exists(directory))
+            {
+                Directory.CreateDirectory(directory);
+                AssetDatabase.Refresh();
+            }
+            if (overwrite)
+            {
+                AssetDatabase.DeleteAsset(savePath);
+            }
+            AssetDatabase.CreateAsset(clip, savePath);
+            AssetDatabase.SaveAssets();
+            
+            Debug.Log($"创建动画片段: {savePath} ({curves.Count} 条曲线, {clip.length:F2} 秒)");
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["path"] = savePath,
+                ["length"] = clip.length,
+                ["frameRate"] = clip.frameRate,
+                ["loop"] = settings.loopTime,
+                ["curveCount"] = AnimationUtility.GetCurveBindings(clip).Length
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"创建动画片段时出错: {e.Message}");
+            return MCPResponse.Error($"创建动画片段失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 按关键帧创建曲线，未给出切线的关键帧使用ClampedAuto平滑切线
+    /// </summary>
+    private AnimationCurve BuildCurve(List<Dictionary<string, object>> keyframes)
+    {
+        var curve = new AnimationCurve();
+        var autoTangents = new List<int>();
+        foreach (var keyframe in keyframes)
+        {
+            float time = System.Convert.ToSingle(keyframe["time"]);
+            float value = System.Convert.ToSingle(keyframe["value"]);
+            bool hasTangents = keyframe.ContainsKey("inTangent") || keyframe.ContainsKey("outTangent");
+            var key = hasTangents
+                ? new Keyframe(time, value,
+                    keyframe.ContainsKey("inTangent") ? System.Convert.ToSingle(keyframe["inTangent"]) : 0f,
+                    keyframe.ContainsKey("outTangent") ? System.Convert.ToSingle(keyframe["outTangent"]) : 0f)
+                : new Keyframe(time, value);
+            int index = curve.AddKey(key);
+            if (!hasTangents)
+            {
+                autoTangents.Add(index);
+            }
+        }
+        foreach (int index in autoTangents)
+        {
+            AnimationUtility.SetKeyLeftTangentMode(curve, index, AnimationUtility.TangentMode.ClampedAuto);
+            AnimationUtility.SetKeyRightTangentMode(curve, index, AnimationUtility.TangentMode.ClampedAuto);
+        }
+        return curve;
+    }
+    
+    /// <summary>
+    /// 按类名查找GameObject或组件类型
+    /// </summary>
+    private static System.Type FindType(string typeName)
+    {
+        if (typeName == "GameObject")
+        {
+            return typeof(GameObject);
+        }
+        foreach (System.Type type in TypeCache.GetTypesDerivedFrom<Component>())
+        {
+            if (type.Name == typeName)
+            {
+                return type;
+            }
+        }
+        return null;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("savePath") || !parameters.ContainsKey("curves"))
+        {
+            return "缺少必需参数: savePath和curves";
+        }
+        return null;
+    }
+}
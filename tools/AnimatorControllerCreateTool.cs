@@ -0,0 +1,135 @@
+using System.Collections.Generic;
+using System.IO;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.Animations;
+
+/// <summary>
+/// Animator控制器创建工具 - 创建控制器资源并添加状态、参数、默认状态和过渡
+/// 状态名、过渡和条件引用由Go端检查，这里先加载所有动画片段，缺少任何一个都不创建资源
+/// </summary>
+public class AnimatorControllerCreateTool : IMCPTool
+{
+    public string ToolName => "animator_controller_create";
+    
+    public string Description => "创建Animator控制器";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string savePath = parameters["savePath"].ToString();
+            bool overwrite = parameters.ContainsKey("overwrite") && System.Convert.ToBoolean(parameters["overwrite"]);
+            if (!overwrite && AssetDatabase.LoadAssetAtPath<Object>(savePath) != null)
+            {
+                return MCPResponse.Error($"资源已存在: {savePath}，设置overwrite=true以替换");
+            }
+            
+            List<Dictionary<string, object>> stateDefinitions = AnimatorControllerHelper.ReadObjects(parameters, "states");
+            var clips = new Dictionary<string, AnimationClip>();
+            var missing = new List<string>();
+            foreach (var definition in stateDefinitions)
+            {
+                if (!definition.ContainsKey("clipPath"))
+                {
+                    continue;
+                }
+                string clipPath = definition["clipPath"].ToString();
+                AnimationClip clip = AssetDatabase.LoadAssetAtPath<AnimationClip>(clipPath);
+                if (clip == null)
+                {
+                    missing.Add(clipPath);
+                    continue;
+                }
+                clips[definition["name"].ToString()] = clip;
+            }
+            if (missing.Count > 0)
+            {
+                return MCPResponse.Error($"未找到动画片段: {string.Join(", ", missing)}");
+            }
+            
+            string directory = Path.GetDirectoryName(savePath);
+            if (!string.IsNullOrEmpty(directory) && !Directory.Exists(directory))
+            {
+                Directory.CreateDirectory(directory);
+                AssetDatabase.Refresh();
+            }
+            if (overwrite)
+            {
+                AssetDatabase.DeleteAsset(savePath);
+            }
+            
+            AnimatorController controller = AnimatorController.CreateAnimatorControllerAtPath(savePath);
+            AnimatorControllerHelper.ApplyParameters(controller, AnimatorControllerHelper.ReadObjects(parameters, "parameters"));
+            
+            AnimatorStateMachine stateMachine = controller.layers[0].stateMachine;
+            var states = new Dictionary<string, AnimatorState>();
+            foreach (var definition in stateDefinitions)
+            {
+                string name = definition["name"].ToString();
+                AnimatorState state = stateMachine.AddState(name);
+                if (clips.TryGetValue(name, out AnimationClip clip))
+                {
+                    state.motion = clip;
+                }
+                if (definition.ContainsKey("speed"))
+                {
+                    state.speed = System.Convert.ToSingle(definition["speed"]);
+                }
+                states[name] = state;
+            }
+            if (parameters.ContainsKey("defaultState"))
+            {
+                stateMachine.defaultState = states[parameters["defaultState"].ToString()];
+            }
+            
+            foreach (var definition in AnimatorControllerHelper.ReadObjects(parameters, "transitions"))
+            {
+                string from = definition["from"].ToString();
+                AnimatorState to = states[definition["to"].ToString()];
+                AnimatorStateTransition transition = from == "Any"
+                    ? stateMachine.AddAnyStateTransition(to)
+                    : states[from].AddTransition(to);
+                transition.hasExitTime = definition.ContainsKey("hasExitTime") && System.Convert.ToBoolean(definition["hasExitTime"]);
+                if (definition.ContainsKey("exitTime")) transition.exitTime = System.Convert.ToSingle(definition["exitTime"]);
+                if (definition.ContainsKey("duration")) transition.duration = System.Convert.ToSingle(definition["duration"]);
+                
+                foreach (var condition in AnimatorControllerHelper.ReadObjects(definition, "conditions"))
+                {
+                    var mode = (AnimatorConditionMode)System.Enum.Parse(typeof(AnimatorConditionMode), condition["mode"].ToString());
+                    float threshold = condition.ContainsKey("threshold") ? System.Convert.ToSingle(condition["threshold"]) : 0f;
+                    transition.AddCondition(mode, threshold, condition["parameter"].ToString());
+                }
+            }
+            
+            EditorUtility.SetDirty(controller);
+            AssetDatabase.SaveAssets();
+            
+            Debug.Log($"创建Animator控制器: {savePath} ({states.Count} 个状态)");
+            return MCPResponse.Success(AnimatorControllerHelper.Describe(controller));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"创建Animator控制器时出错: {e.Message}");
+            return MCPResponse.Error($"创建Animator控制器失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("savePath") || !parameters.ContainsKey("states"))
+        {
+            return "缺少必需参数: savePath和states";
+        }
+        foreach (string key in new[] { "states", "parameters", "transitions" })
+        {
+            string error = AnimatorControllerHelper.CheckObjects(parameters, key);
+            if (error != null)
+            {
+                return error;
+            }
+        }
+        return null;
+    }
+}
@@ -0,0 +1,177 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.Animations;
+
+/// <summary>
+/// Animator控制器辅助方法: 描述控制器的状态、过渡和参数，定义或更新参数
+/// </summary>
+public static class AnimatorControllerHelper
+{
+    /// <summary>
+    /// 控制器的状态和参数清单，只描述第一层 (Base Layer) 的状态机
+    /// </summary>
+    public static Dictionary<string, object> Describe(AnimatorController controller)
+    {
+        var states = new List<object>();
+        var transitions = new List<object>();
+        AnimatorStateMachine stateMachine = controller.layers.Length > 0 ? controller.layers[0].stateMachine : null;
+        if (stateMachine != null)
+        {
+            foreach (ChildAnimatorState child in stateMachine.states)
+            {
+                AnimatorState state = child.state;
+                states.Add(new Dictionary<string, object>
+                {
+                    ["name"] = state.name,
+                    ["clipPath"] = state.motion != null ? AssetDatabase.GetAssetPath(state.motion) : null,
+                    ["speed"] = state.speed,
+                    ["isDefault"] = stateMachine.defaultState == state
+                });
+                foreach (AnimatorStateTransition transition in state.transitions)
+                {
+                    transitions.Add(DescribeTransition(state.name, transition));
+                }
+            }
+            foreach (AnimatorStateTransition transition in stateMachine.anyStateTransitions)
+            {
+                transitions.Add(DescribeTransition("Any", transition));
+            }
+        }
+        
+        return new Dictionary<string, object>
+        {
+            ["path"] = AssetDatabase.GetAssetPath(controller),
+            ["name"] = controller.name,
+            ["layers"] = controller.layers.Length,
+            ["states"] = states,
+            ["transitions"] = transitions,
+            ["parameters"] = DescribeParameters(controller.parameters)
+        };
+    }
+    
+    private static Dictionary<string, object> DescribeTransition(string from, AnimatorStateTransition transition)
+    {
+        var conditions = new List<object>();
+        foreach (AnimatorCondition condition in transition.conditions)
+        {
+            conditions.Add(new Dictionary<string, object>
+            {
+                ["parameter"] = condition.parameter,
+                ["mode"] = condition.mode.ToString(),
+                ["threshold"] = condition.threshold
+            });
+        }
+        return new Dictionary<string, object>
+        {
+            ["from"] = from,
+            ["to"] = transition.destinationState != null ? transition.destinationState.name : null,
+            ["hasExitTime"] = transition.hasExitTime,
+            ["exitTime"] = transition.exitTime,
+            ["duration"] = transition.duration,
+            ["conditions"] = conditions
+        };
+    }
+    
+    public static List<object> DescribeParameters(AnimatorControllerParameter[] parameters)
+    {
+        var result = new List<object>();
+        foreach (AnimatorControllerParameter parameter in parameters)
+        {
+            object value;
+            switch (parameter.type)
+            {
+                case AnimatorControllerParameterType.Float: value = parameter.defaultFloat; break;
+                case AnimatorControllerParameterType.Int: value = parameter.defaultInt; break;
+                case AnimatorControllerParameterType.Bool: value = parameter.defaultBool; break;
+                default: value = null; break;
+            }
+            result.Add(new Dictionary<string, object>
+            {
+                ["name"] = parameter.name,
+                ["type"] = parameter.type.ToString(),
+                ["default"] = value
+            });
+        }
+        return result;
+    }
+    
+    /// <summary>
+    /// 检查对象数组参数的每个元素都是对象，参数不存在时返回null
+    /// </summary>
+    public static string CheckObjects(Dictionary<string, object> parameters, string key)
+    {
+        if (!parameters.ContainsKey(key))
+        {
+            return null;
+        }
+        if (!(parameters[key] is List<object> items))
+        {
+            return $"{key}必须是对象数组";
+        }
+        for (int i = 0; i < items.Count; i++)
+        {
+            if (!(items[i] is Dictionary<string, object>))
+            {
+                return $"{key}[{i}] 必须是对象";
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 读取对象数组参数 (状态、参数定义、过渡、条件)，忽略非对象元素，需要报错的调用方先用CheckObjects检查
+    /// </summary>
+    public static List<Dictionary<string, object>> ReadObjects(Dictionary<string, object> parameters, string key)
+    {
+        var objects = new List<Dictionary<string, object>>();
+        if (parameters.ContainsKey(key) && parameters[key] is System.Collections.IEnumerable items)
+        {
+            foreach (var item in items)
+            {
+                if (item is Dictionary<string, object> obj)
+                {
+                    objects.Add(obj);
+                }
+            }
+        }
+        return objects;
+    }
+    
+    /// <summary>
+    /// 定义参数，同名参数存在时更新类型和默认值
+    /// AnimatorController.parameters返回副本，修改后整体写回
+    /// </summary>
+    public static List<string> ApplyParameters(AnimatorController controller, List<Dictionary<string, object>> definitions)
+    {
+        var list = new List<AnimatorControllerParameter>(controller.parameters);
+        var applied = new List<string>();
+        foreach (var definition in definitions)
+        {
+            string name = definition["name"].ToString();
+            var type = (AnimatorControllerParameterType)System.Enum.Parse(typeof(AnimatorControllerParameterType), definition["type"].ToString());
+            AnimatorControllerParameter parameter = list.Find(p => p.name == name);
+            if (parameter == null)
+            {
+                parameter = new AnimatorControllerParameter { name = name };
+                list.Add(parameter);
+            }
+            parameter.type = type;
+            if (definition.ContainsKey("default") && definition["default"] != null)
+            {
+                object value = definition["default"];
+                switch (type)
+                {
+                    case AnimatorControllerParameterType.Float: parameter.defaultFloat = System.Convert.ToSingle(value); break;
+                    case AnimatorControllerParameterType.Int: parameter.defaultInt = System.Convert.ToInt32(value); break;
+                    case AnimatorControllerParameterType.Bool: parameter.defaultBool = System.Convert.ToBoolean(value); break;
+                }
+            }
+            applied.Add(name);
+        }
+        Undo.RecordObject(controller, "Set Animator Parameters");
+        controller.parameters = list.ToArray();
+        EditorUtility.SetDirty(controller);
+        return applied;
+    }
+}
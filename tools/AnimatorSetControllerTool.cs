@@ -0,0 +1,76 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.Animations;
+
+/// <summary>
+/// Animator控制器设置工具 - 为GameObject的Animator指定控制器，没有Animator时添加
+/// </summary>
+public class AnimatorSetControllerTool : IMCPTool
+{
+    public string ToolName => "animator_set_controller";
+    
+    public string Description => "设置Animator控制器";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (gameObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            string controllerPath = parameters["controllerPath"].ToString();
+            RuntimeAnimatorController controller = null;
+            if (!string.IsNullOrEmpty(controllerPath))
+            {
+                controller = AssetDatabase.LoadAssetAtPath<RuntimeAnimatorController>(controllerPath);
+                if (controller == null)
+                {
+                    return MCPResponse.Error($"未找到Animator控制器: {controllerPath}");
+                }
+            }
+            
+            Animator animator = gameObject.GetComponent<Animator>();
+            bool added = false;
+            if (animator == null)
+            {
+                animator = Undo.AddComponent<Animator>(gameObject);
+                added = true;
+            }
+            else
+            {
+                Undo.RecordObject(animator, "Set Animator Controller");
+            }
+            animator.runtimeAnimatorController = controller;
+            EditorUtility.SetDirty(animator);
+            
+            Debug.Log($"设置 '{gameObject.name}' 的Animator控制器: {(controller != null ? controllerPath : "无")}");
+            var result = controller is AnimatorController animatorController
+                ? AnimatorControllerHelper.Describe(animatorController)
+                : new Dictionary<string, object> { ["path"] = controllerPath };
+            result["instanceId"] = instanceId;
+            result["gameObject"] = gameObject.name;
+            result["animatorAdded"] = added;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置Animator控制器时出错: {e.Message}");
+            return MCPResponse.Error($"设置Animator控制器失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId") || !parameters.ContainsKey("controllerPath"))
+        {
+            return "缺少必需参数: instanceId和controllerPath";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,162 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.Animations;
+
+/// <summary>
+/// Animator参数工具 - 在控制器上定义或更新参数，播放模式下设置Animator的运行时参数值
+/// </summary>
+public class AnimatorSetParametersTool : IMCPTool
+{
+    public string ToolName => "animator_set_parameters";
+    
+    public string Description => "设置Animator参数";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Animator animator = null;
+            AnimatorController controller;
+            if (parameters.ContainsKey("instanceId"))
+            {
+                int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+                GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+                if (gameObject == null)
+                {
+                    return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+                }
+                animator = gameObject.GetComponent<Animator>();
+                if (animator == null)
+                {
+                    return MCPResponse.Error($"GameObject '{gameObject.name}' 没有Animator组件");
+                }
+                controller = animator.runtimeAnimatorController as AnimatorController;
+                if (controller == null)
+                {
+                    return MCPResponse.Error($"GameObject '{gameObject.name}' 的Animator没有指定AnimatorController");
+                }
+            }
+            else
+            {
+                string controllerPath = parameters["controllerPath"].ToString();
+                controller = AssetDatabase.LoadAssetAtPath<AnimatorController>(controllerPath);
+                if (controller == null)
+                {
+                    return MCPResponse.Error($"未找到Animator控制器: {controllerPath}");
+                }
+            }
+            
+            var values = parameters.ContainsKey("values") ? (Dictionary<string, object>)parameters["values"] : null;
+            if (values != null && values.Count > 0 && !EditorApplication.isPlaying)
+            {
+                return MCPResponse.Error("values只能在播放模式下设置，编辑模式下请通过parameters修改默认值");
+            }
+            
+            List<string> defined = new List<string>();
+            List<Dictionary<string, object>> definitions = AnimatorControllerHelper.ReadObjects(parameters, "parameters");
+            if (definitions.Count > 0)
+            {
+                defined = AnimatorControllerHelper.ApplyParameters(controller, definitions);
+                AssetDatabase.SaveAssets();
+            }
+            
+            Dictionary<string, object> result = AnimatorControllerHelper.Describe(controller);
+            result["defined"] = defined;
+            if (values != null && values.Count > 0)
+            {
+                string error = SetValues(animator, values);
+                if (error != null)
+                {
+                    return MCPResponse.Error(error);
+                }
+            }
+            if (animator != null && EditorApplication.isPlaying)
+            {
+                result["values"] = ReadValues(animator);
+            }
+            
+            Debug.Log($"设置Animator参数 '{controller.name}': 定义 {defined.Count} 个，运行时值 {values?.Count ?? 0} 个");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置Animator参数时出错: {e.Message}");
+            return MCPResponse.Error($"设置Animator参数失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 按Animator中的参数类型设置运行时值，先检查全部参数再设置
+    /// </summary>
+    private string SetValues(Animator animator, Dictionary<string, object> values)
+    {
+        var types = new Dictionary<string, AnimatorControllerParameterType>();
+        foreach (AnimatorControllerParameter parameter in animator.parameters)
+        {
+            types[parameter.name] = parameter.type;
+        }
+        var unknown = new List<string>();
+        foreach (string name in values.Keys)
+        {
+            if (!types.ContainsKey(name))
+            {
+                unknown.Add(name);
+            }
+        }
+        if (unknown.Count > 0)
+        {
+            return $"Animator没有这些参数: {string.Join(", ", unknown)}";
+        }
+        
+        foreach (var entry in values)
+        {
+            switch (types[entry.Key])
+            {
+                case AnimatorControllerParameterType.Float:
+                    animator.SetFloat(entry.Key, System.Convert.ToSingle(entry.Value));
+                    break;
+                case AnimatorControllerParameterType.Int:
+                    animator.SetInteger(entry.Key, System.Convert.ToInt32(entry.Value));
+                    break;
+                case AnimatorControllerParameterType.Bool:
+                    animator.SetBool(entry.Key, System.Convert.ToBoolean(entry.Value));
+                    break;
+                case AnimatorControllerParameterType.Trigger:
+                    if (System.Convert.ToBoolean(entry.Value)) animator.SetTrigger(entry.Key);
+                    else animator.ResetTrigger(entry.Key);
+                    break;
+            }
+        }
+        return null;
+    }
+    
+    private Dictionary<string, object> ReadValues(Animator animator)
+    {
+        var values = new Dictionary<string, object>();
+        foreach (AnimatorControllerParameter parameter in animator.parameters)
+        {
+            switch (parameter.type)
+            {
+                case AnimatorControllerParameterType.Float: values[parameter.name] = animator.GetFloat(parameter.name); break;
+                case AnimatorControllerParameterType.Int: values[parameter.name] = animator.GetInteger(parameter.name); break;
+                default: values[parameter.name] = animator.GetBool(parameter.name); break;
+            }
+        }
+        return values;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId") && !parameters.ContainsKey("controllerPath"))
+        {
+            return "缺少必需参数: instanceId或controllerPath";
+        }
+        if (parameters.ContainsKey("values") && !(parameters["values"] is Dictionary<string, object>))
+        {
+            return "values必须是 {参数名: 值} 对象";
+        }
+        return AnimatorControllerHelper.CheckObjects(parameters, "parameters");
+    }
+}
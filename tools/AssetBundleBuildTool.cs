@@ -0,0 +1,181 @@
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// AssetBundle构建工具 - 构建指定平台的AssetBundle并返回清单摘要
+/// BuildPipeline.BuildAssetBundles在主线程上同步执行且没有进度回调，开始、构建和汇总阶段以进度帧报告
+/// </summary>
+public class AssetBundleBuildTool : IMCPTool
+{
+    private readonly MCPMessageDispatcher dispatcher;
+    
+    public AssetBundleBuildTool(MCPMessageDispatcher dispatcher)
+    {
+        this.dispatcher = dispatcher;
+    }
+    
+    public string ToolName => "assetbundle_build";
+    
+    public string Description => "构建AssetBundle";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            if (BuildPipeline.isBuildingPlayer)
+            {
+                return MCPResponse.Error("已有构建正在进行");
+            }
+            if (EditorApplication.isPlayingOrWillChangePlaymode)
+            {
+                return MCPResponse.Error("播放模式下无法构建，请先退出播放模式");
+            }
+            if (EditorApplication.isCompiling)
+            {
+                return MCPResponse.Error("脚本正在编译，请等待编译完成后再构建");
+            }
+            
+            string targetName = parameters["target"].ToString();
+            if (!System.Enum.TryParse(targetName, out BuildTarget target))
+            {
+                return MCPResponse.Error($"未知的构建目标: {targetName}");
+            }
+            if (!BuildPipeline.IsBuildTargetSupported(BuildPipeline.GetBuildTargetGroup(target), target))
+            {
+                return MCPResponse.Error($"未安装 {targetName} 的构建支持模块");
+            }
+            
+            string[] allNames = AssetDatabase.GetAllAssetBundleNames();
+            if (allNames.Length == 0)
+            {
+                return MCPResponse.Error("项目中没有分配AssetBundle名称的资源，请先使用assetbundle_set_name");
+            }
+            AssetBundleBuild[] builds = ReadBuilds(parameters, allNames, out string buildError);
+            if (buildError != null)
+            {
+                return MCPResponse.Error(buildError);
+            }
+            
+            string compression = parameters.ContainsKey("compression") ? parameters["compression"].ToString() : "LZ4";
+            BuildAssetBundleOptions options = ReadOptions(parameters, compression);
+            string outputPath = parameters["outputPath"].ToString();
+            Directory.CreateDirectory(outputPath);
+            
+            int bundleCount = builds?.Length ?? allNames.Length;
+            Debug.Log($"开始构建 {bundleCount} 个AssetBundle ({targetName}): {outputPath}");
+            dispatcher.SendProgress(client, 0.05f, $"正在构建 {bundleCount} 个AssetBundle");
+            var started = System.DateTime.Now;
+            AssetBundleManifest manifest = builds == null
+                ? BuildPipeline.BuildAssetBundles(outputPath, options, target)
+                : BuildPipeline.BuildAssetBundles(outputPath, builds, options, target);
+            if (manifest == null)
+            {
+                return MCPResponse.Error("构建AssetBundle失败，详细错误见Unity控制台");
+            }
+            
+            dispatcher.SendProgress(client, 0.95f, "正在汇总清单");
+            var bundles = new List<object>();
+            long totalSize = 0;
+            foreach (string name in manifest.GetAllAssetBundles())
+            {
+                var file = new FileInfo(Path.Combine(outputPath, name));
+                long size = file.Exists ? file.Length : 0;
+                totalSize += size;
+                bundles.Add(new Dictionary<string, object>
+                {
+                    ["name"] = name,
+                    ["sizeBytes"] = size,
+                    ["hash"] = manifest.GetAssetBundleHash(name).ToString(),
+                    ["dependencies"] = manifest.GetDirectDependencies(name)
+                });
+            }
+            double duration = (System.DateTime.Now - started).TotalSeconds;
+            
+            Debug.Log($"AssetBundle构建结束: {bundles.Count} 个，耗时 {duration:F1}秒");
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["target"] = targetName,
+                ["outputPath"] = Path.GetFullPath(outputPath),
+                ["compression"] = compression,
+                ["bundleCount"] = bundles.Count,
+                ["totalSizeBytes"] = totalSize,
+                ["durationSeconds"] = duration,
+                ["bundles"] = bundles
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"构建AssetBundle时出错: {e.Message}");
+            return MCPResponse.Error($"构建AssetBundle失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 读取要构建的bundle，未给出时返回null表示构建项目中的全部bundle
+    /// </summary>
+    private AssetBundleBuild[] ReadBuilds(Dictionary<string, object> parameters, string[] allNames, out string error)
+    {
+        error = null;
+        if (!parameters.ContainsKey("bundles") || !(parameters["bundles"] is System.Collections.IEnumerable items))
+        {
+            return null;
+        }
+        
+        var builds = new List<AssetBundleBuild>();
+        var missing = new List<string>();
+        foreach (var item in items)
+        {
+            string name = item.ToString();
+            if (!allNames.Contains(name))
+            {
+                missing.Add(name);
+                continue;
+            }
+            builds.Add(new AssetBundleBuild
+            {
+                assetBundleName = name,
+                assetNames = AssetDatabase.GetAssetPathsFromAssetBundle(name)
+            });
+        }
+        if (missing.Count > 0)
+        {
+            error = $"AssetBundle不存在: {string.Join(", ", missing)}";
+        }
+        return builds.ToArray();
+    }
+    
+    private BuildAssetBundleOptions ReadOptions(Dictionary<string, object> parameters, string compression)
+    {
+        BuildAssetBundleOptions options = BuildAssetBundleOptions.None;
+        if (compression == "LZ4")
+        {
+            options |= BuildAssetBundleOptions.ChunkBasedCompression;
+        }
+        else if (compression == "Uncompressed")
+        {
+            options |= BuildAssetBundleOptions.UncompressedAssetBundle;
+        }
+        if (parameters.ContainsKey("forceRebuild") && System.Convert.ToBoolean(parameters["forceRebuild"]))
+        {
+            options |= BuildAssetBundleOptions.ForceRebuildAssetBundle;
+        }
+        if (parameters.ContainsKey("strictMode") && System.Convert.ToBoolean(parameters["strictMode"]))
+        {
+            options |= BuildAssetBundleOptions.StrictMode;
+        }
+        return options;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("target") || !parameters.ContainsKey("outputPath"))
+        {
+            return "缺少必需参数: target和outputPath";
+        }
+        return null;
+    }
+}
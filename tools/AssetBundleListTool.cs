@@ -0,0 +1,64 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// AssetBundle列表工具 - 列出项目中的AssetBundle名称、分配的资源和依赖
+/// </summary>
+public class AssetBundleListTool : IMCPTool
+{
+    public string ToolName => "assetbundle_list";
+    
+    public string Description => "列出AssetBundle";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string[] names = AssetDatabase.GetAllAssetBundleNames();
+            string[] unused = AssetDatabase.GetUnusedAssetBundleNames();
+            if (parameters.ContainsKey("bundleName"))
+            {
+                string filter = parameters["bundleName"].ToString().ToLowerInvariant();
+                names = names.Where(n => n == filter || n.StartsWith(filter + ".")).ToArray();
+                if (names.Length == 0)
+                {
+                    return MCPResponse.Error($"AssetBundle不存在: {filter}");
+                }
+            }
+            
+            var bundles = new List<object>();
+            foreach (string name in names)
+            {
+                string[] assets = AssetDatabase.GetAssetPathsFromAssetBundle(name);
+                bundles.Add(new Dictionary<string, object>
+                {
+                    ["name"] = name,
+                    ["assetCount"] = assets.Length,
+                    ["assets"] = assets,
+                    ["dependencies"] = AssetDatabase.GetAssetBundleDependencies(name, false),
+                    ["unused"] = unused.Contains(name)
+                });
+            }
+            
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["count"] = bundles.Count,
+                ["bundles"] = bundles,
+                ["unusedNames"] = unused
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"列出AssetBundle时出错: {e.Message}");
+            return MCPResponse.Error($"列出AssetBundle失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,62 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// AssetBundle名称设置工具 - 把资源或文件夹分配到AssetBundle，空名称清除分配
+/// </summary>
+public class AssetBundleSetNameTool : IMCPTool
+{
+    public string ToolName => "assetbundle_set_name";
+    
+    public string Description => "设置资源的AssetBundle名称";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            string bundleName = parameters["bundleName"]?.ToString() ?? "";
+            string variant = parameters.ContainsKey("variant") ? parameters["variant"]?.ToString() ?? "" : "";
+            
+            AssetImporter importer = AssetImporter.GetAtPath(assetPath);
+            if (importer == null)
+            {
+                return MCPResponse.Error($"资源不存在: {assetPath}");
+            }
+            
+            string previousName = importer.assetBundleName;
+            string previousVariant = importer.assetBundleVariant;
+            // 清除名称时变体必须同时清除
+            importer.SetAssetBundleNameAndVariant(bundleName, bundleName == "" ? "" : variant);
+            importer.SaveAndReimport();
+            AssetDatabase.RemoveUnusedAssetBundleNames();
+            
+            Debug.Log($"已设置 {assetPath} 的AssetBundle: {(bundleName == "" ? "(无)" : bundleName)}");
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["assetPath"] = assetPath,
+                ["isFolder"] = AssetDatabase.IsValidFolder(assetPath),
+                ["previousBundleName"] = previousName,
+                ["previousVariant"] = previousVariant,
+                ["bundleName"] = importer.assetBundleName,
+                ["variant"] = importer.assetBundleVariant
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置AssetBundle名称时出错: {e.Message}");
+            return MCPResponse.Error($"设置AssetBundle名称失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("assetPath") || !parameters.ContainsKey("bundleName"))
+        {
+            return "缺少必需参数: assetPath和bundleName";
+        }
+        return null;
+    }
+}
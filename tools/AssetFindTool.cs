@@ -22,9 +22,32 @@ public class AssetFindTool : IMCPTool
             string searchPath = parameters.ContainsKey("path") ? parameters["path"].ToString() : "Assets";
             string assetType = parameters.ContainsKey("type") ? parameters["type"].ToString() : "";
             string assetName = parameters.ContainsKey("name") ? parameters["name"].ToString() : "";
+            string nameRegex = parameters.ContainsKey("nameRegex") ? parameters["nameRegex"].ToString() : "";
             string extension = parameters.ContainsKey("extension") ? parameters["extension"].ToString() : "";
+            string guidFilter = parameters.ContainsKey("guid") ? parameters["guid"].ToString() : "";
             bool recursive = parameters.ContainsKey("recursive") ? System.Convert.ToBoolean(parameters["recursive"]) : true;
-            int maxResults = parameters.ContainsKey("maxResults") ? System.Convert.ToInt32(parameters["maxResults"]) : 100;
+            int page = parameters.ContainsKey("page") ? System.Convert.ToInt32(parameters["page"]) : 1;
+            int pageSize = parameters.ContainsKey("pageSize") ? System.Convert.ToInt32(parameters["pageSize"])
+                : parameters.ContainsKey("maxResults") ? System.Convert.ToInt32(parameters["maxResults"]) : 100;
+            long? sizeMin = parameters.ContainsKey("sizeMin") ? System.Convert.ToInt64(parameters["sizeMin"]) : (long?)null;
+            long? sizeMax = parameters.ContainsKey("sizeMax") ? System.Convert.ToInt64(parameters["sizeMax"]) : (long?)null;
+            System.DateTime? modifiedAfter = parameters.ContainsKey("modifiedAfter") ? ParseTime(parameters["modifiedAfter"].ToString()) : (System.DateTime?)null;
+            System.DateTime? modifiedBefore = parameters.ContainsKey("modifiedBefore") ? ParseTime(parameters["modifiedBefore"].ToString()) : (System.DateTime?)null;
+            
+            var labels = new List<string>();
+            if (parameters.ContainsKey("labels") && parameters["labels"] is System.Collections.IEnumerable labelValues)
+            {
+                foreach (var label in labelValues)
+                {
+                    labels.Add(label.ToString());
+                }
+            }
+            
+            System.Text.RegularExpressions.Regex regex = null;
+            if (!string.IsNullOrEmpty(nameRegex))
+            {
+                regex = new System.Text.RegularExpressions.Regex(nameRegex);
+            }
             
             // 验证搜索路径
             if (!searchPath.StartsWith("Assets") && !searchPath.StartsWith("Packages"))
@@ -39,72 +62,115 @@ public class AssetFindTool : IMCPTool
                 searchFilter = $"t:{assetType}";
             }
             
-            // 执行搜索
-            string[] guids;
-            
-            if (recursive)
-            {
-                // 递归搜索指定路径
-                guids = AssetDatabase.FindAssets(searchFilter, new[] { searchPath });
-            }
-            else
-            {
-                // 仅在指定路径搜索
-                guids = AssetDatabase.FindAssets(searchFilter, new[] { searchPath });
-            }
+            // 执行搜索，指定GUID时只检查该资源
+            string[] guids = !string.IsNullOrEmpty(guidFilter)
+                ? new[] { guidFilter }
+                : AssetDatabase.FindAssets(searchFilter, new[] { searchPath });
             
-            var results = new List<Dictionary<string, object>>();
+            var matches = new List<string>();
+            var seen = new HashSet<string>();
             
             foreach (string guid in guids)
             {
-                if (results.Count >= maxResults)
+                string assetPath = AssetDatabase.GUIDToAssetPath(guid);
+                if (string.IsNullOrEmpty(assetPath) || !seen.Add(assetPath))
                 {
-                    break;
+                    continue;
                 }
                 
-                string assetPath = AssetDatabase.GUIDToAssetPath(guid);
+                // 指定GUID时仍然检查路径和类型
+                if (!string.IsNullOrEmpty(guidFilter))
+                {
+                    if (!assetPath.StartsWith(searchPath))
+                    {
+                        continue;
+                    }
+                    System.Type mainType = AssetDatabase.GetMainAssetTypeAtPath(assetPath);
+                    if (!string.IsNullOrEmpty(assetType) && (mainType == null || !string.Equals(mainType.Name, assetType, System.StringComparison.OrdinalIgnoreCase)))
+                    {
+                        continue;
+                    }
+                }
                 
                 // 如果不是递归搜索，过滤掉子目录的资源
                 if (!recursive)
                 {
-                    string assetDir = Path.GetDirectoryName(assetPath);
-                    if (assetDir != searchPath)
+                    string assetDir = Path.GetDirectoryName(assetPath).Replace('\\', '/');
+                    if (assetDir != searchPath.TrimEnd('/'))
                     {
                         continue;
                     }
                 }
                 
+                string fileName = Path.GetFileNameWithoutExtension(assetPath);
+                
                 // 名称过滤
-                if (!string.IsNullOrEmpty(assetName))
+                if (!string.IsNullOrEmpty(assetName) && !IsNameMatch(fileName, assetName))
                 {
-                    string fileName = Path.GetFileNameWithoutExtension(assetPath);
-                    if (!IsNameMatch(fileName, assetName))
-                    {
-                        continue;
-                    }
+                    continue;
+                }
+                if (regex != null && !regex.IsMatch(fileName))
+                {
+                    continue;
                 }
                 
                 // 扩展名过滤
                 if (!string.IsNullOrEmpty(extension))
                 {
                     string fileExt = Path.GetExtension(assetPath).TrimStart('.');
-                    if (!string.Equals(fileExt, extension, System.StringComparison.OrdinalIgnoreCase))
+                    if (!string.Equals(fileExt, extension.TrimStart('.'), System.StringComparison.OrdinalIgnoreCase))
+                    {
+                        continue;
+                    }
+                }
+                
+                // 标签过滤: 必须包含所有标签
+                if (labels.Count > 0)
+                {
+                    string[] assetLabels = AssetDatabase.GetLabels(AssetDatabase.LoadMainAssetAtPath(assetPath));
+                    if (!labels.All(label => assetLabels.Contains(label, System.StringComparer.OrdinalIgnoreCase)))
                     {
                         continue;
                     }
                 }
                 
+                // 修改时间和大小过滤
+                if (modifiedAfter.HasValue || modifiedBefore.HasValue || sizeMin.HasValue || sizeMax.HasValue)
+                {
+                    FileInfo fileInfo = new FileInfo(Path.GetFullPath(assetPath));
+                    if (!fileInfo.Exists)
+                    {
+                        continue;
+                    }
+                    System.DateTime modified = fileInfo.LastWriteTimeUtc;
+                    if ((modifiedAfter.HasValue && modified < modifiedAfter.Value) ||
+                        (modifiedBefore.HasValue && modified >= modifiedBefore.Value) ||
+                        (sizeMin.HasValue && fileInfo.Length < sizeMin.Value) ||
+                        (sizeMax.HasValue && fileInfo.Length > sizeMax.Value))
+                    {
+                        continue;
+                    }
+                }
+                
+                matches.Add(assetPath);
+            }
+            
+            // 按路径排序 (序数比较)，保证分页结果稳定
+            matches.Sort(System.StringComparer.Ordinal);
+            
+            int totalMatches = matches.Count;
+            int totalPages = System.Math.Max(1, (totalMatches + pageSize - 1) / pageSize);
+            var results = new List<Dictionary<string, object>>();
+            foreach (string assetPath in matches.Skip((page - 1) * pageSize).Take(pageSize))
+            {
                 // 获取资源信息
-                var assetInfo = GetAssetInfo(assetPath, guid);
+                var assetInfo = GetAssetInfo(assetPath, AssetDatabase.AssetPathToGUID(assetPath));
                 if (assetInfo != null)
                 {
                     results.Add(assetInfo);
                 }
             }
             
-            // 按路径排序
-            results = results.OrderBy(r => r["path"].ToString()).ToList();
-            
             var result = new Dictionary<string, object>
             {
                 ["searchPath"] = searchPath,
@@ -113,12 +179,17 @@ public class AssetFindTool : IMCPTool
                 ["assetName"] = assetName,
                 ["extension"] = extension,
                 ["recursive"] = recursive,
-                ["maxResults"] = maxResults,
+                ["ordering"] = "path",
+                ["page"] = page,
+                ["pageSize"] = pageSize,
+                ["totalPages"] = totalPages,
+                ["totalMatches"] = totalMatches,
+                ["nextPage"] = page < totalPages ? (object)(page + 1) : null,
                 ["totalFound"] = results.Count,
                 ["assets"] = results
             };
             
-            Debug.Log($"资源搜索完成，共找到 {results.Count} 个资源");
+            Debug.Log($"资源搜索完成，共找到 {totalMatches} 个资源，返回第 {page} 页 {results.Count} 个");
             
             return MCPResponse.Success(result);
         }
@@ -129,6 +200,15 @@ public class AssetFindTool : IMCPTool
         }
     }
     
+    /// <summary>
+    /// 解析ISO 8601时间，不带时区时按UTC处理
+    /// </summary>
+    private System.DateTime ParseTime(string value)
+    {
+        return System.DateTime.Parse(value, System.Globalization.CultureInfo.InvariantCulture,
+            System.Globalization.DateTimeStyles.AssumeUniversal | System.Globalization.DateTimeStyles.AdjustToUniversal);
+    }
+    
     /// <summary>
     /// 检查名称是否匹配（支持通配符）
     /// </summary>
@@ -176,12 +256,12 @@ public class AssetFindTool : IMCPTool
             }
             
             // 获取文件大小
-            string fullPath = Path.Combine(Application.dataPath, assetPath.Substring(7)); // 去掉"Assets/"
-            if (File.Exists(fullPath))
+            FileInfo fileInfo = new FileInfo(Path.GetFullPath(assetPath));
+            if (fileInfo.Exists)
             {
-                FileInfo fileInfo = new FileInfo(fullPath);
                 assetInfo["size"] = fileInfo.Length;
                 assetInfo["lastModified"] = fileInfo.LastWriteTime.ToString("yyyy-MM-dd HH:mm:ss");
+                assetInfo["lastModifiedUtc"] = fileInfo.LastWriteTimeUtc.ToString("yyyy-MM-ddTHH:mm:ssZ");
             }
             
             // 获取资源标签
@@ -231,17 +311,41 @@ public class AssetFindTool : IMCPTool
     
     public string ValidateParameters(Dictionary<string, object> parameters)
     {
-        // 验证maxResults参数
-        if (parameters.ContainsKey("maxResults"))
+        // 验证分页参数 (maxResults为pageSize的旧名称)
+        foreach (string key in new[] { "pageSize", "maxResults" })
         {
-            if (!int.TryParse(parameters["maxResults"].ToString(), out int maxResults) || maxResults <= 0)
+            if (parameters.ContainsKey(key))
             {
-                return "maxResults必须是大于0的整数";
+                if (!int.TryParse(parameters[key].ToString(), out int size) || size <= 0)
+                {
+                    return $"{key}必须是大于0的整数";
+                }
+                
+                if (size > 1000)
+                {
+                    return $"{key}不能超过1000";
+                }
             }
-            
-            if (maxResults > 1000)
+        }
+        
+        if (parameters.ContainsKey("page"))
+        {
+            if (!int.TryParse(parameters["page"].ToString(), out int page) || page <= 0)
+            {
+                return "page必须是大于0的整数";
+            }
+        }
+        
+        // 验证正则表达式
+        if (parameters.ContainsKey("nameRegex"))
+        {
+            try
+            {
+                new System.Text.RegularExpressions.Regex(parameters["nameRegex"].ToString());
+            }
+            catch (System.ArgumentException e)
             {
-                return "maxResults不能超过1000";
+                return $"nameRegex无效: {e.Message}";
             }
         }
         
@@ -0,0 +1,278 @@
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Net.Sockets;
+using System.Text.RegularExpressions;
+using UnityEngine;
+using UnityEngine.Rendering;
+using UnityEditor;
+
+/// <summary>
+/// 未使用资源查找工具 - 列出不被任何构建根资源 (直接或间接) 依赖的资源
+/// 根资源: Build Settings中启用的场景、Resources文件夹、Addressables条目、AssetBundle中的资源、
+/// 预加载资源和渲染管线资源；扫描过程按阶段发送进度帧，结果按路径排序并分页
+/// </summary>
+public class AssetFindUnusedTool : IMCPTool
+{
+    /// <summary>
+    /// 每批计算依赖的根资源数，每批之后发送一次进度
+    /// </summary>
+    private const int DependencyBatchSize = 50;
+    
+    private static readonly string[] ScriptExtensions = { ".cs", ".asmdef", ".asmref", ".dll", ".rsp" };
+    
+    private readonly MCPMessageDispatcher dispatcher;
+    
+    public AssetFindUnusedTool(MCPMessageDispatcher dispatcher)
+    {
+        this.dispatcher = dispatcher;
+    }
+    
+    public string ToolName => "asset_find_unused";
+    
+    public string Description => "查找未被构建场景、Resources或Addressables引用的资源";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string rootPath = parameters.ContainsKey("rootPath") ? parameters["rootPath"].ToString().TrimEnd('/') : "Assets";
+            bool includeScripts = parameters.ContainsKey("includeScripts") && System.Convert.ToBoolean(parameters["includeScripts"]);
+            int page = parameters.ContainsKey("page") ? System.Convert.ToInt32(parameters["page"]) : 1;
+            int pageSize = parameters.ContainsKey("pageSize") ? System.Convert.ToInt32(parameters["pageSize"]) : 100;
+            var assetTypes = ReadStrings(parameters, "assetTypes");
+            var excludes = ReadStrings(parameters, "excludePatterns").ConvertAll(WildcardToRegex);
+            
+            if (!AssetDatabase.IsValidFolder(rootPath))
+            {
+                return MCPResponse.Error($"文件夹不存在: {rootPath}");
+            }
+            
+            // 收集根资源
+            dispatcher.SendProgress(client, 0.02f, "收集构建场景、Resources和Addressables根资源");
+            var roots = new Dictionary<string, List<string>>
+            {
+                ["buildScenes"] = EditorBuildSettings.scenes.Where(s => s.enabled).Select(s => s.path).ToList(),
+                ["resources"] = AssetDatabase.GetAllAssetPaths()
+                    .Where(p => p.Contains("/Resources/") && !AssetDatabase.IsValidFolder(p)).ToList(),
+                ["addressables"] = AddressableAssetPaths(out bool addressablesFound),
+                ["assetBundles"] = AssetDatabase.GetAllAssetBundleNames()
+                    .SelectMany(AssetDatabase.GetAssetPathsFromAssetBundle).ToList(),
+                ["settings"] = SettingsAssetPaths()
+            };
+            
+            // 计算根资源的全部依赖
+            var rootList = roots.Values.SelectMany(paths => paths).Distinct().ToList();
+            var used = new HashSet<string>(rootList);
+            for (int i = 0; i < rootList.Count; i += DependencyBatchSize)
+            {
+                string[] batch = rootList.Skip(i).Take(DependencyBatchSize).ToArray();
+                used.UnionWith(AssetDatabase.GetDependencies(batch, true));
+                dispatcher.SendProgress(client, 0.05f + 0.75f * (i + batch.Length) / rootList.Count,
+                    $"计算依赖 {i + batch.Length}/{rootList.Count}");
+            }
+            
+            // 筛选候选资源
+            dispatcher.SendProgress(client, 0.85f, "筛选未使用的资源");
+            var candidates = new List<Dictionary<string, object>>();
+            long totalSize = 0;
+            int scanned = 0;
+            foreach (string path in AssetDatabase.GetAllAssetPaths()
+                .Where(p => p.StartsWith(rootPath + "/") && !AssetDatabase.IsValidFolder(p))
+                .OrderBy(p => p, System.StringComparer.Ordinal))
+            {
+                if (!includeScripts && ScriptExtensions.Contains(Path.GetExtension(path).ToLowerInvariant()))
+                {
+                    continue;
+                }
+                if (excludes.Any(regex => regex.IsMatch(path)))
+                {
+                    continue;
+                }
+                string typeName = GetAssetTypeName(path);
+                if (assetTypes.Count > 0 && !assetTypes.Any(t => string.Equals(t, typeName, System.StringComparison.OrdinalIgnoreCase)))
+                {
+                    continue;
+                }
+                scanned++;
+                if (used.Contains(path))
+                {
+                    continue;
+                }
+                
+                long size = File.Exists(path) ? new FileInfo(path).Length : 0;
+                totalSize += size;
+                string note = DynamicReferenceNote(path, typeName);
+                candidates.Add(new Dictionary<string, object>
+                {
+                    ["path"] = path,
+                    ["type"] = typeName,
+                    ["sizeBytes"] = size,
+                    ["confidence"] = note == null ? "high" : "low",
+                    ["note"] = note
+                });
+            }
+            
+            int totalPages = System.Math.Max(1, (candidates.Count + pageSize - 1) / pageSize);
+            var notes = new List<string>
+            {
+                "资源可能在运行时通过路径或AssetBundle动态加载，删除前请用asset_find_references和asset_get_dependencies确认",
+                "Resources文件夹中的资源总会被打包，因此视为已使用"
+            };
+            if (!addressablesFound)
+            {
+                notes.Add("项目中没有Addressables分组，未检查Addressables引用");
+            }
+            
+            var rootCounts = roots.ToDictionary(kv => kv.Key, kv => (object)kv.Value.Count);
+            Debug.Log($"未使用资源查找完成: {rootPath}，扫描 {scanned} 个资源，候选 {candidates.Count} 个");
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["rootPath"] = rootPath,
+                ["roots"] = rootCounts,
+                ["usedAssetCount"] = used.Count,
+                ["scannedAssets"] = scanned,
+                ["totalCandidates"] = candidates.Count,
+                ["totalSizeBytes"] = totalSize,
+                ["totalSize"] = EditorUtility.FormatBytes(totalSize),
+                ["candidates"] = candidates.Skip((page - 1) * pageSize).Take(pageSize).ToList(),
+                ["page"] = page,
+                ["pageSize"] = pageSize,
+                ["totalPages"] = totalPages,
+                ["nextPage"] = page < totalPages ? (object)(page + 1) : null,
+                ["notes"] = notes
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"查找未使用资源时出错: {e.Message}");
+            return MCPResponse.Error($"查找未使用资源失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 可能被代码动态引用或只在编辑器中使用的资源的说明，其他资源返回null
+    /// </summary>
+    private string DynamicReferenceNote(string path, string typeName)
+    {
+        if (path.Contains("/Editor/") || path.Contains("/Gizmos/"))
+        {
+            return "编辑器专用资源，不会出现在构建依赖中";
+        }
+        if (path.Contains("/StreamingAssets/"))
+        {
+            return "StreamingAssets中的文件按路径读取，总会被打包";
+        }
+        switch (typeName)
+        {
+            case "MonoScript":
+                return "脚本可能只被代码使用";
+            case "Shader":
+            case "ComputeShader":
+                return "着色器可能通过Shader.Find或Always Included Shaders使用";
+            case "AssemblyDefinitionAsset":
+                return "程序集定义由编译使用";
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// Addressables分组中的资源；通过序列化数据读取，项目不需要安装Addressables包
+    /// </summary>
+    private List<string> AddressableAssetPaths(out bool found)
+    {
+        var paths = new List<string>();
+        string[] groups = AssetDatabase.FindAssets("t:AddressableAssetGroup");
+        found = groups.Length > 0;
+        foreach (string groupGuid in groups)
+        {
+            Object group = AssetDatabase.LoadMainAssetAtPath(AssetDatabase.GUIDToAssetPath(groupGuid));
+            if (group == null)
+            {
+                continue;
+            }
+            SerializedProperty entries = new SerializedObject(group).FindProperty("m_SerializeEntries");
+            if (entries == null || !entries.isArray)
+            {
+                continue;
+            }
+            for (int i = 0; i < entries.arraySize; i++)
+            {
+                SerializedProperty guid = entries.GetArrayElementAtIndex(i).FindPropertyRelative("m_GUID");
+                string path = guid != null ? AssetDatabase.GUIDToAssetPath(guid.stringValue) : null;
+                if (string.IsNullOrEmpty(path))
+                {
+                    continue;
+                }
+                // 文件夹条目包含其中的全部资源
+                if (AssetDatabase.IsValidFolder(path))
+                {
+                    paths.AddRange(AssetDatabase.FindAssets("", new[] { path }).Select(AssetDatabase.GUIDToAssetPath));
+                }
+                else
+                {
+                    paths.Add(path);
+                }
+            }
+        }
+        return paths;
+    }
+    
+    /// <summary>
+    /// 项目设置引用的资源: 预加载资源和渲染管线资源
+    /// </summary>
+    private List<string> SettingsAssetPaths()
+    {
+        var assets = new List<Object>(PlayerSettings.GetPreloadedAssets());
+        assets.Add(GraphicsSettings.defaultRenderPipeline);
+        for (int i = 0; i < QualitySettings.names.Length; i++)
+        {
+            assets.Add(QualitySettings.GetRenderPipelineAssetAt(i));
+        }
+        return assets.Where(a => a != null).Select(AssetDatabase.GetAssetPath).Where(p => !string.IsNullOrEmpty(p)).Distinct().ToList();
+    }
+    
+    /// <summary>
+    /// 资源类型名称，预制体报告为Prefab而不是GameObject
+    /// </summary>
+    private string GetAssetTypeName(string assetPath)
+    {
+        if (assetPath.EndsWith(".prefab", System.StringComparison.OrdinalIgnoreCase))
+        {
+            return "Prefab";
+        }
+        System.Type assetType = AssetDatabase.GetMainAssetTypeAtPath(assetPath);
+        return assetType != null ? assetType.Name : "Unknown";
+    }
+    
+    public static List<string> ReadStrings(Dictionary<string, object> parameters, string key)
+    {
+        var values = new List<string>();
+        if (parameters.ContainsKey(key) && parameters[key] is System.Collections.IEnumerable items)
+        {
+            foreach (var item in items)
+            {
+                values.Add(item.ToString());
+            }
+        }
+        return values;
+    }
+    
+    /// <summary>
+    /// 路径通配符转换为正则: *和?不跨越/，**匹配任意层级
+    /// </summary>
+    public static Regex WildcardToRegex(string pattern)
+    {
+        string regex = Regex.Escape(pattern)
+            .Replace(@"\*\*", "\u0000")
+            .Replace(@"\*", "[^/]*")
+            .Replace(@"\?", "[^/]")
+            .Replace("\u0000", ".*");
+        return new Regex("^" + regex + "$", RegexOptions.IgnoreCase);
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -2,6 +2,7 @@ using System.Collections.Generic;
 using System.Net.Sockets;
 using UnityEngine;
 using UnityEditor;
+using UnityEditor.Presets;
 using System.IO;
 
 /// <summary>
@@ -58,6 +59,21 @@ public class AssetInfoTool : IMCPTool
                 };
             }
             
+            // 预设资源的目标类型
+            if (mainAssetType == typeof(Preset))
+            {
+                Preset preset = AssetDatabase.LoadAssetAtPath<Preset>(assetPath);
+                if (preset != null)
+                {
+                    result["presetTarget"] = new Dictionary<string, object>
+                    {
+                        ["name"] = preset.GetTargetTypeName(),
+                        ["fullName"] = preset.GetTargetFullTypeName(),
+                        ["isValid"] = preset.IsValid()
+                    };
+                }
+            }
+            
             // 获取所有子资源
             UnityEngine.Object[] subAssets = AssetDatabase.LoadAllAssetsAtPath(assetPath);
             if (subAssets.Length > 1) // 除了主资源外还有子资源
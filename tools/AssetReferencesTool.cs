@@ -0,0 +1,132 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 资源引用查找工具 - 查找引用了指定资源的其他资源（反向依赖）
+/// </summary>
+public class AssetReferencesTool : IMCPTool
+{
+    public string ToolName => "asset_find_references";
+    
+    public string Description => "查找引用了指定资源的预制体、场景、材质等资源";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            bool recursive = parameters.ContainsKey("recursive") ? 
+                System.Convert.ToBoolean(parameters["recursive"]) : false;
+            int maxResults = parameters.ContainsKey("maxResults") ? 
+                System.Convert.ToInt32(parameters["maxResults"]) : 200;
+            var assetTypes = new List<string>();
+            if (parameters.ContainsKey("assetTypes") && parameters["assetTypes"] is System.Collections.IEnumerable types)
+            {
+                foreach (var type in types)
+                {
+                    assetTypes.Add(type.ToString());
+                }
+            }
+            
+            // 验证资源路径
+            if (string.IsNullOrEmpty(AssetDatabase.AssetPathToGUID(assetPath)) || AssetDatabase.IsValidFolder(assetPath))
+            {
+                return MCPResponse.Error($"资源路径不存在: {assetPath}");
+            }
+            
+            var references = new List<Dictionary<string, object>>();
+            var countsByType = new Dictionary<string, int>();
+            int scanned = 0;
+            bool truncated = false;
+            
+            // 只扫描项目资源，内置资源和包中的资源不会引用项目资源
+            var candidates = AssetDatabase.GetAllAssetPaths()
+                .Where(path => path.StartsWith("Assets/") && path != assetPath && !AssetDatabase.IsValidFolder(path))
+                .OrderBy(path => path, System.StringComparer.Ordinal);
+            
+            foreach (string checkPath in candidates)
+            {
+                string typeName = GetAssetTypeName(checkPath);
+                if (assetTypes.Count > 0 && !assetTypes.Any(t => string.Equals(t, typeName, System.StringComparison.OrdinalIgnoreCase)))
+                {
+                    continue;
+                }
+                scanned++;
+                
+                // recursive时包括间接引用 (如 场景 → 预制体 → 材质 → 贴图)
+                string[] dependencies = AssetDatabase.GetDependencies(checkPath, recursive);
+                if (!dependencies.Contains(assetPath))
+                {
+                    continue;
+                }
+                
+                if (references.Count >= maxResults)
+                {
+                    truncated = true;
+                    break;
+                }
+                
+                bool direct = !recursive || AssetDatabase.GetDependencies(checkPath, false).Contains(assetPath);
+                references.Add(new Dictionary<string, object>
+                {
+                    ["path"] = checkPath,
+                    ["guid"] = AssetDatabase.AssetPathToGUID(checkPath),
+                    ["name"] = System.IO.Path.GetFileNameWithoutExtension(checkPath),
+                    ["type"] = typeName,
+                    ["direct"] = direct
+                });
+                countsByType[typeName] = countsByType.ContainsKey(typeName) ? countsByType[typeName] + 1 : 1;
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["assetPath"] = assetPath,
+                ["recursive"] = recursive,
+                ["assetTypes"] = assetTypes,
+                ["references"] = references,
+                ["referenceCount"] = references.Count,
+                ["directCount"] = references.Count(r => (bool)r["direct"]),
+                ["countsByType"] = countsByType.OrderByDescending(kv => kv.Value).ToDictionary(kv => kv.Key, kv => kv.Value),
+                ["scannedAssets"] = scanned,
+                ["truncated"] = truncated,
+                ["maxResults"] = maxResults
+            };
+            
+            Debug.Log($"成功查找资源引用: {assetPath}, 引用数: {references.Count}{(truncated ? " (已截断)" : "")}");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"查找资源引用时出错: {e.Message}");
+            return MCPResponse.Error($"查找资源引用失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 资源类型名称，预制体报告为Prefab而不是GameObject
+    /// </summary>
+    private string GetAssetTypeName(string assetPath)
+    {
+        if (assetPath.EndsWith(".prefab", System.StringComparison.OrdinalIgnoreCase))
+        {
+            return "Prefab";
+        }
+        System.Type assetType = AssetDatabase.GetMainAssetTypeAtPath(assetPath);
+        return assetType != null ? assetType.Name : "Unknown";
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        // 检查必需参数
+        if (!parameters.ContainsKey("assetPath") || string.IsNullOrEmpty(parameters["assetPath"].ToString()))
+        {
+            return "缺少必需参数: assetPath";
+        }
+        
+        return null;
+    }
+}
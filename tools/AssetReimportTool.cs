@@ -0,0 +1,273 @@
+using System;
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using Debug = UnityEngine.Debug;
+
+/// <summary>
+/// 资源重新导入工具 - 重新导入单个资源或文件夹中的资源，可以先修改导入器设置
+/// 导入器设置的键和值已由Go服务器按导入器类型校验
+/// </summary>
+public class AssetReimportTool : IMCPTool
+{
+    public string ToolName => "asset_reimport";
+    
+    public string Description => "重新导入资源，可选修改导入器设置";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString().Replace('\\', '/').TrimEnd('/');
+            bool recursive = parameters.ContainsKey("recursive") && System.Convert.ToBoolean(parameters["recursive"]);
+            int maxAssets = parameters.ContainsKey("maxAssets") ? System.Convert.ToInt32(parameters["maxAssets"]) : 500;
+            string importerType = parameters.ContainsKey("importerType") ? parameters["importerType"].ToString() : null;
+            var settings = parameters.ContainsKey("importerSettings") ? (Dictionary<string, object>)parameters["importerSettings"] : null;
+            
+            // 收集要导入的资源
+            var paths = new List<string>();
+            bool truncated = false;
+            if (AssetDatabase.IsValidFolder(assetPath))
+            {
+                foreach (string guid in AssetDatabase.FindAssets("", new[] { assetPath }))
+                {
+                    string path = AssetDatabase.GUIDToAssetPath(guid);
+                    if (AssetDatabase.IsValidFolder(path) || paths.Contains(path)) continue;
+                    if (!recursive && path.Substring(0, path.LastIndexOf('/')) != assetPath) continue;
+                    if (paths.Count >= maxAssets)
+                    {
+                        truncated = true;
+                        break;
+                    }
+                    paths.Add(path);
+                }
+            }
+            else if (AssetImporter.GetAtPath(assetPath) != null)
+            {
+                paths.Add(assetPath);
+            }
+            else
+            {
+                return MCPResponse.Error($"资源或文件夹不存在: {assetPath}");
+            }
+            
+            var assets = new List<object>();
+            int failed = 0;
+            var stopwatch = Stopwatch.StartNew();
+            AssetDatabase.StartAssetEditing();
+            try
+            {
+                foreach (string path in paths)
+                {
+                    var item = new Dictionary<string, object> { ["path"] = path };
+                    AssetImporter importer = AssetImporter.GetAtPath(path);
+                    item["importer"] = importer != null ? importer.GetType().Name : null;
+                    
+                    if (settings != null && settings.Count > 0 && importer != null)
+                    {
+                        if (!MatchesType(importer, importerType))
+                        {
+                            item["settingsSkipped"] = $"导入器 {importer.GetType().Name} 不是 {importerType} 类型";
+                        }
+                        else
+                        {
+                            var applied = new Dictionary<string, object>();
+                            var errors = new Dictionary<string, object>();
+                            ApplySettings(importer, settings, applied, errors);
+                            item["appliedSettings"] = applied;
+                            if (errors.Count > 0)
+                            {
+                                item["failedSettings"] = errors;
+                                failed++;
+                            }
+                            EditorUtility.SetDirty(importer);
+                            importer.SaveAndReimport();
+                            assets.Add(item);
+                            continue;
+                        }
+                    }
+                    AssetDatabase.ImportAsset(path, ImportAssetOptions.ForceUpdate);
+                    assets.Add(item);
+                }
+            }
+            finally
+            {
+                AssetDatabase.StopAssetEditing();
+            }
+            AssetDatabase.Refresh();
+            stopwatch.Stop();
+            
+            var result = new Dictionary<string, object>
+            {
+                ["assetPath"] = assetPath,
+                ["reimportedCount"] = assets.Count,
+                ["assets"] = assets,
+                ["truncated"] = truncated,
+                ["failedCount"] = failed,
+                ["durationMs"] = stopwatch.ElapsedMilliseconds
+            };
+            
+            Debug.Log($"重新导入 {assets.Count} 个资源，耗时 {stopwatch.ElapsedMilliseconds}ms: {assetPath}");
+            return MCPResponse.Success(result);
+        }
+        catch (Exception e)
+        {
+            Debug.LogError($"重新导入资源时出错: {e.Message}");
+            return MCPResponse.Error($"重新导入资源失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 判断导入器是否属于指定的导入器类型
+    /// </summary>
+    private bool MatchesType(AssetImporter importer, string importerType)
+    {
+        switch (importerType)
+        {
+            case "texture": return importer is TextureImporter;
+            case "model": return importer is ModelImporter;
+            case "audio": return importer is AudioImporter;
+            default: return false;
+        }
+    }
+    
+    /// <summary>
+    /// 应用导入器设置，applied记录设置后的实际值
+    /// </summary>
+    private void ApplySettings(AssetImporter importer, Dictionary<string, object> settings,
+        Dictionary<string, object> applied, Dictionary<string, object> errors)
+    {
+        foreach (var setting in settings)
+        {
+            try
+            {
+                object value = importer is TextureImporter texture ? ApplyTexture(texture, setting.Key, setting.Value)
+                    : importer is ModelImporter model ? ApplyModel(model, setting.Key, setting.Value)
+                    : importer is AudioImporter audio ? ApplyAudio(audio, setting.Key, setting.Value)
+                    : throw new ArgumentException("不支持的导入器");
+                applied[setting.Key] = value;
+            }
+            catch (Exception e)
+            {
+                errors[setting.Key] = e.Message;
+            }
+        }
+    }
+    
+    private object ApplyTexture(TextureImporter importer, string key, object value)
+    {
+        switch (key)
+        {
+            case "textureType":
+                importer.textureType = (TextureImporterType)Enum.Parse(typeof(TextureImporterType), value.ToString());
+                return importer.textureType.ToString();
+            case "maxSize":
+                importer.maxTextureSize = System.Convert.ToInt32(value);
+                return importer.maxTextureSize;
+            case "compression":
+                importer.textureCompression = (TextureImporterCompression)Enum.Parse(typeof(TextureImporterCompression), value.ToString());
+                return importer.textureCompression.ToString();
+            case "sRGB":
+                importer.sRGBTexture = System.Convert.ToBoolean(value);
+                return importer.sRGBTexture;
+            case "mipmapEnabled":
+                importer.mipmapEnabled = System.Convert.ToBoolean(value);
+                return importer.mipmapEnabled;
+            case "filterMode":
+                importer.filterMode = (FilterMode)Enum.Parse(typeof(FilterMode), value.ToString());
+                return importer.filterMode.ToString();
+            case "wrapMode":
+                importer.wrapMode = (TextureWrapMode)Enum.Parse(typeof(TextureWrapMode), value.ToString());
+                return importer.wrapMode.ToString();
+            case "isReadable":
+                importer.isReadable = System.Convert.ToBoolean(value);
+                return importer.isReadable;
+            case "alphaIsTransparency":
+                importer.alphaIsTransparency = System.Convert.ToBoolean(value);
+                return importer.alphaIsTransparency;
+        }
+        throw new ArgumentException($"不支持的纹理导入设置: {key}");
+    }
+    
+    private object ApplyModel(ModelImporter importer, string key, object value)
+    {
+        switch (key)
+        {
+            case "scaleFactor":
+                importer.globalScale = System.Convert.ToSingle(value);
+                return importer.globalScale;
+            case "importMaterials":
+                importer.materialImportMode = System.Convert.ToBoolean(value)
+                    ? ModelImporterMaterialImportMode.ImportViaMaterialDescription
+                    : ModelImporterMaterialImportMode.None;
+                return importer.materialImportMode != ModelImporterMaterialImportMode.None;
+            case "importAnimation":
+                importer.importAnimation = System.Convert.ToBoolean(value);
+                return importer.importAnimation;
+            case "isReadable":
+                importer.isReadable = System.Convert.ToBoolean(value);
+                return importer.isReadable;
+            case "meshCompression":
+                importer.meshCompression = (ModelImporterMeshCompression)Enum.Parse(typeof(ModelImporterMeshCompression), value.ToString());
+                return importer.meshCompression.ToString();
+            case "generateSecondaryUV":
+                importer.generateSecondaryUV = System.Convert.ToBoolean(value);
+                return importer.generateSecondaryUV;
+        }
+        throw new ArgumentException($"不支持的模型导入设置: {key}");
+    }
+    
+    private object ApplyAudio(AudioImporter importer, string key, object value)
+    {
+        // 采样设置是结构体，修改后需要写回
+        AudioImporterSampleSettings sample = importer.defaultSampleSettings;
+        object result;
+        switch (key)
+        {
+            case "loadType":
+                sample.loadType = (AudioClipLoadType)Enum.Parse(typeof(AudioClipLoadType), value.ToString());
+                result = sample.loadType.ToString();
+                break;
+            case "compressionFormat":
+                sample.compressionFormat = (AudioCompressionFormat)Enum.Parse(typeof(AudioCompressionFormat), value.ToString());
+                result = sample.compressionFormat.ToString();
+                break;
+            case "quality":
+                sample.quality = System.Convert.ToSingle(value);
+                result = sample.quality;
+                break;
+            case "forceToMono":
+                importer.forceToMono = System.Convert.ToBoolean(value);
+                return importer.forceToMono;
+            case "loadInBackground":
+                importer.loadInBackground = System.Convert.ToBoolean(value);
+                return importer.loadInBackground;
+            default:
+                throw new ArgumentException($"不支持的音频导入设置: {key}");
+        }
+        importer.defaultSampleSettings = sample;
+        return result;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        if (!parameters.ContainsKey("assetPath") || string.IsNullOrEmpty(parameters["assetPath"].ToString()))
+        {
+            return "缺少必需参数: assetPath";
+        }
+        
+        if (parameters.ContainsKey("importerSettings") && !(parameters["importerSettings"] is Dictionary<string, object>))
+        {
+            return "importerSettings必须是 {属性名: 值} 对象";
+        }
+        
+        return null;
+    }
+}
@@ -0,0 +1,64 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 音频片段信息工具 - 获取音频片段的时长、声道、采样率和导入设置
+/// </summary>
+public class AudioClipInfoTool : IMCPTool
+{
+    public string ToolName => "audio_clip_info";
+    
+    public string Description => "获取音频片段信息";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            AudioClip clip = AssetDatabase.LoadAssetAtPath<AudioClip>(assetPath);
+            if (clip == null)
+            {
+                return MCPResponse.Error($"未找到音频片段: {assetPath}");
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["path"] = assetPath,
+                ["name"] = clip.name,
+                ["length"] = clip.length,
+                ["channels"] = clip.channels,
+                ["frequency"] = clip.frequency,
+                ["samples"] = clip.samples,
+                ["ambisonic"] = clip.ambisonic,
+                ["loadType"] = clip.loadType.ToString(),
+                ["loadInBackground"] = clip.loadInBackground
+            };
+            
+            if (AssetImporter.GetAtPath(assetPath) is AudioImporter importer)
+            {
+                AudioImporterSampleSettings settings = importer.defaultSampleSettings;
+                result["compressionFormat"] = settings.compressionFormat.ToString();
+                result["quality"] = settings.quality;
+                result["sampleRateSetting"] = settings.sampleRateSetting.ToString();
+                result["forceToMono"] = importer.forceToMono;
+            }
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取音频片段信息时出错: {e.Message}");
+            return MCPResponse.Error($"获取音频片段信息失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("assetPath"))
+        {
+            return "缺少必需参数: assetPath";
+        }
+        return null;
+    }
+}
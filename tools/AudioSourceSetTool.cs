@@ -0,0 +1,185 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using System.Reflection;
+using UnityEngine;
+using UnityEngine.Audio;
+using UnityEditor;
+
+/// <summary>
+/// AudioSource设置工具 - 设置音频片段、音量、音高、循环、空间混合、输出混音器分组和衰减距离
+/// 没有AudioSource时添加；preview在编辑模式下通过编辑器的预览播放一次音频片段
+/// </summary>
+public class AudioSourceSetTool : IMCPTool
+{
+    public string ToolName => "audio_source_set";
+    
+    public string Description => "设置AudioSource属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (gameObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            // 先加载资源，找不到时不做任何修改
+            AudioClip clip = null;
+            bool setClip = parameters.ContainsKey("clipPath");
+            if (setClip)
+            {
+                string clipPath = parameters["clipPath"].ToString();
+                if (!string.IsNullOrEmpty(clipPath))
+                {
+                    clip = AssetDatabase.LoadAssetAtPath<AudioClip>(clipPath);
+                    if (clip == null)
+                    {
+                        return MCPResponse.Error($"未找到音频片段: {clipPath}");
+                    }
+                }
+            }
+            AudioMixerGroup mixerGroup = null;
+            bool setMixerGroup = parameters.ContainsKey("mixerGroupPath");
+            if (setMixerGroup && parameters.ContainsKey("mixerPath"))
+            {
+                string mixerPath = parameters["mixerPath"].ToString();
+                string groupPath = parameters["mixerGroup"].ToString();
+                AudioMixer mixer = AssetDatabase.LoadAssetAtPath<AudioMixer>(mixerPath);
+                if (mixer == null)
+                {
+                    return MCPResponse.Error($"未找到混音器: {mixerPath}");
+                }
+                mixerGroup = FindGroup(mixer, groupPath);
+                if (mixerGroup == null)
+                {
+                    return MCPResponse.Error($"混音器 '{mixer.name}' 中没有分组: {groupPath}");
+                }
+            }
+            
+            AudioSource source = gameObject.GetComponent<AudioSource>();
+            bool added = false;
+            if (source == null)
+            {
+                source = Undo.AddComponent<AudioSource>(gameObject);
+                added = true;
+            }
+            else
+            {
+                Undo.RecordObject(source, "Set AudioSource Properties");
+            }
+            
+            if (setClip) source.clip = clip;
+            if (setMixerGroup) source.outputAudioMixerGroup = mixerGroup;
+            if (parameters.ContainsKey("volume")) source.volume = System.Convert.ToSingle(parameters["volume"]);
+            if (parameters.ContainsKey("pitch")) source.pitch = System.Convert.ToSingle(parameters["pitch"]);
+            if (parameters.ContainsKey("loop")) source.loop = System.Convert.ToBoolean(parameters["loop"]);
+            if (parameters.ContainsKey("playOnAwake")) source.playOnAwake = System.Convert.ToBoolean(parameters["playOnAwake"]);
+            if (parameters.ContainsKey("spatialBlend")) source.spatialBlend = System.Convert.ToSingle(parameters["spatialBlend"]);
+            if (parameters.ContainsKey("rolloffMode"))
+            {
+                source.rolloffMode = (AudioRolloffMode)System.Enum.Parse(typeof(AudioRolloffMode), parameters["rolloffMode"].ToString());
+            }
+            if (parameters.ContainsKey("minDistance")) source.minDistance = System.Convert.ToSingle(parameters["minDistance"]);
+            if (parameters.ContainsKey("maxDistance")) source.maxDistance = System.Convert.ToSingle(parameters["maxDistance"]);
+            EditorUtility.SetDirty(source);
+            
+            var result = Describe(source);
+            result["audioSourceAdded"] = added;
+            if (parameters.ContainsKey("preview") && System.Convert.ToBoolean(parameters["preview"]))
+            {
+                result["previewed"] = Preview(source);
+            }
+            
+            Debug.Log($"设置 '{gameObject.name}' 的AudioSource属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置AudioSource属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置AudioSource属性失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 按分组路径 (如 Master/SFX) 查找混音器分组
+    /// </summary>
+    private AudioMixerGroup FindGroup(AudioMixer mixer, string groupPath)
+    {
+        foreach (AudioMixerGroup group in mixer.FindMatchingGroups(groupPath))
+        {
+            string name = groupPath.Substring(groupPath.LastIndexOf('/') + 1);
+            if (group.name == name)
+            {
+                return group;
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 播放一次音频片段: 播放模式下调用AudioSource.Play，编辑模式下通过内部的AudioUtil预览
+    /// </summary>
+    private bool Preview(AudioSource source)
+    {
+        if (source.clip == null)
+        {
+            return false;
+        }
+        if (EditorApplication.isPlaying)
+        {
+            source.Play();
+            return true;
+        }
+        
+        // AudioUtil为内部类，Unity 2020.2起方法名为PlayPreviewClip，之前为PlayClip
+        System.Type audioUtil = typeof(AudioImporter).Assembly.GetType("UnityEditor.AudioUtil");
+        if (audioUtil == null)
+        {
+            return false;
+        }
+        foreach (string methodName in new[] { "PlayPreviewClip", "PlayClip" })
+        {
+            MethodInfo method = audioUtil.GetMethod(methodName, BindingFlags.Static | BindingFlags.Public,
+                null, new[] { typeof(AudioClip), typeof(int), typeof(bool) }, null);
+            if (method != null)
+            {
+                method.Invoke(null, new object[] { source.clip, 0, false });
+                return true;
+            }
+        }
+        return false;
+    }
+    
+    private Dictionary<string, object> Describe(AudioSource source)
+    {
+        return new Dictionary<string, object>
+        {
+            ["instanceId"] = source.gameObject.GetInstanceID(),
+            ["gameObject"] = source.gameObject.name,
+            ["clipPath"] = source.clip != null ? AssetDatabase.GetAssetPath(source.clip) : null,
+            ["volume"] = source.volume,
+            ["pitch"] = source.pitch,
+            ["loop"] = source.loop,
+            ["playOnAwake"] = source.playOnAwake,
+            ["spatialBlend"] = source.spatialBlend,
+            ["mixerGroup"] = source.outputAudioMixerGroup != null
+                ? $"{AssetDatabase.GetAssetPath(source.outputAudioMixerGroup)}/{source.outputAudioMixerGroup.name}"
+                : null,
+            ["rolloffMode"] = source.rolloffMode.ToString(),
+            ["minDistance"] = source.minDistance,
+            ["maxDistance"] = source.maxDistance
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
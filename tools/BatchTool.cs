@@ -0,0 +1,205 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Sockets;
+using Newtonsoft.Json.Linq;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 批处理工具 - 在一次请求中按顺序执行多个工具
+/// </summary>
+public class BatchTool : IMCPTool
+{
+    private readonly MCPMessageDispatcher dispatcher;
+
+    public BatchTool(MCPMessageDispatcher dispatcher)
+    {
+        this.dispatcher = dispatcher;
+    }
+
+    public string ToolName => "unity_batch";
+
+    public string Description => "按顺序执行多个工具调用，可选地合并为一个Undo组";
+
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        List<Dictionary<string, object>> steps = ParseSteps(parameters["steps"]);
+        bool atomic = parameters.ContainsKey("atomic") && Convert.ToBoolean(parameters["atomic"]);
+        bool stopOnError = atomic || !parameters.ContainsKey("stopOnError") || Convert.ToBoolean(parameters["stopOnError"]);
+
+        // 原子模式: 所有步骤记录在同一个Undo组中，失败时整体回滚
+        int undoGroup = -1;
+        if (atomic)
+        {
+            Undo.IncrementCurrentGroup();
+            undoGroup = Undo.GetCurrentGroup();
+            Undo.SetCurrentGroupName("MCP Batch");
+        }
+
+        var results = new List<Dictionary<string, object>>();
+        int failedStep = -1;
+        int skipped = 0;
+
+        for (int i = 0; i < steps.Count; i++)
+        {
+            string action = steps[i]["action"].ToString();
+
+            if (failedStep >= 0 && stopOnError)
+            {
+                results.Add(new Dictionary<string, object>
+                {
+                    ["index"] = i,
+                    ["action"] = action,
+                    ["success"] = false,
+                    ["skipped"] = true
+                });
+                skipped++;
+                continue;
+            }
+
+            MCPResponse response = ExecuteStep(action, (Dictionary<string, object>)steps[i]["params"], client);
+            var stepResult = new Dictionary<string, object>
+            {
+                ["index"] = i,
+                ["action"] = action,
+                ["success"] = response.success
+            };
+            if (response.success)
+            {
+                stepResult["data"] = response.data;
+            }
+            else
+            {
+                stepResult["error"] = response.error;
+                if (failedStep < 0)
+                {
+                    failedStep = i;
+                }
+            }
+            results.Add(stepResult);
+        }
+
+        bool rolledBack = false;
+        if (atomic)
+        {
+            if (failedStep >= 0)
+            {
+                Undo.RevertAllDownToGroup(undoGroup);
+                rolledBack = true;
+                Debug.LogWarning($"批处理第 {failedStep} 步失败，已回滚整个批处理");
+            }
+            else
+            {
+                Undo.CollapseUndoOperations(undoGroup);
+            }
+        }
+
+        var result = new Dictionary<string, object>
+        {
+            ["steps"] = results,
+            ["total"] = steps.Count,
+            ["succeeded"] = results.FindAll(r => (bool)r["success"]).Count,
+            ["failedStep"] = failedStep >= 0 ? (object)failedStep : null,
+            ["skipped"] = skipped,
+            ["atomic"] = atomic,
+            ["rolledBack"] = rolledBack
+        };
+
+        Debug.Log($"批处理完成: {steps.Count} 步，失败步骤 {failedStep}，跳过 {skipped} 步");
+
+        // 原子批处理失败时整体视为失败，非原子批处理由调用方检查每一步的结果
+        if (rolledBack)
+        {
+            return new MCPResponse
+            {
+                success = false,
+                error = $"批处理第 {failedStep} 步 ({results[failedStep]["action"]}) 失败: {results[failedStep]["error"]}，已回滚",
+                data = result
+            };
+        }
+        return MCPResponse.Success(result);
+    }
+
+    /// <summary>
+    /// 执行单个步骤，异常转换为错误响应以便继续生成后续步骤的结果
+    /// </summary>
+    private MCPResponse ExecuteStep(string action, Dictionary<string, object> stepParams, TcpClient client)
+    {
+        if (action == ToolName || !dispatcher.TryGetTool(action, out IMCPTool tool))
+        {
+            return MCPResponse.Error($"未找到工具: {action}");
+        }
+
+        string pathError = HierarchyPathHelper.ResolveTargetPath(stepParams);
+        if (pathError != null)
+        {
+            return MCPResponse.Error(pathError);
+        }
+
+        string validationError = tool.ValidateParameters(stepParams);
+        if (!string.IsNullOrEmpty(validationError))
+        {
+            return MCPResponse.Error($"参数验证失败: {validationError}");
+        }
+
+        try
+        {
+            return tool.Execute(stepParams, client);
+        }
+        catch (Exception e)
+        {
+            Debug.LogError($"批处理步骤 {action} 执行出错: {e.Message}");
+            return MCPResponse.Error($"执行失败: {e.Message}");
+        }
+    }
+
+    /// <summary>
+    /// 将steps参数转换为 {action, params} 字典列表
+    /// </summary>
+    private static List<Dictionary<string, object>> ParseSteps(object raw)
+    {
+        var steps = new List<Dictionary<string, object>>();
+        foreach (JToken token in JArray.FromObject(raw))
+        {
+            var stepParams = token["params"] is JObject paramsObject
+                ? (Dictionary<string, object>)MCPMessage.ToPlainValue(paramsObject)
+                : new Dictionary<string, object>();
+            steps.Add(new Dictionary<string, object>
+            {
+                ["action"] = token.Value<string>("action"),
+                ["params"] = stepParams
+            });
+        }
+        return steps;
+    }
+
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null || !parameters.ContainsKey("steps"))
+        {
+            return "缺少必需参数: steps";
+        }
+
+        try
+        {
+            List<Dictionary<string, object>> steps = ParseSteps(parameters["steps"]);
+            if (steps.Count == 0)
+            {
+                return "steps不能为空";
+            }
+            for (int i = 0; i < steps.Count; i++)
+            {
+                if (string.IsNullOrEmpty(steps[i]["action"] as string))
+                {
+                    return $"第 {i} 步缺少action";
+                }
+            }
+        }
+        catch (Exception)
+        {
+            return "steps必须是 {action, params} 对象数组";
+        }
+
+        return null; // 验证通过
+    }
+}
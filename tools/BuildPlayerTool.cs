@@ -0,0 +1,227 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.Build.Reporting;
+
+/// <summary>
+/// 构建播放器工具 - 构建指定平台的播放器并返回BuildReport摘要
+/// BuildPipeline.BuildPlayer在主线程上同步执行，构建阶段通过BuildProgressCallbacks以进度帧报告
+/// </summary>
+public class BuildPlayerTool : IMCPTool
+{
+    /// <summary>
+    /// 返回的警告和错误消息总数上限，超过时只返回计数
+    /// </summary>
+    private const int MaxMessages = 100;
+    
+    private readonly MCPMessageDispatcher dispatcher;
+    
+    public BuildPlayerTool(MCPMessageDispatcher dispatcher)
+    {
+        this.dispatcher = dispatcher;
+    }
+    
+    public string ToolName => "build_player";
+    
+    public string Description => "构建播放器";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            if (BuildPipeline.isBuildingPlayer)
+            {
+                return MCPResponse.Error("已有构建正在进行");
+            }
+            if (EditorApplication.isPlayingOrWillChangePlaymode)
+            {
+                return MCPResponse.Error("播放模式下无法构建，请先退出播放模式");
+            }
+            if (EditorApplication.isCompiling)
+            {
+                return MCPResponse.Error("脚本正在编译，请等待编译完成后再构建");
+            }
+            
+            string targetName = parameters["target"].ToString();
+            if (!System.Enum.TryParse(targetName, out BuildTarget target))
+            {
+                return MCPResponse.Error($"未知的构建目标: {targetName}");
+            }
+            BuildTargetGroup group = BuildPipeline.GetBuildTargetGroup(target);
+            if (!BuildPipeline.IsBuildTargetSupported(group, target))
+            {
+                return MCPResponse.Error($"未安装 {targetName} 的构建支持模块");
+            }
+            
+            string[] scenes = ReadScenes(parameters, out string sceneError);
+            if (scenes == null)
+            {
+                return MCPResponse.Error(sceneError);
+            }
+            
+            BuildOptions options = BuildOptions.None;
+            if (parameters.ContainsKey("development") && System.Convert.ToBoolean(parameters["development"]))
+            {
+                options |= BuildOptions.Development;
+            }
+            if (parameters.ContainsKey("options") && parameters["options"] is System.Collections.IEnumerable flags)
+            {
+                foreach (var flag in flags)
+                {
+                    if (!System.Enum.TryParse(flag.ToString(), out BuildOptions option))
+                    {
+                        return MCPResponse.Error($"当前Unity版本不支持构建选项: {flag}");
+                    }
+                    options |= option;
+                }
+            }
+            
+            var buildOptions = new BuildPlayerOptions
+            {
+                scenes = scenes,
+                locationPathName = parameters["outputPath"].ToString(),
+                target = target,
+                targetGroup = group,
+                options = options
+            };
+            
+            Debug.Log($"开始构建 {targetName}: {buildOptions.locationPathName}");
+            BuildReport report;
+            BuildProgressCallbacks.sceneCount = scenes.Length;
+            BuildProgressCallbacks.onProgress = (progress, message) => dispatcher.SendProgress(client, progress, message);
+            try
+            {
+                report = BuildPipeline.BuildPlayer(buildOptions);
+            }
+            finally
+            {
+                BuildProgressCallbacks.onProgress = null;
+            }
+            
+            Dictionary<string, object> result = DescribeReport(report);
+            result["scenes"] = scenes;
+            Debug.Log($"构建结束: {report.summary.result}，耗时 {report.summary.totalTime}");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"构建播放器时出错: {e.Message}");
+            return MCPResponse.Error($"构建播放器失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 读取要构建的场景，未给出时使用Build Settings中启用的场景
+    /// </summary>
+    private string[] ReadScenes(Dictionary<string, object> parameters, out string error)
+    {
+        error = null;
+        if (parameters.ContainsKey("scenes") && parameters["scenes"] is System.Collections.IEnumerable items)
+        {
+            var scenes = new List<string>();
+            var missing = new List<string>();
+            foreach (var item in items)
+            {
+                string scene = item.ToString();
+                if (AssetDatabase.LoadAssetAtPath<SceneAsset>(scene) == null)
+                {
+                    missing.Add(scene);
+                }
+                scenes.Add(scene);
+            }
+            if (missing.Count > 0)
+            {
+                error = $"场景不存在: {string.Join(", ", missing)}";
+                return null;
+            }
+            return scenes.ToArray();
+        }
+        
+        string[] enabled = EditorBuildSettings.scenes.Where(s => s.enabled).Select(s => s.path).ToArray();
+        if (enabled.Length == 0)
+        {
+            error = "Build Settings中没有启用的场景，请通过scenes参数指定要构建的场景";
+            return null;
+        }
+        return enabled;
+    }
+    
+    /// <summary>
+    /// BuildReport摘要: 结果、大小、耗时、输出位置和每个步骤的警告与错误
+    /// </summary>
+    private Dictionary<string, object> DescribeReport(BuildReport report)
+    {
+        BuildSummary summary = report.summary;
+        var steps = new List<object>();
+        int messageCount = 0;
+        bool truncated = false;
+        foreach (BuildStep step in report.steps)
+        {
+            var messages = new List<object>();
+            int warnings = 0;
+            int errors = 0;
+            foreach (BuildStepMessage message in step.messages)
+            {
+                if (message.type == LogType.Warning)
+                {
+                    warnings++;
+                }
+                else if (message.type == LogType.Error || message.type == LogType.Exception || message.type == LogType.Assert)
+                {
+                    errors++;
+                }
+                else
+                {
+                    continue;
+                }
+                if (messageCount >= MaxMessages)
+                {
+                    truncated = true;
+                    continue;
+                }
+                messages.Add(new Dictionary<string, object>
+                {
+                    ["type"] = message.type.ToString(),
+                    ["content"] = message.content
+                });
+                messageCount++;
+            }
+            if (warnings == 0 && errors == 0)
+            {
+                continue;
+            }
+            steps.Add(new Dictionary<string, object>
+            {
+                ["name"] = step.name,
+                ["durationSeconds"] = step.duration.TotalSeconds,
+                ["warnings"] = warnings,
+                ["errors"] = errors,
+                ["messages"] = messages
+            });
+        }
+        
+        return new Dictionary<string, object>
+        {
+            ["result"] = summary.result.ToString(),
+            ["platform"] = summary.platform.ToString(),
+            ["outputPath"] = summary.outputPath,
+            ["totalSizeBytes"] = (long)summary.totalSize,
+            ["durationSeconds"] = summary.totalTime.TotalSeconds,
+            ["totalWarnings"] = summary.totalWarnings,
+            ["totalErrors"] = summary.totalErrors,
+            ["steps"] = steps,
+            ["messagesTruncated"] = truncated
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("target") || !parameters.ContainsKey("outputPath"))
+        {
+            return "缺少必需参数: target和outputPath";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,48 @@
+using System;
+using UnityEditor.Build;
+using UnityEditor.Build.Reporting;
+using UnityEngine.SceneManagement;
+
+/// <summary>
+/// 构建回调 - BuildPipeline.BuildPlayer阻塞主线程期间，通过构建回调把构建阶段报告给build_player
+/// 只在build_player设置了onProgress时报告，编辑器中的其他构建和进入播放模式时的场景处理不受影响
+/// </summary>
+public class BuildProgressCallbacks : IPreprocessBuildWithReport, IProcessSceneWithReport, IPostprocessBuildWithReport
+{
+    /// <summary>
+    /// 进度回调 (0~1, 说明)，构建结束后由build_player清除
+    /// </summary>
+    public static Action<float, string> onProgress;
+    
+    /// <summary>
+    /// 本次构建的场景数，用于估算场景处理阶段的进度
+    /// </summary>
+    public static int sceneCount;
+    
+    private static int processedScenes;
+    
+    public int callbackOrder => int.MaxValue;
+    
+    public void OnPreprocessBuild(BuildReport report)
+    {
+        processedScenes = 0;
+        onProgress?.Invoke(0.05f, $"开始构建 {report.summary.platform}");
+    }
+    
+    public void OnProcessScene(Scene scene, BuildReport report)
+    {
+        // 进入播放模式时report为null
+        if (report == null || onProgress == null)
+        {
+            return;
+        }
+        processedScenes++;
+        float fraction = sceneCount > 0 ? Math.Min(1f, (float)processedScenes / sceneCount) : 0f;
+        onProgress(0.1f + 0.6f * fraction, $"处理场景 {processedScenes}/{sceneCount}: {scene.path}");
+    }
+    
+    public void OnPostprocessBuild(BuildReport report)
+    {
+        onProgress?.Invoke(0.9f, "后处理构建结果");
+    }
+}
@@ -0,0 +1,40 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+
+/// <summary>
+/// 相机信息工具 - 获取相机设置
+/// </summary>
+public class CameraGetTool : IMCPTool
+{
+    public string ToolName => "camera_get";
+    
+    public string Description => "获取相机设置";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Camera camera = CameraToolHelper.Resolve(parameters, out string error);
+            if (camera == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            return MCPResponse.Success(CameraToolHelper.Describe(camera));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取相机设置时出错: {e.Message}");
+            return MCPResponse.Error($"获取相机设置失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId") && !parameters.ContainsKey("useMainCamera"))
+        {
+            return "缺少必需参数: instanceId或useMainCamera";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,102 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 相机设置工具 - 设置投影、清除方式、背景色、剔除层、裁剪面等相机属性
+/// cullingMask按层名给出，在修改相机之前逐个检查层名
+/// </summary>
+public class CameraSetTool : IMCPTool
+{
+    public string ToolName => "camera_set";
+    
+    public string Description => "设置相机属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Camera camera = CameraToolHelper.Resolve(parameters, out string error);
+            if (camera == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            // 先把层名转换为位掩码，未知的层名逐个报告
+            int? cullingMask = null;
+            if (parameters.ContainsKey("cullingMask") && parameters["cullingMask"] is System.Collections.IEnumerable layers)
+            {
+                int mask = 0;
+                var unknown = new List<string>();
+                foreach (var item in layers)
+                {
+                    string layerName = item.ToString();
+                    int layer = LayerMask.NameToLayer(layerName);
+                    if (layer < 0)
+                    {
+                        unknown.Add($"未知的层: '{layerName}'");
+                        continue;
+                    }
+                    mask |= 1 << layer;
+                }
+                if (unknown.Count > 0)
+                {
+                    return MCPResponse.Error(string.Join("; ", unknown));
+                }
+                cullingMask = mask;
+            }
+            
+            float near = parameters.ContainsKey("nearClipPlane") ? System.Convert.ToSingle(parameters["nearClipPlane"]) : camera.nearClipPlane;
+            float far = parameters.ContainsKey("farClipPlane") ? System.Convert.ToSingle(parameters["farClipPlane"]) : camera.farClipPlane;
+            if (near >= far)
+            {
+                return MCPResponse.Error($"nearClipPlane ({near}) 必须小于 farClipPlane ({far})");
+            }
+            
+            Undo.RecordObject(camera, "Set Camera Properties");
+            
+            if (parameters.ContainsKey("orthographic")) camera.orthographic = System.Convert.ToBoolean(parameters["orthographic"]);
+            if (parameters.ContainsKey("fieldOfView")) camera.fieldOfView = System.Convert.ToSingle(parameters["fieldOfView"]);
+            if (parameters.ContainsKey("orthographicSize")) camera.orthographicSize = System.Convert.ToSingle(parameters["orthographicSize"]);
+            if (parameters.ContainsKey("clearFlags"))
+            {
+                camera.clearFlags = (CameraClearFlags)System.Enum.Parse(typeof(CameraClearFlags), parameters["clearFlags"].ToString());
+            }
+            if (parameters.ContainsKey("backgroundColor"))
+            {
+                camera.backgroundColor = UIControlHelper.ReadColor(parameters["backgroundColor"], camera.backgroundColor);
+            }
+            if (cullingMask.HasValue) camera.cullingMask = cullingMask.Value;
+            camera.nearClipPlane = near;
+            camera.farClipPlane = far;
+            if (parameters.ContainsKey("depth")) camera.depth = System.Convert.ToSingle(parameters["depth"]);
+            if (parameters.ContainsKey("targetDisplay")) camera.targetDisplay = System.Convert.ToInt32(parameters["targetDisplay"]);
+            if (parameters.ContainsKey("renderingPath"))
+            {
+                camera.renderingPath = (RenderingPath)System.Enum.Parse(typeof(RenderingPath), parameters["renderingPath"].ToString());
+            }
+            if (parameters.ContainsKey("allowHDR")) camera.allowHDR = System.Convert.ToBoolean(parameters["allowHDR"]);
+            if (parameters.ContainsKey("allowMSAA")) camera.allowMSAA = System.Convert.ToBoolean(parameters["allowMSAA"]);
+            
+            EditorUtility.SetDirty(camera);
+            
+            Debug.Log($"成功设置相机 '{camera.name}' 的属性");
+            return MCPResponse.Success(CameraToolHelper.Describe(camera));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置相机属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置相机属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId") && !parameters.ContainsKey("useMainCamera"))
+        {
+            return "缺少必需参数: instanceId或useMainCamera";
+        }
+        return null;
+    }
+}
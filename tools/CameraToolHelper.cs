@@ -0,0 +1,90 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 相机工具共用的辅助方法: 解析目标相机和输出相机状态
+/// </summary>
+public static class CameraToolHelper
+{
+    /// <summary>
+    /// 按instanceId或useMainCamera查找相机
+    /// </summary>
+    public static Camera Resolve(Dictionary<string, object> parameters, out string error)
+    {
+        error = null;
+        if (parameters.ContainsKey("useMainCamera") && System.Convert.ToBoolean(parameters["useMainCamera"]))
+        {
+            Camera main = Camera.main;
+            if (main == null)
+            {
+                error = "场景中没有标记为MainCamera的已启用相机";
+            }
+            return main;
+        }
+        
+        int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+        GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+        if (gameObject == null)
+        {
+            error = $"未找到GameObject (InstanceID: {instanceId})";
+            return null;
+        }
+        Camera camera = gameObject.GetComponent<Camera>();
+        if (camera == null)
+        {
+            error = $"GameObject '{gameObject.name}' 没有Camera组件";
+        }
+        return camera;
+    }
+    
+    /// <summary>
+    /// cullingMask中包含的层名，未命名的层以序号表示
+    /// </summary>
+    public static List<string> MaskToLayerNames(int mask)
+    {
+        var names = new List<string>();
+        for (int layer = 0; layer < 32; layer++)
+        {
+            if ((mask & (1 << layer)) != 0)
+            {
+                string name = LayerMask.LayerToName(layer);
+                names.Add(string.IsNullOrEmpty(name) ? layer.ToString() : name);
+            }
+        }
+        return names;
+    }
+    
+    public static Dictionary<string, object> Describe(Camera camera)
+    {
+        Color background = camera.backgroundColor;
+        return new Dictionary<string, object>
+        {
+            ["name"] = camera.gameObject.name,
+            ["instanceId"] = camera.gameObject.GetInstanceID(),
+            ["isMainCamera"] = camera.CompareTag("MainCamera"),
+            ["enabled"] = camera.enabled,
+            ["orthographic"] = camera.orthographic,
+            ["fieldOfView"] = camera.fieldOfView,
+            ["orthographicSize"] = camera.orthographicSize,
+            ["clearFlags"] = camera.clearFlags.ToString(),
+            ["backgroundColor"] = new Dictionary<string, float>
+            {
+                ["r"] = background.r,
+                ["g"] = background.g,
+                ["b"] = background.b,
+                ["a"] = background.a
+            },
+            ["cullingMask"] = MaskToLayerNames(camera.cullingMask),
+            ["cullingMaskValue"] = camera.cullingMask,
+            ["nearClipPlane"] = camera.nearClipPlane,
+            ["farClipPlane"] = camera.farClipPlane,
+            ["depth"] = camera.depth,
+            ["targetDisplay"] = camera.targetDisplay,
+            ["renderingPath"] = camera.renderingPath.ToString(),
+            ["actualRenderingPath"] = camera.actualRenderingPath.ToString(),
+            ["allowHDR"] = camera.allowHDR,
+            ["allowMSAA"] = camera.allowMSAA
+        };
+    }
+}
@@ -0,0 +1,276 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using System.Reflection;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 碰撞体设置工具 - 设置3D/2D碰撞体的形状、触发器和物理材质
+/// 未指定colliderType时使用对象上的第一个碰撞体；指定时使用该类型的碰撞体，不存在则添加
+/// </summary>
+public class ColliderSetTool : IMCPTool
+{
+    public string ToolName => "collider_set";
+    
+    public string Description => "设置碰撞体属性";
+    
+    private static readonly Dictionary<string, System.Type> ColliderTypes3D = new Dictionary<string, System.Type>
+    {
+        ["Box"] = typeof(BoxCollider),
+        ["Sphere"] = typeof(SphereCollider),
+        ["Capsule"] = typeof(CapsuleCollider),
+        ["Mesh"] = typeof(MeshCollider)
+    };
+    
+    private static readonly Dictionary<string, System.Type> ColliderTypes2D = new Dictionary<string, System.Type>
+    {
+        ["Box"] = typeof(BoxCollider2D),
+        ["Circle"] = typeof(CircleCollider2D),
+        ["Capsule"] = typeof(CapsuleCollider2D),
+        ["Polygon"] = typeof(PolygonCollider2D),
+        ["Edge"] = typeof(EdgeCollider2D)
+    };
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (gameObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            bool is2D = parameters.ContainsKey("is2D") && System.Convert.ToBoolean(parameters["is2D"]);
+            System.Type baseType = is2D ? typeof(Collider2D) : typeof(Collider);
+            var types = is2D ? ColliderTypes2D : ColliderTypes3D;
+            
+            // 查找或添加碰撞体
+            Component collider;
+            bool added = false;
+            if (parameters.ContainsKey("colliderType"))
+            {
+                string colliderType = parameters["colliderType"].ToString();
+                if (!types.TryGetValue(colliderType, out System.Type type))
+                {
+                    return MCPResponse.Error($"不支持的碰撞体类型: {colliderType}");
+                }
+                collider = gameObject.GetComponent(type);
+                if (collider == null)
+                {
+                    collider = Undo.AddComponent(gameObject, type);
+                    added = true;
+                }
+            }
+            else
+            {
+                collider = gameObject.GetComponent(baseType);
+                if (collider == null)
+                {
+                    return MCPResponse.Error($"GameObject '{gameObject.name}' 没有{baseType.Name}组件，请指定colliderType以添加");
+                }
+            }
+            
+            string mismatch = CheckShapeParams(collider, parameters);
+            if (mismatch != null)
+            {
+                return MCPResponse.Error(mismatch);
+            }
+            
+            // 物理材质的类型随Unity版本和2D/3D不同，按sharedMaterial属性的类型加载
+            PropertyInfo materialProperty = collider.GetType().GetProperty("sharedMaterial");
+            Object physicMaterial = null;
+            bool setMaterial = parameters.ContainsKey("physicMaterialPath");
+            if (setMaterial)
+            {
+                string materialPath = parameters["physicMaterialPath"].ToString();
+                if (!string.IsNullOrEmpty(materialPath))
+                {
+                    physicMaterial = AssetDatabase.LoadAssetAtPath(materialPath, materialProperty.PropertyType);
+                    if (physicMaterial == null)
+                    {
+                        return MCPResponse.Error($"未找到{materialProperty.PropertyType.Name}资源: {materialPath}");
+                    }
+                }
+            }
+            
+            Undo.RecordObject(collider, "Set Collider Properties");
+            if (setMaterial) materialProperty.SetValue(collider, physicMaterial);
+            if (parameters.ContainsKey("isTrigger"))
+            {
+                collider.GetType().GetProperty("isTrigger").SetValue(collider, System.Convert.ToBoolean(parameters["isTrigger"]));
+            }
+            ApplyShape(collider, parameters);
+            EditorUtility.SetDirty(collider);
+            
+            var result = Describe(collider);
+            result["added"] = added;
+            
+            Debug.Log($"成功设置 '{gameObject.name}' 的{collider.GetType().Name}组件属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置碰撞体属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置碰撞体属性失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 形状参数必须适用于碰撞体的类型，逐项报告不适用的参数
+    /// </summary>
+    private static string CheckShapeParams(Component collider, Dictionary<string, object> parameters)
+    {
+        var allowed = new List<string>();
+        switch (collider)
+        {
+            case BoxCollider _: allowed.AddRange(new[] { "center", "size" }); break;
+            case SphereCollider _: allowed.AddRange(new[] { "center", "radius" }); break;
+            case CapsuleCollider _: allowed.AddRange(new[] { "center", "radius", "height", "direction" }); break;
+            case MeshCollider _: allowed.Add("convex"); break;
+            case BoxCollider2D _: allowed.AddRange(new[] { "center", "size" }); break;
+            case CircleCollider2D _: allowed.AddRange(new[] { "center", "radius" }); break;
+            case CapsuleCollider2D _: allowed.AddRange(new[] { "center", "size", "direction" }); break;
+            default: allowed.Add("center"); break;
+        }
+        var problems = new List<string>();
+        foreach (var name in new[] { "center", "size", "radius", "height", "direction", "convex" })
+        {
+            if (parameters.ContainsKey(name) && !allowed.Contains(name))
+            {
+                problems.Add($"{name} 不适用于{collider.GetType().Name}");
+            }
+        }
+        return problems.Count > 0 ? string.Join("; ", problems) : null;
+    }
+    
+    private static void ApplyShape(Component collider, Dictionary<string, object> parameters)
+    {
+        var center = parameters.ContainsKey("center") ? parameters["center"] as Dictionary<string, object> : null;
+        var size = parameters.ContainsKey("size") ? parameters["size"] as Dictionary<string, object> : null;
+        
+        switch (collider)
+        {
+            case BoxCollider box:
+                if (center != null) box.center = ReadVector3(center, box.center);
+                if (size != null) box.size = ReadVector3(size, box.size);
+                break;
+            case SphereCollider sphere:
+                if (center != null) sphere.center = ReadVector3(center, sphere.center);
+                if (parameters.ContainsKey("radius")) sphere.radius = System.Convert.ToSingle(parameters["radius"]);
+                break;
+            case CapsuleCollider capsule:
+                if (center != null) capsule.center = ReadVector3(center, capsule.center);
+                if (parameters.ContainsKey("radius")) capsule.radius = System.Convert.ToSingle(parameters["radius"]);
+                if (parameters.ContainsKey("height")) capsule.height = System.Convert.ToSingle(parameters["height"]);
+                // CapsuleCollider.direction: 0=X, 1=Y, 2=Z
+                if (parameters.ContainsKey("direction")) capsule.direction = "XYZ".IndexOf(parameters["direction"].ToString());
+                break;
+            case MeshCollider mesh:
+                if (parameters.ContainsKey("convex")) mesh.convex = System.Convert.ToBoolean(parameters["convex"]);
+                break;
+            case Collider2D collider2D:
+                if (center != null) collider2D.offset = ReadVector2(center, collider2D.offset);
+                if (collider2D is BoxCollider2D box2D && size != null) box2D.size = ReadVector2(size, box2D.size);
+                if (collider2D is CircleCollider2D circle && parameters.ContainsKey("radius")) circle.radius = System.Convert.ToSingle(parameters["radius"]);
+                if (collider2D is CapsuleCollider2D capsule2D)
+                {
+                    if (size != null) capsule2D.size = ReadVector2(size, capsule2D.size);
+                    if (parameters.ContainsKey("direction"))
+                    {
+                        capsule2D.direction = (CapsuleDirection2D)System.Enum.Parse(typeof(CapsuleDirection2D), parameters["direction"].ToString());
+                    }
+                }
+                break;
+        }
+    }
+    
+    private static Vector3 ReadVector3(Dictionary<string, object> dict, Vector3 current)
+    {
+        return new Vector3(
+            dict.ContainsKey("x") ? System.Convert.ToSingle(dict["x"]) : current.x,
+            dict.ContainsKey("y") ? System.Convert.ToSingle(dict["y"]) : current.y,
+            dict.ContainsKey("z") ? System.Convert.ToSingle(dict["z"]) : current.z
+        );
+    }
+    
+    private static Vector2 ReadVector2(Dictionary<string, object> dict, Vector2 current)
+    {
+        return new Vector2(
+            dict.ContainsKey("x") ? System.Convert.ToSingle(dict["x"]) : current.x,
+            dict.ContainsKey("y") ? System.Convert.ToSingle(dict["y"]) : current.y
+        );
+    }
+    
+    private static Dictionary<string, float> VectorToDict(Vector3 v)
+    {
+        return new Dictionary<string, float> { ["x"] = v.x, ["y"] = v.y, ["z"] = v.z };
+    }
+    
+    private static Dictionary<string, float> VectorToDict(Vector2 v)
+    {
+        return new Dictionary<string, float> { ["x"] = v.x, ["y"] = v.y };
+    }
+    
+    private static Dictionary<string, object> Describe(Component collider)
+    {
+        Object material = collider.GetType().GetProperty("sharedMaterial").GetValue(collider) as Object;
+        var info = new Dictionary<string, object>
+        {
+            ["name"] = collider.gameObject.name,
+            ["instanceId"] = collider.gameObject.GetInstanceID(),
+            ["componentType"] = collider.GetType().Name,
+            ["isTrigger"] = collider.GetType().GetProperty("isTrigger").GetValue(collider),
+            ["physicMaterialPath"] = material != null ? AssetDatabase.GetAssetPath(material) : null
+        };
+        switch (collider)
+        {
+            case BoxCollider box:
+                info["center"] = VectorToDict(box.center);
+                info["size"] = VectorToDict(box.size);
+                break;
+            case SphereCollider sphere:
+                info["center"] = VectorToDict(sphere.center);
+                info["radius"] = sphere.radius;
+                break;
+            case CapsuleCollider capsule:
+                info["center"] = VectorToDict(capsule.center);
+                info["radius"] = capsule.radius;
+                info["height"] = capsule.height;
+                info["direction"] = "XYZ"[capsule.direction].ToString();
+                break;
+            case MeshCollider mesh:
+                info["convex"] = mesh.convex;
+                info["mesh"] = mesh.sharedMesh != null ? mesh.sharedMesh.name : null;
+                break;
+            case Collider2D collider2D:
+                info["center"] = VectorToDict(collider2D.offset);
+                if (collider2D is BoxCollider2D box2D) info["size"] = VectorToDict(box2D.size);
+                if (collider2D is CircleCollider2D circle) info["radius"] = circle.radius;
+                if (collider2D is CapsuleCollider2D capsule2D)
+                {
+                    info["size"] = VectorToDict(capsule2D.size);
+                    info["direction"] = capsule2D.direction.ToString();
+                }
+                break;
+        }
+        return info;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        foreach (string key in new[] { "center", "size" })
+        {
+            if (parameters.ContainsKey(key) && !(parameters[key] is Dictionary<string, object>))
+            {
+                return $"{key}必须是 {{x, y, z}} 对象";
+            }
+        }
+        return null;
+    }
+}
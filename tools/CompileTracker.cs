@@ -0,0 +1,96 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.Compilation;
+
+/// <summary>
+/// 编译跟踪 - 记录最近一次脚本编译的结果和编译器消息
+/// 编译成功后会发生域重载，因此结果保存在SessionState中
+/// </summary>
+[InitializeOnLoad]
+public static class CompileTracker
+{
+    private const string GenerationKey = "UnityMCP.CompileGeneration";
+    private const string ResultKey = "UnityMCP.CompileResult";
+    
+    /// <summary>
+    /// 一条编译器消息
+    /// </summary>
+    [System.Serializable]
+    public class Message
+    {
+        public string file;
+        public int line;
+        public int column;
+        public string message;
+        public string type;
+        public string assembly;
+    }
+    
+    /// <summary>
+    /// 最近一次编译的结果
+    /// </summary>
+    [System.Serializable]
+    public class Result
+    {
+        public bool succeeded;
+        public string finishedAt;
+        public List<Message> messages = new List<Message>();
+    }
+    
+    private static readonly List<Message> pending = new List<Message>();
+    
+    static CompileTracker()
+    {
+        CompilationPipeline.compilationStarted += _ => pending.Clear();
+        CompilationPipeline.assemblyCompilationFinished += OnAssemblyCompiled;
+        CompilationPipeline.compilationFinished += OnCompilationFinished;
+    }
+    
+    /// <summary>
+    /// 已完成的编译次数，域重载后继续累加
+    /// </summary>
+    public static int Generation => SessionState.GetInt(GenerationKey, 0);
+    
+    /// <summary>
+    /// 最近一次编译的结果，本次编辑器会话中还没有编译时返回null
+    /// </summary>
+    public static Result LastResult
+    {
+        get
+        {
+            string json = SessionState.GetString(ResultKey, "");
+            return string.IsNullOrEmpty(json) ? null : JsonUtility.FromJson<Result>(json);
+        }
+    }
+    
+    private static void OnAssemblyCompiled(string assemblyPath, CompilerMessage[] messages)
+    {
+        string assembly = System.IO.Path.GetFileNameWithoutExtension(assemblyPath);
+        foreach (var message in messages)
+        {
+            pending.Add(new Message
+            {
+                file = message.file,
+                line = message.line,
+                column = message.column,
+                message = message.message,
+                type = message.type == CompilerMessageType.Error ? "error" : "warning",
+                assembly = assembly
+            });
+        }
+    }
+    
+    private static void OnCompilationFinished(object context)
+    {
+        var result = new Result
+        {
+            succeeded = !pending.Exists(m => m.type == "error"),
+            finishedAt = System.DateTime.UtcNow.ToString("yyyy-MM-ddTHH:mm:ssZ"),
+            messages = new List<Message>(pending)
+        };
+        SessionState.SetString(ResultKey, JsonUtility.ToJson(result));
+        SessionState.SetInt(GenerationKey, Generation + 1);
+        pending.Clear();
+    }
+}
@@ -0,0 +1,96 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 组件读取工具 - 导出任意组件的序列化属性，属性路径可直接用于component_set_property
+/// </summary>
+public class ComponentGetTool : IMCPTool
+{
+    public string ToolName => "component_get";
+    
+    public string Description => "获取组件的所有序列化属性及其类型";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int maxProperties = parameters.ContainsKey("maxProperties") ? 
+                System.Convert.ToInt32(parameters["maxProperties"]) : 500;
+            int maxArrayElements = parameters.ContainsKey("maxArrayElements") ? 
+                System.Convert.ToInt32(parameters["maxArrayElements"]) : 50;
+            
+            Component component;
+            int matchingComponents = 1;
+            if (parameters.ContainsKey("componentInstanceId"))
+            {
+                int componentInstanceId = System.Convert.ToInt32(parameters["componentInstanceId"]);
+                component = EditorUtility.InstanceIDToObject(componentInstanceId) as Component;
+                if (component == null)
+                {
+                    return MCPResponse.Error($"未找到组件 (InstanceID: {componentInstanceId})");
+                }
+            }
+            else
+            {
+                int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+                string componentType = parameters["componentType"].ToString();
+                GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+                if (targetObject == null)
+                {
+                    return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+                }
+                
+                // 按短名或完整类型名匹配，不区分大小写
+                var matches = targetObject.GetComponents<Component>()
+                    .Where(c => c != null && (string.Equals(c.GetType().Name, componentType, System.StringComparison.OrdinalIgnoreCase) ||
+                                              string.Equals(c.GetType().FullName, componentType, System.StringComparison.OrdinalIgnoreCase)))
+                    .ToList();
+                if (matches.Count == 0)
+                {
+                    var available = targetObject.GetComponents<Component>().Where(c => c != null).Select(c => c.GetType().Name);
+                    return MCPResponse.Error($"对象 '{targetObject.name}' 没有组件 '{componentType}'，现有组件: {string.Join(", ", available)}");
+                }
+                component = matches[0];
+                matchingComponents = matches.Count;
+            }
+            
+            var properties = SerializedPropertyHelper.Dump(new SerializedObject(component), maxProperties, maxArrayElements, out bool truncated);
+            
+            var result = new Dictionary<string, object>
+            {
+                ["gameObjectName"] = component.gameObject.name,
+                ["gameObjectInstanceId"] = component.gameObject.GetInstanceID(),
+                ["componentType"] = component.GetType().Name,
+                ["componentFullType"] = component.GetType().FullName,
+                ["componentInstanceId"] = component.GetInstanceID(),
+                ["matchingComponents"] = matchingComponents,
+                ["properties"] = properties,
+                ["propertyCount"] = properties.Count,
+                ["truncated"] = truncated
+            };
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取组件属性时出错: {e.Message}");
+            return MCPResponse.Error($"获取组件属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters.ContainsKey("componentInstanceId"))
+        {
+            return null;
+        }
+        if (!parameters.ContainsKey("instanceId") || !parameters.ContainsKey("componentType"))
+        {
+            return "需要componentInstanceId，或instanceId和componentType";
+        }
+        return null;
+    }
+}
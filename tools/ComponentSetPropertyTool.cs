@@ -0,0 +1,96 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 组件属性设置工具 - 按序列化属性路径修改任意组件的字段
+/// </summary>
+public class ComponentSetPropertyTool : IMCPTool
+{
+    public string ToolName => "component_set_property";
+    
+    public string Description => "按属性路径设置组件的序列化属性，支持嵌套路径和对象引用";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int componentInstanceId = System.Convert.ToInt32(parameters["componentInstanceId"]);
+            string propertyPath = parameters["propertyPath"].ToString();
+            object value = parameters.ContainsKey("value") ? parameters["value"] : null;
+            
+            Component component = EditorUtility.InstanceIDToObject(componentInstanceId) as Component;
+            if (component == null)
+            {
+                return MCPResponse.Error($"未找到组件 (InstanceID: {componentInstanceId})");
+            }
+            
+            var serializedObject = new SerializedObject(component);
+            SerializedProperty property = SerializedPropertyHelper.FindProperty(serializedObject, propertyPath);
+            if (property == null)
+            {
+                return MCPResponse.Error($"组件 '{component.GetType().Name}' 没有属性 '{propertyPath}'，可使用component_get查看属性路径");
+            }
+            if (!property.editable)
+            {
+                return MCPResponse.Error($"属性 '{property.propertyPath}' 不可编辑");
+            }
+            if (property.propertyType == SerializedPropertyType.Generic)
+            {
+                return MCPResponse.Error($"属性 '{property.propertyPath}' 是数组或结构体，请设置其子属性 (数组长度使用 {property.propertyPath}.Array.size)");
+            }
+            
+            // 只有对象引用可以省略value (清空引用)
+            if (value == null && property.propertyType != SerializedPropertyType.ObjectReference)
+            {
+                return MCPResponse.Error($"缺少必需参数: value (属性 '{property.propertyPath}' 的类型为 {property.propertyType})");
+            }
+            
+            object previousValue = SerializedPropertyHelper.GetValue(property);
+            string error = SerializedPropertyHelper.SetValue(property, value);
+            if (error != null)
+            {
+                return MCPResponse.Error($"设置属性 '{property.propertyPath}' 失败: {error}");
+            }
+            
+            // ApplyModifiedProperties会注册Undo
+            serializedObject.ApplyModifiedProperties();
+            serializedObject.Update();
+            property = serializedObject.FindProperty(property.propertyPath);
+            
+            var result = new Dictionary<string, object>
+            {
+                ["componentInstanceId"] = componentInstanceId,
+                ["componentType"] = component.GetType().Name,
+                ["gameObjectName"] = component.gameObject.name,
+                ["propertyPath"] = property.propertyPath,
+                ["propertyType"] = property.propertyType.ToString(),
+                ["previousValue"] = previousValue,
+                ["value"] = SerializedPropertyHelper.GetValue(property)
+            };
+            
+            Debug.Log($"成功设置组件 '{component.GetType().Name}' 的属性 '{property.propertyPath}'");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置组件属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置组件属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("componentInstanceId"))
+        {
+            return "缺少必需参数: componentInstanceId";
+        }
+        if (!parameters.ContainsKey("propertyPath") || string.IsNullOrEmpty(parameters["propertyPath"].ToString()))
+        {
+            return "缺少必需参数: propertyPath";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,51 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+
+/// <summary>
+/// Console清除工具 - 清除Unity Console和MCP日志缓冲区；collapseOnly时只合并重复的日志
+/// </summary>
+public class ConsoleClearTool : IMCPTool
+{
+    public string ToolName => "console_clear";
+    
+    public string Description => "清除Unity Console";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            bool collapseOnly = parameters.ContainsKey("collapseOnly") && System.Convert.ToBoolean(parameters["collapseOnly"]);
+            
+            Dictionary<string, object> result;
+            if (collapseOnly)
+            {
+                // Unity Console不能删除单条日志，只能打开Collapse合并显示；缓冲区中的重复日志直接删除
+                int removed = EditorLogBuffer.RemoveDuplicates();
+                bool collapsed = UnityConsoleHelper.SetCollapse(true);
+                result = ConsoleStatsTool.Collect(-1);
+                result["removedDuplicates"] = removed;
+                result["consoleCollapsed"] = collapsed;
+            }
+            else
+            {
+                bool cleared = UnityConsoleHelper.Clear();
+                EditorLogBuffer.Clear();
+                result = ConsoleStatsTool.Collect(-1);
+                result["consoleCleared"] = cleared;
+            }
+            result["collapseOnly"] = collapseOnly;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"清除Console时出错: {e.Message}");
+            return MCPResponse.Error($"清除Console失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,92 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+
+/// <summary>
+/// Console统计工具 - 返回错误、警告和普通日志的数量以及最近一条错误，不返回日志内容
+/// 比editor_get_logs轻得多，适合频繁检查"是否有新错误"
+/// </summary>
+public class ConsoleStatsTool : IMCPTool
+{
+    public string ToolName => "console_stats";
+    
+    public string Description => "获取Console中错误、警告和日志的数量";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            long afterSequence = parameters.ContainsKey("afterSequence") ? System.Convert.ToInt64(parameters["afterSequence"]) : -1;
+            return MCPResponse.Success(Collect(afterSequence));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取Console统计时出错: {e.Message}");
+            return MCPResponse.Error($"获取Console统计失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 统计Console和日志缓冲区；afterSequence >= 0 时另外统计该序号之后的新日志
+    /// </summary>
+    public static Dictionary<string, object> Collect(long afterSequence)
+    {
+        var buffered = EditorLogBuffer.Snapshot();
+        
+        // 优先使用Console窗口的计数，内部API不可用时使用缓冲区的计数
+        bool fromConsole = UnityConsoleHelper.GetCounts(out int errors, out int warnings, out int logs);
+        if (!fromConsole)
+        {
+            errors = buffered.Count(e => IsError(e.Type));
+            warnings = buffered.Count(e => e.Type == LogType.Warning);
+            logs = buffered.Count(e => e.Type == LogType.Log);
+        }
+        
+        var result = new Dictionary<string, object>
+        {
+            ["errors"] = errors,
+            ["warnings"] = warnings,
+            ["logs"] = logs,
+            ["source"] = fromConsole ? "console" : "buffer",
+            ["lastSequence"] = EditorLogBuffer.LastSequence
+        };
+        
+        EditorLogBuffer.Entry lastError = buffered.LastOrDefault(e => IsError(e.Type));
+        result["lastError"] = lastError == null ? null : new Dictionary<string, object>
+        {
+            ["sequence"] = lastError.Sequence,
+            ["timestamp"] = lastError.TimestampUtc.ToString("o"),
+            ["message"] = FirstLine(lastError.Message)
+        };
+        
+        if (afterSequence >= 0)
+        {
+            var newer = buffered.Where(e => e.Sequence > afterSequence).ToList();
+            result["afterSequence"] = afterSequence;
+            result["newErrors"] = newer.Count(e => IsError(e.Type));
+            result["newWarnings"] = newer.Count(e => e.Type == LogType.Warning);
+            result["newLogs"] = newer.Count(e => e.Type == LogType.Log);
+        }
+        return result;
+    }
+    
+    private static bool IsError(LogType type)
+    {
+        return type == LogType.Error || type == LogType.Assert || type == LogType.Exception;
+    }
+    
+    /// <summary>
+    /// 错误信息的第一行，最多200个字符
+    /// </summary>
+    private static string FirstLine(string message)
+    {
+        string line = (message ?? "").Split('\n')[0].Trim();
+        return line.Length > 200 ? line.Substring(0, 200) + "..." : line;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,55 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 编辑器忙碌状态工具 - 报告Unity是否正在编译、导入资源或切换播放模式
+/// 域重载期间Unity无法响应请求，调用方应把通信失败视为重载中
+/// </summary>
+public class EditorBusyStateTool : IMCPTool
+{
+    public string ToolName => "editor_get_busy_state";
+    
+    public string Description => "获取编辑器忙碌状态 (编译、资源导入、播放模式切换)";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            bool compiling = EditorApplication.isCompiling;
+            bool updating = EditorApplication.isUpdating;
+            bool changingPlayMode = EditorApplication.isPlayingOrWillChangePlaymode != EditorApplication.isPlaying;
+            
+            var reasons = new List<string>();
+            if (compiling) reasons.Add("compiling");
+            if (updating) reasons.Add("importing");
+            if (changingPlayMode) reasons.Add("changingPlayMode");
+            
+            var result = new Dictionary<string, object>
+            {
+                ["idle"] = reasons.Count == 0,
+                ["busyReasons"] = reasons,
+                ["isCompiling"] = compiling,
+                ["isImporting"] = updating,
+                ["isChangingPlayMode"] = changingPlayMode,
+                ["isPlaying"] = EditorApplication.isPlaying,
+                ["compileGeneration"] = CompileTracker.Generation,
+                ["domainReloadCount"] = PlayModeTracker.ReloadCount
+            };
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取编辑器状态时出错: {e.Message}");
+            return MCPResponse.Error($"获取编辑器状态失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        // 此工具不需要参数
+        return null;
+    }
+}
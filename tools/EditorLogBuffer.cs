@@ -0,0 +1,143 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// Editor日志缓冲区 - 捕获Console日志并分配递增的序号
+/// 序号保存在SessionState中，域重载后继续递增，客户端可以用序号作为游标只读取新日志
+/// </summary>
+[InitializeOnLoad]
+public static class EditorLogBuffer
+{
+    private const int Capacity = 5000;
+    private const string SequenceKey = "UnityMCP.LogSequence";
+    
+    /// <summary>
+    /// 一条捕获的日志
+    /// </summary>
+    public class Entry
+    {
+        public long Sequence;
+        public System.DateTime TimestampUtc;
+        public LogType Type;
+        public string Message;
+        public string StackTrace;
+        public bool FromBridge; // MCP桥接自身输出的日志 (收发消息等)
+    }
+    
+    // 堆栈中出现这些类时视为MCP桥接自身的日志
+    private static readonly string[] bridgeFrames = { "MCPServer:", "MCPMessageDispatcher:", "MCPLogger:" };
+    
+    private static readonly object bufferLock = new object();
+    private static readonly LinkedList<Entry> entries = new LinkedList<Entry>();
+    private static long lastSequence;
+    
+    static EditorLogBuffer()
+    {
+        lastSequence = SessionState.GetInt(SequenceKey, 0);
+        Application.logMessageReceivedThreaded += OnLogMessage;
+    }
+    
+    /// <summary>
+    /// 最近分配的序号，没有日志时为0
+    /// </summary>
+    public static long LastSequence
+    {
+        get
+        {
+            lock (bufferLock)
+            {
+                return lastSequence;
+            }
+        }
+    }
+    
+    private static void OnLogMessage(string message, string stackTrace, LogType type)
+    {
+        lock (bufferLock)
+        {
+            lastSequence++;
+            entries.AddLast(new Entry
+            {
+                Sequence = lastSequence,
+                TimestampUtc = System.DateTime.UtcNow,
+                Type = type,
+                Message = message,
+                StackTrace = stackTrace,
+                FromBridge = IsBridgeLog(stackTrace)
+            });
+            if (entries.Count > Capacity)
+            {
+                entries.RemoveFirst();
+            }
+        }
+        // SessionState只能在主线程访问
+        EditorApplication.delayCall += SaveSequence;
+    }
+    
+    private static bool IsBridgeLog(string stackTrace)
+    {
+        if (string.IsNullOrEmpty(stackTrace))
+        {
+            return false;
+        }
+        foreach (string frame in bridgeFrames)
+        {
+            if (stackTrace.Contains(frame))
+            {
+                return true;
+            }
+        }
+        return false;
+    }
+    
+    private static void SaveSequence()
+    {
+        SessionState.SetInt(SequenceKey, (int)LastSequence);
+    }
+    
+    /// <summary>
+    /// 返回当前缓冲区的副本，按序号升序
+    /// </summary>
+    public static List<Entry> Snapshot()
+    {
+        lock (bufferLock)
+        {
+            return new List<Entry>(entries);
+        }
+    }
+    
+    /// <summary>
+    /// 相同类型和内容的日志只保留最新的一条，返回删除的条数
+    /// </summary>
+    public static int RemoveDuplicates()
+    {
+        lock (bufferLock)
+        {
+            var seen = new HashSet<string>();
+            int removed = 0;
+            for (var node = entries.Last; node != null;)
+            {
+                var previous = node.Previous;
+                if (!seen.Add((int)node.Value.Type + ":" + node.Value.Message))
+                {
+                    entries.Remove(node);
+                    removed++;
+                }
+                node = previous;
+            }
+            return removed;
+        }
+    }
+    
+    /// <summary>
+    /// 清空缓冲区，序号继续递增
+    /// </summary>
+    public static void Clear()
+    {
+        lock (bufferLock)
+        {
+            entries.Clear();
+        }
+    }
+}
@@ -4,7 +4,7 @@ using System.Linq;
 using System.Text;
 using UnityEngine;
 using UnityEditor;
-using System.Reflection;
+using System.Text.RegularExpressions;
 
 /// <summary>
 /// Editor日志读取工具 - 读取Unity Editor的Console日志
@@ -15,16 +15,6 @@ public class EditorLogTool : IMCPTool
     
     public string Description => "读取Unity Editor Console日志（错误、警告、普通日志）";
     
-    private static System.Type logEntriesType;
-    private static MethodInfo getLogCountMethod;
-    private static MethodInfo getLogEntryMethod;
-    private static MethodInfo clearMethod;
-    
-    static EditorLogTool()
-    {
-        InitializeReflection();
-    }
-    
     public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
     {
         try
@@ -34,6 +24,18 @@ public class EditorLogTool : IMCPTool
             bool clearLogs = parameters.ContainsKey("clearLogs") ? System.Convert.ToBoolean(parameters["clearLogs"]) : false;
             bool includeStackTrace = parameters.ContainsKey("includeStackTrace") ? 
                 System.Convert.ToBoolean(parameters["includeStackTrace"]) : false;
+            bool excludeBridgeLogs = parameters.ContainsKey("excludeBridgeLogs") ? System.Convert.ToBoolean(parameters["excludeBridgeLogs"]) : false;
+            bool collapse = parameters.ContainsKey("collapse") ? System.Convert.ToBoolean(parameters["collapse"]) : false;
+            long afterSequence = parameters.ContainsKey("afterSequence") ? System.Convert.ToInt64(parameters["afterSequence"]) : -1;
+            string search = parameters.ContainsKey("search") ? parameters["search"].ToString() : "";
+            bool searchRegex = parameters.ContainsKey("searchRegex") ? System.Convert.ToBoolean(parameters["searchRegex"]) : false;
+            System.DateTime? since = null;
+            if (parameters.ContainsKey("sinceTimestamp"))
+            {
+                since = System.DateTime.Parse(parameters["sinceTimestamp"].ToString(), System.Globalization.CultureInfo.InvariantCulture,
+                    System.Globalization.DateTimeStyles.AssumeUniversal | System.Globalization.DateTimeStyles.AdjustToUniversal);
+            }
+            Regex searchPattern = searchRegex && !string.IsNullOrEmpty(search) ? new Regex(search, RegexOptions.IgnoreCase) : null;
             
             var result = new Dictionary<string, object>
             {
@@ -44,45 +46,41 @@ public class EditorLogTool : IMCPTool
                 ["timestamp"] = System.DateTime.Now.ToString("yyyy-MM-dd HH:mm:ss")
             };
             
-            if (logEntriesType == null)
-            {
-                return MCPResponse.Error("无法访问Unity Editor日志系统，可能是Unity版本不兼容");
-            }
+            // 读取缓冲区并过滤
+            var buffered = EditorLogBuffer.Snapshot();
+            long lastSequence = EditorLogBuffer.LastSequence;
+            result["totalLogCount"] = buffered.Count;
             
-            // 获取日志总数
-            int totalLogCount = (int)getLogCountMethod.Invoke(null, null);
-            result["totalLogCount"] = totalLogCount;
+            var matched = buffered.Where(e =>
+                e.Sequence > afterSequence &&
+                !(excludeBridgeLogs && e.FromBridge) &&
+                (!since.HasValue || e.TimestampUtc >= since.Value) &&
+                ShouldIncludeLog(LevelName(e.Type), logLevel) &&
+                MatchesSearch(e.Message, search, searchPattern)).ToList();
             
-            if (totalLogCount == 0)
+            // 使用游标时从最早的新日志开始返回，否则返回最近的日志
+            bool truncated = matched.Count > maxLogs;
+            List<EditorLogBuffer.Entry> selected;
+            if (afterSequence >= 0)
             {
-                result["logs"] = new List<Dictionary<string, object>>();
-                result["retrievedCount"] = 0;
-                result["message"] = "没有找到日志";
-                return MCPResponse.Success(result);
+                selected = matched.Take(maxLogs).ToList();
             }
-            
-            // 收集日志
-            var logs = new List<Dictionary<string, object>>();
-            int startIndex = System.Math.Max(0, totalLogCount - maxLogs);
-            
-            for (int i = startIndex; i < totalLogCount; i++)
+            else
             {
-                try
-                {
-                    var logEntry = GetLogEntry(i, includeStackTrace);
-                    if (logEntry != null && ShouldIncludeLog(logEntry, logLevel))
-                    {
-                        logs.Add(logEntry);
-                    }
-                }
-                catch (System.Exception e)
-                {
-                    Debug.LogWarning($"读取日志条目 {i} 时出错: {e.Message}");
-                }
+                selected = matched.Skip(System.Math.Max(0, matched.Count - maxLogs)).ToList();
             }
             
+            var logs = collapse ? CollapseEntries(selected, includeStackTrace) : selected.Select(e => BuildLogEntry(e, includeStackTrace)).ToList();
+            
             result["logs"] = logs;
             result["retrievedCount"] = logs.Count;
+            result["truncated"] = truncated;
+            // 下次以nextCursor作为afterSequence即可只读取之后的日志；按游标分页被截断时指向本页最后一条
+            result["nextCursor"] = afterSequence >= 0 && truncated ? selected[selected.Count - 1].Sequence : lastSequence;
+            if (collapse)
+            {
+                result["collapsed"] = true;
+            }
             
             // 统计信息
             var statistics = CalculateLogStatistics(logs);
@@ -93,7 +91,8 @@ public class EditorLogTool : IMCPTool
             {
                 try
                 {
-                    clearMethod?.Invoke(null, null);
+                    UnityConsoleHelper.Clear();
+                    EditorLogBuffer.Clear();
                     result["logsCleared"] = true;
                     result["message"] = $"成功获取 {logs.Count} 条日志并清除了Console";
                 }
@@ -105,11 +104,9 @@ public class EditorLogTool : IMCPTool
             }
             else
             {
-                result["message"] = $"成功获取 {logs.Count} 条日志";
+                result["message"] = logs.Count == 0 ? "没有找到日志" : $"成功获取 {logs.Count} 条日志";
             }
             
-            Debug.Log($"Editor日志读取完成，获取了 {logs.Count} 条日志");
-            
             return MCPResponse.Success(result);
         }
         catch (System.Exception e)
@@ -120,103 +117,97 @@ public class EditorLogTool : IMCPTool
     }
     
     /// <summary>
-    /// 初始化反射访问Unity内部日志API
+    /// 日志类型对应的级别名称
     /// </summary>
-    private static void InitializeReflection()
+    private static string LevelName(LogType type)
     {
-        try
+        switch (type)
         {
-            // 获取LogEntries类型（Unity内部API）
-            logEntriesType = System.Type.GetType("UnityEditor.LogEntries,UnityEditor");
-            
-            if (logEntriesType != null)
-            {
-                getLogCountMethod = logEntriesType.GetMethod("GetCount", BindingFlags.Static | BindingFlags.Public);
-                clearMethod = logEntriesType.GetMethod("Clear", BindingFlags.Static | BindingFlags.Public);
-                
-                // 获取日志条目的方法可能因Unity版本而异
-                var getMethods = logEntriesType.GetMethods(BindingFlags.Static | BindingFlags.Public)
-                    .Where(m => m.Name.Contains("GetEntryInternal") || m.Name.Contains("GetFirstTwoLinesEntryTextAndModeInternal"))
-                    .ToArray();
-                
-                if (getMethods.Length > 0)
-                {
-                    getLogEntryMethod = getMethods[0];
-                }
-            }
+            case LogType.Error:
+            case LogType.Assert:
+                return "Error";
+            case LogType.Warning:
+                return "Warning";
+            case LogType.Exception:
+                return "Exception";
+            default:
+                return "Log";
         }
-        catch (System.Exception e)
+    }
+    
+    /// <summary>
+    /// 检查消息是否匹配搜索条件 (子串不区分大小写，或正则)
+    /// </summary>
+    private bool MatchesSearch(string message, string search, Regex pattern)
+    {
+        if (string.IsNullOrEmpty(search))
         {
-            Debug.LogWarning($"初始化日志反射失败: {e.Message}");
+            return true;
         }
+        if (pattern != null)
+        {
+            return pattern.IsMatch(message);
+        }
+        return message.IndexOf(search, System.StringComparison.OrdinalIgnoreCase) >= 0;
     }
     
     /// <summary>
-    /// 获取指定索引的日志条目
+    /// 构建返回的日志条目
     /// </summary>
-    private Dictionary<string, object> GetLogEntry(int index, bool includeStackTrace)
+    private Dictionary<string, object> BuildLogEntry(EditorLogBuffer.Entry entry, bool includeStackTrace)
     {
-        try
+        var logEntry = new Dictionary<string, object>
         {
-            if (getLogEntryMethod == null)
-            {
-                return null;
-            }
-            
-            // 尝试不同的方法签名（Unity版本兼容性）
-            object[] parameters = new object[] { index, null, null };
-            getLogEntryMethod.Invoke(null, parameters);
-            
-            string message = parameters[1]?.ToString() ?? "";
-            string file = parameters[2]?.ToString() ?? "";
-            
-            // 解析日志级别（从消息前缀推断）
-            string level = "Log";
-            if (message.Contains("[Error]") || message.Contains("Error:"))
-                level = "Error";
-            else if (message.Contains("[Warning]") || message.Contains("Warning:"))
-                level = "Warning";
-            else if (message.Contains("[Exception]") || message.Contains("Exception:"))
-                level = "Exception";
-            
-            var logEntry = new Dictionary<string, object>
-            {
-                ["index"] = index,
-                ["message"] = message,
-                ["file"] = file,
-                ["level"] = level,
-                ["timestamp"] = System.DateTime.Now.ToString("HH:mm:ss")
-            };
-            
-            // 尝试获取堆栈跟踪信息
-            if (includeStackTrace && !string.IsNullOrEmpty(file))
-            {
-                logEntry["stackTrace"] = file;
-            }
-            
-            return logEntry;
+            ["sequence"] = entry.Sequence,
+            ["message"] = entry.Message,
+            ["level"] = LevelName(entry.Type),
+            ["timestamp"] = entry.TimestampUtc.ToString("yyyy-MM-ddTHH:mm:ss.fffZ")
+        };
+        
+        if (includeStackTrace && !string.IsNullOrEmpty(entry.StackTrace))
+        {
+            logEntry["stackTrace"] = entry.StackTrace;
         }
-        catch (System.Exception e)
+        
+        return logEntry;
+    }
+    
+    /// <summary>
+    /// 合并级别和消息相同的日志，保留第一次出现的位置并记录次数和最后一次的序号
+    /// </summary>
+    private List<Dictionary<string, object>> CollapseEntries(List<EditorLogBuffer.Entry> entries, bool includeStackTrace)
+    {
+        var collapsed = new List<Dictionary<string, object>>();
+        var byKey = new Dictionary<string, Dictionary<string, object>>();
+        foreach (var entry in entries)
         {
-            return new Dictionary<string, object>
+            string key = LevelName(entry.Type) + "\n" + entry.Message;
+            if (byKey.TryGetValue(key, out var existing))
             {
-                ["index"] = index,
-                ["message"] = $"读取日志条目失败: {e.Message}",
-                ["level"] = "Error",
-                ["timestamp"] = System.DateTime.Now.ToString("HH:mm:ss")
-            };
+                existing["count"] = (int)existing["count"] + 1;
+                existing["lastSequence"] = entry.Sequence;
+                existing["lastTimestamp"] = entry.TimestampUtc.ToString("yyyy-MM-ddTHH:mm:ss.fffZ");
+                continue;
+            }
+            var logEntry = BuildLogEntry(entry, includeStackTrace);
+            logEntry["count"] = 1;
+            logEntry["lastSequence"] = entry.Sequence;
+            logEntry["lastTimestamp"] = logEntry["timestamp"];
+            byKey[key] = logEntry;
+            collapsed.Add(logEntry);
         }
+        return collapsed;
     }
     
     /// <summary>
     /// 检查是否应该包含此日志
     /// </summary>
-    private bool ShouldIncludeLog(Dictionary<string, object> logEntry, string logLevel)
+    private bool ShouldIncludeLog(string level, string logLevel)
     {
         if (logLevel == "all")
             return true;
         
-        string entryLevel = logEntry["level"].ToString().ToLower();
+        string entryLevel = level.ToLower();
         
         switch (logLevel)
         {
@@ -251,7 +242,8 @@ public class EditorLogTool : IMCPTool
         foreach (var log in logs)
         {
             string level = log["level"].ToString();
-            levelCounts[level] = levelCounts.ContainsKey(level) ? levelCounts[level] + 1 : 1;
+            int count = log.ContainsKey("count") ? (int)log["count"] : 1;
+            levelCounts[level] = levelCounts.ContainsKey(level) ? levelCounts[level] + count : count;
         }
         
         stats["levelCounts"] = levelCounts;
@@ -290,6 +282,19 @@ public class EditorLogTool : IMCPTool
             }
         }
         
+        // 验证正则搜索
+        if (parameters.ContainsKey("search") && parameters.ContainsKey("searchRegex") && System.Convert.ToBoolean(parameters["searchRegex"]))
+        {
+            try
+            {
+                new Regex(parameters["search"].ToString());
+            }
+            catch (System.ArgumentException e)
+            {
+                return $"search不是有效的正则表达式: {e.Message}";
+            }
+        }
+        
         // 验证logLevel参数
         if (parameters.ContainsKey("logLevel"))
         {
@@ -0,0 +1,94 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.SceneManagement;
+using UnityEditor;
+using Unity.CodeEditor;
+
+/// <summary>
+/// 打开资源工具 - 与在Project窗口中双击相同: 脚本在用户设置的外部编辑器中打开，场景在编辑器中打开，预制体进入预制体模式
+/// </summary>
+public class EditorOpenAssetTool : IMCPTool
+{
+    public string ToolName => "editor_open_asset";
+    
+    public string Description => "在外部编辑器或Unity编辑器中打开资源";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            int line = parameters.ContainsKey("line") ? System.Convert.ToInt32(parameters["line"]) : -1;
+            int column = parameters.ContainsKey("column") ? System.Convert.ToInt32(parameters["column"]) : -1;
+            
+            if (Application.isBatchMode)
+            {
+                return MCPResponse.Error("Unity以batchmode运行，无法打开资源");
+            }
+            
+            Object asset = AssetDatabase.LoadMainAssetAtPath(assetPath);
+            if (asset == null)
+            {
+                return MCPResponse.Error($"资源不存在: {assetPath}");
+            }
+            
+            // 打开场景会替换当前场景，有未保存的修改时Unity会弹出阻塞的保存对话框
+            if (asset is SceneAsset)
+            {
+                if (EditorApplication.isPlayingOrWillChangePlaymode)
+                {
+                    return MCPResponse.Error("播放模式下不能打开场景，请先停止播放");
+                }
+                var dirtyScenes = Enumerable.Range(0, SceneManager.sceneCount)
+                    .Select(SceneManager.GetSceneAt)
+                    .Where(scene => scene.isDirty)
+                    .Select(scene => string.IsNullOrEmpty(scene.path) ? scene.name : scene.path)
+                    .ToList();
+                if (dirtyScenes.Count > 0)
+                {
+                    return MCPResponse.Error($"场景有未保存的修改: {string.Join(", ", dirtyScenes)}，请先保存 (scene_save)");
+                }
+            }
+            
+            bool opened = AssetDatabase.OpenAsset(asset, line, column);
+            Debug.Log($"打开资源: {assetPath}{(line > 0 ? $":{line}" : "")}，结果: {opened}");
+            
+            var result = new Dictionary<string, object>
+            {
+                ["opened"] = opened,
+                ["assetPath"] = assetPath,
+                ["assetType"] = asset.GetType().Name
+            };
+            if (line > 0)
+            {
+                result["line"] = line;
+            }
+            if (column > 0)
+            {
+                result["column"] = column;
+            }
+            if (asset is MonoScript || asset is TextAsset || asset is Shader)
+            {
+                string editorPath = CodeEditor.CurrentEditorInstallation;
+                result["externalEditor"] = string.IsNullOrEmpty(editorPath) ? null : editorPath;
+            }
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"打开资源时出错: {e.Message}");
+            return MCPResponse.Error($"打开资源失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("assetPath") || string.IsNullOrEmpty(parameters["assetPath"]?.ToString()))
+        {
+            return "缺少必需参数: assetPath";
+        }
+        return null;
+    }
+}
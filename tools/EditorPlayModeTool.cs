@@ -0,0 +1,134 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 播放模式工具 - 进入、退出、暂停播放模式和单帧步进
+/// play/stop 在当前帧之后才生效，进入播放模式时的域重载会断开MCP连接
+/// </summary>
+public class EditorPlayModeTool : IMCPTool
+{
+    public string ToolName => "editor_play_mode";
+    
+    public string Description => "控制编辑器播放模式 (play、stop、pause、resume、step、status)";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string operation = parameters["operation"].ToString().ToLower();
+            string message;
+            
+            switch (operation)
+            {
+                case "status":
+                    message = "当前播放模式状态";
+                    break;
+                    
+                case "play":
+                    if (EditorApplication.isPlaying)
+                    {
+                        message = "已经处于播放模式";
+                        break;
+                    }
+                    if (EditorApplication.isCompiling)
+                    {
+                        return MCPResponse.Error("脚本正在编译，无法进入播放模式");
+                    }
+                    EditorApplication.isPlaying = true;
+                    message = "正在进入播放模式";
+                    break;
+                    
+                case "stop":
+                    if (!EditorApplication.isPlaying)
+                    {
+                        message = "当前不在播放模式";
+                        break;
+                    }
+                    EditorApplication.isPlaying = false;
+                    message = "正在退出播放模式";
+                    break;
+                    
+                case "pause":
+                    EditorApplication.isPaused = true;
+                    message = EditorApplication.isPlaying ? "已暂停" : "不在播放模式，进入播放模式后将立即暂停";
+                    break;
+                    
+                case "resume":
+                    EditorApplication.isPaused = false;
+                    message = "已继续";
+                    break;
+                    
+                case "step":
+                    if (!EditorApplication.isPlaying)
+                    {
+                        return MCPResponse.Error("只能在播放模式下单帧步进");
+                    }
+                    EditorApplication.Step();
+                    message = "已步进一帧";
+                    break;
+                    
+                default:
+                    return MCPResponse.Error($"不支持的操作: {operation}");
+            }
+            
+            var result = BuildStatus();
+            result["operation"] = operation;
+            result["message"] = message;
+            if (operation == "play" || operation == "stop")
+            {
+                result["domainReloadExpected"] = operation == "play" && DomainReloadEnabled();
+            }
+            
+            if (operation != "status")
+            {
+                Debug.Log($"播放模式操作 {operation}: {message}");
+            }
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"控制播放模式时出错: {e.Message}");
+            return MCPResponse.Error($"控制播放模式失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 当前播放模式状态
+    /// isTransitioning为true表示play/stop已请求但尚未完成
+    /// </summary>
+    private Dictionary<string, object> BuildStatus()
+    {
+        return new Dictionary<string, object>
+        {
+            ["isPlaying"] = EditorApplication.isPlaying,
+            ["isPaused"] = EditorApplication.isPaused,
+            ["isTransitioning"] = EditorApplication.isPlayingOrWillChangePlaymode != EditorApplication.isPlaying,
+            ["isCompiling"] = EditorApplication.isCompiling,
+            ["domainReloadEnabled"] = DomainReloadEnabled(),
+            ["domainReloadCount"] = PlayModeTracker.ReloadCount,
+            ["lastStateChange"] = PlayModeTracker.LastChange,
+            ["lastStateChangeTime"] = PlayModeTracker.LastChangeTime
+        };
+    }
+    
+    /// <summary>
+    /// 进入播放模式时是否重载脚本域 (Project Settings > Editor > Enter Play Mode Options)
+    /// </summary>
+    private bool DomainReloadEnabled()
+    {
+        return !EditorSettings.enterPlayModeOptionsEnabled ||
+               (EditorSettings.enterPlayModeOptions & EnterPlayModeOptions.DisableDomainReload) == 0;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("operation") || string.IsNullOrEmpty(parameters["operation"].ToString()))
+        {
+            return "缺少必需参数: operation";
+        }
+        return null;
+    }
+}
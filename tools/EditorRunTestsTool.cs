@@ -0,0 +1,140 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 测试运行工具 - 运行EditMode/PlayMode测试
+/// 测试在后续帧中异步运行: start启动运行并立即返回，之后用status查询进度和结果，cancel中止运行
+/// </summary>
+public class EditorRunTestsTool : IMCPTool
+{
+    public string ToolName => "editor_run_tests";
+    
+    public string Description => "运行Unity Test Runner测试并查询结果";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string operation = parameters.ContainsKey("operation") ? parameters["operation"].ToString().ToLower() : "start";
+            TestRunTracker.RunState state;
+            
+            switch (operation)
+            {
+                case "start":
+                    var current = TestRunTracker.Current;
+                    if (current != null && current.status == "running")
+                    {
+                        return MCPResponse.Error($"已有测试正在运行 (runId: {current.runId})");
+                    }
+                    if (EditorApplication.isCompiling)
+                    {
+                        return MCPResponse.Error("脚本正在编译，无法运行测试");
+                    }
+                    if (EditorApplication.isPlaying)
+                    {
+                        return MCPResponse.Error("编辑器处于播放模式，请先退出播放模式再运行测试");
+                    }
+                    
+                    string mode = parameters.ContainsKey("mode") ? parameters["mode"].ToString().ToLower() : "editmode";
+                    var names = new List<string>();
+                    var categories = new List<string>();
+                    if (parameters.ContainsKey("testFilter") && parameters["testFilter"] is System.Collections.IEnumerable filters)
+                    {
+                        // category: 前缀的条目按测试类别匹配，其余按名称匹配
+                        foreach (var item in filters)
+                        {
+                            string filter = item.ToString();
+                            if (filter.StartsWith("category:"))
+                            {
+                                categories.Add(filter.Substring("category:".Length));
+                            }
+                            else
+                            {
+                                names.Add(filter);
+                            }
+                        }
+                    }
+                    
+                    state = TestRunTracker.Start(mode, names, categories);
+                    Debug.Log($"开始运行{mode}测试 (runId: {state.runId})");
+                    break;
+                    
+                case "status":
+                    state = TestRunTracker.Current;
+                    if (state == null)
+                    {
+                        return MCPResponse.Error("本次编辑器会话中还没有运行过测试");
+                    }
+                    break;
+                    
+                case "cancel":
+                    state = TestRunTracker.Cancel();
+                    if (state == null)
+                    {
+                        return MCPResponse.Error("本次编辑器会话中还没有运行过测试");
+                    }
+                    break;
+                    
+                default:
+                    return MCPResponse.Error($"不支持的操作: {operation}");
+            }
+            
+            if (parameters.ContainsKey("runId") && parameters["runId"].ToString() != state.runId)
+            {
+                return MCPResponse.Error($"测试运行 {parameters["runId"]} 已被新的运行 {state.runId} 取代");
+            }
+            
+            return MCPResponse.Success(BuildResult(state));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"运行测试时出错: {e.Message}");
+            return MCPResponse.Error($"运行测试失败: {e.Message}");
+        }
+    }
+    
+    private Dictionary<string, object> BuildResult(TestRunTracker.RunState state)
+    {
+        var failures = state.failures.ConvertAll(f => new Dictionary<string, object>
+        {
+            ["name"] = f.name,
+            ["fullName"] = f.fullName,
+            ["message"] = f.message,
+            ["stackTrace"] = f.stackTrace,
+            ["duration"] = f.duration
+        });
+        return new Dictionary<string, object>
+        {
+            ["runId"] = state.runId,
+            ["mode"] = state.mode,
+            ["status"] = state.status,
+            ["startedAt"] = state.startedAt,
+            ["finishedAt"] = state.finishedAt,
+            ["currentTest"] = state.currentTest,
+            ["total"] = state.total,
+            ["completed"] = state.completed,
+            ["passed"] = state.passed,
+            ["failed"] = state.failed,
+            ["skipped"] = state.skipped,
+            ["inconclusive"] = state.inconclusive,
+            ["duration"] = state.duration,
+            ["failures"] = failures,
+            ["failuresTruncated"] = state.failuresTruncated
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters.ContainsKey("mode"))
+        {
+            string mode = parameters["mode"].ToString().ToLower();
+            if (mode != "editmode" && mode != "playmode")
+            {
+                return "mode必须是editmode或playmode";
+            }
+        }
+        return null;
+    }
+}
@@ -0,0 +1,213 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 编辑器截图工具 - 渲染Game视图或Scene视图的相机并编码为PNG
+/// 指定savePath时写入文件，否则作为二进制附件随响应发送
+/// </summary>
+public class EditorScreenshotTool : IMCPTool
+{
+    /// <summary>
+    /// 截图边长上限，与Go服务器的参数上限一致
+    /// </summary>
+    private const int MaxResolution = 4096;
+    
+    public string ToolName => "editor_take_screenshot";
+    
+    public string Description => "截取Game视图或Scene视图";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string view = parameters.ContainsKey("view") ? parameters["view"].ToString() : "game";
+            string cameraName = parameters.ContainsKey("camera") ? parameters["camera"].ToString() : null;
+            int width = parameters.ContainsKey("width") ? System.Convert.ToInt32(parameters["width"]) : 0;
+            int height = parameters.ContainsKey("height") ? System.Convert.ToInt32(parameters["height"]) : 0;
+            string savePath = parameters.ContainsKey("savePath") ? parameters["savePath"].ToString() : null;
+            
+            Camera camera;
+            Vector2 viewSize;
+            if (view == "scene")
+            {
+                SceneView sceneView = SceneView.lastActiveSceneView;
+                if (sceneView == null || sceneView.camera == null)
+                {
+                    return MCPResponse.Error("没有打开的Scene视图");
+                }
+                camera = sceneView.camera;
+                viewSize = new Vector2(camera.pixelWidth, camera.pixelHeight);
+            }
+            else
+            {
+                camera = FindGameCamera(cameraName, out string cameraError);
+                if (camera == null)
+                {
+                    return MCPResponse.Error(cameraError);
+                }
+                viewSize = Handles.GetMainGameViewSize();
+            }
+            
+            // 只指定一边时按视图宽高比计算另一边；都未指定时使用视图当前大小
+            if (viewSize.x < 1 || viewSize.y < 1)
+            {
+                viewSize = new Vector2(1920, 1080);
+            }
+            if (width <= 0 && height <= 0)
+            {
+                width = Mathf.RoundToInt(viewSize.x);
+                height = Mathf.RoundToInt(viewSize.y);
+            }
+            else if (width <= 0)
+            {
+                width = Mathf.Max(1, Mathf.RoundToInt(height * viewSize.x / viewSize.y));
+            }
+            else if (height <= 0)
+            {
+                height = Mathf.Max(1, Mathf.RoundToInt(width * viewSize.y / viewSize.x));
+            }
+            if (width > MaxResolution || height > MaxResolution)
+            {
+                return MCPResponse.Error($"截图分辨率 {width}x{height} 超过上限 {MaxResolution}x{MaxResolution}");
+            }
+            
+            byte[] png = Render(camera, width, height);
+            
+            var result = new Dictionary<string, object>
+            {
+                ["view"] = view,
+                ["width"] = width,
+                ["height"] = height,
+                ["format"] = "png",
+                ["bytes"] = png.Length,
+                ["camera"] = new Dictionary<string, object>
+                {
+                    ["name"] = camera.name,
+                    ["instanceId"] = camera.GetInstanceID(),
+                    ["orthographic"] = camera.orthographic
+                }
+            };
+            if (view == "game")
+            {
+                // 直接渲染相机，Screen Space - Overlay 的UI不会出现在截图中
+                result["note"] = "Screen Space - Overlay canvases are not rendered by the camera and are not included";
+            }
+            
+            if (string.IsNullOrEmpty(savePath))
+            {
+                return MCPResponse.SuccessWithBinary(result, png, "image/png");
+            }
+            
+            string projectRoot = Path.GetFullPath(Path.Combine(Application.dataPath, ".."));
+            string fullPath = Path.GetFullPath(Path.Combine(projectRoot, savePath));
+            if (!fullPath.StartsWith(projectRoot + Path.DirectorySeparatorChar))
+            {
+                return MCPResponse.Error($"savePath必须位于项目目录内: {savePath}");
+            }
+            Directory.CreateDirectory(Path.GetDirectoryName(fullPath));
+            File.WriteAllBytes(fullPath, png);
+            if (savePath.Replace('\\', '/').StartsWith("Assets/"))
+            {
+                AssetDatabase.ImportAsset(savePath.Replace('\\', '/'));
+            }
+            result["savePath"] = savePath;
+            
+            Debug.Log($"截图已保存: {savePath} ({width}x{height})");
+            return MCPResponse.Success(result);
+        }
+        catch (Exception e)
+        {
+            Debug.LogError($"截图时出错: {e.Message}");
+            return MCPResponse.Error($"截图失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 查找Game视图使用的相机: 指定名称时按名称查找，否则使用MainCamera，再否则使用深度最高的启用相机
+    /// </summary>
+    private Camera FindGameCamera(string cameraName, out string error)
+    {
+        error = null;
+        Camera[] cameras = Camera.allCameras;
+        if (!string.IsNullOrEmpty(cameraName))
+        {
+            foreach (var cam in cameras)
+            {
+                if (cam.name == cameraName)
+                {
+                    return cam;
+                }
+            }
+            error = $"未找到启用的相机: {cameraName}";
+            return null;
+        }
+        
+        if (Camera.main != null)
+        {
+            return Camera.main;
+        }
+        Camera best = null;
+        foreach (var cam in cameras)
+        {
+            if (cam.targetTexture == null && (best == null || cam.depth > best.depth))
+            {
+                best = cam;
+            }
+        }
+        if (best == null)
+        {
+            error = "场景中没有启用的相机";
+        }
+        return best;
+    }
+    
+    /// <summary>
+    /// 把相机渲染到临时RenderTexture并编码为PNG，渲染后恢复相机原来的目标
+    /// </summary>
+    private byte[] Render(Camera camera, int width, int height)
+    {
+        RenderTexture renderTexture = RenderTexture.GetTemporary(width, height, 24, RenderTextureFormat.ARGB32);
+        RenderTexture previousTarget = camera.targetTexture;
+        RenderTexture previousActive = RenderTexture.active;
+        Texture2D texture = new Texture2D(width, height, TextureFormat.RGB24, false);
+        try
+        {
+            camera.targetTexture = renderTexture;
+            camera.Render();
+            RenderTexture.active = renderTexture;
+            texture.ReadPixels(new Rect(0, 0, width, height), 0, 0);
+            texture.Apply();
+            return texture.EncodeToPNG();
+        }
+        finally
+        {
+            camera.targetTexture = previousTarget;
+            RenderTexture.active = previousActive;
+            RenderTexture.ReleaseTemporary(renderTexture);
+            UnityEngine.Object.DestroyImmediate(texture);
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        if (parameters.ContainsKey("view"))
+        {
+            string view = parameters["view"].ToString();
+            if (view != "game" && view != "scene")
+            {
+                return $"无效的view: {view}，应为 game 或 scene";
+            }
+        }
+        
+        return null;
+    }
+}
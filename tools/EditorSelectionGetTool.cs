@@ -0,0 +1,102 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 编辑器选择获取工具 - 返回Hierarchy和Project窗口中当前选中的对象
+/// </summary>
+public class EditorSelectionGetTool : IMCPTool
+{
+    public string ToolName => "editor_selection_get";
+    
+    public string Description => "获取编辑器中当前选中的对象";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int maxResults = parameters.ContainsKey("maxResults") ? 
+                System.Convert.ToInt32(parameters["maxResults"]) : 200;
+            
+            Object[] selected = Selection.objects;
+            var objects = new List<object>();
+            foreach (var obj in selected)
+            {
+                if (obj == null) continue;
+                if (objects.Count >= maxResults) break;
+                objects.Add(Describe(obj));
+            }
+            
+            Object active = Selection.activeObject;
+            var result = new Dictionary<string, object>
+            {
+                ["count"] = selected.Length,
+                ["objects"] = objects,
+                ["truncated"] = selected.Length > objects.Count,
+                ["activeInstanceId"] = active != null ? (object)active.GetInstanceID() : null
+            };
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取选择时出错: {e.Message}");
+            return MCPResponse.Error($"获取选择失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 描述选中的对象: 场景对象带层级路径，资源带资源路径
+    /// </summary>
+    private Dictionary<string, object> Describe(Object obj)
+    {
+        var item = new Dictionary<string, object>
+        {
+            ["instanceId"] = obj.GetInstanceID(),
+            ["name"] = obj.name,
+            ["type"] = obj.GetType().Name
+        };
+        
+        string assetPath = AssetDatabase.GetAssetPath(obj);
+        if (!string.IsNullOrEmpty(assetPath))
+        {
+            item["source"] = "asset";
+            item["assetPath"] = assetPath;
+        }
+        else if (obj is GameObject go)
+        {
+            item["source"] = "scene";
+            item["path"] = GetHierarchyPath(go.transform);
+            item["scene"] = go.scene.name;
+            item["activeInHierarchy"] = go.activeInHierarchy;
+        }
+        else
+        {
+            item["source"] = "other";
+        }
+        return item;
+    }
+    
+    /// <summary>
+    /// 获取对象在层级中的完整路径
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        return null;
+    }
+}
@@ -0,0 +1,138 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 编辑器选择设置工具 - 按InstanceID或资源路径选中对象，可选在Scene视图中聚焦
+/// </summary>
+public class EditorSelectionSetTool : IMCPTool
+{
+    public string ToolName => "editor_selection_set";
+    
+    public string Description => "设置编辑器中选中的对象";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            bool additive = parameters.ContainsKey("additive") && System.Convert.ToBoolean(parameters["additive"]);
+            bool frame = parameters.ContainsKey("frame") && System.Convert.ToBoolean(parameters["frame"]);
+            
+            var targets = new List<Object>();
+            var notFound = new List<object>();
+            if (parameters.ContainsKey("instanceIds") && parameters["instanceIds"] is System.Collections.IEnumerable ids)
+            {
+                foreach (var raw in ids)
+                {
+                    int id = System.Convert.ToInt32(raw);
+                    Object obj = EditorUtility.InstanceIDToObject(id);
+                    if (obj == null)
+                    {
+                        notFound.Add(id);
+                        continue;
+                    }
+                    if (!targets.Contains(obj)) targets.Add(obj);
+                }
+            }
+            if (parameters.ContainsKey("assetPaths") && parameters["assetPaths"] is System.Collections.IEnumerable paths)
+            {
+                foreach (var raw in paths)
+                {
+                    string assetPath = raw.ToString();
+                    Object obj = AssetDatabase.LoadMainAssetAtPath(assetPath);
+                    if (obj == null)
+                    {
+                        notFound.Add(assetPath);
+                        continue;
+                    }
+                    if (!targets.Contains(obj)) targets.Add(obj);
+                }
+            }
+            
+            var selection = new List<Object>();
+            if (additive)
+            {
+                foreach (var obj in Selection.objects)
+                {
+                    if (obj != null && !targets.Contains(obj)) selection.Add(obj);
+                }
+            }
+            selection.AddRange(targets);
+            Selection.objects = selection.ToArray();
+            if (targets.Count > 0)
+            {
+                Selection.activeObject = targets[0];
+            }
+            
+            bool framed = false;
+            if (frame && targets.Count > 0)
+            {
+                framed = Frame(targets);
+            }
+            
+            var selectedIds = new List<object>();
+            foreach (var obj in selection)
+            {
+                selectedIds.Add(obj.GetInstanceID());
+            }
+            var result = new Dictionary<string, object>
+            {
+                ["selectedCount"] = selection.Count,
+                ["selectedInstanceIds"] = selectedIds,
+                ["addedCount"] = targets.Count,
+                ["notFound"] = notFound,
+                ["framed"] = framed
+            };
+            
+            Debug.Log($"已选中 {selection.Count} 个对象");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置选择时出错: {e.Message}");
+            return MCPResponse.Error($"设置选择失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 聚焦选择: 场景对象在Scene视图中居中显示，资源在Project窗口中高亮
+    /// </summary>
+    private bool Frame(List<Object> targets)
+    {
+        bool hasSceneObject = false;
+        foreach (var obj in targets)
+        {
+            if (obj is GameObject go && go.scene.IsValid())
+            {
+                hasSceneObject = true;
+            }
+            else
+            {
+                EditorGUIUtility.PingObject(obj);
+            }
+        }
+        
+        SceneView sceneView = SceneView.lastActiveSceneView;
+        if (!hasSceneObject || sceneView == null)
+        {
+            return false;
+        }
+        return sceneView.FrameSelected();
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        if (!parameters.ContainsKey("instanceIds") && !parameters.ContainsKey("assetPaths"))
+        {
+            return "缺少必需参数: instanceIds 或 assetPaths";
+        }
+        
+        return null;
+    }
+}
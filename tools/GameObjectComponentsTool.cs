@@ -0,0 +1,212 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using System.Reflection;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象组件列表工具 - 获取单个GameObject的组件列表
+/// </summary>
+public class GameObjectComponentsTool : IMCPTool
+{
+    public string ToolName => "gameobject_get_components";
+    
+    public string Description => "获取GameObject上的组件列表，可选包括属性摘要";
+    
+    // Object/Component/Behaviour/MonoBehaviour 的原生序列化字段，无法通过反射确定声明类型
+    private static readonly HashSet<string> BaseClassProperties = new HashSet<string>
+    {
+        "m_ObjectHideFlags", "m_CorrespondingSourceObject", "m_PrefabInstance", "m_PrefabAsset",
+        "m_GameObject", "m_Enabled", "m_Script", "m_EditorHideFlags", "m_EditorClassIdentifier"
+    };
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            bool includeProperties = parameters.ContainsKey("includeProperties") ? 
+                System.Convert.ToBoolean(parameters["includeProperties"]) : false;
+            bool includeInherited = parameters.ContainsKey("includeInherited") ? 
+                System.Convert.ToBoolean(parameters["includeInherited"]) : false;
+            
+            GameObject targetObject;
+            if (parameters.ContainsKey("instanceId"))
+            {
+                int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+                targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+                if (targetObject == null)
+                {
+                    return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+                }
+            }
+            else
+            {
+                string path = parameters["path"].ToString();
+                targetObject = FindByPath(path);
+                if (targetObject == null)
+                {
+                    return MCPResponse.Error($"未找到路径为 {path} 的GameObject");
+                }
+            }
+            
+            var components = new List<Dictionary<string, object>>();
+            int missingScripts = 0;
+            foreach (var component in targetObject.GetComponents<Component>())
+            {
+                // 脚本丢失的组件为null
+                if (component == null)
+                {
+                    missingScripts++;
+                    continue;
+                }
+                
+                System.Type type = component.GetType();
+                var info = new Dictionary<string, object>
+                {
+                    ["type"] = type.Name,
+                    ["fullType"] = type.FullName,
+                    ["instanceId"] = component.GetInstanceID(),
+                    ["enabled"] = component is Behaviour behaviour ? behaviour.enabled : true
+                };
+                if (includeInherited)
+                {
+                    info["baseTypes"] = GetBaseTypes(type);
+                }
+                if (includeProperties)
+                {
+                    info["properties"] = BuildPropertySummary(component, includeInherited);
+                }
+                components.Add(info);
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["gameObjectName"] = targetObject.name,
+                ["gameObjectInstanceId"] = targetObject.GetInstanceID(),
+                ["path"] = GetHierarchyPath(targetObject.transform),
+                ["components"] = components,
+                ["componentCount"] = components.Count,
+                ["missingScripts"] = missingScripts
+            };
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取组件列表时出错: {e.Message}");
+            return MCPResponse.Error($"获取组件列表失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 属性摘要: 只包括顶层的可见属性，数组和结构体只报告类型
+    /// </summary>
+    private Dictionary<string, object> BuildPropertySummary(Component component, bool includeInherited)
+    {
+        var summary = new Dictionary<string, object>();
+        var serializedObject = new SerializedObject(component);
+        SerializedProperty iterator = serializedObject.GetIterator();
+        bool enterChildren = true;
+        while (iterator.NextVisible(enterChildren))
+        {
+            enterChildren = false;
+            if (!includeInherited && IsInherited(component.GetType(), iterator.name))
+            {
+                continue;
+            }
+            
+            object value = SerializedPropertyHelper.GetValue(iterator);
+            if (iterator.propertyType == SerializedPropertyType.Generic)
+            {
+                value = iterator.isArray ? $"{iterator.type}[{iterator.arraySize}]" : iterator.type;
+            }
+            summary[iterator.propertyPath] = value;
+        }
+        return summary;
+    }
+    
+    /// <summary>
+    /// 判断序列化字段是否由基类声明
+    /// 脚本字段通过反射查找声明类型，内置组件的原生字段中只有基类的公共字段视为继承
+    /// </summary>
+    private bool IsInherited(System.Type type, string propertyName)
+    {
+        const BindingFlags flags = BindingFlags.Instance | BindingFlags.Public | BindingFlags.NonPublic | BindingFlags.DeclaredOnly;
+        if (type.GetField(propertyName, flags) != null)
+        {
+            return false;
+        }
+        for (System.Type baseType = type.BaseType; baseType != null; baseType = baseType.BaseType)
+        {
+            if (baseType.GetField(propertyName, flags) != null)
+            {
+                return true;
+            }
+        }
+        return BaseClassProperties.Contains(propertyName);
+    }
+    
+    /// <summary>
+    /// 组件的基类链，到Component为止
+    /// </summary>
+    private List<string> GetBaseTypes(System.Type type)
+    {
+        var baseTypes = new List<string>();
+        for (System.Type baseType = type.BaseType; baseType != null && baseType != typeof(Object); baseType = baseType.BaseType)
+        {
+            baseTypes.Add(baseType.FullName);
+        }
+        return baseTypes;
+    }
+    
+    /// <summary>
+    /// 按层级路径查找对象，如 Canvas/Panel/Button，包括未激活的对象
+    /// </summary>
+    private GameObject FindByPath(string path)
+    {
+        string trimmed = path.Trim('/');
+        int slash = trimmed.IndexOf('/');
+        string rootName = slash < 0 ? trimmed : trimmed.Substring(0, slash);
+        foreach (var rootObj in UnityEngine.SceneManagement.SceneManager.GetActiveScene().GetRootGameObjects())
+        {
+            if (rootObj.name != rootName)
+            {
+                continue;
+            }
+            if (slash < 0)
+            {
+                return rootObj;
+            }
+            Transform found = rootObj.transform.Find(trimmed.Substring(slash + 1));
+            if (found != null)
+            {
+                return found.gameObject;
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 对象的层级路径，如 Level/Spawners/Enemy01
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        bool hasId = parameters.ContainsKey("instanceId");
+        bool hasPath = parameters.ContainsKey("path");
+        if (hasId == hasPath)
+        {
+            return "需要instanceId或path中的一个";
+        }
+        return null;
+    }
+}
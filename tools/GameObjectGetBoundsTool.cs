@@ -0,0 +1,136 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象包围盒工具 - 合并GameObject (及其子对象) 的渲染器和碰撞体的世界空间包围盒
+/// </summary>
+public class GameObjectGetBoundsTool : IMCPTool
+{
+    public string ToolName => "gameobject_get_bounds";
+    
+    public string Description => "获取GameObject的世界空间包围盒";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            bool includeChildren = parameters.ContainsKey("includeChildren") ?
+                System.Convert.ToBoolean(parameters["includeChildren"]) : true;
+            bool includeInactive = parameters.ContainsKey("includeInactive") &&
+                System.Convert.ToBoolean(parameters["includeInactive"]);
+            string source = parameters.ContainsKey("source") ? parameters["source"].ToString() : "both";
+            
+            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (targetObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            Bounds bounds = new Bounds();
+            bool hasBounds = false;
+            int rendererCount = 0;
+            int colliderCount = 0;
+            
+            if (source != "colliders")
+            {
+                Renderer[] renderers = includeChildren
+                    ? targetObject.GetComponentsInChildren<Renderer>(includeInactive)
+                    : targetObject.GetComponents<Renderer>();
+                foreach (Renderer renderer in renderers)
+                {
+                    if (!includeInactive && (!renderer.enabled || !renderer.gameObject.activeInHierarchy))
+                    {
+                        continue;
+                    }
+                    Encapsulate(ref bounds, ref hasBounds, renderer.bounds);
+                    rendererCount++;
+                }
+            }
+            if (source != "renderers")
+            {
+                // 禁用或未激活的碰撞体的bounds为零，始终跳过
+                Collider[] colliders = includeChildren
+                    ? targetObject.GetComponentsInChildren<Collider>()
+                    : targetObject.GetComponents<Collider>();
+                foreach (Collider collider in colliders)
+                {
+                    if (collider.enabled && collider.gameObject.activeInHierarchy)
+                    {
+                        Encapsulate(ref bounds, ref hasBounds, collider.bounds);
+                        colliderCount++;
+                    }
+                }
+                Collider2D[] colliders2D = includeChildren
+                    ? targetObject.GetComponentsInChildren<Collider2D>()
+                    : targetObject.GetComponents<Collider2D>();
+                foreach (Collider2D collider in colliders2D)
+                {
+                    if (collider.enabled && collider.gameObject.activeInHierarchy)
+                    {
+                        Encapsulate(ref bounds, ref hasBounds, collider.bounds);
+                        colliderCount++;
+                    }
+                }
+            }
+            
+            if (!hasBounds)
+            {
+                return MCPResponse.Error($"对象 '{targetObject.name}' {(includeChildren ? "及其子对象" : "")}上没有可用的渲染器或碰撞体，无法计算包围盒");
+            }
+            
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["name"] = targetObject.name,
+                ["instanceId"] = targetObject.GetInstanceID(),
+                ["center"] = ToDictionary(bounds.center),
+                ["size"] = ToDictionary(bounds.size),
+                ["min"] = ToDictionary(bounds.min),
+                ["max"] = ToDictionary(bounds.max),
+                ["extents"] = ToDictionary(bounds.extents),
+                ["rendererCount"] = rendererCount,
+                ["colliderCount"] = colliderCount,
+                ["includeChildren"] = includeChildren
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取包围盒时出错: {e.Message}");
+            return MCPResponse.Error($"获取包围盒失败: {e.Message}");
+        }
+    }
+    
+    private static void Encapsulate(ref Bounds bounds, ref bool hasBounds, Bounds other)
+    {
+        if (hasBounds)
+        {
+            bounds.Encapsulate(other);
+        }
+        else
+        {
+            bounds = other;
+            hasBounds = true;
+        }
+    }
+    
+    private static Dictionary<string, float> ToDictionary(Vector3 v)
+    {
+        return new Dictionary<string, float>
+        {
+            ["x"] = v.x,
+            ["y"] = v.y,
+            ["z"] = v.z
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,156 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象静态标志工具 - 设置GameObject的StaticEditorFlags (光照贴图、遮挡剔除、批处理、导航)
+/// </summary>
+public class GameObjectSetStaticTool : IMCPTool
+{
+    /// <summary>
+    /// 可设置的标志名称；NavigationStatic等在新版本中已过时，按名称解析以避免过时警告
+    /// </summary>
+    private static readonly string[] FlagNames =
+    {
+        "ContributeGI", "OccluderStatic", "OccludeeStatic", "BatchingStatic",
+        "NavigationStatic", "OffMeshLinkGeneration", "ReflectionProbeStatic"
+    };
+    
+    /// <summary>
+    /// 结果中列出的对象数量上限
+    /// </summary>
+    private const int MaxListedObjects = 100;
+    
+    public string ToolName => "gameobject_set_static";
+    
+    public string Description => "设置GameObject的静态标志";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            string mode = parameters.ContainsKey("mode") ? parameters["mode"].ToString() : "set";
+            bool includeChildren = parameters.ContainsKey("includeChildren") &&
+                System.Convert.ToBoolean(parameters["includeChildren"]);
+            
+            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (targetObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            StaticEditorFlags requested = 0;
+            if (parameters.ContainsKey("allStatic"))
+            {
+                requested = System.Convert.ToBoolean(parameters["allStatic"]) ? AllFlags() : 0;
+            }
+            else if (parameters["flags"] is System.Collections.IEnumerable flags)
+            {
+                foreach (var flag in flags)
+                {
+                    if (!System.Enum.TryParse(flag.ToString(), out StaticEditorFlags value))
+                    {
+                        return MCPResponse.Error($"当前Unity版本不支持静态标志: {flag}");
+                    }
+                    requested |= value;
+                }
+            }
+            
+            GameObject[] targets = includeChildren
+                ? targetObject.GetComponentsInChildren<Transform>(true).Select(t => t.gameObject).ToArray()
+                : new[] { targetObject };
+            Undo.RecordObjects(targets, "Set Static Flags");
+            
+            var changed = new List<object>();
+            int changedCount = 0;
+            foreach (GameObject go in targets)
+            {
+                StaticEditorFlags current = GameObjectUtility.GetStaticEditorFlags(go);
+                StaticEditorFlags next = mode == "add" ? current | requested
+                    : mode == "remove" ? current & ~requested
+                    : requested;
+                if (next == current)
+                {
+                    continue;
+                }
+                GameObjectUtility.SetStaticEditorFlags(go, next);
+                changedCount++;
+                if (changed.Count < MaxListedObjects)
+                {
+                    changed.Add(new Dictionary<string, object>
+                    {
+                        ["instanceId"] = go.GetInstanceID(),
+                        ["path"] = HierarchyPathHelper.GetPath(go.transform),
+                        ["flags"] = DescribeFlags(next)
+                    });
+                }
+            }
+            
+            Debug.Log($"设置对象 '{targetObject.name}' 的静态标志，修改 {changedCount} 个对象");
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["name"] = targetObject.name,
+                ["instanceId"] = targetObject.GetInstanceID(),
+                ["flags"] = DescribeFlags(GameObjectUtility.GetStaticEditorFlags(targetObject)),
+                ["isStatic"] = targetObject.isStatic,
+                ["affectedObjects"] = targets.Length,
+                ["changedObjects"] = changedCount,
+                ["changed"] = changed,
+                ["changedTruncated"] = changedCount > changed.Count
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置静态标志时出错: {e.Message}");
+            return MCPResponse.Error($"设置静态标志失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 当前Unity版本支持的全部标志
+    /// </summary>
+    private static StaticEditorFlags AllFlags()
+    {
+        StaticEditorFlags all = 0;
+        foreach (string name in FlagNames)
+        {
+            if (System.Enum.TryParse(name, out StaticEditorFlags value))
+            {
+                all |= value;
+            }
+        }
+        return all;
+    }
+    
+    /// <summary>
+    /// 标志值转换为名称列表
+    /// </summary>
+    private static List<string> DescribeFlags(StaticEditorFlags flags)
+    {
+        var names = new List<string>();
+        foreach (string name in FlagNames)
+        {
+            if (System.Enum.TryParse(name, out StaticEditorFlags value) && (flags & value) != 0)
+            {
+                names.Add(name);
+            }
+        }
+        return names;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        if (!parameters.ContainsKey("flags") && !parameters.ContainsKey("allStatic"))
+        {
+            return "缺少必需参数: flags或allStatic";
+        }
+        return null;
+    }
+}
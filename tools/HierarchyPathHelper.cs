@@ -0,0 +1,167 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.RegularExpressions;
+using UnityEngine;
+using UnityEngine.SceneManagement;
+
+/// <summary>
+/// 层级路径工具类 - 在层级路径 (如 Canvas/MainMenu/PlayButton) 和GameObject之间转换
+/// 路径从根对象开始，在所有已加载的场景中查找；*和?在一层内匹配，**匹配任意层级
+/// </summary>
+public static class HierarchyPathHelper
+{
+    /// <summary>
+    /// 服务器用这个参数代替instanceId转发层级路径，执行工具前解析为instanceId
+    /// </summary>
+    public const string TargetPathKey = "_targetPath";
+    
+    /// <summary>
+    /// 错误信息中最多列出的匹配数
+    /// </summary>
+    private const int MaxListedMatches = 10;
+    
+    /// <summary>
+    /// 对象的层级路径，如 Level/Spawners/Enemy01
+    /// </summary>
+    public static string GetPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
+    /// <summary>
+    /// 按层级顺序返回匹配路径的全部对象
+    /// </summary>
+    public static List<GameObject> Find(string path, bool includeInactive = true)
+    {
+        string[] segments = path.Trim().Trim('/').Split('/');
+        Regex[] patterns = segments.Select(SegmentToRegex).ToArray();
+        var matches = new List<GameObject>();
+        var seen = new HashSet<GameObject>();
+        for (int i = 0; i < SceneManager.sceneCount; i++)
+        {
+            Scene scene = SceneManager.GetSceneAt(i);
+            if (!scene.isLoaded)
+            {
+                continue;
+            }
+            foreach (GameObject root in scene.GetRootGameObjects())
+            {
+                Match(root.transform, segments, patterns, 0, matches, seen);
+            }
+        }
+        return includeInactive ? matches : matches.Where(go => go.activeInHierarchy).ToList();
+    }
+    
+    /// <summary>
+    /// 把路径解析为唯一的对象；没有匹配或匹配多个时返回错误信息
+    /// </summary>
+    public static string Resolve(string path, out GameObject target)
+    {
+        target = null;
+        List<GameObject> matches = Find(path);
+        if (matches.Count == 0)
+        {
+            return $"未找到路径为 {path} 的GameObject";
+        }
+        if (matches.Count > 1)
+        {
+            return $"路径 {path} 匹配到 {matches.Count} 个GameObject，请使用更精确的路径或instanceId: {DescribeMatches(matches)}";
+        }
+        target = matches[0];
+        return null;
+    }
+    
+    /// <summary>
+    /// 把参数中的_targetPath替换为instanceId，失败时返回错误信息，没有路径或解析成功返回null
+    /// </summary>
+    public static string ResolveTargetPath(Dictionary<string, object> parameters)
+    {
+        if (parameters == null || !parameters.ContainsKey(TargetPathKey))
+        {
+            return null;
+        }
+        string path = parameters[TargetPathKey]?.ToString() ?? "";
+        parameters.Remove(TargetPathKey);
+        string error = Resolve(path, out GameObject target);
+        if (error != null)
+        {
+            return error;
+        }
+        parameters["instanceId"] = (long)target.GetInstanceID();
+        return null;
+    }
+    
+    /// <summary>
+    /// 错误信息中的匹配列表，如 Canvas/Button (InstanceID: 1234)
+    /// </summary>
+    public static string DescribeMatches(List<GameObject> matches)
+    {
+        var listed = matches.Take(MaxListedMatches)
+            .Select(go => $"{go.scene.name}:{GetPath(go.transform)} (InstanceID: {go.GetInstanceID()})");
+        string description = string.Join(", ", listed);
+        return matches.Count > MaxListedMatches ? $"{description} 等" : description;
+    }
+    
+    private static void Match(Transform node, string[] segments, Regex[] patterns, int index,
+        List<GameObject> matches, HashSet<GameObject> seen)
+    {
+        if (segments[index] == "**")
+        {
+            // **匹配零层时用下一段匹配当前对象，最后一段的**匹配当前对象及其全部子对象
+            if (index + 1 == segments.Length)
+            {
+                Add(node.gameObject, matches, seen);
+            }
+            else
+            {
+                Match(node, segments, patterns, index + 1, matches, seen);
+            }
+            foreach (Transform child in node)
+            {
+                Match(child, segments, patterns, index, matches, seen);
+            }
+            return;
+        }
+        
+        bool nameMatches = patterns[index] != null ? patterns[index].IsMatch(node.name) : node.name == segments[index];
+        if (!nameMatches)
+        {
+            return;
+        }
+        if (index + 1 == segments.Length)
+        {
+            Add(node.gameObject, matches, seen);
+            return;
+        }
+        foreach (Transform child in node)
+        {
+            Match(child, segments, patterns, index + 1, matches, seen);
+        }
+    }
+    
+    private static void Add(GameObject go, List<GameObject> matches, HashSet<GameObject> seen)
+    {
+        if (seen.Add(go))
+        {
+            matches.Add(go);
+        }
+    }
+    
+    /// <summary>
+    /// 含*或?的路径段转换为正则，普通路径段按名称精确比较 (返回null)
+    /// </summary>
+    private static Regex SegmentToRegex(string segment)
+    {
+        if (segment == "**" || segment.IndexOfAny(new[] { '*', '?' }) < 0)
+        {
+            return null;
+        }
+        string regex = Regex.Escape(segment).Replace(@"\*", ".*").Replace(@"\?", ".");
+        return new Regex("^" + regex + "$");
+    }
+}
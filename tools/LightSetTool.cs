@@ -0,0 +1,102 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 灯光设置工具 - 设置灯光类型、颜色、强度、范围、阴影和烘焙模式
+/// </summary>
+public class LightSetTool : IMCPTool
+{
+    public string ToolName => "light_set";
+    
+    public string Description => "设置灯光属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (gameObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            Light light = gameObject.GetComponent<Light>();
+            if (light == null)
+            {
+                return MCPResponse.Error($"GameObject '{gameObject.name}' 没有Light组件");
+            }
+            
+            // 未指定type时按当前类型检查range/spotAngle是否适用
+            LightType type = parameters.ContainsKey("type")
+                ? (LightType)System.Enum.Parse(typeof(LightType), parameters["type"].ToString())
+                : light.type;
+            var problems = new List<string>();
+            if (parameters.ContainsKey("range") && type != LightType.Point && type != LightType.Spot)
+            {
+                problems.Add($"range 不适用于{type}灯光");
+            }
+            if (parameters.ContainsKey("spotAngle") && type != LightType.Spot)
+            {
+                problems.Add($"spotAngle 不适用于{type}灯光");
+            }
+            if (problems.Count > 0)
+            {
+                return MCPResponse.Error(string.Join("; ", problems));
+            }
+            
+            Undo.RecordObject(light, "Set Light Properties");
+            
+            light.type = type;
+            if (parameters.ContainsKey("color")) light.color = UIControlHelper.ReadColor(parameters["color"], light.color);
+            if (parameters.ContainsKey("intensity")) light.intensity = System.Convert.ToSingle(parameters["intensity"]);
+            if (parameters.ContainsKey("range")) light.range = System.Convert.ToSingle(parameters["range"]);
+            if (parameters.ContainsKey("spotAngle")) light.spotAngle = System.Convert.ToSingle(parameters["spotAngle"]);
+            if (parameters.ContainsKey("shadows"))
+            {
+                light.shadows = (LightShadows)System.Enum.Parse(typeof(LightShadows), parameters["shadows"].ToString());
+            }
+            if (parameters.ContainsKey("shadowStrength")) light.shadowStrength = System.Convert.ToSingle(parameters["shadowStrength"]);
+            if (parameters.ContainsKey("bounceIntensity")) light.bounceIntensity = System.Convert.ToSingle(parameters["bounceIntensity"]);
+            if (parameters.ContainsKey("mode"))
+            {
+                light.lightmapBakeType = (LightmapBakeType)System.Enum.Parse(typeof(LightmapBakeType), parameters["mode"].ToString());
+            }
+            
+            EditorUtility.SetDirty(light);
+            
+            var result = new Dictionary<string, object>
+            {
+                ["name"] = gameObject.name,
+                ["instanceId"] = gameObject.GetInstanceID(),
+                ["type"] = light.type.ToString(),
+                ["color"] = UIControlHelper.ColorToDict(light.color),
+                ["intensity"] = light.intensity,
+                ["range"] = light.range,
+                ["spotAngle"] = light.spotAngle,
+                ["shadows"] = light.shadows.ToString(),
+                ["shadowStrength"] = light.shadowStrength,
+                ["bounceIntensity"] = light.bounceIntensity,
+                ["mode"] = light.lightmapBakeType.ToString()
+            };
+            
+            Debug.Log($"成功设置灯光 '{gameObject.name}' 的属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置灯光属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置灯光属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
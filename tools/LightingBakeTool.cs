@@ -0,0 +1,196 @@
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.Profiling;
+using UnityEngine.SceneManagement;
+using UnityEditor;
+using Debug = UnityEngine.Debug;
+
+/// <summary>
+/// 光照烘焙工具 - 烘焙当前场景或所有打开场景的光照贴图
+/// operation: bake同步烘焙 (默认)；start用Lightmapping.BakeAsync启动后台烘焙并立即返回，之后用status查询进度，cancel取消
+/// scope为active且打开了多个场景时通过BakeMultipleScenes只烘焙当前场景，该方式只能同步进行
+/// </summary>
+public class LightingBakeTool : IMCPTool
+{
+    public string ToolName => "lighting_bake";
+    
+    public string Description => "烘焙光照";
+    
+    /// <summary>
+    /// 后台烘焙的状态: running/succeeded/canceled/failed
+    /// </summary>
+    private static string asyncStatus;
+    private static List<string> asyncScenes;
+    private static Stopwatch asyncStopwatch;
+    private static bool asyncCompleted;
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string operation = parameters.ContainsKey("operation") ? parameters["operation"].ToString().ToLower() : "bake";
+            bool clear = parameters.ContainsKey("clear") && System.Convert.ToBoolean(parameters["clear"]);
+            bool allScenes = parameters.ContainsKey("scope") && parameters["scope"].ToString().ToLower() == "all";
+            
+            switch (operation)
+            {
+                case "bake":
+                {
+                    if (Lightmapping.isRunning)
+                    {
+                        return MCPResponse.Error("光照正在烘焙，请等待完成或先取消");
+                    }
+                    List<string> scenes = ScenesInScope(allScenes);
+                    if (clear) Lightmapping.Clear();
+                    var stopwatch = Stopwatch.StartNew();
+                    bool success = !allScenes && SceneManager.sceneCount > 1
+                        ? Lightmapping.BakeMultipleScenes(scenes.ToArray())
+                        : Lightmapping.Bake();
+                    stopwatch.Stop();
+                    if (!success)
+                    {
+                        return MCPResponse.Error("光照烘焙失败或被取消，详情见Unity控制台");
+                    }
+                    Debug.Log($"光照烘焙完成: {string.Join(", ", scenes)}，耗时 {stopwatch.ElapsedMilliseconds}ms");
+                    return MCPResponse.Success(BuildResult("succeeded", scenes, stopwatch.ElapsedMilliseconds));
+                }
+                
+                case "start":
+                    if (Lightmapping.isRunning)
+                    {
+                        return MCPResponse.Error("光照已经在烘焙");
+                    }
+                    if (!allScenes && SceneManager.sceneCount > 1)
+                    {
+                        return MCPResponse.Error("打开了多个场景时只能同步烘焙当前场景，请使用async=false或scope=all");
+                    }
+                    if (clear) Lightmapping.Clear();
+                    asyncScenes = ScenesInScope(allScenes);
+                    asyncCompleted = false;
+                    Lightmapping.bakeCompleted -= OnBakeCompleted;
+                    Lightmapping.bakeCompleted += OnBakeCompleted;
+                    if (!Lightmapping.BakeAsync())
+                    {
+                        return MCPResponse.Error("无法启动光照烘焙，详情见Unity控制台");
+                    }
+                    asyncStatus = "running";
+                    asyncStopwatch = Stopwatch.StartNew();
+                    Debug.Log($"开始后台烘焙光照: {string.Join(", ", asyncScenes)}");
+                    return MCPResponse.Success(AsyncResult());
+                    
+                case "status":
+                    if (asyncStatus == null)
+                    {
+                        return MCPResponse.Error("本次编辑器会话中还没有后台烘焙过光照");
+                    }
+                    if (asyncStatus == "running" && !Lightmapping.isRunning)
+                    {
+                        // 在编辑器中取消或烘焙出错时不会触发bakeCompleted
+                        asyncStatus = asyncCompleted ? "succeeded" : "failed";
+                        asyncStopwatch.Stop();
+                        Debug.Log($"光照后台烘焙结束 ({asyncStatus})，耗时 {asyncStopwatch.ElapsedMilliseconds}ms");
+                    }
+                    return MCPResponse.Success(AsyncResult());
+                    
+                case "cancel":
+                    if (asyncStatus == null)
+                    {
+                        return MCPResponse.Error("本次编辑器会话中还没有后台烘焙过光照");
+                    }
+                    if (asyncStatus == "running")
+                    {
+                        Lightmapping.Cancel();
+                        asyncStatus = "canceled";
+                        asyncStopwatch.Stop();
+                        Debug.Log("已取消光照后台烘焙");
+                    }
+                    return MCPResponse.Success(AsyncResult());
+                    
+                default:
+                    return MCPResponse.Error($"不支持的操作: {operation}");
+            }
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"烘焙光照时出错: {e.Message}");
+            return MCPResponse.Error($"烘焙光照失败: {e.Message}");
+        }
+    }
+    
+    private static void OnBakeCompleted()
+    {
+        asyncCompleted = true;
+    }
+    
+    /// <summary>
+    /// 本次烘焙包含的场景路径，未保存的场景无法烘焙
+    /// </summary>
+    private List<string> ScenesInScope(bool allScenes)
+    {
+        var scenes = new List<string>();
+        for (int i = 0; i < SceneManager.sceneCount; i++)
+        {
+            Scene scene = SceneManager.GetSceneAt(i);
+            if (!scene.isLoaded || (!allScenes && scene != SceneManager.GetActiveScene()))
+            {
+                continue;
+            }
+            if (string.IsNullOrEmpty(scene.path))
+            {
+                throw new System.InvalidOperationException($"场景 '{scene.name}' 尚未保存，请先保存场景再烘焙");
+            }
+            scenes.Add(scene.path);
+        }
+        return scenes;
+    }
+    
+    private Dictionary<string, object> AsyncResult()
+    {
+        if (asyncStatus == "running")
+        {
+            return new Dictionary<string, object>
+            {
+                ["status"] = asyncStatus,
+                ["scenes"] = asyncScenes,
+                ["progress"] = Lightmapping.buildProgress,
+                ["elapsedMs"] = asyncStopwatch.ElapsedMilliseconds
+            };
+        }
+        return BuildResult(asyncStatus, asyncScenes, asyncStopwatch.ElapsedMilliseconds);
+    }
+    
+    /// <summary>
+    /// 烘焙结果: 耗时、光照贴图数量和光照贴图纹理占用的内存
+    /// </summary>
+    private Dictionary<string, object> BuildResult(string status, List<string> scenes, long durationMs)
+    {
+        LightmapData[] lightmaps = LightmapSettings.lightmaps;
+        long memory = 0;
+        foreach (LightmapData lightmap in lightmaps)
+        {
+            foreach (Texture2D texture in new[] { lightmap.lightmapColor, lightmap.lightmapDir, lightmap.shadowMask })
+            {
+                if (texture != null)
+                {
+                    memory += Profiler.GetRuntimeMemorySizeLong(texture);
+                }
+            }
+        }
+        return new Dictionary<string, object>
+        {
+            ["status"] = status,
+            ["scenes"] = scenes,
+            ["durationMs"] = durationMs,
+            ["lightmapCount"] = lightmaps.Length,
+            ["lightmapMemoryBytes"] = memory,
+            ["lightmapMemory"] = EditorUtility.FormatBytes(memory)
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,98 @@
+using System.Collections.Generic;
+using System.IO;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.Rendering;
+
+/// <summary>
+/// 材质创建工具 - 用指定着色器创建材质资源，可选设置初始属性
+/// </summary>
+public class MaterialCreateTool : IMCPTool
+{
+    public string ToolName => "material_create";
+    
+    public string Description => "创建材质资源";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string savePath = parameters["savePath"].ToString();
+            bool overwrite = parameters.ContainsKey("overwrite") && System.Convert.ToBoolean(parameters["overwrite"]);
+            
+            if (!overwrite && AssetDatabase.LoadAssetAtPath<Object>(savePath) != null)
+            {
+                return MCPResponse.Error($"资源已存在: {savePath}，设置overwrite=true以替换");
+            }
+            
+            Shader shader;
+            if (parameters.ContainsKey("shaderName"))
+            {
+                string shaderName = parameters["shaderName"].ToString();
+                shader = Shader.Find(shaderName);
+                if (shader == null)
+                {
+                    return MCPResponse.Error($"未找到着色器: {shaderName}");
+                }
+            }
+            else
+            {
+                // 使用当前渲染管线的默认着色器
+                RenderPipelineAsset pipeline = GraphicsSettings.currentRenderPipeline;
+                shader = pipeline != null && pipeline.defaultShader != null ? pipeline.defaultShader : Shader.Find("Standard");
+            }
+            
+            string directory = Path.GetDirectoryName(savePath);
+            if (!string.IsNullOrEmpty(directory) && !Directory.Exists(directory))
+            {
+                Directory.CreateDirectory(directory);
+                AssetDatabase.Refresh();
+            }
+            
+            var material = new Material(shader);
+            var resolved = new Dictionary<string, object>();
+            var unknown = new List<string>();
+            var failed = new Dictionary<string, object>();
+            if (parameters.ContainsKey("properties") && parameters["properties"] is Dictionary<string, object> properties)
+            {
+                MaterialPropertyHelper.Apply(material, properties, resolved, unknown, failed);
+            }
+            
+            if (overwrite)
+            {
+                AssetDatabase.DeleteAsset(savePath);
+            }
+            AssetDatabase.CreateAsset(material, savePath);
+            AssetDatabase.SaveAssets();
+            
+            var result = MaterialPropertyHelper.Describe(material);
+            result["properties"] = resolved;
+            result["unknownProperties"] = unknown;
+            result["failedProperties"] = failed;
+            
+            Debug.Log($"创建材质: {savePath} (着色器: {shader.name})");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"创建材质时出错: {e.Message}");
+            return MCPResponse.Error($"创建材质失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        if (!parameters.ContainsKey("savePath") || string.IsNullOrEmpty(parameters["savePath"].ToString()))
+        {
+            return "缺少必需参数: savePath";
+        }
+        
+        return null;
+    }
+}
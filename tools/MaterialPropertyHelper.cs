@@ -0,0 +1,160 @@
+using System;
+using System.Collections;
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.Rendering;
+
+/// <summary>
+/// 材质属性辅助 - material_create 和 material_set_properties 共用
+/// 值的类型按着色器属性类型解释，值的形式已由Go服务器检查
+/// </summary>
+public static class MaterialPropertyHelper
+{
+    /// <summary>
+    /// 查找着色器属性，找不到时尝试加上 _ 前缀 (Color → _Color)
+    /// </summary>
+    public static int FindProperty(Shader shader, string name)
+    {
+        int index = shader.FindPropertyIndex(name);
+        if (index < 0 && !name.StartsWith("_"))
+        {
+            index = shader.FindPropertyIndex("_" + name);
+        }
+        return index;
+    }
+    
+    /// <summary>
+    /// 设置属性，resolved记录设置后的值，unknown记录着色器中不存在的属性，failed记录无法设置的属性
+    /// </summary>
+    public static void Apply(Material material, Dictionary<string, object> properties,
+        Dictionary<string, object> resolved, List<string> unknown, Dictionary<string, object> failed)
+    {
+        foreach (var property in properties)
+        {
+            int index = FindProperty(material.shader, property.Key);
+            if (index < 0)
+            {
+                unknown.Add(property.Key);
+                continue;
+            }
+            string name = material.shader.GetPropertyName(index);
+            try
+            {
+                string error = SetValue(material, name, material.shader.GetPropertyType(index), property.Value);
+                if (error != null)
+                {
+                    failed[property.Key] = error;
+                    continue;
+                }
+                resolved[name] = GetValue(material, name, material.shader.GetPropertyType(index));
+            }
+            catch (Exception e)
+            {
+                failed[property.Key] = e.Message;
+            }
+        }
+    }
+    
+    /// <summary>
+    /// 按属性类型设置值，失败时返回错误信息
+    /// </summary>
+    private static string SetValue(Material material, string name, ShaderPropertyType type, object value)
+    {
+        switch (type)
+        {
+            case ShaderPropertyType.Color:
+                float[] color = ReadComponents(value, new[] { "r", "g", "b", "a" }, material.GetColor(name));
+                if (color == null) return "颜色属性需要 #RRGGBB、{r,g,b,a} 或数字数组";
+                material.SetColor(name, new Color(color[0], color[1], color[2], color[3]));
+                return null;
+            case ShaderPropertyType.Vector:
+                float[] vector = ReadComponents(value, new[] { "x", "y", "z", "w" }, material.GetVector(name));
+                if (vector == null) return "向量属性需要 {x,y,z,w} 或数字数组";
+                material.SetVector(name, new Vector4(vector[0], vector[1], vector[2], vector[3]));
+                return null;
+            case ShaderPropertyType.Texture:
+                if (value == null || value.ToString() == "")
+                {
+                    material.SetTexture(name, null);
+                    return null;
+                }
+                if (!(value is string texturePath)) return "纹理属性需要资源路径";
+                Texture texture = AssetDatabase.LoadAssetAtPath<Texture>(texturePath);
+                if (texture == null) return $"无法加载纹理: {texturePath}";
+                material.SetTexture(name, texture);
+                return null;
+            default:
+                if (value is bool flag)
+                {
+                    // 开关属性 (Toggle) 使用0/1
+                    material.SetFloat(name, flag ? 1f : 0f);
+                    return null;
+                }
+                if (value == null || value is string || value is IDictionary || value is IList) return "数值属性需要数字";
+                material.SetFloat(name, Convert.ToSingle(value));
+                return null;
+        }
+    }
+    
+    /// <summary>
+    /// 读取颜色或向量分量，未给出的分量保持当前值
+    /// </summary>
+    private static float[] ReadComponents(object value, string[] names, Vector4 current)
+    {
+        float[] result = { current.x, current.y, current.z, current.w };
+        if (value is Dictionary<string, object> dict)
+        {
+            for (int i = 0; i < names.Length; i++)
+            {
+                if (dict.ContainsKey(names[i])) result[i] = Convert.ToSingle(dict[names[i]]);
+            }
+            return result;
+        }
+        if (value is IList list && !(value is string))
+        {
+            for (int i = 0; i < list.Count && i < 4; i++)
+            {
+                result[i] = Convert.ToSingle(list[i]);
+            }
+            return result;
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 读取属性的当前值
+    /// </summary>
+    public static object GetValue(Material material, string name, ShaderPropertyType type)
+    {
+        switch (type)
+        {
+            case ShaderPropertyType.Color:
+                Color c = material.GetColor(name);
+                return new Dictionary<string, object> { ["r"] = c.r, ["g"] = c.g, ["b"] = c.b, ["a"] = c.a };
+            case ShaderPropertyType.Vector:
+                Vector4 v = material.GetVector(name);
+                return new Dictionary<string, object> { ["x"] = v.x, ["y"] = v.y, ["z"] = v.z, ["w"] = v.w };
+            case ShaderPropertyType.Texture:
+                Texture texture = material.GetTexture(name);
+                return texture != null ? AssetDatabase.GetAssetPath(texture) : null;
+            default:
+                return material.GetFloat(name);
+        }
+    }
+    
+    /// <summary>
+    /// 材质的基本信息
+    /// </summary>
+    public static Dictionary<string, object> Describe(Material material)
+    {
+        return new Dictionary<string, object>
+        {
+            ["materialPath"] = AssetDatabase.GetAssetPath(material),
+            ["materialName"] = material.name,
+            ["instanceId"] = material.GetInstanceID(),
+            ["shader"] = material.shader != null ? material.shader.name : null,
+            ["enabledKeywords"] = material.shaderKeywords
+        };
+    }
+}
@@ -0,0 +1,96 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 材质属性设置工具 - 一次设置多个材质属性和关键字
+/// 着色器中不存在的属性逐个报告，不影响其他属性
+/// </summary>
+public class MaterialSetPropertiesTool : IMCPTool
+{
+    public string ToolName => "material_set_properties";
+    
+    public string Description => "设置材质的多个属性和关键字";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Material material;
+            if (parameters.ContainsKey("materialPath"))
+            {
+                string materialPath = parameters["materialPath"].ToString();
+                material = AssetDatabase.LoadAssetAtPath<Material>(materialPath);
+                if (material == null)
+                {
+                    return MCPResponse.Error($"无法加载材质: {materialPath}");
+                }
+            }
+            else
+            {
+                int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+                material = EditorUtility.InstanceIDToObject(instanceId) as Material;
+                if (material == null)
+                {
+                    return MCPResponse.Error($"未找到材质 (InstanceID: {instanceId})");
+                }
+            }
+            
+            Undo.RecordObject(material, "Set Material Properties");
+            
+            var resolved = new Dictionary<string, object>();
+            var unknown = new List<string>();
+            var failed = new Dictionary<string, object>();
+            if (parameters.ContainsKey("properties") && parameters["properties"] is Dictionary<string, object> properties)
+            {
+                MaterialPropertyHelper.Apply(material, properties, resolved, unknown, failed);
+            }
+            
+            if (parameters.ContainsKey("enableKeywords") && parameters["enableKeywords"] is System.Collections.IEnumerable enable)
+            {
+                foreach (var keyword in enable)
+                {
+                    material.EnableKeyword(keyword.ToString());
+                }
+            }
+            if (parameters.ContainsKey("disableKeywords") && parameters["disableKeywords"] is System.Collections.IEnumerable disable)
+            {
+                foreach (var keyword in disable)
+                {
+                    material.DisableKeyword(keyword.ToString());
+                }
+            }
+            
+            EditorUtility.SetDirty(material);
+            
+            var result = MaterialPropertyHelper.Describe(material);
+            result["properties"] = resolved;
+            result["unknownProperties"] = unknown;
+            result["failedProperties"] = failed;
+            
+            Debug.Log($"设置材质 '{material.name}' 的 {resolved.Count} 个属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置材质属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置材质属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        if (!parameters.ContainsKey("materialPath") && !parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: materialPath 或 instanceId";
+        }
+        
+        return null;
+    }
+}
@@ -0,0 +1,131 @@
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.Net.Sockets;
+using UnityEditor;
+using UnityEditor.SceneManagement;
+using Debug = UnityEngine.Debug;
+using NavMeshBuilder = UnityEditor.AI.NavMeshBuilder;
+
+/// <summary>
+/// NavMesh烘焙工具 - 烘焙当前场景的NavMesh，烘焙前可以覆盖代理半径、高度、坡度和台阶高度
+/// operation: bake同步烘焙 (默认)；start启动后台烘焙并立即返回，之后用status查询，cancel取消
+/// </summary>
+public class NavMeshBakeTool : IMCPTool
+{
+    public string ToolName => "navmesh_bake";
+    
+    public string Description => "烘焙NavMesh";
+    
+    /// <summary>
+    /// 后台烘焙的状态: running/succeeded/canceled
+    /// </summary>
+    private static string asyncStatus;
+    private static string asyncScene;
+    private static Stopwatch asyncStopwatch;
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string operation = parameters.ContainsKey("operation") ? parameters["operation"].ToString().ToLower() : "bake";
+            switch (operation)
+            {
+                case "bake":
+                {
+                    if (NavMeshBuilder.isRunning)
+                    {
+                        return MCPResponse.Error("NavMesh正在后台烘焙，请等待完成或先取消");
+                    }
+                    NavMeshHelper.ApplyAgentSettings(parameters);
+                    string scene = EditorSceneManager.GetActiveScene().path;
+                    var stopwatch = Stopwatch.StartNew();
+                    NavMeshBuilder.BuildNavMesh();
+                    stopwatch.Stop();
+                    EditorSceneManager.MarkSceneDirty(EditorSceneManager.GetActiveScene());
+                    Debug.Log($"NavMesh烘焙完成: {scene}，耗时 {stopwatch.ElapsedMilliseconds}ms");
+                    return MCPResponse.Success(BuildResult("succeeded", scene, stopwatch.ElapsedMilliseconds));
+                }
+                
+                case "start":
+                    if (NavMeshBuilder.isRunning)
+                    {
+                        return MCPResponse.Error("NavMesh已经在后台烘焙");
+                    }
+                    NavMeshHelper.ApplyAgentSettings(parameters);
+                    asyncScene = EditorSceneManager.GetActiveScene().path;
+                    asyncStopwatch = Stopwatch.StartNew();
+                    asyncStatus = "running";
+                    NavMeshBuilder.BuildNavMeshAsync();
+                    Debug.Log($"开始后台烘焙NavMesh: {asyncScene}");
+                    return MCPResponse.Success(AsyncResult());
+                    
+                case "status":
+                    if (asyncStatus == null)
+                    {
+                        return MCPResponse.Error("本次编辑器会话中还没有后台烘焙过NavMesh");
+                    }
+                    if (asyncStatus == "running" && !NavMeshBuilder.isRunning)
+                    {
+                        asyncStatus = "succeeded";
+                        asyncStopwatch.Stop();
+                        EditorSceneManager.MarkSceneDirty(EditorSceneManager.GetActiveScene());
+                        Debug.Log($"NavMesh后台烘焙完成: {asyncScene}，耗时 {asyncStopwatch.ElapsedMilliseconds}ms");
+                    }
+                    return MCPResponse.Success(AsyncResult());
+                    
+                case "cancel":
+                    if (asyncStatus == null)
+                    {
+                        return MCPResponse.Error("本次编辑器会话中还没有后台烘焙过NavMesh");
+                    }
+                    if (asyncStatus == "running" && NavMeshBuilder.isRunning)
+                    {
+                        NavMeshBuilder.Cancel();
+                        asyncStatus = "canceled";
+                        asyncStopwatch.Stop();
+                        Debug.Log($"已取消NavMesh后台烘焙: {asyncScene}");
+                    }
+                    return MCPResponse.Success(AsyncResult());
+                    
+                default:
+                    return MCPResponse.Error($"不支持的操作: {operation}");
+            }
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"烘焙NavMesh时出错: {e.Message}");
+            return MCPResponse.Error($"烘焙NavMesh失败: {e.Message}");
+        }
+    }
+    
+    private Dictionary<string, object> AsyncResult()
+    {
+        if (asyncStatus == "running")
+        {
+            return new Dictionary<string, object>
+            {
+                ["status"] = asyncStatus,
+                ["scene"] = asyncScene,
+                ["elapsedMs"] = asyncStopwatch.ElapsedMilliseconds
+            };
+        }
+        return BuildResult(asyncStatus, asyncScene, asyncStopwatch.ElapsedMilliseconds);
+    }
+    
+    private Dictionary<string, object> BuildResult(string status, string scene, long durationMs)
+    {
+        return new Dictionary<string, object>
+        {
+            ["status"] = status,
+            ["scene"] = scene,
+            ["durationMs"] = durationMs,
+            ["agent"] = NavMeshHelper.DescribeAgentSettings(),
+            ["triangulation"] = NavMeshHelper.DescribeTriangulation()
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
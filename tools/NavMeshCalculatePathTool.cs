@@ -0,0 +1,70 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.AI;
+
+/// <summary>
+/// NavMesh路径工具 - 计算两点之间的NavMesh路径，返回状态、拐点和路径长度
+/// </summary>
+public class NavMeshCalculatePathTool : IMCPTool
+{
+    public string ToolName => "navmesh_calculate_path";
+    
+    public string Description => "计算NavMesh路径";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            if (!NavMeshHelper.HasNavMesh())
+            {
+                return MCPResponse.Error("当前场景没有已烘焙的NavMesh，请先使用navmesh_bake");
+            }
+            Vector3 from = NavMeshHelper.ReadVector3(parameters["from"]);
+            Vector3 to = NavMeshHelper.ReadVector3(parameters["to"]);
+            int areaMask = parameters.ContainsKey("areaMask") ? System.Convert.ToInt32(parameters["areaMask"]) : NavMesh.AllAreas;
+            
+            var path = new NavMeshPath();
+            NavMesh.CalculatePath(from, to, areaMask, path);
+            
+            var corners = new List<object>();
+            float length = 0f;
+            for (int i = 0; i < path.corners.Length; i++)
+            {
+                corners.Add(NavMeshHelper.VectorToDict(path.corners[i]));
+                if (i > 0)
+                {
+                    length += Vector3.Distance(path.corners[i - 1], path.corners[i]);
+                }
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["from"] = NavMeshHelper.VectorToDict(from),
+                ["to"] = NavMeshHelper.VectorToDict(to),
+                ["status"] = path.status.ToString(),
+                ["corners"] = corners,
+                ["length"] = length
+            };
+            if (path.status == NavMeshPathStatus.PathInvalid)
+            {
+                result["hint"] = "起点或终点不在NavMesh上，可以先用navmesh_sample_position找到最近的点";
+            }
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"计算NavMesh路径时出错: {e.Message}");
+            return MCPResponse.Error($"计算NavMesh路径失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("from") || !parameters.ContainsKey("to"))
+        {
+            return "缺少必需参数: from 和 to";
+        }
+        return NavMeshHelper.CheckVector3(parameters, "from") ?? NavMeshHelper.CheckVector3(parameters, "to");
+    }
+}
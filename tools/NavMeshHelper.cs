@@ -0,0 +1,120 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEngine.AI;
+using UnityEditor;
+
+/// <summary>
+/// NavMesh辅助方法: 读写场景的烘焙代理设置、统计三角化结果和向量转换
+/// 代理设置保存在场景的NavMeshSettings对象的m_BuildSettings中
+/// </summary>
+public static class NavMeshHelper
+{
+    /// <summary>
+    /// 工具参数名对应的m_BuildSettings字段
+    /// </summary>
+    private static readonly Dictionary<string, string> AgentFields = new Dictionary<string, string>
+    {
+        ["agentRadius"] = "agentRadius",
+        ["agentHeight"] = "agentHeight",
+        ["maxSlope"] = "agentSlope",
+        ["stepHeight"] = "agentClimb"
+    };
+    
+    /// <summary>
+    /// 把参数中的代理设置写入场景的烘焙设置 (支持撤销)，返回是否有修改
+    /// </summary>
+    public static bool ApplyAgentSettings(Dictionary<string, object> parameters)
+    {
+        var settings = new SerializedObject(UnityEditor.AI.NavMeshBuilder.navMeshSettingsObject);
+        bool changed = false;
+        foreach (var field in AgentFields)
+        {
+            if (!parameters.ContainsKey(field.Key))
+            {
+                continue;
+            }
+            SerializedProperty property = settings.FindProperty("m_BuildSettings." + field.Value);
+            if (property == null)
+            {
+                throw new System.InvalidOperationException($"找不到NavMesh烘焙设置字段: {field.Value}");
+            }
+            property.floatValue = System.Convert.ToSingle(parameters[field.Key]);
+            changed = true;
+        }
+        if (changed)
+        {
+            settings.ApplyModifiedProperties();
+        }
+        return changed;
+    }
+    
+    /// <summary>
+    /// 当前场景的代理设置
+    /// </summary>
+    public static Dictionary<string, object> DescribeAgentSettings()
+    {
+        var settings = new SerializedObject(UnityEditor.AI.NavMeshBuilder.navMeshSettingsObject);
+        var result = new Dictionary<string, object>();
+        foreach (var field in AgentFields)
+        {
+            SerializedProperty property = settings.FindProperty("m_BuildSettings." + field.Value);
+            result[field.Key] = property != null ? (object)property.floatValue : null;
+        }
+        return result;
+    }
+    
+    /// <summary>
+    /// 已烘焙NavMesh的三角化统计: 顶点数、三角形数和使用的区域
+    /// </summary>
+    public static Dictionary<string, object> DescribeTriangulation()
+    {
+        NavMeshTriangulation triangulation = NavMesh.CalculateTriangulation();
+        string[] names = NavMesh.GetAreaNames();
+        var areaNames = new List<string>();
+        foreach (int area in new SortedSet<int>(triangulation.areas))
+        {
+            areaNames.Add(area < names.Length ? names[area] : area.ToString());
+        }
+        return new Dictionary<string, object>
+        {
+            ["vertices"] = triangulation.vertices.Length,
+            ["triangles"] = triangulation.indices.Length / 3,
+            ["areas"] = areaNames
+        };
+    }
+    
+    /// <summary>
+    /// 场景中是否有已烘焙的NavMesh
+    /// </summary>
+    public static bool HasNavMesh()
+    {
+        return NavMesh.CalculateTriangulation().vertices.Length > 0;
+    }
+    
+    /// <summary>
+    /// 检查必需的坐标参数存在且是 {x, y, z} 对象
+    /// </summary>
+    public static string CheckVector3(Dictionary<string, object> parameters, string key)
+    {
+        if (!parameters.ContainsKey(key))
+        {
+            return $"缺少必需参数: {key}";
+        }
+        return parameters[key] is Dictionary<string, object> ? null : $"{key}必须是 {{x, y, z}} 对象";
+    }
+    
+    public static Vector3 ReadVector3(object value)
+    {
+        var dict = (Dictionary<string, object>)value;
+        return new Vector3(
+            dict.ContainsKey("x") ? System.Convert.ToSingle(dict["x"]) : 0f,
+            dict.ContainsKey("y") ? System.Convert.ToSingle(dict["y"]) : 0f,
+            dict.ContainsKey("z") ? System.Convert.ToSingle(dict["z"]) : 0f
+        );
+    }
+    
+    public static Dictionary<string, float> VectorToDict(Vector3 v)
+    {
+        return new Dictionary<string, float> { ["x"] = v.x, ["y"] = v.y, ["z"] = v.z };
+    }
+}
@@ -0,0 +1,56 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.AI;
+
+/// <summary>
+/// NavMesh采样工具 - 查找位置附近maxDistance范围内NavMesh上最近的点
+/// </summary>
+public class NavMeshSamplePositionTool : IMCPTool
+{
+    public string ToolName => "navmesh_sample_position";
+    
+    public string Description => "查找NavMesh上最近的点";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            if (!NavMeshHelper.HasNavMesh())
+            {
+                return MCPResponse.Error("当前场景没有已烘焙的NavMesh，请先使用navmesh_bake");
+            }
+            Vector3 position = NavMeshHelper.ReadVector3(parameters["position"]);
+            float maxDistance = parameters.ContainsKey("maxDistance") ? System.Convert.ToSingle(parameters["maxDistance"]) : 1f;
+            int areaMask = parameters.ContainsKey("areaMask") ? System.Convert.ToInt32(parameters["areaMask"]) : NavMesh.AllAreas;
+            
+            var result = new Dictionary<string, object>
+            {
+                ["position"] = NavMeshHelper.VectorToDict(position),
+                ["maxDistance"] = maxDistance
+            };
+            if (NavMesh.SamplePosition(position, out NavMeshHit hit, maxDistance, areaMask))
+            {
+                result["found"] = true;
+                result["point"] = NavMeshHelper.VectorToDict(hit.position);
+                result["distance"] = hit.distance;
+                result["areaMask"] = hit.mask;
+            }
+            else
+            {
+                result["found"] = false;
+            }
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"NavMesh采样时出错: {e.Message}");
+            return MCPResponse.Error($"NavMesh采样失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return NavMeshHelper.CheckVector3(parameters, "position");
+    }
+}
@@ -0,0 +1,84 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 添加包工具 - 通过Package Manager安装包
+/// 安装在后续帧中异步进行: start启动操作并立即返回，之后用status查询结果
+/// </summary>
+public class PackageAddTool : IMCPTool
+{
+    public string ToolName => "package_add";
+    
+    public string Description => "通过Package Manager添加包";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string operation = parameters.ContainsKey("operation") ? parameters["operation"].ToString().ToLower() : "start";
+            PackageOperationTracker.OperationState state;
+            
+            switch (operation)
+            {
+                case "start":
+                    if (PackageOperationTracker.IsRunning)
+                    {
+                        var running = PackageOperationTracker.Current;
+                        return MCPResponse.Error($"已有包操作正在进行: {running.kind} {running.identifier}");
+                    }
+                    
+                    string name = parameters.ContainsKey("name") ? parameters["name"].ToString() : null;
+                    string version = parameters.ContainsKey("version") ? parameters["version"].ToString() : null;
+                    string gitUrl = parameters.ContainsKey("gitUrl") ? parameters["gitUrl"].ToString() : null;
+                    
+                    // git URL直接作为标识，否则使用 name 或 name@version
+                    string identifier = !string.IsNullOrEmpty(gitUrl) ? gitUrl
+                        : string.IsNullOrEmpty(version) ? name : $"{name}@{version}";
+                    state = PackageOperationTracker.StartAdd(identifier, name);
+                    Debug.Log($"开始添加包: {identifier}");
+                    break;
+                    
+                case "status":
+                    state = PackageOperationTracker.Current;
+                    if (state == null)
+                    {
+                        return MCPResponse.Error("本次编辑器会话中还没有进行过包操作");
+                    }
+                    break;
+                    
+                default:
+                    return MCPResponse.Error($"不支持的操作: {operation}");
+            }
+            
+            if (parameters.ContainsKey("operationId") && parameters["operationId"].ToString() != state.operationId)
+            {
+                return MCPResponse.Error($"包操作 {parameters["operationId"]} 已被新的操作 {state.operationId} 取代");
+            }
+            
+            return MCPResponse.Success(PackageOperationResult.Build(state));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"添加包时出错: {e.Message}");
+            return MCPResponse.Error($"添加包失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        string operation = parameters.ContainsKey("operation") ? parameters["operation"].ToString().ToLower() : "start";
+        if (operation == "start" && !parameters.ContainsKey("name") && !parameters.ContainsKey("gitUrl"))
+        {
+            return "缺少必需参数: name 或 gitUrl";
+        }
+        
+        return null;
+    }
+}
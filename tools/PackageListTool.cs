@@ -0,0 +1,79 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.PackageManager;
+using PackageInfo = UnityEditor.PackageManager.PackageInfo;
+
+/// <summary>
+/// 包列表工具 - 列出已安装的UPM包及其版本，并根据Package Manager缓存的版本信息判断是否有更新
+/// </summary>
+public class PackageListTool : IMCPTool
+{
+    public string ToolName => "package_list";
+    
+    public string Description => "列出已安装的UPM包";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            bool includeBuiltIn = parameters.ContainsKey("includeBuiltIn") && System.Convert.ToBoolean(parameters["includeBuiltIn"]);
+            bool includeIndirect = parameters.ContainsKey("includeIndirect") && System.Convert.ToBoolean(parameters["includeIndirect"]);
+            
+            var packages = new List<object>();
+            int updates = 0;
+            foreach (PackageInfo package in PackageInfo.GetAllRegisteredPackages())
+            {
+                if (!includeBuiltIn && package.source == PackageSource.BuiltIn) continue;
+                if (!includeIndirect && !package.isDirectDependency) continue;
+                
+                // 只有注册表中的包有可比较的版本
+                string latest = package.versions != null ? package.versions.latestCompatible : null;
+                bool updateAvailable = package.source == PackageSource.Registry &&
+                    !string.IsNullOrEmpty(latest) && latest != package.version;
+                if (updateAvailable) updates++;
+                
+                packages.Add(new Dictionary<string, object>
+                {
+                    ["name"] = package.name,
+                    ["displayName"] = package.displayName,
+                    ["version"] = package.version,
+                    ["source"] = package.source.ToString(),
+                    ["isDirectDependency"] = package.isDirectDependency,
+                    ["latestCompatibleVersion"] = latest,
+                    ["updateAvailable"] = updateAvailable,
+                    ["packageId"] = package.packageId
+                });
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["count"] = packages.Count,
+                ["updatesAvailable"] = updates,
+                ["packages"] = packages
+            };
+            var current = PackageOperationTracker.Current;
+            if (current != null && current.status == "running")
+            {
+                result["runningOperation"] = $"{current.kind} {current.identifier}";
+            }
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"列出包时出错: {e.Message}");
+            return MCPResponse.Error($"列出包失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        return null;
+    }
+}
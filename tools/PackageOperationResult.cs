@@ -0,0 +1,26 @@
+using System.Collections.Generic;
+
+/// <summary>
+/// 包操作结果 - package_add 和 package_remove 共用的结果格式
+/// </summary>
+public static class PackageOperationResult
+{
+    public static Dictionary<string, object> Build(PackageOperationTracker.OperationState state)
+    {
+        return new Dictionary<string, object>
+        {
+            ["operationId"] = state.operationId,
+            ["operation"] = state.kind,
+            ["identifier"] = state.identifier,
+            ["packageName"] = state.packageName,
+            ["status"] = state.status,
+            ["startedAt"] = state.startedAt,
+            ["finishedAt"] = state.finishedAt,
+            ["installedVersion"] = state.installedVersion,
+            ["source"] = state.source,
+            ["error"] = state.error,
+            ["errorCode"] = state.errorCode,
+            ["resolvedAfterReload"] = state.resolvedAfterReload
+        };
+    }
+}
@@ -0,0 +1,193 @@
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.PackageManager;
+using UnityEditor.PackageManager.Requests;
+using PackageInfo = UnityEditor.PackageManager.PackageInfo;
+
+/// <summary>
+/// Package Manager操作跟踪 - 启动添加/删除请求，在编辑器更新中轮询请求直到完成
+/// 安装或删除包通常会触发编译和域重载，因此操作状态保存在SessionState中；
+/// 重载时丢失了请求对象的操作按包是否已安装判断结果
+/// </summary>
+[InitializeOnLoad]
+public static class PackageOperationTracker
+{
+    private const string StateKey = "UnityMCP.PackageOperation";
+    
+    /// <summary>
+    /// 一次包操作的状态
+    /// kind: add/remove，status: running/succeeded/failed
+    /// </summary>
+    [System.Serializable]
+    public class OperationState
+    {
+        public string operationId;
+        public string kind;
+        public string identifier;
+        public string packageName;
+        public string status;
+        public string startedAt;
+        public string finishedAt;
+        public string error;
+        public string errorCode;
+        public string installedVersion;
+        public string source;
+        public bool resolvedAfterReload;
+    }
+    
+    private static Request request;
+    
+    static PackageOperationTracker()
+    {
+        EditorApplication.update += Poll;
+    }
+    
+    /// <summary>
+    /// 当前或最近一次操作的状态，本次编辑器会话中没有操作过时返回null
+    /// </summary>
+    public static OperationState Current
+    {
+        get
+        {
+            string json = SessionState.GetString(StateKey, "");
+            return string.IsNullOrEmpty(json) ? null : JsonUtility.FromJson<OperationState>(json);
+        }
+    }
+    
+    private static void Save(OperationState state)
+    {
+        SessionState.SetString(StateKey, JsonUtility.ToJson(state));
+    }
+    
+    /// <summary>
+    /// 启动添加包: identifier可以是包名、name@version或git URL
+    /// </summary>
+    public static OperationState StartAdd(string identifier, string packageName)
+    {
+        var state = NewState("add", identifier, packageName);
+        request = Client.Add(identifier);
+        Save(state);
+        return state;
+    }
+    
+    /// <summary>
+    /// 启动删除包
+    /// </summary>
+    public static OperationState StartRemove(string packageName)
+    {
+        var state = NewState("remove", packageName, packageName);
+        request = Client.Remove(packageName);
+        Save(state);
+        return state;
+    }
+    
+    /// <summary>
+    /// 是否有操作正在进行
+    /// </summary>
+    public static bool IsRunning
+    {
+        get
+        {
+            var state = Current;
+            return state != null && state.status == "running";
+        }
+    }
+    
+    private static OperationState NewState(string kind, string identifier, string packageName)
+    {
+        return new OperationState
+        {
+            operationId = System.Guid.NewGuid().ToString("N"),
+            kind = kind,
+            identifier = identifier,
+            packageName = packageName,
+            status = "running",
+            startedAt = System.DateTime.UtcNow.ToString("yyyy-MM-ddTHH:mm:ssZ")
+        };
+    }
+    
+    /// <summary>
+    /// 检查请求是否完成并记录结果
+    /// </summary>
+    private static void Poll()
+    {
+        var state = Current;
+        if (state == null || state.status != "running")
+        {
+            return;
+        }
+        
+        if (request == null)
+        {
+            // 域重载后请求对象已丢失
+            ResolveAfterReload(state);
+            return;
+        }
+        if (!request.IsCompleted)
+        {
+            return;
+        }
+        
+        if (request.Status == StatusCode.Success)
+        {
+            state.status = "succeeded";
+            if (request is AddRequest addRequest && addRequest.Result != null)
+            {
+                state.packageName = addRequest.Result.name;
+                state.installedVersion = addRequest.Result.version;
+                state.source = addRequest.Result.source.ToString();
+            }
+        }
+        else
+        {
+            state.status = "failed";
+            state.error = request.Error != null ? request.Error.message : "未知错误";
+            state.errorCode = request.Error != null ? request.Error.errorCode.ToString() : null;
+        }
+        state.finishedAt = System.DateTime.UtcNow.ToString("yyyy-MM-ddTHH:mm:ssZ");
+        request = null;
+        Save(state);
+        Debug.Log($"包操作 {state.kind} {state.identifier} 结束: {state.status}");
+    }
+    
+    /// <summary>
+    /// 请求在域重载中丢失时，按包的安装状态判断操作结果
+    /// </summary>
+    private static void ResolveAfterReload(OperationState state)
+    {
+        PackageInfo installed = FindInstalled(state.packageName);
+        bool succeeded = state.kind == "add" ? installed != null : installed == null;
+        state.status = succeeded ? "succeeded" : "failed";
+        state.resolvedAfterReload = true;
+        if (installed != null)
+        {
+            state.installedVersion = installed.version;
+            state.source = installed.source.ToString();
+        }
+        if (!succeeded)
+        {
+            state.error = "Package Manager请求在域重载中丢失，包的安装状态与预期不符";
+        }
+        state.finishedAt = System.DateTime.UtcNow.ToString("yyyy-MM-ddTHH:mm:ssZ");
+        Save(state);
+    }
+    
+    /// <summary>
+    /// 按包名查找已安装的包
+    /// </summary>
+    public static PackageInfo FindInstalled(string packageName)
+    {
+        if (string.IsNullOrEmpty(packageName))
+        {
+            return null;
+        }
+        foreach (var package in PackageInfo.GetAllRegisteredPackages())
+        {
+            if (package.name == packageName)
+            {
+                return package;
+            }
+        }
+        return null;
+    }
+}
@@ -0,0 +1,54 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 粒子系统读取工具 - 以particle_system_set相同的结构返回main、emission和shape模块的属性
+/// </summary>
+public class ParticleSystemGetTool : IMCPTool
+{
+    public string ToolName => "particle_system_get";
+    
+    public string Description => "获取粒子系统属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (gameObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            ParticleSystem particleSystem = gameObject.GetComponent<ParticleSystem>();
+            if (particleSystem == null)
+            {
+                return MCPResponse.Error($"GameObject '{gameObject.name}' 上没有ParticleSystem组件");
+            }
+            
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["instanceId"] = instanceId,
+                ["gameObject"] = gameObject.name,
+                ["isPlaying"] = particleSystem.isPlaying,
+                ["modules"] = ParticleSystemHelper.Describe(particleSystem)
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取粒子系统属性时出错: {e.Message}");
+            return MCPResponse.Error($"获取粒子系统属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,251 @@
+using System.Collections;
+using System.Collections.Generic;
+using UnityEngine;
+
+/// <summary>
+/// 粒子系统辅助方法: 按 {模块: {属性: 值}} 读写main、emission和shape模块的常用属性
+/// 数值属性为常量或 {min, max} 两个常量，由曲线驱动的属性只报告模式
+/// </summary>
+public static class ParticleSystemHelper
+{
+    /// <summary>
+    /// 各模块支持的属性，与服务端的particleModules一致
+    /// </summary>
+    public static readonly Dictionary<string, string[]> ModuleProperties = new Dictionary<string, string[]>
+    {
+        ["main"] = new[] { "duration", "looping", "prewarm", "startDelay", "startLifetime", "startSpeed", "startSize",
+            "startRotation", "startColor", "gravityModifier", "simulationSpace", "simulationSpeed", "playOnAwake", "maxParticles" },
+        ["emission"] = new[] { "enabled", "rateOverTime", "rateOverDistance", "bursts" },
+        ["shape"] = new[] { "enabled", "shapeType", "radius", "radiusThickness", "angle", "arc" }
+    };
+    
+    /// <summary>
+    /// 修改前检查模块和属性名，返回错误信息，全部有效时返回null
+    /// </summary>
+    public static string Validate(Dictionary<string, object> modules)
+    {
+        foreach (var module in modules)
+        {
+            if (!ModuleProperties.TryGetValue(module.Key, out string[] properties))
+            {
+                return $"未知的粒子模块: {module.Key}，可用模块: {string.Join(", ", ModuleProperties.Keys)}";
+            }
+            if (!(module.Value is Dictionary<string, object> values))
+            {
+                return $"模块 {module.Key} 的值必须是属性对象";
+            }
+            foreach (string name in values.Keys)
+            {
+                if (System.Array.IndexOf(properties, name) < 0)
+                {
+                    return $"模块 {module.Key} 没有属性: {name}，可用属性: {string.Join(", ", properties)}";
+                }
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 应用已通过Validate检查的模块属性
+    /// </summary>
+    public static void Apply(ParticleSystem particleSystem, Dictionary<string, object> modules)
+    {
+        if (modules.TryGetValue("main", out object mainValues)) ApplyMain(particleSystem.main, (Dictionary<string, object>)mainValues);
+        if (modules.TryGetValue("emission", out object emissionValues)) ApplyEmission(particleSystem.emission, (Dictionary<string, object>)emissionValues);
+        if (modules.TryGetValue("shape", out object shapeValues)) ApplyShape(particleSystem.shape, (Dictionary<string, object>)shapeValues);
+    }
+    
+    private static void ApplyMain(ParticleSystem.MainModule main, Dictionary<string, object> values)
+    {
+        foreach (var pair in values)
+        {
+            switch (pair.Key)
+            {
+                case "duration": main.duration = System.Convert.ToSingle(pair.Value); break;
+                case "looping": main.loop = System.Convert.ToBoolean(pair.Value); break;
+                case "prewarm": main.prewarm = System.Convert.ToBoolean(pair.Value); break;
+                case "startDelay": main.startDelay = ReadCurve(pair.Value); break;
+                case "startLifetime": main.startLifetime = ReadCurve(pair.Value); break;
+                case "startSpeed": main.startSpeed = ReadCurve(pair.Value); break;
+                case "startSize": main.startSize = ReadCurve(pair.Value); break;
+                case "startRotation": main.startRotation = ReadCurve(pair.Value, Mathf.Deg2Rad); break;
+                case "startColor": main.startColor = ReadGradient(pair.Value); break;
+                case "gravityModifier": main.gravityModifier = ReadCurve(pair.Value); break;
+                case "simulationSpace":
+                    main.simulationSpace = (ParticleSystemSimulationSpace)System.Enum.Parse(typeof(ParticleSystemSimulationSpace), pair.Value.ToString());
+                    break;
+                case "simulationSpeed": main.simulationSpeed = System.Convert.ToSingle(pair.Value); break;
+                case "playOnAwake": main.playOnAwake = System.Convert.ToBoolean(pair.Value); break;
+                case "maxParticles": main.maxParticles = System.Convert.ToInt32(pair.Value); break;
+            }
+        }
+    }
+    
+    private static void ApplyEmission(ParticleSystem.EmissionModule emission, Dictionary<string, object> values)
+    {
+        foreach (var pair in values)
+        {
+            switch (pair.Key)
+            {
+                case "enabled": emission.enabled = System.Convert.ToBoolean(pair.Value); break;
+                case "rateOverTime": emission.rateOverTime = ReadCurve(pair.Value); break;
+                case "rateOverDistance": emission.rateOverDistance = ReadCurve(pair.Value); break;
+                case "bursts": emission.SetBursts(ReadBursts(pair.Value)); break;
+            }
+        }
+    }
+    
+    private static void ApplyShape(ParticleSystem.ShapeModule shape, Dictionary<string, object> values)
+    {
+        foreach (var pair in values)
+        {
+            switch (pair.Key)
+            {
+                case "enabled": shape.enabled = System.Convert.ToBoolean(pair.Value); break;
+                case "shapeType":
+                    shape.shapeType = (ParticleSystemShapeType)System.Enum.Parse(typeof(ParticleSystemShapeType), pair.Value.ToString());
+                    break;
+                case "radius": shape.radius = System.Convert.ToSingle(pair.Value); break;
+                case "radiusThickness": shape.radiusThickness = System.Convert.ToSingle(pair.Value); break;
+                case "angle": shape.angle = System.Convert.ToSingle(pair.Value); break;
+                case "arc": shape.arc = System.Convert.ToSingle(pair.Value); break;
+            }
+        }
+    }
+    
+    /// <summary>
+    /// 常量或 {min, max} 转换为MinMaxCurve，scale用于单位换算 (startRotation的角度转弧度)
+    /// </summary>
+    private static ParticleSystem.MinMaxCurve ReadCurve(object value, float scale = 1f)
+    {
+        if (value is Dictionary<string, object> range)
+        {
+            return new ParticleSystem.MinMaxCurve(
+                System.Convert.ToSingle(range["min"]) * scale,
+                System.Convert.ToSingle(range["max"]) * scale);
+        }
+        return new ParticleSystem.MinMaxCurve(System.Convert.ToSingle(value) * scale);
+    }
+    
+    /// <summary>
+    /// 颜色 {r,g,b,a} 或 {min, max} 两个颜色转换为MinMaxGradient
+    /// </summary>
+    private static ParticleSystem.MinMaxGradient ReadGradient(object value)
+    {
+        var dict = (Dictionary<string, object>)value;
+        if (dict.ContainsKey("min"))
+        {
+            return new ParticleSystem.MinMaxGradient(
+                UIControlHelper.ReadColor(dict["min"], Color.white),
+                UIControlHelper.ReadColor(dict["max"], Color.white));
+        }
+        return new ParticleSystem.MinMaxGradient(UIControlHelper.ReadColor(dict, Color.white));
+    }
+    
+    private static ParticleSystem.Burst[] ReadBursts(object value)
+    {
+        var bursts = new List<ParticleSystem.Burst>();
+        foreach (object item in (IEnumerable)value)
+        {
+            var dict = (Dictionary<string, object>)item;
+            var burst = new ParticleSystem.Burst(System.Convert.ToSingle(dict["time"]), ReadCurve(dict["count"]));
+            if (dict.ContainsKey("cycles")) burst.cycleCount = System.Convert.ToInt32(dict["cycles"]);
+            if (dict.ContainsKey("interval")) burst.repeatInterval = System.Convert.ToSingle(dict["interval"]);
+            if (dict.ContainsKey("probability")) burst.probability = System.Convert.ToSingle(dict["probability"]);
+            bursts.Add(burst);
+        }
+        return bursts.ToArray();
+    }
+    
+    /// <summary>
+    /// 以与particle_system_set相同的结构描述粒子系统
+    /// </summary>
+    public static Dictionary<string, object> Describe(ParticleSystem particleSystem)
+    {
+        ParticleSystem.MainModule main = particleSystem.main;
+        ParticleSystem.EmissionModule emission = particleSystem.emission;
+        ParticleSystem.ShapeModule shape = particleSystem.shape;
+        
+        var bursts = new List<object>();
+        var burstArray = new ParticleSystem.Burst[emission.burstCount];
+        emission.GetBursts(burstArray);
+        foreach (ParticleSystem.Burst burst in burstArray)
+        {
+            bursts.Add(new Dictionary<string, object>
+            {
+                ["time"] = burst.time,
+                ["count"] = DescribeCurve(burst.count),
+                ["cycles"] = burst.cycleCount,
+                ["interval"] = burst.repeatInterval,
+                ["probability"] = burst.probability
+            });
+        }
+        
+        return new Dictionary<string, object>
+        {
+            ["main"] = new Dictionary<string, object>
+            {
+                ["duration"] = main.duration,
+                ["looping"] = main.loop,
+                ["prewarm"] = main.prewarm,
+                ["startDelay"] = DescribeCurve(main.startDelay),
+                ["startLifetime"] = DescribeCurve(main.startLifetime),
+                ["startSpeed"] = DescribeCurve(main.startSpeed),
+                ["startSize"] = DescribeCurve(main.startSize),
+                ["startRotation"] = DescribeCurve(main.startRotation, Mathf.Rad2Deg),
+                ["startColor"] = DescribeGradient(main.startColor),
+                ["gravityModifier"] = DescribeCurve(main.gravityModifier),
+                ["simulationSpace"] = main.simulationSpace.ToString(),
+                ["simulationSpeed"] = main.simulationSpeed,
+                ["playOnAwake"] = main.playOnAwake,
+                ["maxParticles"] = main.maxParticles
+            },
+            ["emission"] = new Dictionary<string, object>
+            {
+                ["enabled"] = emission.enabled,
+                ["rateOverTime"] = DescribeCurve(emission.rateOverTime),
+                ["rateOverDistance"] = DescribeCurve(emission.rateOverDistance),
+                ["bursts"] = bursts
+            },
+            ["shape"] = new Dictionary<string, object>
+            {
+                ["enabled"] = shape.enabled,
+                ["shapeType"] = shape.shapeType.ToString(),
+                ["radius"] = shape.radius,
+                ["radiusThickness"] = shape.radiusThickness,
+                ["angle"] = shape.angle,
+                ["arc"] = shape.arc
+            }
+        };
+    }
+    
+    private static object DescribeCurve(ParticleSystem.MinMaxCurve curve, float scale = 1f)
+    {
+        switch (curve.mode)
+        {
+            case ParticleSystemCurveMode.Constant:
+                return curve.constant * scale;
+            case ParticleSystemCurveMode.TwoConstants:
+                return new Dictionary<string, object> { ["min"] = curve.constantMin * scale, ["max"] = curve.constantMax * scale };
+            default:
+                return new Dictionary<string, object> { ["mode"] = curve.mode.ToString() };
+        }
+    }
+    
+    private static object DescribeGradient(ParticleSystem.MinMaxGradient gradient)
+    {
+        switch (gradient.mode)
+        {
+            case ParticleSystemGradientMode.Color:
+                return UIControlHelper.ColorToDict(gradient.color);
+            case ParticleSystemGradientMode.TwoColors:
+                return new Dictionary<string, object>
+                {
+                    ["min"] = UIControlHelper.ColorToDict(gradient.colorMin),
+                    ["max"] = UIControlHelper.ColorToDict(gradient.colorMax)
+                };
+            default:
+                return new Dictionary<string, object> { ["mode"] = gradient.mode.ToString() };
+        }
+    }
+}
@@ -0,0 +1,80 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 粒子系统设置工具 - 按 {模块: {属性: 值}} 设置main、emission和shape模块的常用属性
+/// 未知模块或属性在修改前报错；restart清除现有粒子并重新播放
+/// </summary>
+public class ParticleSystemSetTool : IMCPTool
+{
+    public string ToolName => "particle_system_set";
+    
+    public string Description => "设置粒子系统属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (gameObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            ParticleSystem particleSystem = gameObject.GetComponent<ParticleSystem>();
+            if (particleSystem == null)
+            {
+                return MCPResponse.Error($"GameObject '{gameObject.name}' 上没有ParticleSystem组件");
+            }
+            
+            var modules = parameters.ContainsKey("modules") && parameters["modules"] is Dictionary<string, object> dict
+                ? dict
+                : new Dictionary<string, object>();
+            string error = ParticleSystemHelper.Validate(modules);
+            if (error != null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            if (modules.Count > 0)
+            {
+                Undo.RecordObject(particleSystem, "Set ParticleSystem Properties");
+                ParticleSystemHelper.Apply(particleSystem, modules);
+                EditorUtility.SetDirty(particleSystem);
+            }
+            
+            bool restart = parameters.ContainsKey("restart") && System.Convert.ToBoolean(parameters["restart"]);
+            if (restart)
+            {
+                particleSystem.Stop(true, ParticleSystemStopBehavior.StopEmittingAndClear);
+                particleSystem.Play(true);
+            }
+            
+            Debug.Log($"设置 '{gameObject.name}' 的粒子系统属性: {string.Join(", ", modules.Keys)}");
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["instanceId"] = instanceId,
+                ["gameObject"] = gameObject.name,
+                ["updatedModules"] = new List<string>(modules.Keys),
+                ["restarted"] = restart,
+                ["modules"] = ParticleSystemHelper.Describe(particleSystem)
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置粒子系统属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置粒子系统属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,41 @@
+using UnityEditor;
+
+/// <summary>
+/// 播放模式跟踪 - 记录域重载次数和最近一次播放模式变化
+/// 进入播放模式通常会重载脚本域，静态字段会被清空，因此计数保存在SessionState中
+/// </summary>
+[InitializeOnLoad]
+public static class PlayModeTracker
+{
+    private const string ReloadCountKey = "UnityMCP.DomainReloadCount";
+    private const string LastChangeKey = "UnityMCP.LastPlayModeChange";
+    private const string LastChangeTimeKey = "UnityMCP.LastPlayModeChangeTime";
+    
+    static PlayModeTracker()
+    {
+        // 每次域重载都会重新执行静态构造函数
+        SessionState.SetInt(ReloadCountKey, SessionState.GetInt(ReloadCountKey, 0) + 1);
+        EditorApplication.playModeStateChanged += OnPlayModeStateChanged;
+    }
+    
+    /// <summary>
+    /// 本次编辑器会话中的域重载次数
+    /// </summary>
+    public static int ReloadCount => SessionState.GetInt(ReloadCountKey, 0);
+    
+    /// <summary>
+    /// 最近一次播放模式变化 (EnteredEditMode、ExitingEditMode、EnteredPlayMode、ExitingPlayMode)
+    /// </summary>
+    public static string LastChange => SessionState.GetString(LastChangeKey, "");
+    
+    /// <summary>
+    /// 最近一次播放模式变化的时间
+    /// </summary>
+    public static string LastChangeTime => SessionState.GetString(LastChangeTimeKey, "");
+    
+    private static void OnPlayModeStateChanged(PlayModeStateChange change)
+    {
+        SessionState.SetString(LastChangeKey, change.ToString());
+        SessionState.SetString(LastChangeTimeKey, System.DateTime.Now.ToString("yyyy-MM-dd HH:mm:ss"));
+    }
+}
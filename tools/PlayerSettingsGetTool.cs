@@ -0,0 +1,41 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// PlayerSettings读取工具 - 获取常用PlayerSettings的快照，按平台的设置分别列出
+/// </summary>
+public class PlayerSettingsGetTool : IMCPTool
+{
+    public string ToolName => "player_settings_get";
+    
+    public string Description => "获取PlayerSettings";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            List<string> platforms = PlayerSettingsHelper.ReadPlatforms(parameters);
+            if (platforms != null)
+            {
+                var unknown = platforms.FindAll(name => !PlayerSettingsHelper.TryParsePlatform(name, out _));
+                if (unknown.Count > 0)
+                {
+                    return MCPResponse.Error($"未知平台: {string.Join(", ", unknown)}");
+                }
+            }
+            return MCPResponse.Success(PlayerSettingsHelper.Describe(platforms));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取PlayerSettings时出错: {e.Message}");
+            return MCPResponse.Error($"获取PlayerSettings失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
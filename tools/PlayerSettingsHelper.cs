@@ -0,0 +1,94 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// PlayerSettings辅助方法: 生成player_settings_get/set返回的快照
+/// 按平台的设置使用BuildTargetGroup，平台名与Go端的playerSettingPlatforms一致
+/// </summary>
+public static class PlayerSettingsHelper
+{
+    public static readonly string[] DefaultPlatforms = { "Standalone", "Android", "iOS", "WebGL" };
+    
+    /// <summary>
+    /// 解析平台名称
+    /// </summary>
+    public static bool TryParsePlatform(string name, out BuildTargetGroup group)
+    {
+        return System.Enum.TryParse(name, true, out group) && group != BuildTargetGroup.Unknown;
+    }
+    
+    /// <summary>
+    /// 生成PlayerSettings快照，platforms为空时使用默认平台
+    /// </summary>
+    public static Dictionary<string, object> Describe(IEnumerable<string> platforms)
+    {
+        var perPlatform = new Dictionary<string, object>();
+        foreach (string name in platforms ?? DefaultPlatforms)
+        {
+            if (!TryParsePlatform(name, out BuildTargetGroup group))
+            {
+                continue;
+            }
+            perPlatform[name] = DescribePlatform(group);
+        }
+        
+        return new Dictionary<string, object>
+        {
+            ["companyName"] = PlayerSettings.companyName,
+            ["productName"] = PlayerSettings.productName,
+            ["version"] = PlayerSettings.bundleVersion,
+            ["defaultOrientation"] = PlayerSettings.defaultInterfaceOrientation.ToString(),
+            ["runInBackground"] = PlayerSettings.runInBackground,
+            ["defaultScreenWidth"] = PlayerSettings.defaultScreenWidth,
+            ["defaultScreenHeight"] = PlayerSettings.defaultScreenHeight,
+            ["fullScreenMode"] = PlayerSettings.fullScreenMode.ToString(),
+            ["hasDefaultIcon"] = HasIcons(BuildTargetGroup.Unknown),
+            ["platforms"] = perPlatform
+        };
+    }
+    
+    private static Dictionary<string, object> DescribePlatform(BuildTargetGroup group)
+    {
+        return new Dictionary<string, object>
+        {
+            ["bundleIdentifier"] = PlayerSettings.GetApplicationIdentifier(group),
+            ["scriptingBackend"] = PlayerSettings.GetScriptingBackend(group).ToString(),
+            ["apiCompatibilityLevel"] = PlayerSettings.GetApiCompatibilityLevel(group).ToString(),
+            ["il2cppCompilerConfiguration"] = PlayerSettings.GetIl2CppCompilerConfiguration(group).ToString(),
+            ["scriptingDefineSymbols"] = PlayerSettings.GetScriptingDefineSymbolsForGroup(group),
+            ["hasIcons"] = HasIcons(group)
+        };
+    }
+    
+    /// <summary>
+    /// 平台是否设置了图标 (Unknown表示默认图标)
+    /// </summary>
+    private static bool HasIcons(BuildTargetGroup group)
+    {
+        Texture2D[] icons = PlayerSettings.GetIconsForTargetGroup(group);
+        if (icons == null) return false;
+        foreach (Texture2D icon in icons)
+        {
+            if (icon != null) return true;
+        }
+        return false;
+    }
+    
+    /// <summary>
+    /// 读取平台列表参数，未给出时返回null
+    /// </summary>
+    public static List<string> ReadPlatforms(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("platforms") || !(parameters["platforms"] is System.Collections.IEnumerable items))
+        {
+            return null;
+        }
+        var platforms = new List<string>();
+        foreach (var item in items)
+        {
+            platforms.Add(item.ToString());
+        }
+        return platforms;
+    }
+}
@@ -0,0 +1,116 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// PlayerSettings设置工具 - 修改项目的PlayerSettings
+/// 键名、值类型和确认要求由Go端检查，这里先解析全部枚举值再修改，避免部分修改
+/// </summary>
+public class PlayerSettingsSetTool : IMCPTool
+{
+    public string ToolName => "player_settings_set";
+    
+    public string Description => "设置PlayerSettings";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            var settings = (Dictionary<string, object>)parameters["settings"];
+            
+            BuildTargetGroup group = BuildTargetGroup.Unknown;
+            string platform = parameters.ContainsKey("platform") ? parameters["platform"].ToString() : null;
+            if (platform != null && !PlayerSettingsHelper.TryParsePlatform(platform, out group))
+            {
+                return MCPResponse.Error($"未知平台: {platform}");
+            }
+            
+            // 先解析枚举，任何一个无效都不做修改
+            var enums = new Dictionary<string, object>();
+            var problems = new List<string>();
+            ParseEnum<UIOrientation>(settings, "defaultOrientation", enums, problems);
+            ParseEnum<FullScreenMode>(settings, "fullScreenMode", enums, problems);
+            ParseEnum<ScriptingImplementation>(settings, "scriptingBackend", enums, problems);
+            ParseEnum<ApiCompatibilityLevel>(settings, "apiCompatibilityLevel", enums, problems);
+            ParseEnum<Il2CppCompilerConfiguration>(settings, "il2cppCompilerConfiguration", enums, problems);
+            if (problems.Count > 0)
+            {
+                return MCPResponse.Error(string.Join("; ", problems));
+            }
+            
+            var changed = new List<string>();
+            foreach (var entry in settings)
+            {
+                object value = enums.ContainsKey(entry.Key) ? enums[entry.Key] : entry.Value;
+                if (!Apply(entry.Key, value, group))
+                {
+                    return MCPResponse.Error($"不支持的设置: {entry.Key}");
+                }
+                changed.Add(entry.Key);
+            }
+            AssetDatabase.SaveAssets();
+            
+            Debug.Log($"修改PlayerSettings{(platform != null ? $" ({platform})" : "")}: {string.Join(", ", changed)}");
+            var result = PlayerSettingsHelper.Describe(platform != null ? new[] { platform } : null);
+            result["changed"] = changed;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置PlayerSettings时出错: {e.Message}");
+            return MCPResponse.Error($"设置PlayerSettings失败: {e.Message}");
+        }
+    }
+    
+    private static void ParseEnum<T>(Dictionary<string, object> settings, string key, Dictionary<string, object> enums, List<string> problems) where T : struct
+    {
+        if (!settings.ContainsKey(key))
+        {
+            return;
+        }
+        string name = settings[key].ToString();
+        if (System.Enum.TryParse(name, true, out T value))
+        {
+            enums[key] = value;
+        }
+        else
+        {
+            problems.Add($"{key} 的值 '{name}' 在当前Unity版本中不可用");
+        }
+    }
+    
+    private static bool Apply(string key, object value, BuildTargetGroup group)
+    {
+        switch (key)
+        {
+            case "companyName": PlayerSettings.companyName = value.ToString(); return true;
+            case "productName": PlayerSettings.productName = value.ToString(); return true;
+            case "version": PlayerSettings.bundleVersion = value.ToString(); return true;
+            case "defaultOrientation": PlayerSettings.defaultInterfaceOrientation = (UIOrientation)value; return true;
+            case "runInBackground": PlayerSettings.runInBackground = System.Convert.ToBoolean(value); return true;
+            case "defaultScreenWidth": PlayerSettings.defaultScreenWidth = System.Convert.ToInt32(value); return true;
+            case "defaultScreenHeight": PlayerSettings.defaultScreenHeight = System.Convert.ToInt32(value); return true;
+            case "fullScreenMode": PlayerSettings.fullScreenMode = (FullScreenMode)value; return true;
+            case "bundleIdentifier": PlayerSettings.SetApplicationIdentifier(group, value.ToString()); return true;
+            case "scriptingBackend": PlayerSettings.SetScriptingBackend(group, (ScriptingImplementation)value); return true;
+            case "apiCompatibilityLevel": PlayerSettings.SetApiCompatibilityLevel(group, (ApiCompatibilityLevel)value); return true;
+            case "il2cppCompilerConfiguration": PlayerSettings.SetIl2CppCompilerConfiguration(group, (Il2CppCompilerConfiguration)value); return true;
+            case "scriptingDefineSymbols": PlayerSettings.SetScriptingDefineSymbolsForGroup(group, value.ToString()); return true;
+            default: return false;
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("settings"))
+        {
+            return "缺少必需参数: settings";
+        }
+        if (!(parameters["settings"] is Dictionary<string, object>))
+        {
+            return "settings必须是对象";
+        }
+        return null;
+    }
+}
@@ -52,24 +52,32 @@ public class PrefabModifyTool : IMCPTool
                 ["originalStatus"] = status.ToString()
             };
             
+            // apply/revert 可以只处理一个属性覆盖 (mode=property + propertyPath)
+            string propertyPath = parameters.ContainsKey("propertyPath") ? parameters["propertyPath"].ToString() : null;
+            bool singleProperty = !string.IsNullOrEmpty(propertyPath) &&
+                (!parameters.ContainsKey("mode") || parameters["mode"].ToString().ToLower() == "property");
+            
             switch (operation)
             {
                 case "apply":
                 case "apply_all":
-                    return ApplyOverrides(instance, result);
+                    return singleProperty ? ApplyPropertyOverride(instance, propertyPath, result) : ApplyOverrides(instance, result);
                     
                 case "revert":
                 case "revert_all":
-                    return RevertOverrides(instance, result);
+                    return singleProperty ? RevertPropertyOverride(instance, propertyPath, result) : RevertOverrides(instance, result);
                     
                 case "unpack":
-                    return UnpackPrefab(instance, result);
+                    bool completely = !parameters.ContainsKey("completely") || System.Convert.ToBoolean(parameters["completely"]);
+                    return UnpackPrefab(instance, completely, result);
                     
                 case "disconnect":
                     return DisconnectPrefab(instance, result);
                     
                 case "check_overrides":
-                    return CheckOverrides(instance, result);
+                    bool includeDefaultOverrides = parameters.ContainsKey("includeDefaultOverrides") &&
+                        System.Convert.ToBoolean(parameters["includeDefaultOverrides"]);
+                    return CheckOverrides(instance, includeDefaultOverrides, result);
                     
                 default:
                     return MCPResponse.Error($"不支持的操作: {operation}。支持的操作: apply, revert, unpack, disconnect, check_overrides");
@@ -168,18 +176,110 @@ public class PrefabModifyTool : IMCPTool
     }
     
     /// <summary>
-    /// 解包预制体
+    /// 查找预制体实例中带有覆盖的属性，依次在实例层级中的GameObject和组件上查找
+    /// </summary>
+    private SerializedProperty FindOverriddenProperty(GameObject instance, string propertyPath)
+    {
+        var targets = new List<Object>();
+        foreach (Transform child in instance.GetComponentsInChildren<Transform>(true))
+        {
+            targets.Add(child.gameObject);
+            targets.AddRange(child.GetComponents<Component>());
+        }
+        
+        foreach (var target in targets)
+        {
+            if (target == null)
+            {
+                continue;
+            }
+            var property = new SerializedObject(target).FindProperty(propertyPath);
+            if (property != null && property.prefabOverride)
+            {
+                return property;
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 应用单个属性覆盖到预制体
     /// </summary>
-    private MCPResponse UnpackPrefab(GameObject instance, Dictionary<string, object> result)
+    private MCPResponse ApplyPropertyOverride(GameObject instance, string propertyPath, Dictionary<string, object> result)
     {
         try
         {
-            // 解包预制体（完全断开连接）
-            PrefabUtility.UnpackPrefabInstance(instance, PrefabUnpackMode.Completely, InteractionMode.UserAction);
+            var property = FindOverriddenProperty(instance, propertyPath);
+            if (property == null)
+            {
+                return MCPResponse.Error($"未找到属性覆盖: {propertyPath}");
+            }
+            
+            string prefabPath = PrefabUtility.GetPrefabAssetPathOfNearestInstanceRoot(instance);
+            PrefabUtility.ApplyPropertyOverride(property, prefabPath, InteractionMode.UserAction);
             
             result["success"] = true;
+            result["prefabPath"] = prefabPath;
+            result["propertyPath"] = propertyPath;
+            result["target"] = property.serializedObject.targetObject.name;
+            result["message"] = $"成功应用属性覆盖 {propertyPath} 到预制体";
+            
+            Debug.Log($"成功将 '{instance.name}' 的属性覆盖 {propertyPath} 应用到预制体: {prefabPath}");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            result["error"] = e.Message;
+            return MCPResponse.Error($"应用属性覆盖失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 恢复单个属性覆盖
+    /// </summary>
+    private MCPResponse RevertPropertyOverride(GameObject instance, string propertyPath, Dictionary<string, object> result)
+    {
+        try
+        {
+            var property = FindOverriddenProperty(instance, propertyPath);
+            if (property == null)
+            {
+                return MCPResponse.Error($"未找到属性覆盖: {propertyPath}");
+            }
+            
+            PrefabUtility.RevertPropertyOverride(property, InteractionMode.UserAction);
+            
+            result["success"] = true;
+            result["propertyPath"] = propertyPath;
+            result["target"] = property.serializedObject.targetObject.name;
+            result["message"] = $"成功恢复属性覆盖 {propertyPath}";
+            
+            Debug.Log($"成功恢复 '{instance.name}' 的属性覆盖 {propertyPath}");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            result["error"] = e.Message;
+            return MCPResponse.Error($"恢复属性覆盖失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 解包预制体，completely为false时只解包最外层，嵌套的预制体保持连接
+    /// </summary>
+    private MCPResponse UnpackPrefab(GameObject instance, bool completely, Dictionary<string, object> result)
+    {
+        try
+        {
+            var mode = completely ? PrefabUnpackMode.Completely : PrefabUnpackMode.OutermostRoot;
+            PrefabUtility.UnpackPrefabInstance(instance, mode, InteractionMode.UserAction);
+            
+            result["success"] = true;
+            result["completely"] = completely;
             result["unpackedInstanceId"] = instance.GetInstanceID();
-            result["message"] = "成功解包预制体，已完全断开与预制体的连接";
+            result["message"] = completely ? "成功解包预制体，已完全断开与预制体的连接" : "成功解包最外层预制体，嵌套预制体保持连接";
             
             Debug.Log($"成功解包预制体: '{instance.name}'");
             
@@ -219,17 +319,18 @@ public class PrefabModifyTool : IMCPTool
     /// <summary>
     /// 检查覆盖信息
     /// </summary>
-    private MCPResponse CheckOverrides(GameObject instance, Dictionary<string, object> result)
+    private MCPResponse CheckOverrides(GameObject instance, bool includeDefaultOverrides, Dictionary<string, object> result)
     {
         try
         {
-            bool hasOverrides = PrefabUtility.HasPrefabInstanceAnyOverrides(instance, false);
+            bool hasOverrides = PrefabUtility.HasPrefabInstanceAnyOverrides(instance, includeDefaultOverrides);
             result["hasOverrides"] = hasOverrides;
+            result["includeDefaultOverrides"] = includeDefaultOverrides;
             
             if (hasOverrides)
             {
                 // 获取对象覆盖
-                var objectOverrides = PrefabUtility.GetObjectOverrides(instance);
+                var objectOverrides = PrefabUtility.GetObjectOverrides(instance, includeDefaultOverrides);
                 var objectOverrideList = new List<Dictionary<string, object>>();
                 
                 foreach (var objOverride in objectOverrides)
@@ -251,6 +352,11 @@ public class PrefabModifyTool : IMCPTool
                     
                     foreach (var propOverride in propertyOverrides)
                     {
+                        // 默认覆盖 (根对象的位置、旋转、名称等) 只在includeDefaultOverrides时返回
+                        if (!includeDefaultOverrides && PrefabUtility.IsDefaultOverride(propOverride))
+                        {
+                            continue;
+                        }
                         propertyOverrideList.Add(new Dictionary<string, object>
                         {
                             ["propertyPath"] = propOverride.propertyPath,
@@ -261,7 +367,7 @@ public class PrefabModifyTool : IMCPTool
                     }
                     
                     result["propertyOverrides"] = propertyOverrideList;
-                    result["propertyOverrideCount"] = propertyOverrides.Length;
+                    result["propertyOverrideCount"] = propertyOverrideList.Count;
                 }
                 else
                 {
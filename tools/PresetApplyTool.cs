@@ -0,0 +1,209 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using System.Text.RegularExpressions;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.Presets;
+
+/// <summary>
+/// 预设应用工具 - 把Preset应用到资源导入器 (单个资源或文件夹) 或组件
+/// 导入器预设应用后重新导入资源，组件预设通过Undo记录属性修改
+/// </summary>
+public class PresetApplyTool : IMCPTool
+{
+    public string ToolName => "preset_apply";
+    
+    public string Description => "应用预设";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string presetPath = parameters["presetPath"].ToString();
+            Preset preset = AssetDatabase.LoadAssetAtPath<Preset>(presetPath);
+            if (preset == null)
+            {
+                return MCPResponse.Error($"预设不存在: {presetPath}");
+            }
+            if (!preset.IsValid())
+            {
+                return MCPResponse.Error($"预设无效，目标类型可能已不存在: {presetPath}");
+            }
+            bool dryRun = parameters.ContainsKey("dryRun") && System.Convert.ToBoolean(parameters["dryRun"]);
+            
+            var result = new Dictionary<string, object>
+            {
+                ["presetPath"] = presetPath,
+                ["presetTarget"] = preset.GetTargetFullTypeName(),
+                ["dryRun"] = dryRun
+            };
+            string error = parameters.ContainsKey("instanceId")
+                ? ApplyToComponent(preset, parameters, dryRun, result)
+                : ApplyToImporters(preset, parameters, dryRun, result);
+            if (error != null)
+            {
+                return MCPResponse.Error(error);
+            }
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"应用预设时出错: {e.Message}");
+            return MCPResponse.Error($"应用预设失败: {e.Message}");
+        }
+    }
+    
+    private string ApplyToComponent(Preset preset, Dictionary<string, object> parameters, bool dryRun, Dictionary<string, object> result)
+    {
+        int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+        GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+        if (gameObject == null)
+        {
+            return $"找不到GameObject: {instanceId}";
+        }
+        string componentType = parameters["componentType"].ToString();
+        Component component = gameObject.GetComponents<Component>().FirstOrDefault(c => c != null &&
+            (string.Equals(c.GetType().Name, componentType, System.StringComparison.OrdinalIgnoreCase) ||
+             string.Equals(c.GetType().FullName, componentType, System.StringComparison.OrdinalIgnoreCase)));
+        if (component == null)
+        {
+            return $"GameObject {gameObject.name} 上没有组件: {componentType}";
+        }
+        if (!preset.CanBeAppliedTo(component))
+        {
+            return $"预设的目标类型为 {preset.GetTargetTypeName()}，不能应用到 {component.GetType().Name}";
+        }
+        
+        bool unchanged = preset.DataEquals(component);
+        bool success = true;
+        if (!dryRun && !unchanged)
+        {
+            Undo.RecordObject(component, "Apply Preset");
+            success = preset.ApplyTo(component);
+            EditorUtility.SetDirty(component);
+        }
+        var target = new Dictionary<string, object>
+        {
+            ["instanceId"] = instanceId,
+            ["path"] = HierarchyPathHelper.GetPath(gameObject.transform),
+            ["componentType"] = component.GetType().Name,
+            ["success"] = success,
+            ["change"] = unchanged ? "unchanged" : "propertiesModified"
+        };
+        result["targets"] = new List<object> { target };
+        result["applied"] = success && !dryRun && !unchanged ? 1 : 0;
+        result["failed"] = success ? 0 : 1;
+        Debug.Log($"已将预设应用到 {gameObject.name} 的 {component.GetType().Name}");
+        return null;
+    }
+    
+    private string ApplyToImporters(Preset preset, Dictionary<string, object> parameters, bool dryRun, Dictionary<string, object> result)
+    {
+        var importers = new List<AssetImporter>();
+        int skipped = 0;
+        if (parameters.ContainsKey("assetPath"))
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            AssetImporter importer = AssetImporter.GetAtPath(assetPath);
+            if (importer == null)
+            {
+                return $"资源不存在: {assetPath}";
+            }
+            if (!preset.CanBeAppliedTo(importer))
+            {
+                return $"预设的目标类型为 {preset.GetTargetTypeName()}，不能应用到 {importer.GetType().Name} ({assetPath})";
+            }
+            importers.Add(importer);
+        }
+        else
+        {
+            string folder = parameters["folder"].ToString().TrimEnd('/');
+            if (!AssetDatabase.IsValidFolder(folder))
+            {
+                return $"文件夹不存在: {folder}";
+            }
+            List<Regex> includes = AssetFindUnusedTool.ReadStrings(parameters, "include").ConvertAll(AssetFindUnusedTool.WildcardToRegex);
+            List<Regex> excludes = AssetFindUnusedTool.ReadStrings(parameters, "exclude").ConvertAll(AssetFindUnusedTool.WildcardToRegex);
+            IEnumerable<string> paths = AssetDatabase.FindAssets("", new[] { folder })
+                .Select(AssetDatabase.GUIDToAssetPath)
+                .Distinct()
+                .Where(p => !AssetDatabase.IsValidFolder(p))
+                .Where(p => includes.Count == 0 || includes.Any(regex => regex.IsMatch(p)))
+                .Where(p => !excludes.Any(regex => regex.IsMatch(p)))
+                .OrderBy(p => p);
+            foreach (string path in paths)
+            {
+                AssetImporter importer = AssetImporter.GetAtPath(path);
+                // 导入器类型不同的资源 (如文件夹中的材质和脚本) 不是预设的目标
+                if (importer == null || !preset.CanBeAppliedTo(importer))
+                {
+                    skipped++;
+                    continue;
+                }
+                importers.Add(importer);
+            }
+        }
+        
+        var targets = new List<object>();
+        int applied = 0;
+        int failed = 0;
+        AssetDatabase.StartAssetEditing();
+        try
+        {
+            foreach (AssetImporter importer in importers)
+            {
+                bool unchanged = preset.DataEquals(importer);
+                bool success = true;
+                if (!dryRun && !unchanged)
+                {
+                    success = preset.ApplyTo(importer);
+                    if (success)
+                    {
+                        importer.SaveAndReimport();
+                        applied++;
+                    }
+                    else
+                    {
+                        failed++;
+                    }
+                }
+                targets.Add(new Dictionary<string, object>
+                {
+                    ["assetPath"] = importer.assetPath,
+                    ["importerType"] = importer.GetType().Name,
+                    ["success"] = success,
+                    ["change"] = unchanged ? "unchanged" : "reimported"
+                });
+            }
+        }
+        finally
+        {
+            AssetDatabase.StopAssetEditing();
+        }
+        
+        result["targets"] = targets;
+        result["applied"] = applied;
+        result["failed"] = failed;
+        result["skipped"] = skipped;
+        Debug.Log($"预设 {result["presetPath"]} 已应用到 {applied} 个资源{(dryRun ? " (试运行)" : "")}");
+        return null;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("presetPath"))
+        {
+            return "缺少必需参数: presetPath";
+        }
+        if (!parameters.ContainsKey("assetPath") && !parameters.ContainsKey("folder") && !parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: assetPath、folder或instanceId";
+        }
+        if (parameters.ContainsKey("instanceId") && !parameters.ContainsKey("componentType"))
+        {
+            return "缺少必需参数: componentType";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,32 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 层列表工具 - 获取项目中的32个层
+/// </summary>
+public class ProjectLayersGetTool : IMCPTool
+{
+    public string ToolName => "project_layers_get";
+    
+    public string Description => "获取项目层列表";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            return MCPResponse.Success(TagManagerHelper.DescribeLayers());
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取层列表时出错: {e.Message}");
+            return MCPResponse.Error($"获取层列表失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
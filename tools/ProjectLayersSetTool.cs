@@ -0,0 +1,60 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 层设置工具 - 为用户层 (8~31) 命名或清除名称
+/// </summary>
+public class ProjectLayersSetTool : IMCPTool
+{
+    public string ToolName => "project_layers_set";
+    
+    public string Description => "设置项目层名称";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int index = System.Convert.ToInt32(parameters["index"]);
+            string name = parameters["name"].ToString();
+            if (index < TagManagerHelper.FirstUserLayer || index > 31)
+            {
+                return MCPResponse.Error($"只能设置用户层 (8-31)，收到: {index}");
+            }
+            
+            SerializedObject tagManager = TagManagerHelper.Load();
+            SerializedProperty layers = tagManager.FindProperty("layers");
+            for (int i = 0; i < layers.arraySize; i++)
+            {
+                if (i != index && !string.IsNullOrEmpty(name) && layers.GetArrayElementAtIndex(i).stringValue == name)
+                {
+                    return MCPResponse.Error($"层名称 '{name}' 已被层 {i} 使用");
+                }
+            }
+            string previous = layers.GetArrayElementAtIndex(index).stringValue;
+            layers.GetArrayElementAtIndex(index).stringValue = name;
+            TagManagerHelper.Save(tagManager);
+            
+            Debug.Log($"设置层 {index}: '{previous}' -> '{name}'");
+            var result = TagManagerHelper.DescribeLayers();
+            result["index"] = index;
+            result["previousName"] = previous;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置层名称时出错: {e.Message}");
+            return MCPResponse.Error($"设置层名称失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("index") || !parameters.ContainsKey("name"))
+        {
+            return "缺少必需参数: index和name";
+        }
+        return null;
+    }
+}
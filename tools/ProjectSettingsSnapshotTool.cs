@@ -0,0 +1,166 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 项目设置快照工具 - capture导出各ProjectSettings资源的可编辑序列化属性 (属性路径到值)，restore按属性路径写回
+/// 快照的保存和比较在Go端完成；对象引用 (图标等) 不导出
+/// </summary>
+public class ProjectSettingsSnapshotTool : IMCPTool
+{
+    /// <summary>
+    /// 快照的设置名称对应的ProjectSettings资源，与Go端的settingsSnapshotSections一致
+    /// </summary>
+    private static readonly Dictionary<string, string> Sections = new Dictionary<string, string>
+    {
+        ["player"] = "ProjectSettings/ProjectSettings.asset",
+        ["quality"] = "ProjectSettings/QualitySettings.asset",
+        ["physics"] = "ProjectSettings/DynamicsManager.asset",
+        ["time"] = "ProjectSettings/TimeManager.asset",
+        ["tags"] = "ProjectSettings/TagManager.asset"
+    };
+    
+    public string ToolName => "project_settings_snapshot";
+    
+    public string Description => "导出或恢复项目设置快照";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string operation = parameters["operation"].ToString();
+            switch (operation)
+            {
+                case "capture":
+                {
+                    IEnumerable<string> sections = parameters.ContainsKey("sections") && parameters["sections"] is System.Collections.IEnumerable items
+                        ? items.Cast<object>().Select(item => item.ToString())
+                        : Sections.Keys;
+                    var settings = new Dictionary<string, object>();
+                    foreach (string section in sections)
+                    {
+                        if (!Sections.ContainsKey(section))
+                        {
+                            return MCPResponse.Error($"不支持的设置: {section}");
+                        }
+                        settings[section] = Capture(Load(section));
+                    }
+                    return MCPResponse.Success(new Dictionary<string, object> { ["settings"] = settings });
+                }
+                
+                case "restore":
+                {
+                    if (!(parameters.ContainsKey("settings") && parameters["settings"] is Dictionary<string, object> settings))
+                    {
+                        return MCPResponse.Error("缺少必需参数: settings");
+                    }
+                    var restored = new Dictionary<string, object>();
+                    var failed = new Dictionary<string, string>();
+                    foreach (var section in settings)
+                    {
+                        if (!Sections.ContainsKey(section.Key) || !(section.Value is Dictionary<string, object> values))
+                        {
+                            failed[section.Key] = "不支持的设置";
+                            continue;
+                        }
+                        SerializedObject serializedObject = Load(section.Key);
+                        var sectionFailed = new Dictionary<string, string>();
+                        restored[section.Key] = Restore(serializedObject, values, sectionFailed);
+                        serializedObject.ApplyModifiedProperties();
+                        foreach (var entry in sectionFailed)
+                        {
+                            failed[$"{section.Key}/{entry.Key}"] = entry.Value;
+                        }
+                    }
+                    AssetDatabase.SaveAssets();
+                    Debug.Log($"已恢复项目设置快照: {string.Join(", ", restored.Select(r => $"{r.Key} {r.Value}"))}");
+                    return MCPResponse.Success(new Dictionary<string, object>
+                    {
+                        ["restored"] = restored,
+                        ["failed"] = failed
+                    });
+                }
+                
+                default:
+                    return MCPResponse.Error($"不支持的操作: {operation}");
+            }
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"处理项目设置快照时出错: {e.Message}");
+            return MCPResponse.Error($"处理项目设置快照失败: {e.Message}");
+        }
+    }
+    
+    private static SerializedObject Load(string section)
+    {
+        Object[] assets = AssetDatabase.LoadAllAssetsAtPath(Sections[section]);
+        if (assets == null || assets.Length == 0)
+        {
+            throw new System.InvalidOperationException($"无法加载 {Sections[section]}");
+        }
+        return new SerializedObject(assets[0]);
+    }
+    
+    /// <summary>
+    /// 导出所有可编辑的值属性，数组和结构体展开为子属性，数组长度以 .Array.size 路径导出
+    /// </summary>
+    private static Dictionary<string, object> Capture(SerializedObject serializedObject)
+    {
+        var values = new Dictionary<string, object>();
+        SerializedProperty iterator = serializedObject.GetIterator();
+        bool enterChildren = true;
+        while (iterator.NextVisible(enterChildren))
+        {
+            enterChildren = iterator.propertyType == SerializedPropertyType.Generic;
+            string jsonType = SerializedPropertyHelper.JsonType(iterator);
+            if (!iterator.editable || jsonType == "generic" || jsonType == "reference" || jsonType == "unsupported")
+            {
+                continue;
+            }
+            values[iterator.propertyPath] = SerializedPropertyHelper.GetValue(iterator);
+        }
+        return values;
+    }
+    
+    /// <summary>
+    /// 写回属性值，返回写入的属性数；外层数组的长度先于内层写入，以便恢复被删除的数组元素
+    /// </summary>
+    private static int Restore(SerializedObject serializedObject, Dictionary<string, object> values, Dictionary<string, string> failed)
+    {
+        int restored = 0;
+        var levels = values.Keys
+            .GroupBy(path => path.EndsWith(".Array.size") ? CountOf(path, ".Array.data[") : int.MaxValue)
+            .OrderBy(group => group.Key);
+        foreach (var level in levels)
+        {
+            var batch = level.ToDictionary(path => path, path => values[path]);
+            restored += SerializedPropertyHelper.SetValues(serializedObject, batch, failed).Count;
+            // 数组长度需要先应用，之后才能找到新元素的属性
+            serializedObject.ApplyModifiedProperties();
+            serializedObject.Update();
+        }
+        return restored;
+    }
+    
+    private static int CountOf(string text, string marker)
+    {
+        int count = 0;
+        for (int index = text.IndexOf(marker); index >= 0; index = text.IndexOf(marker, index + marker.Length))
+        {
+            count++;
+        }
+        return count;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("operation") || string.IsNullOrEmpty(parameters["operation"]?.ToString()))
+        {
+            return "缺少必需参数: operation";
+        }
+        return null;
+    }
+}
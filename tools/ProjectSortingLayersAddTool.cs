@@ -0,0 +1,64 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 排序层添加工具 - 在末尾添加2D排序层
+/// </summary>
+public class ProjectSortingLayersAddTool : IMCPTool
+{
+    public string ToolName => "project_sorting_layers_add";
+    
+    public string Description => "添加排序层";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string name = parameters["name"].ToString();
+            SerializedObject tagManager = TagManagerHelper.Load();
+            SerializedProperty layers = tagManager.FindProperty("m_SortingLayers");
+            var usedIds = new HashSet<long>();
+            for (int i = 0; i < layers.arraySize; i++)
+            {
+                SerializedProperty layer = layers.GetArrayElementAtIndex(i);
+                if (layer.FindPropertyRelative("name").stringValue == name)
+                {
+                    return MCPResponse.Error($"排序层已存在: {name}");
+                }
+                usedIds.Add(layer.FindPropertyRelative("uniqueID").longValue);
+            }
+            
+            // uniqueID与Unity编辑器一样使用非零随机数
+            long uniqueId;
+            do
+            {
+                uniqueId = (uint)System.Guid.NewGuid().GetHashCode();
+            } while (uniqueId == 0 || usedIds.Contains(uniqueId));
+            
+            layers.InsertArrayElementAtIndex(layers.arraySize);
+            SerializedProperty added = layers.GetArrayElementAtIndex(layers.arraySize - 1);
+            added.FindPropertyRelative("name").stringValue = name;
+            added.FindPropertyRelative("uniqueID").longValue = uniqueId;
+            TagManagerHelper.Save(tagManager);
+            
+            Debug.Log($"添加排序层: {name}");
+            return MCPResponse.Success(TagManagerHelper.DescribeSortingLayers());
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"添加排序层时出错: {e.Message}");
+            return MCPResponse.Error($"添加排序层失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("name"))
+        {
+            return "缺少必需参数: name";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,32 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 排序层列表工具 - 按渲染顺序获取2D排序层
+/// </summary>
+public class ProjectSortingLayersGetTool : IMCPTool
+{
+    public string ToolName => "project_sorting_layers_get";
+    
+    public string Description => "获取排序层列表";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            return MCPResponse.Success(TagManagerHelper.DescribeSortingLayers());
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取排序层列表时出错: {e.Message}");
+            return MCPResponse.Error($"获取排序层列表失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,69 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 排序层排序工具 - 按给出的名称顺序重新排列2D排序层，必须列出全部排序层
+/// </summary>
+public class ProjectSortingLayersReorderTool : IMCPTool
+{
+    public string ToolName => "project_sorting_layers_reorder";
+    
+    public string Description => "重新排列排序层";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            var order = TagManagerHelper.ReadNames(parameters, "order");
+            SerializedObject tagManager = TagManagerHelper.Load();
+            SerializedProperty layers = tagManager.FindProperty("m_SortingLayers");
+            
+            var current = new List<string>();
+            for (int i = 0; i < layers.arraySize; i++)
+            {
+                current.Add(layers.GetArrayElementAtIndex(i).FindPropertyRelative("name").stringValue);
+            }
+            var unknown = order.FindAll(name => !current.Contains(name));
+            var omitted = current.FindAll(name => !order.Contains(name));
+            if (unknown.Count > 0 || omitted.Count > 0)
+            {
+                var problems = new List<string>();
+                if (unknown.Count > 0) problems.Add($"不存在的排序层: {string.Join(", ", unknown)}");
+                if (omitted.Count > 0) problems.Add($"缺少排序层: {string.Join(", ", omitted)}");
+                return MCPResponse.Error(string.Join("; ", problems));
+            }
+            
+            // 逐个把目标位置的排序层移动到位
+            for (int target = 0; target < order.Count; target++)
+            {
+                int source = current.IndexOf(order[target]);
+                if (source != target)
+                {
+                    layers.MoveArrayElement(source, target);
+                    current.RemoveAt(source);
+                    current.Insert(target, order[target]);
+                }
+            }
+            TagManagerHelper.Save(tagManager);
+            
+            Debug.Log($"重新排列排序层: {string.Join(", ", order)}");
+            return MCPResponse.Success(TagManagerHelper.DescribeSortingLayers());
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"重新排列排序层时出错: {e.Message}");
+            return MCPResponse.Error($"重新排列排序层失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("order"))
+        {
+            return "缺少必需参数: order";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,56 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 标签添加工具 - 向TagManager添加标签，已存在的标签跳过
+/// </summary>
+public class ProjectTagsAddTool : IMCPTool
+{
+    public string ToolName => "project_tags_add";
+    
+    public string Description => "添加项目标签";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            SerializedObject tagManager = TagManagerHelper.Load();
+            SerializedProperty tags = tagManager.FindProperty("tags");
+            var existing = TagManagerHelper.GetUserTags(tagManager);
+            var added = new List<string>();
+            foreach (string tag in TagManagerHelper.ReadNames(parameters, "tags"))
+            {
+                if (existing.Contains(tag) || System.Array.IndexOf(TagManagerHelper.BuiltinTags, tag) >= 0)
+                {
+                    continue;
+                }
+                tags.InsertArrayElementAtIndex(tags.arraySize);
+                tags.GetArrayElementAtIndex(tags.arraySize - 1).stringValue = tag;
+                existing.Add(tag);
+                added.Add(tag);
+            }
+            TagManagerHelper.Save(tagManager);
+            
+            Debug.Log($"添加标签: {string.Join(", ", added)}");
+            var result = TagManagerHelper.DescribeTags();
+            result["added"] = added;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"添加标签时出错: {e.Message}");
+            return MCPResponse.Error($"添加标签失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("tags"))
+        {
+            return "缺少必需参数: tags";
+        }
+        return null;
+    }
+}
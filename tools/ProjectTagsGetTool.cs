@@ -0,0 +1,32 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 标签列表工具 - 获取项目中的所有标签
+/// </summary>
+public class ProjectTagsGetTool : IMCPTool
+{
+    public string ToolName => "project_tags_get";
+    
+    public string Description => "获取项目标签列表";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            return MCPResponse.Success(TagManagerHelper.DescribeTags());
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取标签列表时出错: {e.Message}");
+            return MCPResponse.Error($"获取标签列表失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,64 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 标签删除工具 - 从TagManager删除标签，内置标签不能删除
+/// </summary>
+public class ProjectTagsRemoveTool : IMCPTool
+{
+    public string ToolName => "project_tags_remove";
+    
+    public string Description => "删除项目标签";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            SerializedObject tagManager = TagManagerHelper.Load();
+            SerializedProperty tags = tagManager.FindProperty("tags");
+            var removed = new List<string>();
+            var missing = new List<string>();
+            foreach (string tag in TagManagerHelper.ReadNames(parameters, "tags"))
+            {
+                if (System.Array.IndexOf(TagManagerHelper.BuiltinTags, tag) >= 0)
+                {
+                    return MCPResponse.Error($"不能删除内置标签: {tag}");
+                }
+                int index = TagManagerHelper.GetUserTags(tagManager).IndexOf(tag);
+                if (index < 0)
+                {
+                    missing.Add(tag);
+                    continue;
+                }
+                tags.DeleteArrayElementAtIndex(index);
+                removed.Add(tag);
+            }
+            if (missing.Count > 0)
+            {
+                return MCPResponse.Error($"标签不存在: {string.Join(", ", missing)}");
+            }
+            TagManagerHelper.Save(tagManager);
+            
+            Debug.Log($"删除标签: {string.Join(", ", removed)}");
+            var result = TagManagerHelper.DescribeTags();
+            result["removed"] = removed;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"删除标签时出错: {e.Message}");
+            return MCPResponse.Error($"删除标签失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("tags"))
+        {
+            return "缺少必需参数: tags";
+        }
+        return null;
+    }
+}
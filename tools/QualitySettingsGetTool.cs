@@ -0,0 +1,31 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+
+/// <summary>
+/// 质量设置读取工具 - 列出质量等级及其主要设置、当前等级和各平台默认等级
+/// </summary>
+public class QualitySettingsGetTool : IMCPTool
+{
+    public string ToolName => "quality_settings_get";
+    
+    public string Description => "获取质量设置";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            return MCPResponse.Success(QualitySettingsHelper.Describe());
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取质量设置时出错: {e.Message}");
+            return MCPResponse.Error($"获取质量设置失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,221 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 质量设置辅助方法: 通过SerializedObject读写 ProjectSettings/QualitySettings.asset
+/// QualitySettings的静态属性只作用于当前等级，用序列化数据可以修改任意等级而不切换当前等级
+/// </summary>
+public static class QualitySettingsHelper
+{
+    public const string QualitySettingsPath = "ProjectSettings/QualitySettings.asset";
+    
+    /// <summary>
+    /// 工具键名对应的序列化字段；枚举字段按名称在数组中的下标保存
+    /// textureQuality在Unity 2022.2之后改名为globalTextureMipmapLimit
+    /// </summary>
+    private class Field
+    {
+        public string[] propertyNames;
+        public string[] enumNames;
+        
+        public Field(string[] enumNames, params string[] propertyNames)
+        {
+            this.propertyNames = propertyNames;
+            this.enumNames = enumNames;
+        }
+    }
+    
+    private static readonly Dictionary<string, Field> Fields = new Dictionary<string, Field>
+    {
+        ["shadows"] = new Field(new[] { "Disable", "HardOnly", "All" }, "shadows"),
+        ["shadowDistance"] = new Field(null, "shadowDistance"),
+        ["shadowResolution"] = new Field(new[] { "Low", "Medium", "High", "VeryHigh" }, "shadowResolution"),
+        ["shadowCascades"] = new Field(null, "shadowCascades"),
+        ["antiAliasing"] = new Field(null, "antiAliasing"),
+        ["vSyncCount"] = new Field(null, "vSyncCount"),
+        ["textureQuality"] = new Field(null, "globalTextureMipmapLimit", "textureQuality"),
+        ["anisotropicFiltering"] = new Field(new[] { "Disable", "Enable", "ForceEnable" }, "anisotropicTextures"),
+        ["lodBias"] = new Field(null, "lodBias"),
+        ["maximumLODLevel"] = new Field(null, "maximumLODLevel"),
+        ["pixelLightCount"] = new Field(null, "pixelLightCount"),
+        ["particleRaycastBudget"] = new Field(null, "particleRaycastBudget"),
+        ["softParticles"] = new Field(null, "softParticles"),
+        ["realtimeReflectionProbes"] = new Field(null, "realtimeReflectionProbes")
+    };
+    
+    /// <summary>
+    /// 重新加载质量设置
+    /// </summary>
+    public static SerializedObject Load()
+    {
+        Object[] assets = AssetDatabase.LoadAllAssetsAtPath(QualitySettingsPath);
+        if (assets == null || assets.Length == 0)
+        {
+            throw new System.InvalidOperationException($"无法加载 {QualitySettingsPath}");
+        }
+        return new SerializedObject(assets[0]);
+    }
+    
+    /// <summary>
+    /// 按名称 (大小写不敏感) 或下标查找质量等级，找不到时返回-1
+    /// </summary>
+    public static int FindLevel(Dictionary<string, object> parameters, out string error)
+    {
+        error = null;
+        string[] names = QualitySettings.names;
+        if (parameters.ContainsKey("index"))
+        {
+            int index = System.Convert.ToInt32(parameters["index"]);
+            if (index < 0 || index >= names.Length)
+            {
+                error = $"质量等级下标超出范围: {index}，共有 {names.Length} 个等级";
+                return -1;
+            }
+            return index;
+        }
+        string level = parameters.ContainsKey("level") ? parameters["level"].ToString() : "";
+        for (int i = 0; i < names.Length; i++)
+        {
+            if (string.Equals(names[i], level, System.StringComparison.OrdinalIgnoreCase))
+            {
+                return i;
+            }
+        }
+        error = $"未找到质量等级: {level}，可用等级: {string.Join(", ", names)}";
+        return -1;
+    }
+    
+    /// <summary>
+    /// 描述一个质量等级
+    /// </summary>
+    public static Dictionary<string, object> DescribeLevel(SerializedObject qualitySettings, int index)
+    {
+        SerializedProperty level = qualitySettings.FindProperty("m_QualitySettings").GetArrayElementAtIndex(index);
+        var result = new Dictionary<string, object>
+        {
+            ["index"] = index,
+            ["name"] = level.FindPropertyRelative("name").stringValue,
+            ["active"] = index == QualitySettings.GetQualityLevel()
+        };
+        foreach (var entry in Fields)
+        {
+            SerializedProperty property = FindField(level, entry.Value);
+            if (property == null)
+            {
+                continue;
+            }
+            result[entry.Key] = ReadValue(property, entry.Value);
+        }
+        return result;
+    }
+    
+    /// <summary>
+    /// 全部质量等级、当前等级和各平台的默认等级
+    /// </summary>
+    public static Dictionary<string, object> Describe()
+    {
+        SerializedObject qualitySettings = Load();
+        var levels = new List<object>();
+        int count = qualitySettings.FindProperty("m_QualitySettings").arraySize;
+        for (int i = 0; i < count; i++)
+        {
+            levels.Add(DescribeLevel(qualitySettings, i));
+        }
+        
+        var platformDefaults = new Dictionary<string, object>();
+        SerializedProperty perPlatform = qualitySettings.FindProperty("m_PerPlatformDefaultQuality");
+        if (perPlatform != null)
+        {
+            string[] names = QualitySettings.names;
+            for (int i = 0; i < perPlatform.arraySize; i++)
+            {
+                SerializedProperty pair = perPlatform.GetArrayElementAtIndex(i);
+                int level = pair.FindPropertyRelative("second").intValue;
+                platformDefaults[pair.FindPropertyRelative("first").stringValue] = level >= 0 && level < names.Length ? names[level] : level.ToString();
+            }
+        }
+        
+        int current = QualitySettings.GetQualityLevel();
+        return new Dictionary<string, object>
+        {
+            ["levels"] = levels,
+            ["activeIndex"] = current,
+            ["activeName"] = QualitySettings.names[current],
+            ["platformDefaults"] = platformDefaults
+        };
+    }
+    
+    /// <summary>
+    /// 修改一个质量等级，返回当前Unity版本中不存在的键
+    /// </summary>
+    public static List<string> Apply(SerializedObject qualitySettings, int index, Dictionary<string, object> settings)
+    {
+        SerializedProperty level = qualitySettings.FindProperty("m_QualitySettings").GetArrayElementAtIndex(index);
+        var unsupported = new List<string>();
+        foreach (var entry in settings)
+        {
+            if (!Fields.TryGetValue(entry.Key, out Field field) || FindField(level, field) == null)
+            {
+                unsupported.Add(entry.Key);
+            }
+        }
+        if (unsupported.Count > 0)
+        {
+            return unsupported;
+        }
+        
+        foreach (var entry in settings)
+        {
+            Field field = Fields[entry.Key];
+            SerializedProperty property = FindField(level, field);
+            if (field.enumNames != null)
+            {
+                property.intValue = System.Array.IndexOf(field.enumNames, entry.Value.ToString());
+            }
+            else if (property.propertyType == SerializedPropertyType.Boolean)
+            {
+                property.boolValue = System.Convert.ToBoolean(entry.Value);
+            }
+            else if (property.propertyType == SerializedPropertyType.Float)
+            {
+                property.floatValue = System.Convert.ToSingle(entry.Value);
+            }
+            else
+            {
+                property.intValue = System.Convert.ToInt32(entry.Value);
+            }
+        }
+        qualitySettings.ApplyModifiedProperties();
+        AssetDatabase.SaveAssets();
+        return unsupported;
+    }
+    
+    private static SerializedProperty FindField(SerializedProperty level, Field field)
+    {
+        foreach (string name in field.propertyNames)
+        {
+            SerializedProperty property = level.FindPropertyRelative(name);
+            if (property != null)
+            {
+                return property;
+            }
+        }
+        return null;
+    }
+    
+    private static object ReadValue(SerializedProperty property, Field field)
+    {
+        if (field.enumNames != null)
+        {
+            int value = property.intValue;
+            return value >= 0 && value < field.enumNames.Length ? field.enumNames[value] : (object)value;
+        }
+        switch (property.propertyType)
+        {
+            case SerializedPropertyType.Boolean: return property.boolValue;
+            case SerializedPropertyType.Float: return property.floatValue;
+            default: return property.intValue;
+        }
+    }
+}
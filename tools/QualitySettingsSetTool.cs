@@ -0,0 +1,67 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 质量设置工具 - 修改指定质量等级的设置，或将其设为当前等级
+/// 键名和取值由Go端检查，这里检查当前Unity版本是否支持
+/// </summary>
+public class QualitySettingsSetTool : IMCPTool
+{
+    public string ToolName => "quality_settings_set";
+    
+    public string Description => "设置质量等级";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int index = QualitySettingsHelper.FindLevel(parameters, out string error);
+            if (index < 0)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            SerializedObject qualitySettings = QualitySettingsHelper.Load();
+            var changed = new List<string>();
+            if (parameters.ContainsKey("settings") && parameters["settings"] is Dictionary<string, object> settings && settings.Count > 0)
+            {
+                List<string> unsupported = QualitySettingsHelper.Apply(qualitySettings, index, settings);
+                if (unsupported.Count > 0)
+                {
+                    return MCPResponse.Error($"当前Unity版本不支持这些设置: {string.Join(", ", unsupported)}");
+                }
+                changed.AddRange(settings.Keys);
+            }
+            
+            bool setActive = parameters.ContainsKey("setActive") && System.Convert.ToBoolean(parameters["setActive"]);
+            // 修改的是当前等级时也重新应用，使编辑器立即生效
+            if (setActive || index == QualitySettings.GetQualityLevel())
+            {
+                QualitySettings.SetQualityLevel(index, true);
+            }
+            
+            string name = QualitySettings.names[index];
+            Debug.Log($"修改质量等级 '{name}': {string.Join(", ", changed)}{(setActive ? " (设为当前等级)" : "")}");
+            
+            var result = QualitySettingsHelper.DescribeLevel(QualitySettingsHelper.Load(), index);
+            result["changed"] = changed;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置质量等级时出错: {e.Message}");
+            return MCPResponse.Error($"设置质量等级失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("level") && !parameters.ContainsKey("index"))
+        {
+            return "缺少必需参数: level或index";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,133 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.Rendering;
+using UnityEditor;
+using UnityEditor.SceneManagement;
+
+/// <summary>
+/// 场景光照设置工具 - 设置当前场景的天空盒、环境光、雾和全局光照
+/// RenderSettings属于当前活动场景，修改后标记场景为已修改；全局光照开关保存在场景的Lighting Settings资源中
+/// </summary>
+public class RenderSettingsSetTool : IMCPTool
+{
+    public string ToolName => "render_settings_set";
+    
+    public string Description => "设置场景光照（天空盒、环境光、雾、全局光照）";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            // 先检查会失败的部分，避免部分修改
+            Material skybox = null;
+            bool setSkybox = parameters.ContainsKey("skyboxMaterialPath");
+            if (setSkybox)
+            {
+                string skyboxPath = parameters["skyboxMaterialPath"].ToString();
+                if (!string.IsNullOrEmpty(skyboxPath))
+                {
+                    skybox = AssetDatabase.LoadAssetAtPath<Material>(skyboxPath);
+                    if (skybox == null)
+                    {
+                        return MCPResponse.Error($"未找到材质: {skyboxPath}");
+                    }
+                }
+            }
+            
+            LightingSettings lightingSettings = null;
+            bool setGI = parameters.ContainsKey("realtimeGI") || parameters.ContainsKey("bakedGI");
+            if (setGI)
+            {
+                lightingSettings = GetLightingSettings();
+                if (lightingSettings == null)
+                {
+                    return MCPResponse.Error("当前场景没有Lighting Settings资源，请先在Lighting窗口中新建后再设置全局光照");
+                }
+            }
+            
+            if (setSkybox) RenderSettings.skybox = skybox;
+            if (parameters.ContainsKey("ambientMode"))
+            {
+                RenderSettings.ambientMode = (AmbientMode)System.Enum.Parse(typeof(AmbientMode), parameters["ambientMode"].ToString());
+            }
+            if (parameters.ContainsKey("ambientColor")) RenderSettings.ambientLight = UIControlHelper.ReadColor(parameters["ambientColor"], RenderSettings.ambientLight);
+            if (parameters.ContainsKey("ambientSkyColor")) RenderSettings.ambientSkyColor = UIControlHelper.ReadColor(parameters["ambientSkyColor"], RenderSettings.ambientSkyColor);
+            if (parameters.ContainsKey("ambientEquatorColor")) RenderSettings.ambientEquatorColor = UIControlHelper.ReadColor(parameters["ambientEquatorColor"], RenderSettings.ambientEquatorColor);
+            if (parameters.ContainsKey("ambientGroundColor")) RenderSettings.ambientGroundColor = UIControlHelper.ReadColor(parameters["ambientGroundColor"], RenderSettings.ambientGroundColor);
+            if (parameters.ContainsKey("ambientIntensity")) RenderSettings.ambientIntensity = System.Convert.ToSingle(parameters["ambientIntensity"]);
+            
+            if (parameters.ContainsKey("fog")) RenderSettings.fog = System.Convert.ToBoolean(parameters["fog"]);
+            if (parameters.ContainsKey("fogColor")) RenderSettings.fogColor = UIControlHelper.ReadColor(parameters["fogColor"], RenderSettings.fogColor);
+            if (parameters.ContainsKey("fogMode"))
+            {
+                RenderSettings.fogMode = (FogMode)System.Enum.Parse(typeof(FogMode), parameters["fogMode"].ToString());
+            }
+            if (parameters.ContainsKey("fogDensity")) RenderSettings.fogDensity = System.Convert.ToSingle(parameters["fogDensity"]);
+            if (parameters.ContainsKey("fogStartDistance")) RenderSettings.fogStartDistance = System.Convert.ToSingle(parameters["fogStartDistance"]);
+            if (parameters.ContainsKey("fogEndDistance")) RenderSettings.fogEndDistance = System.Convert.ToSingle(parameters["fogEndDistance"]);
+            
+            if (setGI)
+            {
+                Undo.RecordObject(lightingSettings, "Set Global Illumination");
+                if (parameters.ContainsKey("realtimeGI")) lightingSettings.realtimeGI = System.Convert.ToBoolean(parameters["realtimeGI"]);
+                if (parameters.ContainsKey("bakedGI")) lightingSettings.bakedGI = System.Convert.ToBoolean(parameters["bakedGI"]);
+                EditorUtility.SetDirty(lightingSettings);
+            }
+            else
+            {
+                lightingSettings = GetLightingSettings();
+            }
+            
+            EditorSceneManager.MarkSceneDirty(EditorSceneManager.GetActiveScene());
+            
+            var result = new Dictionary<string, object>
+            {
+                ["scene"] = EditorSceneManager.GetActiveScene().path,
+                ["skyboxMaterialPath"] = RenderSettings.skybox != null ? AssetDatabase.GetAssetPath(RenderSettings.skybox) : null,
+                ["ambientMode"] = RenderSettings.ambientMode.ToString(),
+                ["ambientColor"] = UIControlHelper.ColorToDict(RenderSettings.ambientLight),
+                ["ambientSkyColor"] = UIControlHelper.ColorToDict(RenderSettings.ambientSkyColor),
+                ["ambientEquatorColor"] = UIControlHelper.ColorToDict(RenderSettings.ambientEquatorColor),
+                ["ambientGroundColor"] = UIControlHelper.ColorToDict(RenderSettings.ambientGroundColor),
+                ["ambientIntensity"] = RenderSettings.ambientIntensity,
+                ["fog"] = RenderSettings.fog,
+                ["fogColor"] = UIControlHelper.ColorToDict(RenderSettings.fogColor),
+                ["fogMode"] = RenderSettings.fogMode.ToString(),
+                ["fogDensity"] = RenderSettings.fogDensity,
+                ["fogStartDistance"] = RenderSettings.fogStartDistance,
+                ["fogEndDistance"] = RenderSettings.fogEndDistance,
+                ["realtimeGI"] = lightingSettings != null ? (object)lightingSettings.realtimeGI : null,
+                ["bakedGI"] = lightingSettings != null ? (object)lightingSettings.bakedGI : null
+            };
+            
+            Debug.Log("成功设置场景光照");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置场景光照时出错: {e.Message}");
+            return MCPResponse.Error($"设置场景光照失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 当前场景的Lighting Settings资源，没有时Unity会抛出异常，这里返回null
+    /// </summary>
+    private static LightingSettings GetLightingSettings()
+    {
+        try
+        {
+            return Lightmapping.lightingSettings;
+        }
+        catch (System.Exception)
+        {
+            return null;
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,168 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.Rendering;
+using UnityEditor;
+
+/// <summary>
+/// 渲染器设置工具 - 给对象的Renderer (MeshRenderer、SkinnedMeshRenderer、SpriteRenderer等) 指定材质和渲染设置
+/// </summary>
+public class RendererSetTool : IMCPTool
+{
+    public string ToolName => "renderer_set";
+    
+    public string Description => "设置渲染器的材质、阴影和排序";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (targetObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            Renderer renderer = targetObject.GetComponent<Renderer>();
+            if (renderer == null)
+            {
+                return MCPResponse.Error($"对象 '{targetObject.name}' 没有Renderer组件");
+            }
+            
+            Undo.RecordObject(renderer, "Set Renderer");
+            
+            // 材质: materialPaths按槽位替换 (null保留原材质)，或materialPath + slot替换单个槽位
+            var materials = new List<Material>(renderer.sharedMaterials);
+            if (parameters.ContainsKey("materialPaths") && parameters["materialPaths"] is System.Collections.IList paths)
+            {
+                for (int slot = 0; slot < paths.Count; slot++)
+                {
+                    if (paths[slot] == null || paths[slot].ToString() == "")
+                    {
+                        continue;
+                    }
+                    string error = SetSlot(materials, slot, paths[slot].ToString());
+                    if (error != null) return MCPResponse.Error(error);
+                }
+                // 数组长度决定槽位数量
+                if (materials.Count > paths.Count)
+                {
+                    materials.RemoveRange(paths.Count, materials.Count - paths.Count);
+                }
+            }
+            else if (parameters.ContainsKey("materialPath"))
+            {
+                int slot = parameters.ContainsKey("slot") ? System.Convert.ToInt32(parameters["slot"]) : 0;
+                if (slot > materials.Count)
+                {
+                    return MCPResponse.Error($"槽位 {slot} 超出范围，渲染器有 {materials.Count} 个材质槽位");
+                }
+                string error = SetSlot(materials, slot, parameters["materialPath"].ToString());
+                if (error != null) return MCPResponse.Error(error);
+            }
+            renderer.sharedMaterials = materials.ToArray();
+            
+            if (parameters.ContainsKey("castShadows"))
+            {
+                renderer.shadowCastingMode = (ShadowCastingMode)System.Enum.Parse(typeof(ShadowCastingMode), parameters["castShadows"].ToString());
+            }
+            if (parameters.ContainsKey("receiveShadows"))
+            {
+                renderer.receiveShadows = System.Convert.ToBoolean(parameters["receiveShadows"]);
+            }
+            if (parameters.ContainsKey("enabled"))
+            {
+                renderer.enabled = System.Convert.ToBoolean(parameters["enabled"]);
+            }
+            if (parameters.ContainsKey("sortingLayer"))
+            {
+                string layerName = parameters["sortingLayer"].ToString();
+                if (!SortingLayerExists(layerName))
+                {
+                    return MCPResponse.Error($"排序层不存在: {layerName}");
+                }
+                renderer.sortingLayerName = layerName;
+            }
+            if (parameters.ContainsKey("orderInLayer"))
+            {
+                renderer.sortingOrder = System.Convert.ToInt32(parameters["orderInLayer"]);
+            }
+            
+            EditorUtility.SetDirty(renderer);
+            
+            var slots = new List<object>();
+            Material[] assigned = renderer.sharedMaterials;
+            for (int i = 0; i < assigned.Length; i++)
+            {
+                slots.Add(new Dictionary<string, object>
+                {
+                    ["slot"] = i,
+                    ["materialName"] = assigned[i] != null ? assigned[i].name : null,
+                    ["materialPath"] = assigned[i] != null ? AssetDatabase.GetAssetPath(assigned[i]) : null
+                });
+            }
+            var result = new Dictionary<string, object>
+            {
+                ["gameObjectName"] = targetObject.name,
+                ["instanceId"] = instanceId,
+                ["rendererType"] = renderer.GetType().Name,
+                ["materials"] = slots,
+                ["castShadows"] = renderer.shadowCastingMode.ToString(),
+                ["receiveShadows"] = renderer.receiveShadows,
+                ["enabled"] = renderer.enabled,
+                ["sortingLayer"] = renderer.sortingLayerName,
+                ["orderInLayer"] = renderer.sortingOrder
+            };
+            
+            Debug.Log($"设置对象 '{targetObject.name}' 的渲染器");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置渲染器时出错: {e.Message}");
+            return MCPResponse.Error($"设置渲染器失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 设置一个材质槽位，槽位等于当前数量时追加
+    /// </summary>
+    private string SetSlot(List<Material> materials, int slot, string materialPath)
+    {
+        Material material = AssetDatabase.LoadAssetAtPath<Material>(materialPath);
+        if (material == null)
+        {
+            return $"未找到材质: {materialPath}";
+        }
+        while (materials.Count <= slot)
+        {
+            materials.Add(null);
+        }
+        materials[slot] = material;
+        return null;
+    }
+    
+    private bool SortingLayerExists(string layerName)
+    {
+        foreach (var layer in SortingLayer.layers)
+        {
+            if (layer.name == layerName) return true;
+        }
+        return false;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters == null)
+        {
+            return "参数不能为空";
+        }
+        
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        
+        return null;
+    }
+}
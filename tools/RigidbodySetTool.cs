@@ -0,0 +1,168 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 刚体设置工具 - 设置Rigidbody/Rigidbody2D的质量、阻力、重力、插值、碰撞检测和约束，组件不存在时添加
+/// </summary>
+public class RigidbodySetTool : IMCPTool
+{
+    public string ToolName => "rigidbody_set";
+    
+    public string Description => "设置Rigidbody/Rigidbody2D组件属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (gameObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            bool is2D = parameters.ContainsKey("is2D") && System.Convert.ToBoolean(parameters["is2D"]);
+            var result = is2D ? Apply2D(gameObject, parameters) : Apply3D(gameObject, parameters);
+            
+            Debug.Log($"成功设置 '{gameObject.name}' 的{(is2D ? "Rigidbody2D" : "Rigidbody")}组件属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置刚体属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置刚体属性失败: {e.Message}");
+        }
+    }
+    
+    private static Dictionary<string, object> Apply3D(GameObject gameObject, Dictionary<string, object> parameters)
+    {
+        Rigidbody body = gameObject.GetComponent<Rigidbody>();
+        bool added = body == null;
+        if (added)
+        {
+            body = Undo.AddComponent<Rigidbody>(gameObject);
+        }
+        Undo.RecordObject(body, "Set Rigidbody Properties");
+        
+        if (parameters.ContainsKey("mass")) body.mass = System.Convert.ToSingle(parameters["mass"]);
+        if (parameters.ContainsKey("drag")) body.drag = System.Convert.ToSingle(parameters["drag"]);
+        if (parameters.ContainsKey("angularDrag")) body.angularDrag = System.Convert.ToSingle(parameters["angularDrag"]);
+        if (parameters.ContainsKey("useGravity")) body.useGravity = System.Convert.ToBoolean(parameters["useGravity"]);
+        if (parameters.ContainsKey("isKinematic")) body.isKinematic = System.Convert.ToBoolean(parameters["isKinematic"]);
+        if (parameters.ContainsKey("interpolation"))
+        {
+            body.interpolation = (RigidbodyInterpolation)System.Enum.Parse(typeof(RigidbodyInterpolation), parameters["interpolation"].ToString());
+        }
+        if (parameters.ContainsKey("collisionDetection"))
+        {
+            body.collisionDetectionMode = (CollisionDetectionMode)System.Enum.Parse(typeof(CollisionDetectionMode), parameters["collisionDetection"].ToString());
+        }
+        if (parameters.ContainsKey("constraints") && parameters["constraints"] is System.Collections.IEnumerable names)
+        {
+            RigidbodyConstraints constraints = RigidbodyConstraints.None;
+            foreach (var name in names)
+            {
+                constraints |= (RigidbodyConstraints)System.Enum.Parse(typeof(RigidbodyConstraints), name.ToString());
+            }
+            body.constraints = constraints;
+        }
+        EditorUtility.SetDirty(body);
+        
+        return new Dictionary<string, object>
+        {
+            ["name"] = gameObject.name,
+            ["instanceId"] = gameObject.GetInstanceID(),
+            ["componentType"] = "Rigidbody",
+            ["added"] = added,
+            ["mass"] = body.mass,
+            ["drag"] = body.drag,
+            ["angularDrag"] = body.angularDrag,
+            ["useGravity"] = body.useGravity,
+            ["isKinematic"] = body.isKinematic,
+            ["interpolation"] = body.interpolation.ToString(),
+            ["collisionDetection"] = body.collisionDetectionMode.ToString(),
+            ["constraints"] = ConstraintNames(body.constraints)
+        };
+    }
+    
+    private static Dictionary<string, object> Apply2D(GameObject gameObject, Dictionary<string, object> parameters)
+    {
+        Rigidbody2D body = gameObject.GetComponent<Rigidbody2D>();
+        bool added = body == null;
+        if (added)
+        {
+            body = Undo.AddComponent<Rigidbody2D>(gameObject);
+        }
+        Undo.RecordObject(body, "Set Rigidbody2D Properties");
+        
+        if (parameters.ContainsKey("mass")) body.mass = System.Convert.ToSingle(parameters["mass"]);
+        if (parameters.ContainsKey("drag")) body.drag = System.Convert.ToSingle(parameters["drag"]);
+        if (parameters.ContainsKey("angularDrag")) body.angularDrag = System.Convert.ToSingle(parameters["angularDrag"]);
+        if (parameters.ContainsKey("gravityScale")) body.gravityScale = System.Convert.ToSingle(parameters["gravityScale"]);
+        if (parameters.ContainsKey("isKinematic")) body.isKinematic = System.Convert.ToBoolean(parameters["isKinematic"]);
+        if (parameters.ContainsKey("interpolation"))
+        {
+            body.interpolation = (RigidbodyInterpolation2D)System.Enum.Parse(typeof(RigidbodyInterpolation2D), parameters["interpolation"].ToString());
+        }
+        if (parameters.ContainsKey("collisionDetection"))
+        {
+            body.collisionDetectionMode = (CollisionDetectionMode2D)System.Enum.Parse(typeof(CollisionDetectionMode2D), parameters["collisionDetection"].ToString());
+        }
+        if (parameters.ContainsKey("constraints") && parameters["constraints"] is System.Collections.IEnumerable names)
+        {
+            RigidbodyConstraints2D constraints = RigidbodyConstraints2D.None;
+            foreach (var name in names)
+            {
+                constraints |= (RigidbodyConstraints2D)System.Enum.Parse(typeof(RigidbodyConstraints2D), name.ToString());
+            }
+            body.constraints = constraints;
+        }
+        EditorUtility.SetDirty(body);
+        
+        return new Dictionary<string, object>
+        {
+            ["name"] = gameObject.name,
+            ["instanceId"] = gameObject.GetInstanceID(),
+            ["componentType"] = "Rigidbody2D",
+            ["added"] = added,
+            ["mass"] = body.mass,
+            ["drag"] = body.drag,
+            ["angularDrag"] = body.angularDrag,
+            ["gravityScale"] = body.gravityScale,
+            ["isKinematic"] = body.isKinematic,
+            ["interpolation"] = body.interpolation.ToString(),
+            ["collisionDetection"] = body.collisionDetectionMode.ToString(),
+            ["constraints"] = ConstraintNames(body.constraints)
+        };
+    }
+    
+    /// <summary>
+    /// 约束位掩码中单独的约束名 (不含FreezePosition等组合值)
+    /// </summary>
+    private static List<string> ConstraintNames(System.Enum constraints)
+    {
+        var names = new List<string>();
+        long value = System.Convert.ToInt64(constraints);
+        foreach (System.Enum flag in System.Enum.GetValues(constraints.GetType()))
+        {
+            long bit = System.Convert.ToInt64(flag);
+            // 只输出单个位的标志
+            if (bit != 0 && (bit & (bit - 1)) == 0 && (value & bit) != 0)
+            {
+                names.Add(flag.ToString());
+            }
+        }
+        return names;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
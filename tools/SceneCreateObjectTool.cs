@@ -16,11 +16,39 @@ public class SceneCreateObjectTool : IMCPTool
     {
         try
         {
-            string objectName = parameters.ContainsKey("name") ? parameters["name"].ToString() : "New GameObject";
             int parentInstanceId = parameters.ContainsKey("parentId") ? System.Convert.ToInt32(parameters["parentId"]) : 0;
+            string primitive = parameters.ContainsKey("primitive") ? parameters["primitive"].ToString() : null;
+            string prefabPath = parameters.ContainsKey("prefabPath") ? parameters["prefabPath"].ToString() : null;
             
-            // 创建新的GameObject
-            GameObject newObject = new GameObject(objectName);
+            // 创建新的GameObject: 基本几何体、预制体实例或空对象
+            GameObject newObject;
+            if (!string.IsNullOrEmpty(primitive))
+            {
+                if (!System.Enum.TryParse<PrimitiveType>(primitive, true, out PrimitiveType primitiveType))
+                {
+                    return MCPResponse.Error($"不支持的基本几何体: {primitive}");
+                }
+                newObject = GameObject.CreatePrimitive(primitiveType);
+            }
+            else if (!string.IsNullOrEmpty(prefabPath))
+            {
+                GameObject prefab = AssetDatabase.LoadAssetAtPath<GameObject>(prefabPath);
+                if (prefab == null)
+                {
+                    return MCPResponse.Error($"未找到预制体: {prefabPath}");
+                }
+                newObject = PrefabUtility.InstantiatePrefab(prefab) as GameObject;
+            }
+            else
+            {
+                newObject = new GameObject("New GameObject");
+            }
+            
+            if (parameters.ContainsKey("name"))
+            {
+                newObject.name = parameters["name"].ToString();
+            }
+            string objectName = newObject.name;
             
             // 如果指定了父对象，设置父子关系
             if (parentInstanceId != 0)
@@ -110,6 +138,12 @@ public class SceneCreateObjectTool : IMCPTool
                 }
             }
             
+            // 设置激活状态
+            if (parameters.ContainsKey("active"))
+            {
+                newObject.SetActive(System.Convert.ToBoolean(parameters["active"]));
+            }
+            
             // 注册到Undo系统
             Undo.RegisterCreatedObjectUndo(newObject, $"Create {objectName}");
             
@@ -120,6 +154,8 @@ public class SceneCreateObjectTool : IMCPTool
             {
                 ["name"] = newObject.name,
                 ["instanceId"] = newObject.GetInstanceID(),
+                ["hierarchyPath"] = GetHierarchyPath(newObject.transform),
+                ["active"] = newObject.activeSelf,
                 ["position"] = new Dictionary<string, float>
                 {
                     ["x"] = newObject.transform.position.x,
@@ -142,6 +178,14 @@ public class SceneCreateObjectTool : IMCPTool
                 ["layer"] = newObject.layer,
                 ["layerName"] = LayerMask.LayerToName(newObject.layer)
             };
+            if (!string.IsNullOrEmpty(primitive))
+            {
+                result["primitive"] = primitive.ToLower();
+            }
+            if (!string.IsNullOrEmpty(prefabPath))
+            {
+                result["prefabPath"] = prefabPath;
+            }
             
             Debug.Log($"成功创建GameObject: {objectName} (InstanceID: {newObject.GetInstanceID()})");
             
@@ -154,10 +198,27 @@ public class SceneCreateObjectTool : IMCPTool
         }
     }
     
+    /// <summary>
+    /// 返回对象在场景层级中的路径，如 Canvas/Panel/Button
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
     public string ValidateParameters(Dictionary<string, object> parameters)
     {
         // 此工具不需要必需参数，所有参数都是可选的
-        // name参数如果不提供会使用默认值
+        // name参数如果不提供会使用基本几何体或预制体的名称
+        if (parameters.ContainsKey("primitive") && parameters.ContainsKey("prefabPath"))
+        {
+            return "primitive和prefabPath不能同时使用";
+        }
         return null;
     }
 }
@@ -1,4 +1,5 @@
 using System.Collections.Generic;
+using System.Linq;
 using System.Net.Sockets;
 using UnityEngine;
 using UnityEditor;
@@ -10,112 +11,121 @@ public class SceneDeleteObjectTool : IMCPTool
 {
     public string ToolName => "scene_delete_object";
     
-    public string Description => "删除场景中的GameObject";
+    public string Description => "删除场景中的GameObject，支持批量删除和预览";
     
     public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
     {
         try
         {
-            // 获取必需参数
-            if (!parameters.ContainsKey("instanceId"))
+            // 获取要删除的对象: instanceId和instanceIds可以同时使用
+            var instanceIds = new List<int>();
+            if (parameters.ContainsKey("instanceId"))
             {
-                return MCPResponse.Error("缺少必需参数: instanceId");
+                instanceIds.Add(System.Convert.ToInt32(parameters["instanceId"]));
+            }
+            if (parameters.ContainsKey("instanceIds") && parameters["instanceIds"] is System.Collections.IEnumerable ids)
+            {
+                foreach (var id in ids)
+                {
+                    instanceIds.Add(System.Convert.ToInt32(id));
+                }
+            }
+            instanceIds = instanceIds.Distinct().ToList();
+            if (instanceIds.Count == 0)
+            {
+                return MCPResponse.Error("缺少必需参数: instanceId 或 instanceIds");
             }
             
-            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
             bool deleteChildren = parameters.ContainsKey("deleteChildren") ? 
                 System.Convert.ToBoolean(parameters["deleteChildren"]) : true;
+            bool dryRun = parameters.ContainsKey("dryRun") ? System.Convert.ToBoolean(parameters["dryRun"]) : false;
+            
+            // 查找对象，未找到的单独报告
+            var targets = new List<GameObject>();
+            var notFound = new List<Dictionary<string, object>>();
+            foreach (int instanceId in instanceIds)
+            {
+                GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+                if (targetObject == null)
+                {
+                    notFound.Add(new Dictionary<string, object>
+                    {
+                        ["instanceId"] = instanceId,
+                        ["error"] = "未找到GameObject"
+                    });
+                    continue;
+                }
+                targets.Add(targetObject);
+            }
             
-            // 获取GameObject
-            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
-            if (targetObject == null)
+            if (targets.Count == 0)
             {
-                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {string.Join(", ", instanceIds)})");
             }
             
-            // 收集删除信息
+            // 删除子对象时，祖先也在列表中的对象会随祖先一起删除
+            if (deleteChildren)
+            {
+                targets = targets.Where(t => !targets.Any(other => other != t && t.transform.IsChildOf(other.transform))).ToList();
+            }
+            
+            var objects = targets.Select(t => DescribeObject(t, deleteChildren)).ToList();
+            
             var result = new Dictionary<string, object>
             {
-                ["deletedObject"] = new Dictionary<string, object>
-                {
-                    ["name"] = targetObject.name,
-                    ["instanceId"] = targetObject.GetInstanceID(),
-                    ["tag"] = targetObject.tag,
-                    ["layer"] = targetObject.layer,
-                    ["sceneName"] = targetObject.scene.name
-                },
+                ["dryRun"] = dryRun,
                 ["deleteChildren"] = deleteChildren,
+                ["requestedCount"] = instanceIds.Count,
+                ["notFound"] = notFound,
                 ["timestamp"] = System.DateTime.Now.ToString("yyyy-MM-dd HH:mm:ss")
             };
             
-            // 统计子对象信息
-            int childCount = 0;
-            var childNames = new List<string>();
-            
-            if (deleteChildren)
+            // 预览: 只返回将被删除的对象
+            if (dryRun)
             {
-                CountChildrenRecursive(targetObject.transform, ref childCount, childNames);
-                result["childrenDeleted"] = childCount;
-                result["childNames"] = childNames;
+                result["wouldDelete"] = objects;
+                result["wouldDeletePaths"] = CollectPaths(objects, deleteChildren);
+                result["message"] = $"预览: 将删除 {targets.Count} 个GameObject";
+                return MCPResponse.Success(result);
             }
-            else
+            
+            // 所有删除合并为一个Undo操作
+            Undo.IncrementCurrentGroup();
+            int undoGroup = Undo.GetCurrentGroup();
+            Undo.SetCurrentGroupName(targets.Count == 1 ? $"Delete {targets[0].name}" : $"Delete {targets.Count} GameObjects");
+            
+            foreach (var targetObject in targets)
             {
-                // 如果不删除子对象，将子对象移动到父级
-                Transform parent = targetObject.transform.parent;
-                var movedChildren = new List<Dictionary<string, object>>();
-                
-                for (int i = targetObject.transform.childCount - 1; i >= 0; i--)
+                if (!deleteChildren)
                 {
-                    Transform child = targetObject.transform.GetChild(i);
-                    child.SetParent(parent);
-                    
-                    movedChildren.Add(new Dictionary<string, object>
+                    // 如果不删除子对象，将子对象移动到父级
+                    Transform parent = targetObject.transform.parent;
+                    for (int i = targetObject.transform.childCount - 1; i >= 0; i--)
                     {
-                        ["name"] = child.name,
-                        ["instanceId"] = child.GetInstanceID(),
-                        ["newParent"] = parent?.name ?? "Root"
-                    });
+                        Undo.SetTransformParent(targetObject.transform.GetChild(i), parent, "Move child to parent");
+                    }
                 }
                 
-                result["movedChildren"] = movedChildren;
-                result["movedChildrenCount"] = movedChildren.Count;
+                // 注册Undo操作
+                Undo.DestroyObjectImmediate(targetObject);
             }
+            Undo.CollapseUndoOperations(undoGroup);
             
-            // 获取父对象信息
-            if (targetObject.transform.parent != null)
+            var deletedPaths = CollectPaths(objects, deleteChildren);
+            result["deletedObjects"] = objects;
+            result["deletedPaths"] = deletedPaths;
+            result["deletedCount"] = deletedPaths.Count;
+            result["undoRegistered"] = true;
+            result["undoGroupName"] = Undo.GetCurrentGroupName();
+            if (objects.Count == 1)
             {
-                result["parentObject"] = new Dictionary<string, object>
-                {
-                    ["name"] = targetObject.transform.parent.name,
-                    ["instanceId"] = targetObject.transform.parent.GetInstanceID()
-                };
+                // 兼容单个对象删除的结果格式
+                result["deletedObject"] = objects[0];
             }
+            result["message"] = $"成功删除 {objects.Count} 个GameObject" +
+                (notFound.Count > 0 ? $"，{notFound.Count} 个InstanceID未找到" : "");
             
-            // 检查是否为预制体实例
-            bool isPrefabInstance = PrefabUtility.IsPartOfPrefabInstance(targetObject);
-            if (isPrefabInstance)
-            {
-                result["wasPrefabInstance"] = true;
-                GameObject prefabAsset = PrefabUtility.GetCorrespondingObjectFromSource(targetObject);
-                if (prefabAsset != null)
-                {
-                    result["prefabAssetPath"] = AssetDatabase.GetAssetPath(prefabAsset);
-                }
-            }
-            else
-            {
-                result["wasPrefabInstance"] = false;
-            }
-            
-            // 注册Undo操作
-            Undo.DestroyObjectImmediate(targetObject);
-            
-            result["success"] = true;
-            result["message"] = $"成功删除GameObject: {targetObject.name}" + 
-                (deleteChildren && childCount > 0 ? $" 及其 {childCount} 个子对象" : "");
-            
-            Debug.Log($"成功删除GameObject: {targetObject.name} (InstanceID: {instanceId})" + 
-                (deleteChildren && childCount > 0 ? $" 及其 {childCount} 个子对象" : ""));
+            Debug.Log($"成功删除 {objects.Count} 个GameObject: {string.Join(", ", objects.Select(o => o["path"]))}");
             
             return MCPResponse.Success(result);
         }
@@ -127,35 +137,113 @@ public class SceneDeleteObjectTool : IMCPTool
     }
     
     /// <summary>
-    /// 递归计算子对象数量和名称
+    /// 在删除之前收集对象信息
     /// </summary>
-    private void CountChildrenRecursive(Transform parent, ref int count, List<string> names)
+    private Dictionary<string, object> DescribeObject(GameObject targetObject, bool deleteChildren)
+    {
+        var info = new Dictionary<string, object>
+        {
+            ["name"] = targetObject.name,
+            ["instanceId"] = targetObject.GetInstanceID(),
+            ["path"] = GetHierarchyPath(targetObject.transform),
+            ["tag"] = targetObject.tag,
+            ["layer"] = targetObject.layer,
+            ["sceneName"] = targetObject.scene.name,
+            ["wasPrefabInstance"] = PrefabUtility.IsPartOfPrefabInstance(targetObject)
+        };
+        
+        if (PrefabUtility.IsPartOfPrefabInstance(targetObject))
+        {
+            GameObject prefabAsset = PrefabUtility.GetCorrespondingObjectFromSource(targetObject);
+            if (prefabAsset != null)
+            {
+                info["prefabAssetPath"] = AssetDatabase.GetAssetPath(prefabAsset);
+            }
+        }
+        
+        if (deleteChildren)
+        {
+            var childPaths = new List<string>();
+            CollectChildPaths(targetObject.transform, childPaths);
+            info["childrenDeleted"] = childPaths.Count;
+            info["childPaths"] = childPaths;
+        }
+        else
+        {
+            // 子对象将被移动到父级
+            info["movedChildren"] = Enumerable.Range(0, targetObject.transform.childCount)
+                .Select(i => targetObject.transform.GetChild(i))
+                .Select(child => new Dictionary<string, object>
+                {
+                    ["name"] = child.name,
+                    ["instanceId"] = child.gameObject.GetInstanceID(),
+                    ["newParent"] = targetObject.transform.parent?.name ?? "Root"
+                })
+                .ToList();
+        }
+        
+        return info;
+    }
+    
+    /// <summary>
+    /// 汇总所有被删除对象的路径 (包括子对象)
+    /// </summary>
+    private List<string> CollectPaths(List<Dictionary<string, object>> objects, bool deleteChildren)
+    {
+        var paths = new List<string>();
+        foreach (var info in objects)
+        {
+            paths.Add(info["path"].ToString());
+            if (deleteChildren)
+            {
+                paths.AddRange((List<string>)info["childPaths"]);
+            }
+        }
+        return paths;
+    }
+    
+    /// <summary>
+    /// 递归收集子对象的层级路径
+    /// </summary>
+    private void CollectChildPaths(Transform parent, List<string> paths)
     {
         for (int i = 0; i < parent.childCount; i++)
         {
             Transform child = parent.GetChild(i);
-            count++;
-            names.Add(child.name);
+            paths.Add(GetHierarchyPath(child));
             
-            // 递归计算子对象的子对象
-            CountChildrenRecursive(child, ref count, names);
+            // 递归收集子对象的子对象
+            CollectChildPaths(child, paths);
+        }
+    }
+    
+    /// <summary>
+    /// 对象的层级路径，如 Level/Spawners/Enemy01
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
         }
+        return path;
     }
     
     public string ValidateParameters(Dictionary<string, object> parameters)
     {
         // 检查必需参数
-        if (!parameters.ContainsKey("instanceId"))
+        if (!parameters.ContainsKey("instanceId") && !parameters.ContainsKey("instanceIds"))
         {
-            return "缺少必需参数: instanceId";
+            return "缺少必需参数: instanceId 或 instanceIds";
         }
         
         // 验证instanceId是否为有效数字
-        if (!int.TryParse(parameters["instanceId"].ToString(), out _))
+        if (parameters.ContainsKey("instanceId") && !int.TryParse(parameters["instanceId"].ToString(), out _))
         {
             return "instanceId必须是有效的整数";
         }
         
         return null;
     }
-}
\ No newline at end of file
+}
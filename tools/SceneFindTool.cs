@@ -4,12 +4,30 @@ using System.Linq;
 using UnityEngine;
 using UnityEditor;
 using UnityEngine.SceneManagement;
+using System.Text.RegularExpressions;
 
 /// <summary>
 /// 场景对象查找工具 - 按条件查找场景中的GameObject
 /// </summary>
 public class SceneFindTool : IMCPTool
 {
+    /// <summary>
+    /// 搜索条件，所有条件之间为AND关系
+    /// </summary>
+    private class SearchCriteria
+    {
+        public string Name;
+        public Regex NameRegex;
+        public Regex PathPattern;
+        public string Tag;
+        public List<string> ComponentTypes = new List<string>();
+        public bool AnyComponent;
+        public string Layer;
+        public bool ActiveOnly;
+        public bool IncludeInactive = true;
+        public bool ExactMatch;
+    }
+    
     public string ToolName => "scene_find_objects";
     
     public string Description => "按条件查找场景中的GameObject（名称、标签、组件等）";
@@ -20,11 +38,42 @@ public class SceneFindTool : IMCPTool
         {
             // 获取搜索参数
             string objectName = parameters.ContainsKey("name") ? parameters["name"].ToString() : "";
+            string nameRegex = parameters.ContainsKey("nameRegex") ? parameters["nameRegex"].ToString() : "";
+            string path = parameters.ContainsKey("path") ? parameters["path"].ToString() : "";
             string tag = parameters.ContainsKey("tag") ? parameters["tag"].ToString() : "";
             string componentType = parameters.ContainsKey("componentType") ? parameters["componentType"].ToString() : "";
             string layer = parameters.ContainsKey("layer") ? parameters["layer"].ToString() : "";
             bool activeOnly = parameters.ContainsKey("activeOnly") ? System.Convert.ToBoolean(parameters["activeOnly"]) : false;
+            bool includeInactive = parameters.ContainsKey("includeInactive") ? System.Convert.ToBoolean(parameters["includeInactive"]) : true;
+            bool anyComponent = parameters.ContainsKey("anyComponent") ? System.Convert.ToBoolean(parameters["anyComponent"]) : false;
             bool exactMatch = parameters.ContainsKey("exactMatch") ? System.Convert.ToBoolean(parameters["exactMatch"]) : false;
+            
+            var componentTypes = new List<string>();
+            if (parameters.ContainsKey("componentTypes") && parameters["componentTypes"] is System.Collections.IEnumerable typeValues)
+            {
+                foreach (var type in typeValues)
+                {
+                    componentTypes.Add(type.ToString());
+                }
+            }
+            if (!string.IsNullOrEmpty(componentType))
+            {
+                componentTypes.Add(componentType);
+            }
+            
+            var criteria = new SearchCriteria
+            {
+                Name = objectName,
+                NameRegex = string.IsNullOrEmpty(nameRegex) ? null : new Regex(nameRegex),
+                PathPattern = string.IsNullOrEmpty(path) ? null : PathToRegex(path),
+                Tag = tag,
+                ComponentTypes = componentTypes,
+                AnyComponent = anyComponent,
+                Layer = layer,
+                ActiveOnly = activeOnly,
+                IncludeInactive = includeInactive,
+                ExactMatch = exactMatch
+            };
             int maxResults = parameters.ContainsKey("maxResults") ? System.Convert.ToInt32(parameters["maxResults"]) : 100;
             string scenePath = parameters.ContainsKey("scenePath") ? parameters["scenePath"].ToString() : "";
             
@@ -33,10 +82,14 @@ public class SceneFindTool : IMCPTool
                 ["searchCriteria"] = new Dictionary<string, object>
                 {
                     ["name"] = objectName,
+                    ["nameRegex"] = nameRegex,
+                    ["path"] = path,
                     ["tag"] = tag,
-                    ["componentType"] = componentType,
+                    ["componentTypes"] = componentTypes,
+                    ["anyComponent"] = anyComponent,
                     ["layer"] = layer,
                     ["activeOnly"] = activeOnly,
+                    ["includeInactive"] = includeInactive,
                     ["exactMatch"] = exactMatch,
                     ["maxResults"] = maxResults,
                     ["scenePath"] = scenePath
@@ -70,20 +123,24 @@ public class SceneFindTool : IMCPTool
             // 执行搜索
             var foundObjects = new List<Dictionary<string, object>>();
             
+            int matchCount = 0;
+            
             foreach (GameObject obj in searchObjects)
             {
-                if (foundObjects.Count >= maxResults)
-                    break;
-                
-                if (MatchesSearchCriteria(obj, objectName, tag, componentType, layer, activeOnly, exactMatch))
+                if (MatchesSearchCriteria(obj, criteria))
                 {
-                    foundObjects.Add(CreateObjectInfo(obj));
+                    matchCount++;
+                    if (foundObjects.Count < maxResults)
+                    {
+                        foundObjects.Add(CreateObjectInfo(obj));
+                    }
                 }
             }
             
             result["foundObjects"] = foundObjects;
             result["foundCount"] = foundObjects.Count;
-            result["limitReached"] = foundObjects.Count >= maxResults;
+            result["totalMatches"] = matchCount;
+            result["limitReached"] = matchCount > maxResults;
             
             // 搜索统计
             result["searchStatistics"] = CreateSearchStatistics(foundObjects);
@@ -157,59 +214,58 @@ public class SceneFindTool : IMCPTool
     /// <summary>
     /// 检查对象是否符合搜索条件
     /// </summary>
-    private bool MatchesSearchCriteria(GameObject obj, string objectName, string tag, string componentType, 
-        string layer, bool activeOnly, bool exactMatch)
+    private bool MatchesSearchCriteria(GameObject obj, SearchCriteria criteria)
     {
-        // 检查激活状态
-        if (activeOnly && !obj.activeInHierarchy)
+        // 检查激活状态: activeOnly只看对象自身，includeInactive=false时排除父对象未激活的对象
+        if (criteria.ActiveOnly && !obj.activeSelf)
+            return false;
+        if (!criteria.IncludeInactive && !obj.activeInHierarchy)
             return false;
         
-        // 检查名称
+        // 检查名称，包含*或?时按通配符匹配
+        string objectName = criteria.Name;
         if (!string.IsNullOrEmpty(objectName))
         {
-            bool nameMatches = exactMatch ? 
-                string.Equals(obj.name, objectName, System.StringComparison.OrdinalIgnoreCase) :
-                obj.name.IndexOf(objectName, System.StringComparison.OrdinalIgnoreCase) >= 0;
+            bool nameMatches;
+            if (objectName.Contains("*") || objectName.Contains("?"))
+                nameMatches = WildcardToRegex(objectName, false).IsMatch(obj.name);
+            else
+                nameMatches = criteria.ExactMatch ? 
+                    string.Equals(obj.name, objectName, System.StringComparison.OrdinalIgnoreCase) :
+                    obj.name.IndexOf(objectName, System.StringComparison.OrdinalIgnoreCase) >= 0;
             
             if (!nameMatches)
                 return false;
         }
         
+        if (criteria.NameRegex != null && !criteria.NameRegex.IsMatch(obj.name))
+            return false;
+        
+        // 检查层级路径
+        if (criteria.PathPattern != null && !criteria.PathPattern.IsMatch(GetHierarchyPath(obj.transform)))
+            return false;
+        
         // 检查标签
-        if (!string.IsNullOrEmpty(tag))
+        if (!string.IsNullOrEmpty(criteria.Tag))
         {
-            if (!string.Equals(obj.tag, tag, System.StringComparison.OrdinalIgnoreCase))
+            if (!string.Equals(obj.tag, criteria.Tag, System.StringComparison.OrdinalIgnoreCase))
                 return false;
         }
         
-        // 检查组件
-        if (!string.IsNullOrEmpty(componentType))
+        // 检查组件: 默认需要全部组件，anyComponent时任意一个即可
+        if (criteria.ComponentTypes.Count > 0)
         {
-            bool hasComponent = false;
             Component[] components = obj.GetComponents<Component>();
+            bool matches = criteria.AnyComponent
+                ? criteria.ComponentTypes.Any(type => HasComponent(components, type))
+                : criteria.ComponentTypes.All(type => HasComponent(components, type));
             
-            foreach (Component comp in components)
-            {
-                if (comp != null)
-                {
-                    string compTypeName = comp.GetType().Name;
-                    string compFullTypeName = comp.GetType().FullName;
-                    
-                    if (string.Equals(compTypeName, componentType, System.StringComparison.OrdinalIgnoreCase) ||
-                        string.Equals(compFullTypeName, componentType, System.StringComparison.OrdinalIgnoreCase) ||
-                        compFullTypeName.EndsWith("." + componentType, System.StringComparison.OrdinalIgnoreCase))
-                    {
-                        hasComponent = true;
-                        break;
-                    }
-                }
-            }
-            
-            if (!hasComponent)
+            if (!matches)
                 return false;
         }
         
         // 检查层级
+        string layer = criteria.Layer;
         if (!string.IsNullOrEmpty(layer))
         {
             bool layerMatches = false;
@@ -233,6 +289,66 @@ public class SceneFindTool : IMCPTool
         return true;
     }
     
+    /// <summary>
+    /// 检查组件列表中是否有指定类型 (类名、完整类名或命名空间后缀)
+    /// </summary>
+    private bool HasComponent(Component[] components, string componentType)
+    {
+        foreach (Component comp in components)
+        {
+            if (comp != null)
+            {
+                string compTypeName = comp.GetType().Name;
+                string compFullTypeName = comp.GetType().FullName;
+                
+                if (string.Equals(compTypeName, componentType, System.StringComparison.OrdinalIgnoreCase) ||
+                    string.Equals(compFullTypeName, componentType, System.StringComparison.OrdinalIgnoreCase) ||
+                    compFullTypeName.EndsWith("." + componentType, System.StringComparison.OrdinalIgnoreCase))
+                {
+                    return true;
+                }
+            }
+        }
+        return false;
+    }
+    
+    /// <summary>
+    /// 把通配符转换为正则: *匹配任意字符，?匹配单个字符；
+    /// 路径模式中*和?不跨越/，**匹配任意层级
+    /// </summary>
+    private static Regex WildcardToRegex(string pattern, bool isPath)
+    {
+        string any = isPath ? "[^/]*" : ".*";
+        string one = isPath ? "[^/]" : ".";
+        string regex = Regex.Escape(pattern)
+            .Replace(@"\*\*", "\u0000")
+            .Replace(@"\*", any)
+            .Replace(@"\?", one)
+            .Replace("\u0000", ".*");
+        return new Regex("^" + regex + "$", RegexOptions.IgnoreCase);
+    }
+    
+    /// <summary>
+    /// 层级路径模式，如 Level/Spawners/** 或 /Level/*/Enemy?
+    /// </summary>
+    private static Regex PathToRegex(string path)
+    {
+        return WildcardToRegex(path.Trim().Trim('/'), true);
+    }
+    
+    /// <summary>
+    /// 对象的层级路径，如 Level/Spawners/Enemy01
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
     /// <summary>
     /// 创建对象信息
     /// </summary>
@@ -242,6 +358,7 @@ public class SceneFindTool : IMCPTool
         {
             ["name"] = obj.name,
             ["instanceId"] = obj.GetInstanceID(),
+            ["path"] = GetHierarchyPath(obj.transform),
             ["active"] = obj.activeInHierarchy,
             ["activeSelf"] = obj.activeSelf,
             ["tag"] = obj.tag,
@@ -304,6 +421,7 @@ public class SceneFindTool : IMCPTool
         
         info["components"] = componentList;
         info["componentCount"] = componentList.Count;
+        info["componentSummary"] = string.Join(", ", componentList.Select(c => c["type"].ToString()));
         
         // 预制体信息
         if (PrefabUtility.IsPartOfPrefabInstance(obj))
@@ -432,16 +550,27 @@ public class SceneFindTool : IMCPTool
             }
         }
         
+        // 验证正则表达式
+        if (parameters.ContainsKey("nameRegex"))
+        {
+            try
+            {
+                new Regex(parameters["nameRegex"].ToString());
+            }
+            catch (System.ArgumentException e)
+            {
+                return $"nameRegex无效: {e.Message}";
+            }
+        }
+        
         // 至少需要一个搜索条件
-        bool hasSearchCriteria = 
-            parameters.ContainsKey("name") && !string.IsNullOrEmpty(parameters["name"].ToString()) ||
-            parameters.ContainsKey("tag") && !string.IsNullOrEmpty(parameters["tag"].ToString()) ||
-            parameters.ContainsKey("componentType") && !string.IsNullOrEmpty(parameters["componentType"].ToString()) ||
-            parameters.ContainsKey("layer") && !string.IsNullOrEmpty(parameters["layer"].ToString());
+        string[] criteriaKeys = { "name", "nameRegex", "path", "tag", "componentType", "componentTypes", "layer" };
+        bool hasSearchCriteria = criteriaKeys.Any(key =>
+            parameters.ContainsKey(key) && parameters[key] != null && !string.IsNullOrEmpty(parameters[key].ToString()));
         
         if (!hasSearchCriteria)
         {
-            return "至少需要提供一个搜索条件: name, tag, componentType, 或 layer";
+            return "至少需要提供一个搜索条件: name, nameRegex, path, tag, componentTypes, 或 layer";
         }
         
         return null;
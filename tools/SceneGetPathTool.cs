@@ -0,0 +1,97 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象路径查询工具 - 返回GameObject (或组件所在GameObject) 的层级路径，是scene_resolve_path的逆操作
+/// </summary>
+public class SceneGetPathTool : IMCPTool
+{
+    public string ToolName => "scene_get_path";
+    
+    public string Description => "获取GameObject的层级路径";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            var instanceIds = new List<int>();
+            if (parameters.ContainsKey("instanceIds") && parameters["instanceIds"] is System.Collections.IEnumerable ids)
+            {
+                foreach (var id in ids)
+                {
+                    instanceIds.Add(System.Convert.ToInt32(id));
+                }
+            }
+            else
+            {
+                instanceIds.Add(System.Convert.ToInt32(parameters["instanceId"]));
+            }
+            
+            var results = instanceIds.Select(Describe).ToList();
+            if (!parameters.ContainsKey("instanceIds"))
+            {
+                Dictionary<string, object> result = results[0];
+                if (result.ContainsKey("error"))
+                {
+                    return MCPResponse.Error(result["error"].ToString());
+                }
+                return MCPResponse.Success(result);
+            }
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["objects"] = results,
+                ["notFound"] = results.Count(r => r.ContainsKey("error"))
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取层级路径时出错: {e.Message}");
+            return MCPResponse.Error($"获取层级路径失败: {e.Message}");
+        }
+    }
+    
+    private Dictionary<string, object> Describe(int instanceId)
+    {
+        Object obj = EditorUtility.InstanceIDToObject(instanceId);
+        GameObject go = obj as GameObject ?? (obj as Component)?.gameObject;
+        if (go == null)
+        {
+            return new Dictionary<string, object>
+            {
+                ["instanceId"] = instanceId,
+                ["error"] = $"未找到GameObject (InstanceID: {instanceId})"
+            };
+        }
+        
+        string path = HierarchyPathHelper.GetPath(go.transform);
+        var result = new Dictionary<string, object>
+        {
+            ["instanceId"] = instanceId,
+            ["gameObjectInstanceId"] = go.GetInstanceID(),
+            ["name"] = go.name,
+            ["path"] = path,
+            ["scene"] = go.scene.name,
+            ["scenePath"] = go.scene.path,
+            ["siblingIndex"] = go.transform.GetSiblingIndex(),
+            // 同名的同级对象会让路径匹配多个对象，此时只能用instanceId指定
+            ["unique"] = HierarchyPathHelper.Find(path).Count == 1
+        };
+        if (obj is Component component)
+        {
+            result["component"] = component.GetType().Name;
+        }
+        return result;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId") && !parameters.ContainsKey("instanceIds"))
+        {
+            return "缺少必需参数: instanceId或instanceIds";
+        }
+        return null;
+    }
+}
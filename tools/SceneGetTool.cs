@@ -16,30 +16,82 @@ public class SceneGetTool : IMCPTool
     {
         try
         {
-            bool includeComponents = parameters.ContainsKey("includeComponents") ? (bool)parameters["includeComponents"] : false;
-            bool includeTransform = parameters.ContainsKey("includeTransform") ? (bool)parameters["includeTransform"] : true;
+            bool includeComponents = parameters.ContainsKey("includeComponents") ? System.Convert.ToBoolean(parameters["includeComponents"]) : false;
+            bool includeTransform = parameters.ContainsKey("includeTransform") ? System.Convert.ToBoolean(parameters["includeTransform"]) : true;
+            bool summaryOnly = parameters.ContainsKey("summaryOnly") ? System.Convert.ToBoolean(parameters["summaryOnly"]) : false;
+            int maxDepth = parameters.ContainsKey("maxDepth") ? System.Convert.ToInt32(parameters["maxDepth"]) : -1;
+            int page = parameters.ContainsKey("page") ? System.Convert.ToInt32(parameters["page"]) : 1;
+            int pageSize = parameters.ContainsKey("pageSize") ? System.Convert.ToInt32(parameters["pageSize"]) : 0;
+            string nameFilter = parameters.ContainsKey("nameFilter") ? parameters["nameFilter"].ToString() : null;
             
-            // 获取场景中的所有根对象
-            var rootObjects = UnityEngine.SceneManagement.SceneManager.GetActiveScene().GetRootGameObjects();
+            var scene = UnityEngine.SceneManagement.SceneManager.GetActiveScene();
+            var rootObjects = scene.GetRootGameObjects();
+            
+            // 确定返回的顶层对象: 指定的子树或场景根对象
+            var topLevel = new List<GameObject>();
+            if (parameters.ContainsKey("rootInstanceId"))
+            {
+                int rootInstanceId = System.Convert.ToInt32(parameters["rootInstanceId"]);
+                GameObject root = EditorUtility.InstanceIDToObject(rootInstanceId) as GameObject;
+                if (root == null)
+                {
+                    return MCPResponse.Error($"未找到InstanceID为 {rootInstanceId} 的GameObject");
+                }
+                topLevel.Add(root);
+            }
+            else if (parameters.ContainsKey("rootPath"))
+            {
+                string rootPath = parameters["rootPath"].ToString();
+                GameObject root = FindByPath(rootObjects, rootPath);
+                if (root == null)
+                {
+                    return MCPResponse.Error($"未找到路径为 {rootPath} 的GameObject");
+                }
+                topLevel.Add(root);
+            }
+            else
+            {
+                topLevel.AddRange(rootObjects);
+            }
+            
+            // 按名称过滤: 保留子树中存在匹配对象的顶层对象
+            if (!string.IsNullOrEmpty(nameFilter))
+            {
+                topLevel = topLevel.FindAll(obj => SubtreeContainsName(obj.transform, nameFilter));
+            }
+            
+            // 分页，顺序与Hierarchy窗口一致
+            int total = topLevel.Count;
+            int totalPages = 1;
+            if (pageSize > 0)
+            {
+                totalPages = System.Math.Max(1, (total + pageSize - 1) / pageSize);
+                int skip = (page - 1) * pageSize;
+                topLevel = skip < total ? topLevel.GetRange(skip, System.Math.Min(pageSize, total - skip)) : new List<GameObject>();
+            }
             
             var sceneData = new Dictionary<string, object>
             {
-                ["sceneName"] = UnityEngine.SceneManagement.SceneManager.GetActiveScene().name,
-                ["scenePath"] = UnityEngine.SceneManagement.SceneManager.GetActiveScene().path,
+                ["sceneName"] = scene.name,
+                ["scenePath"] = scene.path,
                 ["rootObjectCount"] = rootObjects.Length,
-                ["gameObjects"] = new List<Dictionary<string, object>>()
+                ["totalObjects"] = total,
+                ["page"] = page,
+                ["pageSize"] = pageSize,
+                ["totalPages"] = totalPages,
+                ["hasMore"] = page < totalPages
             };
             
-            var gameObjectsList = (List<Dictionary<string, object>>)sceneData["gameObjects"];
-            
-            // 遍历所有根对象
-            foreach (var rootObj in rootObjects)
+            var gameObjectsList = new List<Dictionary<string, object>>();
+            foreach (var obj in topLevel)
             {
-                var objData = BuildGameObjectData(rootObj, includeComponents, includeTransform);
-                gameObjectsList.Add(objData);
+                gameObjectsList.Add(summaryOnly
+                    ? BuildSummary(obj)
+                    : BuildGameObjectData(obj, includeComponents, includeTransform, 0, maxDepth));
             }
+            sceneData[summaryOnly ? "summary" : "gameObjects"] = gameObjectsList;
             
-            Debug.Log($"成功获取场景层级数据: {rootObjects.Length} 个根对象");
+            Debug.Log($"成功获取场景层级数据: {gameObjectsList.Count}/{total} 个顶层对象");
             
             return MCPResponse.Success(sceneData);
         }
@@ -50,10 +102,92 @@ public class SceneGetTool : IMCPTool
         }
     }
     
+    /// <summary>
+    /// 按层级路径查找对象，如 Canvas/Panel/Button，包括未激活的对象
+    /// </summary>
+    private GameObject FindByPath(GameObject[] rootObjects, string path)
+    {
+        string trimmed = path.Trim('/');
+        int slash = trimmed.IndexOf('/');
+        string rootName = slash < 0 ? trimmed : trimmed.Substring(0, slash);
+        foreach (var rootObj in rootObjects)
+        {
+            if (rootObj.name != rootName)
+            {
+                continue;
+            }
+            if (slash < 0)
+            {
+                return rootObj;
+            }
+            Transform found = rootObj.transform.Find(trimmed.Substring(slash + 1));
+            if (found != null)
+            {
+                return found.gameObject;
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 判断子树中是否有名称包含filter的对象
+    /// </summary>
+    private bool SubtreeContainsName(Transform transform, string filter)
+    {
+        if (transform.name.IndexOf(filter, System.StringComparison.OrdinalIgnoreCase) >= 0)
+        {
+            return true;
+        }
+        for (int i = 0; i < transform.childCount; i++)
+        {
+            if (SubtreeContainsName(transform.GetChild(i), filter))
+            {
+                return true;
+            }
+        }
+        return false;
+    }
+    
+    /// <summary>
+    /// 构建顶层对象的统计信息 (summaryOnly)
+    /// </summary>
+    private Dictionary<string, object> BuildSummary(GameObject obj)
+    {
+        int objectCount = 0;
+        int componentCount = 0;
+        int depth = 0;
+        CountSubtree(obj.transform, 0, ref objectCount, ref componentCount, ref depth);
+        return new Dictionary<string, object>
+        {
+            ["name"] = obj.name,
+            ["instanceId"] = obj.GetInstanceID(),
+            ["active"] = obj.activeInHierarchy,
+            ["childCount"] = obj.transform.childCount,
+            ["objectCount"] = objectCount,
+            ["componentCount"] = componentCount,
+            ["depth"] = depth
+        };
+    }
+    
+    /// <summary>
+    /// 统计子树中的对象数量、组件数量和最大深度
+    /// </summary>
+    private void CountSubtree(Transform transform, int level, ref int objectCount, ref int componentCount, ref int depth)
+    {
+        objectCount++;
+        componentCount += transform.GetComponents<Component>().Length;
+        depth = System.Math.Max(depth, level);
+        for (int i = 0; i < transform.childCount; i++)
+        {
+            CountSubtree(transform.GetChild(i), level + 1, ref objectCount, ref componentCount, ref depth);
+        }
+    }
+    
     /// <summary>
     /// 递归构建GameObject数据
+    /// depth为当前层级，maxDepth小于0表示不限制层级
     /// </summary>
-    private Dictionary<string, object> BuildGameObjectData(GameObject obj, bool includeComponents, bool includeTransform)
+    private Dictionary<string, object> BuildGameObjectData(GameObject obj, bool includeComponents, bool includeTransform, int depth, int maxDepth)
     {
         var objData = new Dictionary<string, object>
         {
@@ -115,14 +249,19 @@ public class SceneGetTool : IMCPTool
             objData["components"] = componentList;
         }
         
-        // 递归处理子对象
-        if (obj.transform.childCount > 0)
+        // 递归处理子对象，超过maxDepth的子对象只报告数量
+        if (obj.transform.childCount > 0 && maxDepth >= 0 && depth >= maxDepth)
+        {
+            objData["childCount"] = obj.transform.childCount;
+            objData["childrenTruncated"] = true;
+        }
+        else if (obj.transform.childCount > 0)
         {
             var children = new List<Dictionary<string, object>>();
             for (int i = 0; i < obj.transform.childCount; i++)
             {
                 var child = obj.transform.GetChild(i).gameObject;
-                children.Add(BuildGameObjectData(child, includeComponents, includeTransform));
+                children.Add(BuildGameObjectData(child, includeComponents, includeTransform, depth + 1, maxDepth));
             }
             objData["children"] = children;
             objData["childCount"] = obj.transform.childCount;
@@ -138,6 +277,10 @@ public class SceneGetTool : IMCPTool
     public string ValidateParameters(Dictionary<string, object> parameters)
     {
         // 此工具不需要必需参数，所有参数都是可选的
+        if (parameters.ContainsKey("rootInstanceId") && parameters.ContainsKey("rootPath"))
+        {
+            return "rootInstanceId和rootPath不能同时使用";
+        }
         return null;
     }
 }
@@ -28,45 +28,50 @@ public class SceneObjectAddComponentTool : IMCPTool
             }
             
             // 解析组件类型
-            Type compType = GetComponentType(componentType);
+            Type compType = GetComponentType(componentType, out string typeError);
             if (compType == null)
             {
-                return MCPResponse.Error($"未知的组件类型: {componentType}");
+                return MCPResponse.Error(typeError);
             }
             
-            // 检查是否已经存在该组件
-            if (targetObject.GetComponent(compType) != null)
+            bool allowDuplicate = parameters.ContainsKey("allowDuplicate") ? 
+                System.Convert.ToBoolean(parameters["allowDuplicate"]) : false;
+            
+            // 已经存在该组件时返回现有组件，不修改它
+            Component existing = targetObject.GetComponent(compType);
+            if (existing != null && !allowDuplicate)
+            {
+                var existingResult = BuildResult(targetObject, existing, compType);
+                existingResult["created"] = false;
+                existingResult["message"] = $"对象 '{targetObject.name}' 已经包含组件 '{compType.Name}'，未应用properties；设置allowDuplicate=true以添加新组件";
+                return MCPResponse.Success(existingResult);
+            }
+            if (existing != null && Attribute.IsDefined(compType, typeof(DisallowMultipleComponent), true))
             {
-                return MCPResponse.Error($"对象 '{targetObject.name}' 已经包含组件 '{componentType}'");
+                return MCPResponse.Error($"组件 '{compType.Name}' 不允许在同一对象上添加多个");
             }
             
-            // 添加组件
-            Component newComponent = targetObject.AddComponent(compType);
+            // 添加组件并注册到Undo系统
+            Component newComponent = Undo.AddComponent(targetObject, compType);
+            if (newComponent == null)
+            {
+                return MCPResponse.Error($"无法为对象 '{targetObject.name}' 添加组件 '{compType.Name}'，可能与已有组件冲突");
+            }
             
-            // 注册到Undo系统
-            Undo.RegisterCreatedObjectUndo(newComponent, $"Add {componentType}");
+            var result = BuildResult(targetObject, newComponent, compType);
+            result["created"] = true;
             
             // 设置组件参数（如果提供）
-            if (parameters.ContainsKey("properties"))
+            if (parameters.ContainsKey("properties") && parameters["properties"] is Dictionary<string, object> properties)
             {
-                var properties = parameters["properties"] as Dictionary<string, object>;
-                if (properties != null)
-                {
-                    SetComponentProperties(newComponent, properties);
-                }
+                var applied = new List<string>();
+                var failed = new Dictionary<string, object>();
+                SetComponentProperties(newComponent, properties, applied, failed);
+                result["appliedProperties"] = applied;
+                result["failedProperties"] = failed;
             }
             
-            var result = new Dictionary<string, object>
-            {
-                ["gameObjectName"] = targetObject.name,
-                ["gameObjectInstanceId"] = targetObject.GetInstanceID(),
-                ["componentType"] = compType.Name,
-                ["componentFullType"] = compType.FullName,
-                ["componentInstanceId"] = newComponent.GetInstanceID(),
-                ["enabled"] = newComponent is Behaviour behaviour ? behaviour.enabled : true
-            };
-            
-            Debug.Log($"成功为对象 '{targetObject.name}' 添加组件 '{componentType}'");
+            Debug.Log($"成功为对象 '{targetObject.name}' 添加组件 '{compType.FullName}'");
             
             return MCPResponse.Success(result);
         }
@@ -77,11 +82,31 @@ public class SceneObjectAddComponentTool : IMCPTool
         }
     }
     
+    /// <summary>
+    /// 构建组件结果，包含后续属性调用所需的InstanceID和完整类型名
+    /// </summary>
+    private Dictionary<string, object> BuildResult(GameObject targetObject, Component component, Type compType)
+    {
+        return new Dictionary<string, object>
+        {
+            ["gameObjectName"] = targetObject.name,
+            ["gameObjectInstanceId"] = targetObject.GetInstanceID(),
+            ["componentType"] = compType.Name,
+            ["componentFullType"] = compType.FullName,
+            ["componentAssembly"] = compType.Assembly.GetName().Name,
+            ["componentInstanceId"] = component.GetInstanceID(),
+            ["enabled"] = component is Behaviour behaviour ? behaviour.enabled : true
+        };
+    }
+    
     /// <summary>
     /// 根据字符串获取组件类型
+    /// 支持常用短名、完整类型名 (MyGame.Player) 和程序集限定名 (MyGame.Player, Assembly-CSharp)
     /// </summary>
-    private Type GetComponentType(string typeName)
+    private Type GetComponentType(string typeName, out string error)
     {
+        error = null;
+        
         // 常用组件映射
         var componentMap = new Dictionary<string, Type>
         {
@@ -120,77 +145,123 @@ public class SceneObjectAddComponentTool : IMCPTool
             return componentMap[typeName];
         }
         
-        // 尝试通过Type.GetType查找
+        // 程序集限定名
         Type type = Type.GetType(typeName);
-        if (type != null && typeof(Component).IsAssignableFrom(type))
+        if (type == null && typeName.Contains(","))
         {
-            return type;
+            error = $"未找到类型: {typeName}，请检查程序集名称";
+            return null;
         }
         
-        // 在UnityEngine程序集中搜索
-        type = Type.GetType($"UnityEngine.{typeName}, UnityEngine");
-        if (type != null && typeof(Component).IsAssignableFrom(type))
+        // 在所有已加载的组件类型中按完整类型名或短名查找，包括项目中的自定义MonoBehaviour
+        if (type == null)
         {
-            return type;
+            var candidates = new List<Type>();
+            foreach (var candidate in TypeCache.GetTypesDerivedFrom<Component>())
+            {
+                if (candidate.FullName == typeName)
+                {
+                    type = candidate;
+                    break;
+                }
+                if (candidate.Name == typeName)
+                {
+                    candidates.Add(candidate);
+                }
+            }
+            if (type == null && candidates.Count > 1)
+            {
+                var names = candidates.ConvertAll(c => $"{c.FullName}, {c.Assembly.GetName().Name}");
+                error = $"组件类型 '{typeName}' 不唯一，请使用完整类型名或程序集限定名: {string.Join("; ", names)}";
+                return null;
+            }
+            if (type == null && candidates.Count == 1)
+            {
+                type = candidates[0];
+            }
         }
         
-        // 在UnityEngine.UI程序集中搜索
-        type = Type.GetType($"UnityEngine.UI.{typeName}, UnityEngine.UI");
-        if (type != null && typeof(Component).IsAssignableFrom(type))
+        if (type == null)
         {
-            return type;
+            error = $"未知的组件类型: {typeName}";
+            return null;
         }
-        
-        return null;
+        if (!typeof(Component).IsAssignableFrom(type))
+        {
+            error = $"类型 '{type.FullName}' 不是组件";
+            return null;
+        }
+        if (type.IsAbstract)
+        {
+            error = $"组件类型 '{type.FullName}' 是抽象类型，无法添加";
+            return null;
+        }
+        return type;
     }
     
     /// <summary>
     /// 设置组件属性
+    /// 优先按序列化字段名设置 (如 m_Mass 或 mass)，找不到时回退到公共属性和字段
     /// </summary>
-    private void SetComponentProperties(Component component, Dictionary<string, object> properties)
+    private void SetComponentProperties(Component component, Dictionary<string, object> properties,
+        List<string> applied, Dictionary<string, object> failed)
     {
-        try
+        var serializedObject = new SerializedObject(component);
+        var reflected = new Dictionary<string, object>();
+        
+        foreach (var prop in properties)
         {
-            Type componentType = component.GetType();
+            SerializedProperty property = SerializedPropertyHelper.FindProperty(serializedObject, prop.Key);
+            if (property == null)
+            {
+                reflected[prop.Key] = prop.Value;
+                continue;
+            }
             
-            foreach (var prop in properties)
+            string error = SerializedPropertyHelper.SetValue(property, prop.Value);
+            if (error != null)
+            {
+                failed[prop.Key] = error;
+            }
+            else
+            {
+                applied.Add(property.propertyPath);
+            }
+        }
+        serializedObject.ApplyModifiedProperties();
+        
+        if (reflected.Count == 0)
+        {
+            return;
+        }
+        
+        Undo.RecordObject(component, "Set component properties");
+        Type componentType = component.GetType();
+        foreach (var prop in reflected)
+        {
+            try
             {
                 var propertyInfo = componentType.GetProperty(prop.Key);
+                var fieldInfo = componentType.GetField(prop.Key);
                 if (propertyInfo != null && propertyInfo.CanWrite)
                 {
-                    try
-                    {
-                        object value = ConvertValue(prop.Value, propertyInfo.PropertyType);
-                        propertyInfo.SetValue(component, value);
-                        Debug.Log($"设置属性 {prop.Key} = {value}");
-                    }
-                    catch (Exception e)
-                    {
-                        Debug.LogWarning($"设置属性 {prop.Key} 失败: {e.Message}");
-                    }
+                    propertyInfo.SetValue(component, ConvertValue(prop.Value, propertyInfo.PropertyType));
+                }
+                else if (fieldInfo != null)
+                {
+                    fieldInfo.SetValue(component, ConvertValue(prop.Value, fieldInfo.FieldType));
                 }
                 else
                 {
-                    var fieldInfo = componentType.GetField(prop.Key);
-                    if (fieldInfo != null)
-                    {
-                        try
-                        {
-                            object value = ConvertValue(prop.Value, fieldInfo.FieldType);
-                            fieldInfo.SetValue(component, value);
-                            Debug.Log($"设置字段 {prop.Key} = {value}");
-                        }
-                        catch (Exception e)
-                        {
-                            Debug.LogWarning($"设置字段 {prop.Key} 失败: {e.Message}");
-                        }
-                    }
+                    failed[prop.Key] = $"组件 '{componentType.Name}' 没有属性或字段 '{prop.Key}'";
+                    continue;
                 }
+                applied.Add(prop.Key);
+            }
+            catch (Exception e)
+            {
+                failed[prop.Key] = e.Message;
             }
-        }
-        catch (Exception e)
-        {
-            Debug.LogWarning($"设置组件属性时出错: {e.Message}");
         }
     }
     
@@ -0,0 +1,153 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象复制工具 - 复制GameObject，预制体实例的连接保持不变
+/// </summary>
+public class SceneObjectDuplicateTool : IMCPTool
+{
+    public string ToolName => "scene_object_duplicate";
+    
+    public string Description => "复制GameObject，支持多个副本、命名模式和位置偏移";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            int count = parameters.ContainsKey("count") ? System.Convert.ToInt32(parameters["count"]) : 1;
+            string newName = parameters.ContainsKey("newName") ? parameters["newName"].ToString() : null;
+            
+            GameObject source = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (source == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            Transform parent = null;
+            bool hasParent = parameters.ContainsKey("parentInstanceId");
+            if (hasParent)
+            {
+                int parentInstanceId = System.Convert.ToInt32(parameters["parentInstanceId"]);
+                if (parentInstanceId != 0)
+                {
+                    GameObject parentObject = EditorUtility.InstanceIDToObject(parentInstanceId) as GameObject;
+                    if (parentObject == null)
+                    {
+                        return MCPResponse.Error($"未找到父对象 (InstanceID: {parentInstanceId})");
+                    }
+                    parent = parentObject.transform;
+                }
+            }
+            
+            Vector3 offset = Vector3.zero;
+            if (parameters.ContainsKey("offset") && parameters["offset"] is Dictionary<string, object> offsetDict)
+            {
+                offset = new Vector3(
+                    offsetDict.ContainsKey("x") ? System.Convert.ToSingle(offsetDict["x"]) : 0f,
+                    offsetDict.ContainsKey("y") ? System.Convert.ToSingle(offsetDict["y"]) : 0f,
+                    offsetDict.ContainsKey("z") ? System.Convert.ToSingle(offsetDict["z"]) : 0f
+                );
+            }
+            
+            Undo.IncrementCurrentGroup();
+            int undoGroup = Undo.GetCurrentGroup();
+            Undo.SetCurrentGroupName($"Duplicate {source.name}");
+            
+            var previousSelection = Selection.objects;
+            var copies = new List<Dictionary<string, object>>();
+            try
+            {
+                for (int n = 1; n <= count; n++)
+                {
+                    GameObject copy = Duplicate(source);
+                    
+                    if (hasParent)
+                    {
+                        Undo.SetTransformParent(copy.transform, parent, "Set Parent");
+                    }
+                    if (offset != Vector3.zero)
+                    {
+                        Undo.RecordObject(copy.transform, "Offset Duplicate");
+                        copy.transform.position = source.transform.position + offset * n;
+                    }
+                    if (!string.IsNullOrEmpty(newName))
+                    {
+                        Undo.RecordObject(copy, "Rename Duplicate");
+                        copy.name = newName.Replace("{n}", n.ToString());
+                    }
+                    
+                    copies.Add(new Dictionary<string, object>
+                    {
+                        ["name"] = copy.name,
+                        ["instanceId"] = copy.GetInstanceID(),
+                        ["path"] = GetHierarchyPath(copy.transform),
+                        ["isPrefabInstance"] = PrefabUtility.IsPartOfPrefabInstance(copy)
+                    });
+                }
+            }
+            finally
+            {
+                Selection.objects = previousSelection;
+                Undo.CollapseUndoOperations(undoGroup);
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["sourceName"] = source.name,
+                ["sourceInstanceId"] = source.GetInstanceID(),
+                ["sourceIsPrefabInstance"] = PrefabUtility.IsPartOfPrefabInstance(source),
+                ["copies"] = copies,
+                ["count"] = copies.Count
+            };
+            
+            Debug.Log($"复制对象 '{source.name}' {copies.Count} 次");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"复制对象时出错: {e.Message}");
+            return MCPResponse.Error($"复制对象失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 使用编辑器的复制命令 (与Ctrl+D相同)，预制体实例的连接和覆盖都会保留，并自动注册Undo
+    /// </summary>
+    private GameObject Duplicate(GameObject source)
+    {
+        Selection.objects = new Object[] { source };
+        Unsupported.DuplicateGameObjectsUsingPasteboard();
+        GameObject copy = Selection.activeGameObject;
+        if (copy == null || copy == source)
+        {
+            throw new System.InvalidOperationException($"编辑器复制命令未能复制 '{source.name}'");
+        }
+        return copy;
+    }
+    
+    /// <summary>
+    /// 对象的层级路径，如 Level/Spawners/Enemy01
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,105 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象重命名工具 - 修改GameObject的名称
+/// </summary>
+public class SceneObjectRenameTool : IMCPTool
+{
+    public string ToolName => "scene_object_rename";
+    
+    public string Description => "重命名GameObject，可避免与同级对象重名";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            string newName = parameters["name"].ToString();
+            bool makeUnique = parameters.ContainsKey("makeUnique") ? 
+                System.Convert.ToBoolean(parameters["makeUnique"]) : false;
+            
+            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (targetObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            // 与同级对象重名时追加序号，如 Enemy (1)
+            if (makeUnique)
+            {
+                string[] siblingNames = GetSiblings(targetObject)
+                    .Where(sibling => sibling != targetObject)
+                    .Select(sibling => sibling.name)
+                    .ToArray();
+                if (siblingNames.Contains(newName))
+                {
+                    newName = ObjectNames.GetUniqueName(siblingNames, newName);
+                }
+            }
+            
+            string previousName = targetObject.name;
+            Undo.RecordObject(targetObject, "Rename GameObject");
+            targetObject.name = newName;
+            
+            var result = new Dictionary<string, object>
+            {
+                ["instanceId"] = targetObject.GetInstanceID(),
+                ["name"] = targetObject.name,
+                ["previousName"] = previousName,
+                ["path"] = GetHierarchyPath(targetObject.transform)
+            };
+            
+            Debug.Log($"重命名对象 '{previousName}' 为 '{newName}'");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"重命名对象时出错: {e.Message}");
+            return MCPResponse.Error($"重命名对象失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 同级对象: 同一父对象的子对象，或场景的根对象
+    /// </summary>
+    private IEnumerable<GameObject> GetSiblings(GameObject obj)
+    {
+        Transform parent = obj.transform.parent;
+        if (parent == null)
+        {
+            return obj.scene.GetRootGameObjects();
+        }
+        return Enumerable.Range(0, parent.childCount).Select(i => parent.GetChild(i).gameObject);
+    }
+    
+    /// <summary>
+    /// 对象的层级路径，如 Level/Spawners/Enemy01
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        if (!parameters.ContainsKey("name") || string.IsNullOrEmpty(parameters["name"].ToString()))
+        {
+            return "缺少必需参数: name";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,87 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象激活状态工具 - 设置GameObject的激活状态
+/// </summary>
+public class SceneObjectSetActiveTool : IMCPTool
+{
+    public string ToolName => "scene_object_set_active";
+    
+    public string Description => "设置GameObject的激活状态";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            bool active = System.Convert.ToBoolean(parameters["active"]);
+            bool includeChildren = parameters.ContainsKey("includeChildren") ? 
+                System.Convert.ToBoolean(parameters["includeChildren"]) : false;
+            
+            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (targetObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            var targets = new List<GameObject> { targetObject };
+            if (includeChildren)
+            {
+                foreach (Transform child in targetObject.GetComponentsInChildren<Transform>(true))
+                {
+                    if (child != targetObject.transform)
+                    {
+                        targets.Add(child.gameObject);
+                    }
+                }
+            }
+            
+            int changed = 0;
+            foreach (var obj in targets)
+            {
+                if (obj.activeSelf == active)
+                {
+                    continue;
+                }
+                Undo.RecordObject(obj, active ? "Activate GameObject" : "Deactivate GameObject");
+                obj.SetActive(active);
+                changed++;
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["name"] = targetObject.name,
+                ["instanceId"] = targetObject.GetInstanceID(),
+                ["activeSelf"] = targetObject.activeSelf,
+                ["activeInHierarchy"] = targetObject.activeInHierarchy,
+                ["affectedObjects"] = targets.Count,
+                ["changedObjects"] = changed
+            };
+            
+            Debug.Log($"设置对象 '{targetObject.name}' 激活状态为 {active}，修改了 {changed} 个对象");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置激活状态时出错: {e.Message}");
+            return MCPResponse.Error($"设置激活状态失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        if (!parameters.ContainsKey("active"))
+        {
+            return "缺少必需参数: active";
+        }
+        return null;
+    }
+}
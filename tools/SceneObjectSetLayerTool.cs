@@ -0,0 +1,106 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象层级工具 - 设置GameObject的Layer
+/// </summary>
+public class SceneObjectSetLayerTool : IMCPTool
+{
+    public string ToolName => "scene_object_set_layer";
+    
+    public string Description => "设置GameObject的Layer，接受层名称或索引";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            string layerValue = parameters["layer"].ToString();
+            bool includeChildren = parameters.ContainsKey("includeChildren") ? 
+                System.Convert.ToBoolean(parameters["includeChildren"]) : false;
+            
+            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (targetObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            // 解析层: 索引或名称
+            int layer;
+            if (int.TryParse(layerValue, out layer))
+            {
+                if (layer < 0 || layer > 31)
+                {
+                    return MCPResponse.Error($"Layer索引必须在0到31之间: {layer}");
+                }
+            }
+            else
+            {
+                layer = LayerMask.NameToLayer(layerValue);
+                if (layer < 0)
+                {
+                    return MCPResponse.Error($"Layer '{layerValue}' 不存在，可用Layer: {string.Join(", ", GetLayerNames())}");
+                }
+            }
+            
+            var targets = includeChildren
+                ? targetObject.GetComponentsInChildren<Transform>(true)
+                : new[] { targetObject.transform };
+            foreach (Transform t in targets)
+            {
+                Undo.RecordObject(t.gameObject, "Set Layer");
+                t.gameObject.layer = layer;
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["name"] = targetObject.name,
+                ["instanceId"] = targetObject.GetInstanceID(),
+                ["layer"] = targetObject.layer,
+                ["layerName"] = LayerMask.LayerToName(targetObject.layer),
+                ["affectedObjects"] = targets.Length
+            };
+            
+            Debug.Log($"设置对象 '{targetObject.name}' Layer为 {layer}，影响 {targets.Length} 个对象");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置Layer时出错: {e.Message}");
+            return MCPResponse.Error($"设置Layer失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 所有已命名的Layer
+    /// </summary>
+    private List<string> GetLayerNames()
+    {
+        var names = new List<string>();
+        for (int i = 0; i < 32; i++)
+        {
+            string name = LayerMask.LayerToName(i);
+            if (!string.IsNullOrEmpty(name))
+            {
+                names.Add($"{i}:{name}");
+            }
+        }
+        return names;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        if (!parameters.ContainsKey("layer") || string.IsNullOrEmpty(parameters["layer"].ToString()))
+        {
+            return "缺少必需参数: layer";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,132 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 对象父级工具 - 修改GameObject的父对象
+/// </summary>
+public class SceneObjectSetParentTool : IMCPTool
+{
+    public string ToolName => "scene_object_set_parent";
+    
+    public string Description => "修改GameObject的父对象，可保持世界坐标";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            int parentInstanceId = parameters.ContainsKey("parentInstanceId") ? 
+                System.Convert.ToInt32(parameters["parentInstanceId"]) : 0;
+            bool worldPositionStays = parameters.ContainsKey("worldPositionStays") ? 
+                System.Convert.ToBoolean(parameters["worldPositionStays"]) : true;
+            
+            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (targetObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            // parentInstanceId为0表示移动到场景根部
+            Transform newParent = null;
+            if (parentInstanceId != 0)
+            {
+                GameObject parentObject = EditorUtility.InstanceIDToObject(parentInstanceId) as GameObject;
+                if (parentObject == null)
+                {
+                    return MCPResponse.Error($"未找到父对象 (InstanceID: {parentInstanceId})");
+                }
+                newParent = parentObject.transform;
+                
+                // 不能把对象移动到自身或自己的子对象下
+                if (newParent.IsChildOf(targetObject.transform))
+                {
+                    return MCPResponse.Error($"不能将 '{targetObject.name}' 的父对象设置为它自身或它的子对象 '{GetHierarchyPath(newParent)}'");
+                }
+            }
+            
+            Transform transform = targetObject.transform;
+            string previousPath = GetHierarchyPath(transform);
+            Vector3 localPosition = transform.localPosition;
+            Quaternion localRotation = transform.localRotation;
+            Vector3 localScale = transform.localScale;
+            
+            // Undo.SetTransformParent保持世界坐标，不保持时恢复原来的局部坐标
+            Undo.SetTransformParent(transform, newParent, "Set Parent");
+            if (!worldPositionStays)
+            {
+                Undo.RecordObject(transform, "Set Parent");
+                transform.localPosition = localPosition;
+                transform.localRotation = localRotation;
+                transform.localScale = localScale;
+            }
+            
+            if (parameters.ContainsKey("siblingIndex"))
+            {
+                Undo.RecordObject(transform, "Set Sibling Index");
+                transform.SetSiblingIndex(System.Convert.ToInt32(parameters["siblingIndex"]));
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["name"] = targetObject.name,
+                ["instanceId"] = targetObject.GetInstanceID(),
+                ["previousPath"] = previousPath,
+                ["path"] = GetHierarchyPath(transform),
+                ["parentInstanceId"] = newParent != null ? newParent.gameObject.GetInstanceID() : 0,
+                ["siblingIndex"] = transform.GetSiblingIndex(),
+                ["worldPositionStays"] = worldPositionStays,
+                ["localPosition"] = new Dictionary<string, float>
+                {
+                    ["x"] = transform.localPosition.x,
+                    ["y"] = transform.localPosition.y,
+                    ["z"] = transform.localPosition.z
+                },
+                ["localRotation"] = new Dictionary<string, float>
+                {
+                    ["x"] = transform.localEulerAngles.x,
+                    ["y"] = transform.localEulerAngles.y,
+                    ["z"] = transform.localEulerAngles.z
+                },
+                ["localScale"] = new Dictionary<string, float>
+                {
+                    ["x"] = transform.localScale.x,
+                    ["y"] = transform.localScale.y,
+                    ["z"] = transform.localScale.z
+                }
+            };
+            
+            Debug.Log($"移动对象 '{previousPath}' 到 '{GetHierarchyPath(transform)}'");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置父对象时出错: {e.Message}");
+            return MCPResponse.Error($"设置父对象失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 对象的层级路径，如 Level/Spawners/Enemy01
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,81 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEditorInternal;
+
+/// <summary>
+/// 对象标签工具 - 设置GameObject的标签
+/// </summary>
+public class SceneObjectSetTagTool : IMCPTool
+{
+    public string ToolName => "scene_object_set_tag";
+    
+    public string Description => "设置GameObject的标签，标签不存在时可以创建";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            string tag = parameters["tag"].ToString();
+            bool createIfMissing = parameters.ContainsKey("createIfMissing") ? 
+                System.Convert.ToBoolean(parameters["createIfMissing"]) : false;
+            
+            GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (targetObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            
+            // 检查标签是否存在
+            bool tagCreated = false;
+            if (!InternalEditorUtility.tags.Contains(tag))
+            {
+                if (!createIfMissing)
+                {
+                    return MCPResponse.Error($"标签 '{tag}' 不存在，可用标签: {string.Join(", ", InternalEditorUtility.tags)}；设置createIfMissing=true以创建");
+                }
+                InternalEditorUtility.AddTag(tag);
+                tagCreated = true;
+                Debug.Log($"创建标签: {tag}");
+            }
+            
+            string previousTag = targetObject.tag;
+            Undo.RecordObject(targetObject, "Set Tag");
+            targetObject.tag = tag;
+            
+            var result = new Dictionary<string, object>
+            {
+                ["name"] = targetObject.name,
+                ["instanceId"] = targetObject.GetInstanceID(),
+                ["tag"] = targetObject.tag,
+                ["previousTag"] = previousTag,
+                ["tagCreated"] = tagCreated
+            };
+            
+            Debug.Log($"设置对象 '{targetObject.name}' 标签为 {tag}");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置标签时出错: {e.Message}");
+            return MCPResponse.Error($"设置标签失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        if (!parameters.ContainsKey("tag") || string.IsNullOrEmpty(parameters["tag"].ToString()))
+        {
+            return "缺少必需参数: tag";
+        }
+        return null;
+    }
+}
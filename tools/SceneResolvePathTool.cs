@@ -0,0 +1,69 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+
+/// <summary>
+/// 层级路径解析工具 - 把层级路径转换为InstanceID，多个匹配时按ambiguity处理
+/// </summary>
+public class SceneResolvePathTool : IMCPTool
+{
+    public string ToolName => "scene_resolve_path";
+    
+    public string Description => "把层级路径解析为GameObject的InstanceID";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string path = parameters["path"].ToString();
+            string ambiguity = parameters.ContainsKey("ambiguity") ? parameters["ambiguity"].ToString() : "error";
+            bool includeInactive = parameters.ContainsKey("includeInactive") ?
+                System.Convert.ToBoolean(parameters["includeInactive"]) : true;
+            int maxResults = parameters.ContainsKey("maxResults") ? System.Convert.ToInt32(parameters["maxResults"]) : 100;
+            
+            List<GameObject> matches = HierarchyPathHelper.Find(path, includeInactive);
+            if (matches.Count == 0)
+            {
+                return MCPResponse.Error($"未找到路径为 {path} 的GameObject");
+            }
+            if (matches.Count > 1 && ambiguity == "error")
+            {
+                return MCPResponse.Error($"路径 {path} 匹配到 {matches.Count} 个GameObject，请使用更精确的路径，或设置ambiguity=first/all: {HierarchyPathHelper.DescribeMatches(matches)}");
+            }
+            
+            int limit = ambiguity == "all" ? maxResults : 1;
+            var results = matches.Take(limit).Select(go => new Dictionary<string, object>
+            {
+                ["instanceId"] = go.GetInstanceID(),
+                ["name"] = go.name,
+                ["path"] = HierarchyPathHelper.GetPath(go.transform),
+                ["scene"] = go.scene.name,
+                ["activeInHierarchy"] = go.activeInHierarchy
+            }).ToList();
+            
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["path"] = path,
+                ["instanceId"] = results[0]["instanceId"],
+                ["matchCount"] = matches.Count,
+                ["matches"] = results,
+                ["truncated"] = matches.Count > limit && ambiguity == "all"
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"解析层级路径时出错: {e.Message}");
+            return MCPResponse.Error($"解析层级路径失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("path") || string.IsNullOrEmpty(parameters["path"]?.ToString()))
+        {
+            return "缺少必需参数: path";
+        }
+        return null;
+    }
+}
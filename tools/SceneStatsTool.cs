@@ -0,0 +1,386 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.Profiling;
+using UnityEngine.SceneManagement;
+using UnityEngine.UI;
+using UnityEditor;
+
+/// <summary>
+/// 场景统计工具 - 面向性能分析的场景统计: 对象层级、顶点和三角形、材质和着色器、光源、纹理内存、UI元素，
+/// 以及最重的网格和纹理；列表按maxEntries和topN截断，大场景的结果也能放进一帧
+/// </summary>
+public class SceneStatsTool : IMCPTool
+{
+    public string ToolName => "scene_stats";
+    
+    public string Description => "统计场景的对象、渲染、光照、纹理和UI数据";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            bool includeInactive = parameters.ContainsKey("includeInactive") ?
+                System.Convert.ToBoolean(parameters["includeInactive"]) : true;
+            int topN = parameters.ContainsKey("topN") ? System.Convert.ToInt32(parameters["topN"]) : 10;
+            int maxEntries = parameters.ContainsKey("maxEntries") ? System.Convert.ToInt32(parameters["maxEntries"]) : 50;
+            
+            // 统计范围: 指定对象的子树或活动场景的全部根对象
+            var roots = new List<Transform>();
+            string scope;
+            if (parameters.ContainsKey("rootInstanceId"))
+            {
+                int rootId = System.Convert.ToInt32(parameters["rootInstanceId"]);
+                GameObject root = EditorUtility.InstanceIDToObject(rootId) as GameObject;
+                if (root == null)
+                {
+                    return MCPResponse.Error($"未找到GameObject (InstanceID: {rootId})");
+                }
+                roots.Add(root.transform);
+                scope = HierarchyPathHelper.GetPath(root.transform);
+            }
+            else
+            {
+                Scene scene = SceneManager.GetActiveScene();
+                if (!scene.IsValid() || !scene.isLoaded)
+                {
+                    return MCPResponse.Error("没有有效的活动场景");
+                }
+                roots.AddRange(scene.GetRootGameObjects().Select(go => go.transform));
+                scope = scene.name;
+            }
+            
+            var objects = new List<GameObject>();
+            var depthCounts = new SortedDictionary<int, int>();
+            foreach (Transform root in roots)
+            {
+                CollectObjects(root, 0, includeInactive, objects, depthCounts);
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["scope"] = scope,
+                ["objects"] = new Dictionary<string, object>
+                {
+                    ["total"] = objects.Count,
+                    ["active"] = objects.Count(go => go.activeInHierarchy),
+                    ["maxDepth"] = depthCounts.Count > 0 ? depthCounts.Keys.Last() : 0,
+                    ["byDepth"] = depthCounts.Select(kv => new Dictionary<string, object> { ["depth"] = kv.Key, ["count"] = kv.Value }).ToList()
+                }
+            };
+            
+            var renderers = objects.SelectMany(go => go.GetComponents<Renderer>()).ToList();
+            var textures = new Dictionary<Texture, HashSet<Material>>();
+            result["rendering"] = GetRenderingStats(renderers, topN, out List<Dictionary<string, object>> heaviestMeshes);
+            result["materials"] = GetMaterialStats(renderers, maxEntries, textures);
+            result["shaders"] = GetShaderStats(renderers, maxEntries);
+            result["lights"] = GetLightStats(objects);
+            result["ui"] = GetUIStats(objects, textures);
+            result["textures"] = GetTextureStats(textures, topN, out List<Dictionary<string, object>> heaviestTextures);
+            result["heaviestMeshes"] = heaviestMeshes;
+            result["heaviestTextures"] = heaviestTextures;
+            
+            Debug.Log($"场景统计完成: {scope}，{objects.Count} 个对象，{renderers.Count} 个渲染器");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"统计场景时出错: {e.Message}");
+            return MCPResponse.Error($"统计场景失败: {e.Message}");
+        }
+    }
+    
+    private void CollectObjects(Transform transform, int depth, bool includeInactive,
+        List<GameObject> objects, SortedDictionary<int, int> depthCounts)
+    {
+        if (!includeInactive && !transform.gameObject.activeInHierarchy)
+        {
+            return;
+        }
+        objects.Add(transform.gameObject);
+        depthCounts[depth] = depthCounts.TryGetValue(depth, out int count) ? count + 1 : 1;
+        foreach (Transform child in transform)
+        {
+            CollectObjects(child, depth + 1, includeInactive, objects, depthCounts);
+        }
+    }
+    
+    /// <summary>
+    /// 按渲染器类型统计顶点和三角形，网格按三角形数排序取前topN
+    /// </summary>
+    private Dictionary<string, object> GetRenderingStats(List<Renderer> renderers, int topN,
+        out List<Dictionary<string, object>> heaviestMeshes)
+    {
+        var byType = new SortedDictionary<string, long[]>(); // 类型 → [数量, 顶点, 三角形]
+        var meshUsers = new Dictionary<Mesh, List<Renderer>>();
+        long totalVertices = 0;
+        long totalTriangles = 0;
+        foreach (Renderer renderer in renderers)
+        {
+            string typeName = renderer.GetType().Name;
+            if (!byType.TryGetValue(typeName, out long[] counts))
+            {
+                counts = byType[typeName] = new long[3];
+            }
+            counts[0]++;
+            
+            Mesh mesh = GetMesh(renderer);
+            if (mesh == null)
+            {
+                continue;
+            }
+            long triangles = CountTriangles(mesh);
+            counts[1] += mesh.vertexCount;
+            counts[2] += triangles;
+            totalVertices += mesh.vertexCount;
+            totalTriangles += triangles;
+            if (!meshUsers.TryGetValue(mesh, out List<Renderer> users))
+            {
+                users = meshUsers[mesh] = new List<Renderer>();
+            }
+            users.Add(renderer);
+        }
+        
+        heaviestMeshes = meshUsers
+            .OrderByDescending(kv => CountTriangles(kv.Key))
+            .Take(topN)
+            .Select(kv => new Dictionary<string, object>
+            {
+                ["name"] = kv.Key.name,
+                ["assetPath"] = AssetDatabase.GetAssetPath(kv.Key),
+                ["vertices"] = kv.Key.vertexCount,
+                ["triangles"] = CountTriangles(kv.Key),
+                ["rendererCount"] = kv.Value.Count,
+                ["totalTriangles"] = CountTriangles(kv.Key) * kv.Value.Count,
+                ["example"] = HierarchyPathHelper.GetPath(kv.Value[0].transform)
+            })
+            .ToList();
+        
+        return new Dictionary<string, object>
+        {
+            ["rendererCount"] = renderers.Count,
+            ["totalVertices"] = totalVertices,
+            ["totalTriangles"] = totalTriangles,
+            ["uniqueMeshes"] = meshUsers.Count,
+            ["byRendererType"] = byType.ToDictionary(kv => kv.Key, kv => (object)new Dictionary<string, object>
+            {
+                ["count"] = kv.Value[0],
+                ["vertices"] = kv.Value[1],
+                ["triangles"] = kv.Value[2]
+            })
+        };
+    }
+    
+    /// <summary>
+    /// 材质使用次数 (按渲染器槽位计)，同时收集材质引用的纹理
+    /// </summary>
+    private Dictionary<string, object> GetMaterialStats(List<Renderer> renderers, int maxEntries,
+        Dictionary<Texture, HashSet<Material>> textures)
+    {
+        var usage = new Dictionary<Material, int>();
+        int emptySlots = 0;
+        foreach (Material material in renderers.SelectMany(r => r.sharedMaterials))
+        {
+            if (material == null)
+            {
+                emptySlots++;
+                continue;
+            }
+            usage[material] = usage.TryGetValue(material, out int count) ? count + 1 : 1;
+        }
+        foreach (Material material in usage.Keys)
+        {
+            foreach (string property in material.GetTexturePropertyNames())
+            {
+                Texture texture = material.GetTexture(property);
+                if (texture != null)
+                {
+                    AddTexture(textures, texture, material);
+                }
+            }
+        }
+        
+        return new Dictionary<string, object>
+        {
+            ["unique"] = usage.Count,
+            ["emptySlots"] = emptySlots,
+            ["usage"] = usage.OrderByDescending(kv => kv.Value).Take(maxEntries).Select(kv => new Dictionary<string, object>
+            {
+                ["name"] = kv.Key.name,
+                ["assetPath"] = AssetDatabase.GetAssetPath(kv.Key),
+                ["shader"] = kv.Key.shader != null ? kv.Key.shader.name : null,
+                ["count"] = kv.Value
+            }).ToList(),
+            ["truncated"] = usage.Count > maxEntries
+        };
+    }
+    
+    /// <summary>
+    /// 着色器使用情况: 使用它的不同材质数和渲染器槽位数
+    /// </summary>
+    private Dictionary<string, object> GetShaderStats(List<Renderer> renderers, int maxEntries)
+    {
+        var materials = new Dictionary<Shader, HashSet<Material>>();
+        var slots = new Dictionary<Shader, int>();
+        foreach (Material material in renderers.SelectMany(r => r.sharedMaterials))
+        {
+            if (material == null || material.shader == null)
+            {
+                continue;
+            }
+            Shader shader = material.shader;
+            if (!materials.ContainsKey(shader))
+            {
+                materials[shader] = new HashSet<Material>();
+                slots[shader] = 0;
+            }
+            materials[shader].Add(material);
+            slots[shader]++;
+        }
+        
+        return new Dictionary<string, object>
+        {
+            ["unique"] = materials.Count,
+            ["usage"] = slots.OrderByDescending(kv => kv.Value).Take(maxEntries).Select(kv => new Dictionary<string, object>
+            {
+                ["name"] = kv.Key.name,
+                ["materialCount"] = materials[kv.Key].Count,
+                ["count"] = kv.Value
+            }).ToList(),
+            ["truncated"] = materials.Count > maxEntries
+        };
+    }
+    
+    private Dictionary<string, object> GetLightStats(List<GameObject> objects)
+    {
+        var lights = objects.SelectMany(go => go.GetComponents<Light>()).ToList();
+        var enabled = lights.Where(l => l.enabled && l.gameObject.activeInHierarchy).ToList();
+        return new Dictionary<string, object>
+        {
+            ["total"] = lights.Count,
+            ["enabled"] = enabled.Count,
+            ["realtime"] = enabled.Count(l => l.lightmapBakeType == LightmapBakeType.Realtime),
+            ["mixed"] = enabled.Count(l => l.lightmapBakeType == LightmapBakeType.Mixed),
+            ["baked"] = enabled.Count(l => l.lightmapBakeType == LightmapBakeType.Baked),
+            ["shadowCasting"] = enabled.Count(l => l.shadows != LightShadows.None),
+            ["byType"] = enabled.GroupBy(l => l.type.ToString()).ToDictionary(g => g.Key, g => (object)g.Count())
+        };
+    }
+    
+    /// <summary>
+    /// Canvas和UI元素数量；UI元素按Graphic、Selectable和ScrollRect的具体类型统计，包括TextMeshPro
+    /// </summary>
+    private Dictionary<string, object> GetUIStats(List<GameObject> objects, Dictionary<Texture, HashSet<Material>> textures)
+    {
+        var canvases = objects.SelectMany(go => go.GetComponents<Canvas>()).ToList();
+        var elements = new SortedDictionary<string, int>();
+        foreach (GameObject go in objects)
+        {
+            foreach (Component component in go.GetComponents<Component>())
+            {
+                if (component is Graphic || component is Selectable || component is ScrollRect)
+                {
+                    string typeName = component.GetType().Name;
+                    elements[typeName] = elements.TryGetValue(typeName, out int count) ? count + 1 : 1;
+                }
+                // UI图片引用的纹理也计入纹理内存
+                if (component is Image image && image.sprite != null && image.sprite.texture != null)
+                {
+                    AddTexture(textures, image.sprite.texture, null);
+                }
+                else if (component is RawImage rawImage && rawImage.texture != null)
+                {
+                    AddTexture(textures, rawImage.texture, null);
+                }
+            }
+        }
+        
+        return new Dictionary<string, object>
+        {
+            ["canvasCount"] = canvases.Count,
+            ["rootCanvasCount"] = canvases.Count(c => c.isRootCanvas),
+            ["elementCount"] = elements.Values.Sum(),
+            ["elementsByType"] = elements.ToDictionary(kv => kv.Key, kv => (object)kv.Value)
+        };
+    }
+    
+    /// <summary>
+    /// 纹理内存估算，使用Profiler报告的运行时大小 (编辑器中可读纹理会多算一份CPU副本)
+    /// </summary>
+    private Dictionary<string, object> GetTextureStats(Dictionary<Texture, HashSet<Material>> textures, int topN,
+        out List<Dictionary<string, object>> heaviestTextures)
+    {
+        var sizes = textures.Keys.ToDictionary(t => t, t => Profiler.GetRuntimeMemorySizeLong(t));
+        long total = sizes.Values.Sum();
+        
+        heaviestTextures = sizes.OrderByDescending(kv => kv.Value).Take(topN).Select(kv =>
+        {
+            var info = new Dictionary<string, object>
+            {
+                ["name"] = kv.Key.name,
+                ["assetPath"] = AssetDatabase.GetAssetPath(kv.Key),
+                ["width"] = kv.Key.width,
+                ["height"] = kv.Key.height,
+                ["memoryBytes"] = kv.Value,
+                ["memory"] = EditorUtility.FormatBytes(kv.Value),
+                ["materialCount"] = textures[kv.Key].Count
+            };
+            if (kv.Key is Texture2D texture2D)
+            {
+                info["format"] = texture2D.format.ToString();
+            }
+            return info;
+        }).ToList();
+        
+        return new Dictionary<string, object>
+        {
+            ["unique"] = textures.Count,
+            ["estimatedMemoryBytes"] = total,
+            ["estimatedMemory"] = EditorUtility.FormatBytes(total)
+        };
+    }
+    
+    private static void AddTexture(Dictionary<Texture, HashSet<Material>> textures, Texture texture, Material material)
+    {
+        if (!textures.TryGetValue(texture, out HashSet<Material> materials))
+        {
+            materials = textures[texture] = new HashSet<Material>();
+        }
+        if (material != null)
+        {
+            materials.Add(material);
+        }
+    }
+    
+    private static Mesh GetMesh(Renderer renderer)
+    {
+        if (renderer is SkinnedMeshRenderer skinned)
+        {
+            return skinned.sharedMesh;
+        }
+        MeshFilter filter = renderer.GetComponent<MeshFilter>();
+        return renderer is MeshRenderer && filter != null ? filter.sharedMesh : null;
+    }
+    
+    /// <summary>
+    /// 三角形数量，使用索引数量计算，不需要网格可读
+    /// </summary>
+    private static long CountTriangles(Mesh mesh)
+    {
+        long triangles = 0;
+        for (int i = 0; i < mesh.subMeshCount; i++)
+        {
+            if (mesh.GetTopology(i) == MeshTopology.Triangles)
+            {
+                triangles += mesh.GetIndexCount(i) / 3;
+            }
+        }
+        return triangles;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -17,7 +17,8 @@ public class SceneTransformSetTool : IMCPTool
         try
         {
             int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
-            bool worldSpace = parameters.ContainsKey("worldSpace") ? (bool)parameters["worldSpace"] : true;
+            bool worldSpace = parameters.ContainsKey("worldSpace") ? System.Convert.ToBoolean(parameters["worldSpace"]) : true;
+            bool relative = parameters.ContainsKey("relative") && System.Convert.ToBoolean(parameters["relative"]);
             
             // 通过InstanceID查找GameObject
             GameObject targetObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
@@ -37,11 +38,7 @@ public class SceneTransformSetTool : IMCPTool
                 var posDict = parameters["position"] as Dictionary<string, object>;
                 if (posDict != null)
                 {
-                    Vector3 newPosition = new Vector3(
-                        posDict.ContainsKey("x") ? System.Convert.ToSingle(posDict["x"]) : (worldSpace ? transform.position.x : transform.localPosition.x),
-                        posDict.ContainsKey("y") ? System.Convert.ToSingle(posDict["y"]) : (worldSpace ? transform.position.y : transform.localPosition.y),
-                        posDict.ContainsKey("z") ? System.Convert.ToSingle(posDict["z"]) : (worldSpace ? transform.position.z : transform.localPosition.z)
-                    );
+                    Vector3 newPosition = ResolveVector(posDict, worldSpace ? transform.position : transform.localPosition, relative);
                     
                     if (worldSpace)
                     {
@@ -56,69 +53,14 @@ public class SceneTransformSetTool : IMCPTool
                 }
             }
             
-            // 设置旋转
+            // 设置旋转 (欧拉角)
             if (parameters.ContainsKey("rotation"))
             {
                 var rotDict = parameters["rotation"] as Dictionary<string, object>;
                 if (rotDict != null)
                 {
-                    Quaternion newRotation;
-                    
-                    // 检查是否提供了四元数
-                    if (rotDict.ContainsKey("quaternion"))
-                    {
-                        var quatDict = rotDict["quaternion"] as Dictionary<string, object>;
-                        if (quatDict != null)
-                        {
-                            newRotation = new Quaternion(
-                                quatDict.ContainsKey("x") ? System.Convert.ToSingle(quatDict["x"]) : 0f,
-                                quatDict.ContainsKey("y") ? System.Convert.ToSingle(quatDict["y"]) : 0f,
-                                quatDict.ContainsKey("z") ? System.Convert.ToSingle(quatDict["z"]) : 0f,
-                                quatDict.ContainsKey("w") ? System.Convert.ToSingle(quatDict["w"]) : 1f
-                            );
-                        }
-                        else
-                        {
-                            newRotation = worldSpace ? transform.rotation : transform.localRotation;
-                        }
-                    }
-                    // 检查是否提供了欧拉角
-                    else if (rotDict.ContainsKey("eulerAngles") || rotDict.ContainsKey("x") || rotDict.ContainsKey("y") || rotDict.ContainsKey("z"))
-                    {
-                        Vector3 currentEuler = worldSpace ? transform.rotation.eulerAngles : transform.localRotation.eulerAngles;
-                        
-                        Vector3 newEuler;
-                        if (rotDict.ContainsKey("eulerAngles"))
-                        {
-                            var eulerDict = rotDict["eulerAngles"] as Dictionary<string, object>;
-                            if (eulerDict != null)
-                            {
-                                newEuler = new Vector3(
-                                    eulerDict.ContainsKey("x") ? System.Convert.ToSingle(eulerDict["x"]) : currentEuler.x,
-                                    eulerDict.ContainsKey("y") ? System.Convert.ToSingle(eulerDict["y"]) : currentEuler.y,
-                                    eulerDict.ContainsKey("z") ? System.Convert.ToSingle(eulerDict["z"]) : currentEuler.z
-                                );
-                            }
-                            else
-                            {
-                                newEuler = currentEuler;
-                            }
-                        }
-                        else
-                        {
-                            newEuler = new Vector3(
-                                rotDict.ContainsKey("x") ? System.Convert.ToSingle(rotDict["x"]) : currentEuler.x,
-                                rotDict.ContainsKey("y") ? System.Convert.ToSingle(rotDict["y"]) : currentEuler.y,
-                                rotDict.ContainsKey("z") ? System.Convert.ToSingle(rotDict["z"]) : currentEuler.z
-                            );
-                        }
-                        
-                        newRotation = Quaternion.Euler(newEuler);
-                    }
-                    else
-                    {
-                        newRotation = worldSpace ? transform.rotation : transform.localRotation;
-                    }
+                    Vector3 currentEuler = worldSpace ? transform.rotation.eulerAngles : transform.localRotation.eulerAngles;
+                    Quaternion newRotation = Quaternion.Euler(ResolveVector(rotDict, currentEuler, relative));
                     
                     if (worldSpace)
                     {
@@ -139,12 +81,7 @@ public class SceneTransformSetTool : IMCPTool
                 var scaleDict = parameters["scale"] as Dictionary<string, object>;
                 if (scaleDict != null)
                 {
-                    Vector3 currentScale = transform.localScale;
-                    Vector3 newScale = new Vector3(
-                        scaleDict.ContainsKey("x") ? System.Convert.ToSingle(scaleDict["x"]) : currentScale.x,
-                        scaleDict.ContainsKey("y") ? System.Convert.ToSingle(scaleDict["y"]) : currentScale.y,
-                        scaleDict.ContainsKey("z") ? System.Convert.ToSingle(scaleDict["z"]) : currentScale.z
-                    );
+                    Vector3 newScale = ResolveVector(scaleDict, transform.localScale, relative);
                     
                     transform.localScale = newScale;
                     Debug.Log($"设置 '{targetObject.name}' 缩放: {newScale}");
@@ -156,7 +93,8 @@ public class SceneTransformSetTool : IMCPTool
             {
                 ["gameObjectName"] = targetObject.name,
                 ["gameObjectInstanceId"] = targetObject.GetInstanceID(),
-                ["worldSpace"] = worldSpace
+                ["worldSpace"] = worldSpace,
+                ["relative"] = relative
             };
             
             if (worldSpace)
@@ -237,6 +175,24 @@ public class SceneTransformSetTool : IMCPTool
         }
     }
     
+    /// <summary>
+    /// 计算新的向量值: 未提供的分量保持当前值，relative为true时提供的分量作为增量
+    /// </summary>
+    public static Vector3 ResolveVector(Dictionary<string, object> values, Vector3 current, bool relative)
+    {
+        float Component(string key, float currentValue)
+        {
+            if (!values.ContainsKey(key))
+            {
+                return currentValue;
+            }
+            float value = System.Convert.ToSingle(values[key]);
+            return relative ? currentValue + value : value;
+        }
+        
+        return new Vector3(Component("x", current.x), Component("y", current.y), Component("z", current.z));
+    }
+    
     public string ValidateParameters(Dictionary<string, object> parameters)
     {
         if (parameters == null)
@@ -264,6 +220,15 @@ public class SceneTransformSetTool : IMCPTool
             return "至少需要提供position、rotation或scale中的一个参数";
         }
         
+        // 嵌套参数由分发器转换为Dictionary，其他类型说明调用方传错了格式，不能当作未设置
+        foreach (string key in new[] { "position", "rotation", "scale" })
+        {
+            if (parameters.ContainsKey(key) && !(parameters[key] is Dictionary<string, object>))
+            {
+                return $"{key}必须是 {{x, y, z}} 对象";
+            }
+        }
+        
         return null;
     }
 }
@@ -0,0 +1,263 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.SceneManagement;
+using UnityEditor;
+using UnityEditor.SceneManagement;
+
+/// <summary>
+/// 场景检查工具 - 检查丢失的脚本、损坏或为空的对象引用、源资源丢失的预制体实例和不在Canvas下的UI元素
+/// 范围为当前场景、指定场景或Build Settings中的全部场景；未打开的场景以叠加方式打开，检查后关闭
+/// 每个场景开始时发送进度帧
+/// </summary>
+public class SceneValidateTool : IMCPTool
+{
+    private static readonly string[] AllCategories = { "missingScript", "missingReference", "nullReference", "missingPrefab", "uiOutsideCanvas" };
+    
+    private readonly MCPMessageDispatcher dispatcher;
+    
+    public SceneValidateTool(MCPMessageDispatcher dispatcher)
+    {
+        this.dispatcher = dispatcher;
+    }
+    
+    public string ToolName => "scene_validate";
+    
+    public string Description => "检查场景中的常见问题";
+    
+    /// <summary>
+    /// 一次检查的状态: 启用的类别、问题列表和各类别计数
+    /// </summary>
+    private class Scan
+    {
+        public HashSet<string> categories;
+        public int maxIssues;
+        public List<Dictionary<string, object>> issues = new List<Dictionary<string, object>>();
+        public Dictionary<string, int> counts = new Dictionary<string, int>();
+        public int objectCount;
+        public string scenePath;
+        public bool sceneWasOpen;
+        
+        public void Add(string category, GameObject gameObject, string component, string property, string message, string fix)
+        {
+            counts[category] = counts.TryGetValue(category, out int count) ? count + 1 : 1;
+            if (issues.Count >= maxIssues)
+            {
+                return;
+            }
+            var issue = new Dictionary<string, object>
+            {
+                ["category"] = category,
+                ["scene"] = scenePath,
+                ["objectPath"] = GetHierarchyPath(gameObject.transform),
+                ["message"] = message,
+                ["suggestedFix"] = fix
+            };
+            // 检查后关闭的场景中的InstanceID无法再使用
+            if (sceneWasOpen) issue["instanceId"] = gameObject.GetInstanceID();
+            if (component != null) issue["component"] = component;
+            if (property != null) issue["property"] = property;
+            issues.Add(issue);
+        }
+    }
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string scope = parameters.ContainsKey("scope") ? parameters["scope"].ToString().ToLower() : "active";
+            var scan = new Scan
+            {
+                categories = new HashSet<string>(AllCategories),
+                maxIssues = parameters.ContainsKey("maxIssues") ? System.Convert.ToInt32(parameters["maxIssues"]) : 200
+            };
+            if (parameters.ContainsKey("categories") && parameters["categories"] is System.Collections.IEnumerable list)
+            {
+                scan.categories.Clear();
+                foreach (object item in list)
+                {
+                    scan.categories.Add(item.ToString());
+                }
+            }
+            
+            var scenePaths = new List<string>();
+            switch (scope)
+            {
+                case "active":
+                    Scene active = SceneManager.GetActiveScene();
+                    scenePaths.Add(active.path);
+                    break;
+                case "scene":
+                    string scenePath = parameters["scenePath"].ToString();
+                    if (AssetDatabase.LoadAssetAtPath<SceneAsset>(scenePath) == null)
+                    {
+                        return MCPResponse.Error($"未找到场景: {scenePath}");
+                    }
+                    scenePaths.Add(scenePath);
+                    break;
+                case "build":
+                    foreach (EditorBuildSettingsScene buildScene in EditorBuildSettings.scenes)
+                    {
+                        if (buildScene.enabled) scenePaths.Add(buildScene.path);
+                    }
+                    if (scenePaths.Count == 0)
+                    {
+                        return MCPResponse.Error("Build Settings中没有启用的场景");
+                    }
+                    break;
+                default:
+                    return MCPResponse.Error($"不支持的范围: {scope}");
+            }
+            
+            var scanned = new List<string>();
+            for (int i = 0; i < scenePaths.Count; i++)
+            {
+                string path = scenePaths[i];
+                dispatcher.SendProgress(client, (float)i / scenePaths.Count, $"检查场景 {i + 1}/{scenePaths.Count}: {path}");
+                
+                // 当前场景可能尚未保存 (path为空)
+                Scene scene = string.IsNullOrEmpty(path) ? SceneManager.GetActiveScene() : SceneManager.GetSceneByPath(path);
+                scan.sceneWasOpen = scene.IsValid() && scene.isLoaded;
+                if (!scan.sceneWasOpen)
+                {
+                    scene = EditorSceneManager.OpenScene(path, OpenSceneMode.Additive);
+                }
+                scan.scenePath = string.IsNullOrEmpty(path) ? scene.name : path;
+                try
+                {
+                    foreach (GameObject root in scene.GetRootGameObjects())
+                    {
+                        foreach (Transform transform in root.GetComponentsInChildren<Transform>(true))
+                        {
+                            CheckObject(transform.gameObject, scan);
+                        }
+                    }
+                }
+                finally
+                {
+                    if (!scan.sceneWasOpen)
+                    {
+                        EditorSceneManager.CloseScene(scene, true);
+                    }
+                }
+                scanned.Add(scan.scenePath);
+            }
+            
+            int total = 0;
+            foreach (int count in scan.counts.Values) total += count;
+            Debug.Log($"场景检查完成: {scanned.Count} 个场景，{scan.objectCount} 个对象，{total} 个问题");
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["scope"] = scope,
+                ["scenes"] = scanned,
+                ["objectCount"] = scan.objectCount,
+                ["issueCount"] = total,
+                ["counts"] = scan.counts,
+                ["issues"] = scan.issues,
+                ["truncated"] = total > scan.issues.Count
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"检查场景时出错: {e.Message}");
+            return MCPResponse.Error($"检查场景失败: {e.Message}");
+        }
+    }
+    
+    private void CheckObject(GameObject gameObject, Scan scan)
+    {
+        scan.objectCount++;
+        
+        if (scan.categories.Contains("missingScript"))
+        {
+            int missing = GameObjectUtility.GetMonoBehavioursWithMissingScriptCount(gameObject);
+            if (missing > 0)
+            {
+                scan.Add("missingScript", gameObject, null, null,
+                    $"{missing} 个组件的脚本丢失",
+                    "恢复被删除或重命名的脚本，或用GameObjectUtility.RemoveMonoBehavioursWithMissingScript移除这些组件");
+            }
+        }
+        
+        if (scan.categories.Contains("missingPrefab") &&
+            PrefabUtility.GetPrefabInstanceStatus(gameObject) == PrefabInstanceStatus.MissingAsset &&
+            PrefabUtility.GetNearestPrefabInstanceRoot(gameObject) == gameObject)
+        {
+            scan.Add("missingPrefab", gameObject, null, null,
+                "预制体实例的源资源已丢失",
+                "恢复预制体资源，或在Hierarchy中右键选择Prefab > Unpack Completely解除关联");
+        }
+        
+        if (scan.categories.Contains("uiOutsideCanvas"))
+        {
+            var graphic = gameObject.GetComponent<UnityEngine.UI.Graphic>();
+            var selectable = gameObject.GetComponent<UnityEngine.UI.Selectable>();
+            if ((graphic != null || selectable != null) && gameObject.GetComponentInParent<Canvas>(true) == null)
+            {
+                string component = graphic != null ? graphic.GetType().Name : selectable.GetType().Name;
+                scan.Add("uiOutsideCanvas", gameObject, component, null,
+                    $"UI组件 {component} 不在任何Canvas下，不会被渲染",
+                    "把对象移动到Canvas下 (scene_object_set_parent)");
+            }
+        }
+        
+        if (scan.categories.Contains("missingReference") || scan.categories.Contains("nullReference"))
+        {
+            foreach (Component component in gameObject.GetComponents<Component>())
+            {
+                if (component != null)
+                {
+                    CheckReferences(gameObject, component, scan);
+                }
+            }
+        }
+    }
+    
+    /// <summary>
+    /// 引用了已删除对象的字段为missingReference；项目脚本中为空的引用字段为nullReference
+    /// </summary>
+    private void CheckReferences(GameObject gameObject, Component component, Scan scan)
+    {
+        bool projectScript = component is MonoBehaviour && !component.GetType().Assembly.GetName().Name.StartsWith("Unity");
+        SerializedProperty iterator = new SerializedObject(component).GetIterator();
+        while (iterator.NextVisible(true))
+        {
+            if (iterator.propertyType != SerializedPropertyType.ObjectReference || iterator.objectReferenceValue != null ||
+                iterator.propertyPath == "m_Script")
+            {
+                continue;
+            }
+            string typeName = component.GetType().Name;
+            if (iterator.objectReferenceInstanceIDValue != 0)
+            {
+                if (scan.categories.Contains("missingReference"))
+                {
+                    scan.Add("missingReference", gameObject, typeName, iterator.propertyPath,
+                        $"{typeName}.{iterator.propertyPath} 引用的对象已被删除",
+                        "使用component_set_property重新指定引用，或设置为null");
+                }
+            }
+            else if (projectScript && scan.categories.Contains("nullReference"))
+            {
+                scan.Add("nullReference", gameObject, typeName, iterator.propertyPath,
+                    $"{typeName}.{iterator.propertyPath} 未指定引用",
+                    "如果该字段是必需的，使用component_set_property指定引用");
+            }
+        }
+    }
+    
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,162 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// Scene视图控制工具 - 读取和设置Scene视图的观察点、旋转、缩放和2D/正交模式
+/// </summary>
+public class SceneViewControlTool : IMCPTool
+{
+    public string ToolName => "scene_view_control";
+    
+    public string Description => "控制Scene视图相机 (聚焦对象、移动视角、切换模式)";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string operation = parameters["operation"].ToString();
+            bool instant = parameters.ContainsKey("instant") ? System.Convert.ToBoolean(parameters["instant"]) : true;
+            
+            if (Application.isBatchMode)
+            {
+                return MCPResponse.Error("Unity以batchmode运行，没有Scene视图");
+            }
+            SceneView sceneView = SceneView.lastActiveSceneView;
+            if (sceneView == null && SceneView.sceneViews.Count > 0)
+            {
+                sceneView = SceneView.sceneViews[0] as SceneView;
+            }
+            if (sceneView == null)
+            {
+                return MCPResponse.Error("没有打开的Scene视图，请在Unity中打开Scene窗口 (Window > General > Scene)");
+            }
+            
+            switch (operation)
+            {
+                case "get":
+                    break;
+                    
+                case "frame":
+                {
+                    int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+                    GameObject target = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+                    if (target == null || !target.scene.IsValid())
+                    {
+                        return MCPResponse.Error($"未找到场景中的GameObject (InstanceID: {instanceId})");
+                    }
+                    sceneView.Frame(GetBounds(target), instant);
+                    break;
+                }
+                
+                case "look_at":
+                {
+                    Vector3 pivot = parameters.ContainsKey("position") ?
+                        ResolveVector((Dictionary<string, object>)parameters["position"], sceneView.pivot) : sceneView.pivot;
+                    Vector3 euler = parameters.ContainsKey("rotation") ?
+                        ResolveVector((Dictionary<string, object>)parameters["rotation"], sceneView.rotation.eulerAngles) : sceneView.rotation.eulerAngles;
+                    float size = parameters.ContainsKey("size") ? System.Convert.ToSingle(parameters["size"]) : sceneView.size;
+                    bool orthographic = parameters.ContainsKey("orthographic") ?
+                        System.Convert.ToBoolean(parameters["orthographic"]) : sceneView.orthographic;
+                    if (sceneView.in2DMode && parameters.ContainsKey("rotation"))
+                    {
+                        return MCPResponse.Error("Scene视图处于2D模式，不能设置rotation，请先用set_mode切换到3D");
+                    }
+                    sceneView.LookAt(pivot, Quaternion.Euler(euler), size, orthographic, instant);
+                    break;
+                }
+                
+                case "set_mode":
+                    if (parameters.ContainsKey("in2DMode"))
+                    {
+                        sceneView.in2DMode = System.Convert.ToBoolean(parameters["in2DMode"]);
+                    }
+                    if (parameters.ContainsKey("orthographic"))
+                    {
+                        sceneView.orthographic = System.Convert.ToBoolean(parameters["orthographic"]);
+                    }
+                    break;
+                    
+                default:
+                    return MCPResponse.Error($"不支持的操作: {operation}");
+            }
+            
+            sceneView.Repaint();
+            var result = DescribeView(sceneView);
+            result["operation"] = operation;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"控制Scene视图时出错: {e.Message}");
+            return MCPResponse.Error($"控制Scene视图失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 对象及其子对象的渲染器包围盒，没有渲染器时使用碰撞体，都没有时以对象位置为中心
+    /// </summary>
+    private static Bounds GetBounds(GameObject target)
+    {
+        var bounds = target.GetComponentsInChildren<Renderer>().Select(r => r.bounds)
+            .Concat(target.GetComponentsInChildren<Collider>().Select(c => c.bounds))
+            .ToList();
+        if (bounds.Count == 0)
+        {
+            return new Bounds(target.transform.position, Vector3.one);
+        }
+        Bounds combined = bounds[0];
+        foreach (Bounds b in bounds.Skip(1))
+        {
+            combined.Encapsulate(b);
+        }
+        return combined;
+    }
+    
+    /// <summary>
+    /// 未提供的分量保持当前值
+    /// </summary>
+    private static Vector3 ResolveVector(Dictionary<string, object> values, Vector3 current)
+    {
+        values = values ?? new Dictionary<string, object>();
+        float Component(string key, float currentValue)
+        {
+            return values.ContainsKey(key) ? System.Convert.ToSingle(values[key]) : currentValue;
+        }
+        return new Vector3(Component("x", current.x), Component("y", current.y), Component("z", current.z));
+    }
+    
+    private static Dictionary<string, object> DescribeView(SceneView sceneView)
+    {
+        Vector3 pivot = sceneView.pivot;
+        Vector3 euler = sceneView.rotation.eulerAngles;
+        Vector3 cameraPosition = sceneView.camera != null ? sceneView.camera.transform.position : pivot;
+        return new Dictionary<string, object>
+        {
+            ["pivot"] = new Dictionary<string, float> { ["x"] = pivot.x, ["y"] = pivot.y, ["z"] = pivot.z },
+            ["rotation"] = new Dictionary<string, float> { ["x"] = euler.x, ["y"] = euler.y, ["z"] = euler.z },
+            ["size"] = sceneView.size,
+            ["in2DMode"] = sceneView.in2DMode,
+            ["orthographic"] = sceneView.orthographic,
+            ["cameraPosition"] = new Dictionary<string, float> { ["x"] = cameraPosition.x, ["y"] = cameraPosition.y, ["z"] = cameraPosition.z }
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("operation"))
+        {
+            return "缺少必需参数: operation";
+        }
+        foreach (string key in new[] { "position", "rotation" })
+        {
+            if (parameters.ContainsKey(key) && !(parameters[key] is Dictionary<string, object>))
+            {
+                return $"{key}必须是 {{x, y, z}} 对象";
+            }
+        }
+        return null;
+    }
+}
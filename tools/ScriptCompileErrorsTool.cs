@@ -0,0 +1,71 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 编译错误工具 - 返回当前的脚本编译状态和编译错误
+/// </summary>
+public class ScriptCompileErrorsTool : IMCPTool
+{
+    public string ToolName => "script_get_compile_errors";
+    
+    public string Description => "获取当前的脚本编译状态和编译错误列表";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            bool includeWarnings = parameters.ContainsKey("includeWarnings") ? System.Convert.ToBoolean(parameters["includeWarnings"]) : false;
+            
+            var lastResult = CompileTracker.LastResult;
+            var messages = lastResult != null ? lastResult.messages : new List<CompileTracker.Message>();
+            var errors = messages.Where(m => m.type == "error").Select(ToDictionary).ToList();
+            
+            var result = new Dictionary<string, object>
+            {
+                ["isCompiling"] = EditorApplication.isCompiling,
+                ["compileGeneration"] = CompileTracker.Generation,
+                // 本次会话还没有编译时使用编辑器记录的状态
+                ["compileSucceeded"] = lastResult != null ? lastResult.succeeded : !EditorUtility.scriptCompilationFailed,
+                ["errorCount"] = errors.Count,
+                ["warningCount"] = messages.Count(m => m.type == "warning"),
+                ["errors"] = errors
+            };
+            if (lastResult != null)
+            {
+                result["lastCompileFinished"] = lastResult.finishedAt;
+            }
+            if (includeWarnings)
+            {
+                result["warnings"] = messages.Where(m => m.type == "warning").Select(ToDictionary).ToList();
+            }
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取编译错误时出错: {e.Message}");
+            return MCPResponse.Error($"获取编译错误失败: {e.Message}");
+        }
+    }
+    
+    private static Dictionary<string, object> ToDictionary(CompileTracker.Message message)
+    {
+        return new Dictionary<string, object>
+        {
+            ["file"] = message.file,
+            ["line"] = message.line,
+            ["column"] = message.column,
+            ["message"] = message.message,
+            ["assembly"] = message.assembly
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        // 此工具不需要必需参数
+        return null;
+    }
+}
@@ -0,0 +1,88 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+
+/// <summary>
+/// 脚本列表工具 - 列出Assets目录下的C#脚本文件
+/// </summary>
+public class ScriptListTool : IMCPTool
+{
+    public string ToolName => "script_list";
+    
+    public string Description => "列出Assets目录下的C#脚本文件，支持路径前缀过滤";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string prefix = parameters.ContainsKey("prefix") && parameters["prefix"] != null ? parameters["prefix"].ToString() : "";
+            int maxResults = parameters.ContainsKey("maxResults") ? Convert.ToInt32(parameters["maxResults"]) : 500;
+            
+            // 统一为相对Assets目录、使用/分隔的路径
+            prefix = prefix.Replace('\\', '/').TrimStart('/');
+            if (prefix.StartsWith("Assets/"))
+            {
+                prefix = prefix.Substring("Assets/".Length);
+            }
+            
+            string root = Application.dataPath;
+            var matches = Directory.GetFiles(root, "*.cs", SearchOption.AllDirectories)
+                .Select(file => file.Substring(root.Length).Replace('\\', '/').TrimStart('/'))
+                .Where(path => path.StartsWith(prefix, StringComparison.OrdinalIgnoreCase))
+                .OrderBy(path => path, StringComparer.Ordinal)
+                .ToList();
+            
+            var scripts = new List<Dictionary<string, object>>();
+            foreach (string path in matches.Take(maxResults))
+            {
+                var fileInfo = new FileInfo(Path.Combine(root, path));
+                scripts.Add(new Dictionary<string, object>
+                {
+                    ["path"] = path,
+                    ["size"] = fileInfo.Length,
+                    ["lastModified"] = fileInfo.LastWriteTimeUtc.ToString("yyyy-MM-ddTHH:mm:ssZ")
+                });
+            }
+            
+            var result = new Dictionary<string, object>
+            {
+                ["prefix"] = prefix,
+                ["totalFound"] = matches.Count,
+                ["truncated"] = matches.Count > maxResults,
+                ["scripts"] = scripts
+            };
+            
+            Debug.Log($"列出脚本文件: 前缀 '{prefix}'，共 {matches.Count} 个");
+            
+            return MCPResponse.Success(result);
+        }
+        catch (Exception e)
+        {
+            Debug.LogError($"列出脚本文件时出错: {e.Message}");
+            return MCPResponse.Error($"列出脚本失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (parameters != null && parameters.ContainsKey("maxResults"))
+        {
+            try
+            {
+                if (Convert.ToInt32(parameters["maxResults"]) <= 0)
+                {
+                    return "maxResults必须大于0";
+                }
+            }
+            catch
+            {
+                return "maxResults必须是有效的整数";
+            }
+        }
+        
+        return null; // 验证通过
+    }
+}
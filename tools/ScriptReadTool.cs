@@ -1,6 +1,7 @@
 using System.Collections.Generic;
 using System.IO;
 using System.Net.Sockets;
+using System.Text;
 using UnityEngine;
 
 /// <summary>
@@ -37,19 +38,70 @@ public class ScriptReadTool : IMCPTool
                 return MCPResponse.Error($"不支持的文件类型: {extension}");
             }
             
-            // 读取文件内容
-            string content = File.ReadAllText(filePath);
+            int startLine = parameters.ContainsKey("startLine") ? System.Convert.ToInt32(parameters["startLine"]) : 1;
+            int endLine = parameters.ContainsKey("endLine") ? System.Convert.ToInt32(parameters["endLine"]) : int.MaxValue;
+            bool includeLineNumbers = parameters.ContainsKey("includeLineNumbers") ? System.Convert.ToBoolean(parameters["includeLineNumbers"]) : false;
+            int maxBytes = parameters.ContainsKey("maxBytes") ? System.Convert.ToInt32(parameters["maxBytes"]) : int.MaxValue;
+            
+            // 读取文件内容，按BOM识别编码
+            byte[] bytes = File.ReadAllBytes(filePath);
+            string encodingName;
+            string text = DecodeText(bytes, out encodingName);
+            
+            string[] lines = text.Split('\n');
+            int totalLines = text.EndsWith("\n") ? lines.Length - 1 : lines.Length;
+            if (totalLines == 0)
+            {
+                totalLines = text.Length > 0 ? 1 : 0;
+            }
+            if (startLine > System.Math.Max(totalLines, 1))
+            {
+                return MCPResponse.Error($"startLine {startLine} 超出文件行数 {totalLines}");
+            }
+            endLine = System.Math.Min(endLine, totalLines);
+            
+            // 按行截取，超过maxBytes时在行边界截断
+            var content = new StringBuilder();
+            int contentBytes = 0;
+            int lastLine = startLine - 1;
+            bool truncated = false;
+            for (int i = startLine; i <= endLine; i++)
+            {
+                // 保留原有的换行符 (包括CRLF中的\r)，最后一行没有换行时不补充
+                string line = lines[i - 1];
+                string newline = i < totalLines || text.EndsWith("\n") ? "\n" : "";
+                string outputLine = (includeLineNumbers ? $"{i.ToString().PadLeft(endLine.ToString().Length)}: {line}" : line) + newline;
+                int lineBytes = Encoding.UTF8.GetByteCount(outputLine);
+                if (contentBytes + lineBytes > maxBytes)
+                {
+                    truncated = true;
+                    break;
+                }
+                content.Append(outputLine);
+                contentBytes += lineBytes;
+                lastLine = i;
+            }
             
             var result = new Dictionary<string, object>
             {
                 ["path"] = filePath,
-                ["content"] = content,
-                ["size"] = content.Length,
+                ["content"] = content.ToString(),
+                ["size"] = contentBytes,
+                ["fileSize"] = bytes.Length,
+                ["totalLines"] = totalLines,
+                ["startLine"] = startLine,
+                ["endLine"] = lastLine,
+                ["truncated"] = truncated,
+                ["encoding"] = encodingName,
                 ["extension"] = extension,
                 ["fileName"] = Path.GetFileName(filePath)
             };
+            if (truncated)
+            {
+                result["nextStartLine"] = lastLine + 1;
+            }
             
-            Debug.Log($"成功读取脚本文件: {filePath} ({content.Length} 字符)");
+            Debug.Log($"成功读取脚本文件: {filePath} (第 {startLine}-{lastLine} 行，共 {totalLines} 行)");
             
             return MCPResponse.Success(result);
         }
@@ -60,6 +112,44 @@ public class ScriptReadTool : IMCPTool
         }
     }
     
+    /// <summary>
+    /// 按BOM识别编码并解码，没有BOM时按UTF-8严格解码，失败时标记为invalid-utf-8并用替换字符解码
+    /// </summary>
+    private static string DecodeText(byte[] bytes, out string encodingName)
+    {
+        if (bytes.Length >= 3 && bytes[0] == 0xEF && bytes[1] == 0xBB && bytes[2] == 0xBF)
+        {
+            encodingName = "utf-8-bom";
+            return Encoding.UTF8.GetString(bytes, 3, bytes.Length - 3);
+        }
+        if (bytes.Length >= 4 && bytes[0] == 0xFF && bytes[1] == 0xFE && bytes[2] == 0 && bytes[3] == 0)
+        {
+            encodingName = "utf-32le";
+            return Encoding.UTF32.GetString(bytes, 4, bytes.Length - 4);
+        }
+        if (bytes.Length >= 2 && bytes[0] == 0xFF && bytes[1] == 0xFE)
+        {
+            encodingName = "utf-16le";
+            return Encoding.Unicode.GetString(bytes, 2, bytes.Length - 2);
+        }
+        if (bytes.Length >= 2 && bytes[0] == 0xFE && bytes[1] == 0xFF)
+        {
+            encodingName = "utf-16be";
+            return Encoding.BigEndianUnicode.GetString(bytes, 2, bytes.Length - 2);
+        }
+        
+        try
+        {
+            encodingName = "utf-8";
+            return new UTF8Encoding(false, true).GetString(bytes);
+        }
+        catch (DecoderFallbackException)
+        {
+            encodingName = "invalid-utf-8";
+            return Encoding.UTF8.GetString(bytes);
+        }
+    }
+    
     public string ValidateParameters(Dictionary<string, object> parameters)
     {
         if (parameters == null || !parameters.ContainsKey("path"))
@@ -72,6 +162,12 @@ public class ScriptReadTool : IMCPTool
             return "path参数不能为空";
         }
         
+        if (parameters.ContainsKey("startLine") && parameters.ContainsKey("endLine") &&
+            System.Convert.ToInt32(parameters["startLine"]) > System.Convert.ToInt32(parameters["endLine"]))
+        {
+            return "startLine不能大于endLine";
+        }
+        
         return null; // 验证通过
     }
 }
@@ -1,8 +1,11 @@
 using System.Collections.Generic;
 using System.IO;
+using System.Linq;
 using System.Net.Sockets;
+using System.Text.RegularExpressions;
 using UnityEngine;
 using UnityEditor;
+using UnityEditor.Compilation;
 
 /// <summary>
 /// 脚本写入工具 - 创建或更新脚本文件
@@ -19,7 +22,9 @@ public class ScriptWriteTool : IMCPTool
         {
             string filePath = parameters["path"].ToString();
             string content = parameters["content"].ToString();
-            bool overwrite = parameters.ContainsKey("overwrite") ? (bool)parameters["overwrite"] : true;
+            string mode = parameters.ContainsKey("mode") ? parameters["mode"].ToString().ToLower() : "overwrite";
+            bool overwrite = parameters.ContainsKey("overwrite") ? System.Convert.ToBoolean(parameters["overwrite"]) : true;
+            bool backup = parameters.ContainsKey("backup") ? System.Convert.ToBoolean(parameters["backup"]) : false;
             
             // 转换为绝对路径
             if (!Path.IsPathRooted(filePath))
@@ -27,12 +32,69 @@ public class ScriptWriteTool : IMCPTool
                 filePath = Path.Combine(Application.dataPath, filePath);
             }
             
+            // 检查文件扩展名
+            string extension = Path.GetExtension(filePath).ToLower();
+            if (string.IsNullOrEmpty(extension))
+            {
+                filePath += ".cs"; // 默认为C#脚本
+                extension = ".cs";
+            }
+            
+            if (extension != ".cs" && extension != ".js" && extension != ".py" && extension != ".txt")
+            {
+                return MCPResponse.Error($"不支持的文件类型: {extension}");
+            }
+            
             // 检查文件是否已存在
             bool fileExists = File.Exists(filePath);
-            if (fileExists && !overwrite)
+            if (fileExists && mode == "overwrite" && !overwrite)
             {
                 return MCPResponse.Error($"文件已存在且不允许覆盖: {filePath}");
             }
+            if (!fileExists && (mode == "patch" || mode == "insert"))
+            {
+                return MCPResponse.Error($"文件不存在，无法使用{mode}模式: {filePath}");
+            }
+            
+            // 先在内存中生成新内容，任何错误都不会写入文件
+            string original = fileExists ? File.ReadAllText(filePath) : "";
+            string updated;
+            int linesAdded;
+            int linesRemoved;
+            string error;
+            switch (mode)
+            {
+                case "overwrite":
+                    updated = content;
+                    linesRemoved = CountLines(original);
+                    linesAdded = CountLines(content);
+                    break;
+                case "append":
+                    string separator = original.Length > 0 && !original.EndsWith("\n") ? DetectNewline(original) : "";
+                    updated = original + separator + content;
+                    linesRemoved = 0;
+                    linesAdded = CountLines(content);
+                    break;
+                case "insert":
+                    int insertAt = System.Convert.ToInt32(parameters["insertAt"]);
+                    updated = InsertLines(original, content, insertAt, out error);
+                    if (updated == null)
+                    {
+                        return MCPResponse.Error(error);
+                    }
+                    linesRemoved = 0;
+                    linesAdded = CountLines(content);
+                    break;
+                case "patch":
+                    updated = ApplyPatch(original, content, out linesAdded, out linesRemoved, out error);
+                    if (updated == null)
+                    {
+                        return MCPResponse.Error($"补丁未应用，文件未修改: {error}");
+                    }
+                    break;
+                default:
+                    return MCPResponse.Error($"不支持的写入模式: {mode}");
+            }
             
             // 确保目录存在
             string directory = Path.GetDirectoryName(filePath);
@@ -42,39 +104,60 @@ public class ScriptWriteTool : IMCPTool
                 Debug.Log($"创建目录: {directory}");
             }
             
-            // 检查文件扩展名
-            string extension = Path.GetExtension(filePath).ToLower();
-            if (string.IsNullOrEmpty(extension))
+            // 备份旧版本
+            string backupPath = null;
+            if (backup && fileExists)
             {
-                filePath += ".cs"; // 默认为C#脚本
-                extension = ".cs";
+                backupPath = filePath + ".bak";
+                File.Copy(filePath, backupPath, true);
             }
             
-            if (extension != ".cs" && extension != ".js" && extension != ".py" && extension != ".txt")
+            // 写入临时文件后替换，避免写入失败时留下不完整的文件
+            string tempPath = filePath + ".tmp";
+            File.WriteAllText(tempPath, updated);
+            if (fileExists)
             {
-                return MCPResponse.Error($"不支持的文件类型: {extension}");
+                File.Replace(tempPath, filePath, null);
+            }
+            else
+            {
+                File.Move(tempPath, filePath);
             }
-            
-            // 写入文件
-            File.WriteAllText(filePath, content);
             
             // 刷新Unity资源数据库
             string relativePath = filePath.Replace(Application.dataPath, "Assets");
             AssetDatabase.ImportAsset(relativePath);
             
+            // 等待编译结果时确保触发一次编译，否则内容未变化时不会产生新的编译结果
+            bool waitForCompile = parameters.ContainsKey("waitForCompile") && System.Convert.ToBoolean(parameters["waitForCompile"]);
+            if (waitForCompile && extension == ".cs")
+            {
+                CompilationPipeline.RequestScriptCompilation();
+            }
+            
             var result = new Dictionary<string, object>
             {
                 ["path"] = filePath,
                 ["relativePath"] = relativePath,
-                ["size"] = content.Length,
+                ["mode"] = mode,
+                ["size"] = updated.Length,
                 ["extension"] = extension,
                 ["fileName"] = Path.GetFileName(filePath),
                 ["created"] = !fileExists,
-                ["updated"] = fileExists
+                ["updated"] = fileExists,
+                ["linesAdded"] = linesAdded,
+                ["linesRemoved"] = linesRemoved,
+                ["totalLines"] = CountLines(updated),
+                ["backupCreated"] = backupPath != null,
+                ["compileRequested"] = waitForCompile && extension == ".cs"
             };
+            if (backupPath != null)
+            {
+                result["backupPath"] = backupPath;
+            }
             
             string action = fileExists ? "更新" : "创建";
-            Debug.Log($"成功{action}脚本文件: {filePath} ({content.Length} 字符)");
+            Debug.Log($"成功{action}脚本文件 ({mode}): {filePath} (+{linesAdded} -{linesRemoved} 行)");
             
             return MCPResponse.Success(result);
         }
@@ -85,6 +168,156 @@ public class ScriptWriteTool : IMCPTool
         }
     }
     
+    /// <summary>
+    /// 文本的行数，末尾的换行不算新的一行
+    /// </summary>
+    private static int CountLines(string text)
+    {
+        if (string.IsNullOrEmpty(text))
+        {
+            return 0;
+        }
+        int count = text.Split('\n').Length;
+        return text.EndsWith("\n") ? count - 1 : count;
+    }
+    
+    /// <summary>
+    /// 文件使用的换行符，默认\n
+    /// </summary>
+    private static string DetectNewline(string text)
+    {
+        return text.Contains("\r\n") ? "\r\n" : "\n";
+    }
+    
+    /// <summary>
+    /// 在第insertAt行之前插入内容 (1-based)，insertAt为总行数+1时追加到末尾
+    /// </summary>
+    private static string InsertLines(string original, string content, int insertAt, out string error)
+    {
+        error = null;
+        string newline = DetectNewline(original);
+        var lines = new List<string>(original.Replace("\r\n", "\n").Split('\n'));
+        bool trailingNewline = original.EndsWith("\n");
+        if (trailingNewline)
+        {
+            lines.RemoveAt(lines.Count - 1);
+        }
+        if (original.Length == 0)
+        {
+            lines.Clear();
+        }
+        if (insertAt < 1 || insertAt > lines.Count + 1)
+        {
+            error = $"insertAt必须在1到{lines.Count + 1}之间，实际为{insertAt}";
+            return null;
+        }
+        
+        var inserted = content.Replace("\r\n", "\n").Split('\n').ToList();
+        if (content.EndsWith("\n"))
+        {
+            inserted.RemoveAt(inserted.Count - 1);
+        }
+        lines.InsertRange(insertAt - 1, inserted);
+        // 空文件按插入内容决定是否以换行结尾
+        bool endsWithNewline = original.Length == 0 ? content.EndsWith("\n") : trailingNewline;
+        return string.Join(newline, lines) + (endsWithNewline ? newline : "");
+    }
+    
+    /// <summary>
+    /// 应用unified diff，所有hunk的上下文和删除行必须与当前文件完全一致 (忽略行尾\r)，否则整个补丁被拒绝
+    /// </summary>
+    private static string ApplyPatch(string original, string diff, out int linesAdded, out int linesRemoved, out string error)
+    {
+        linesAdded = 0;
+        linesRemoved = 0;
+        error = null;
+        string newline = DetectNewline(original);
+        bool trailingNewline = original.Length == 0 || original.EndsWith("\n");
+        var source = original.Replace("\r\n", "\n").Split('\n').ToList();
+        if (original.EndsWith("\n") || original.Length == 0)
+        {
+            source.RemoveAt(source.Count - 1);
+        }
+        
+        var output = new List<string>();
+        int position = 0; // source中下一行的索引
+        int hunkIndex = 0;
+        var diffLines = diff.Replace("\r\n", "\n").TrimEnd('\n').Split('\n');
+        for (int i = 0; i < diffLines.Length; i++)
+        {
+            if (!diffLines[i].StartsWith("@@"))
+            {
+                continue;
+            }
+            hunkIndex++;
+            var header = Regex.Match(diffLines[i], @"^@@ -(\d+)(?:,(\d+))? \+(\d+)(?:,(\d+))? @@");
+            if (!header.Success)
+            {
+                error = $"hunk {hunkIndex} 头部格式无效: {diffLines[i]}";
+                return null;
+            }
+            int oldStart = int.Parse(header.Groups[1].Value);
+            int oldCount = header.Groups[2].Success ? int.Parse(header.Groups[2].Value) : 1;
+            // 删除0行的hunk中oldStart指向插入位置之前的行
+            int hunkStart = oldCount == 0 ? oldStart : oldStart - 1;
+            if (hunkStart < position || hunkStart > source.Count)
+            {
+                error = $"hunk {hunkIndex} 的起始行 {oldStart} 超出文件范围或与前一个hunk重叠";
+                return null;
+            }
+            
+            // 复制hunk之前未修改的行
+            output.AddRange(source.GetRange(position, hunkStart - position));
+            position = hunkStart;
+            
+            for (i = i + 1; i < diffLines.Length && !diffLines[i].StartsWith("@@"); i++)
+            {
+                string line = diffLines[i];
+                if (line.StartsWith("\\"))
+                {
+                    continue;
+                }
+                char kind = line.Length == 0 ? ' ' : line[0];
+                string text = line.Length == 0 ? "" : line.Substring(1);
+                if (kind == '+')
+                {
+                    output.Add(text);
+                    linesAdded++;
+                    continue;
+                }
+                if (kind != ' ' && kind != '-')
+                {
+                    continue;
+                }
+                if (position >= source.Count || source[position].TrimEnd('\r') != text.TrimEnd('\r'))
+                {
+                    string actual = position < source.Count ? source[position] : "<end of file>";
+                    error = $"hunk {hunkIndex} 在第 {position + 1} 行上下文不匹配: 期望 \"{text}\"，实际 \"{actual}\"";
+                    return null;
+                }
+                if (kind == ' ')
+                {
+                    output.Add(source[position]);
+                }
+                else
+                {
+                    linesRemoved++;
+                }
+                position++;
+            }
+            i--;
+        }
+        
+        if (hunkIndex == 0)
+        {
+            error = "补丁中没有hunk";
+            return null;
+        }
+        
+        output.AddRange(source.GetRange(position, source.Count - position));
+        return string.Join(newline, output) + (trailingNewline && output.Count > 0 ? newline : "");
+    }
+    
     public string ValidateParameters(Dictionary<string, object> parameters)
     {
         if (parameters == null)
@@ -102,6 +335,17 @@ public class ScriptWriteTool : IMCPTool
             return "缺少必需参数: content";
         }
         
+        string mode = parameters.ContainsKey("mode") ? parameters["mode"].ToString().ToLower() : "overwrite";
+        if (mode != "overwrite" && mode != "patch" && mode != "append" && mode != "insert")
+        {
+            return "mode必须是以下值之一: overwrite, patch, append, insert";
+        }
+        
+        if (mode == "insert" && !parameters.ContainsKey("insertAt"))
+        {
+            return "insert模式需要insertAt参数";
+        }
+        
         return null; // 验证通过
     }
 }
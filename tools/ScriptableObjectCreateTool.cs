@@ -0,0 +1,91 @@
+using System.Collections.Generic;
+using System.IO;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// ScriptableObject创建工具 - 创建指定类型的ScriptableObject资源并设置初始字段
+/// 类型不存在时返回typeNotFound和可创建的类型列表，由服务端给出相似类型的建议
+/// </summary>
+public class ScriptableObjectCreateTool : IMCPTool
+{
+    public string ToolName => "scriptable_object_create";
+    
+    public string Description => "创建ScriptableObject资源";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string typeName = parameters["typeName"].ToString();
+            string savePath = parameters["savePath"].ToString();
+            bool overwrite = parameters.ContainsKey("overwrite") && System.Convert.ToBoolean(parameters["overwrite"]);
+            
+            if (!overwrite && AssetDatabase.LoadAssetAtPath<Object>(savePath) != null)
+            {
+                return MCPResponse.Error($"资源已存在: {savePath}，设置overwrite=true以替换");
+            }
+            
+            System.Type type = ScriptableObjectHelper.FindType(typeName, out string typeError);
+            if (typeError != null)
+            {
+                return MCPResponse.Error(typeError);
+            }
+            if (type == null)
+            {
+                return MCPResponse.Success(new Dictionary<string, object>
+                {
+                    ["typeNotFound"] = typeName,
+                    ["candidates"] = ScriptableObjectHelper.CandidateNames()
+                });
+            }
+            
+            // 在创建资源之前写入字段，任何字段失败时不创建资源
+            ScriptableObject asset = ScriptableObject.CreateInstance(type);
+            if (parameters.ContainsKey("fields") && parameters["fields"] is Dictionary<string, object> fields)
+            {
+                string error = ScriptableObjectHelper.ApplyFields(new SerializedObject(asset), fields, out _);
+                if (error != null)
+                {
+                    Object.DestroyImmediate(asset);
+                    return MCPResponse.Error(error);
+                }
+            }
+            
+            string directory = Path.GetDirectoryName(savePath);
+            if (!string.IsNullOrEmpty(directory) && !Directory.Exists(directory))
+            {
+                Directory.CreateDirectory(directory);
+                AssetDatabase.Refresh();
+            }
+            if (overwrite)
+            {
+                AssetDatabase.DeleteAsset(savePath);
+            }
+            AssetDatabase.CreateAsset(asset, savePath);
+            AssetDatabase.SaveAssets();
+            
+            Debug.Log($"创建ScriptableObject: {savePath} (类型: {type.FullName})");
+            return MCPResponse.Success(ScriptableObjectHelper.Describe(asset));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"创建ScriptableObject时出错: {e.Message}");
+            return MCPResponse.Error($"创建ScriptableObject失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("typeName") || string.IsNullOrEmpty(parameters["typeName"].ToString()))
+        {
+            return "缺少必需参数: typeName";
+        }
+        if (!parameters.ContainsKey("savePath"))
+        {
+            return "缺少必需参数: savePath";
+        }
+        return null;
+    }
+}
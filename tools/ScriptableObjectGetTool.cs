@@ -0,0 +1,45 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// ScriptableObject读取工具 - 导出ScriptableObject资源的序列化字段，属性路径可直接用于scriptable_object_set
+/// </summary>
+public class ScriptableObjectGetTool : IMCPTool
+{
+    public string ToolName => "scriptable_object_get";
+    
+    public string Description => "获取ScriptableObject的序列化字段";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            int maxProperties = parameters.ContainsKey("maxProperties") ? System.Convert.ToInt32(parameters["maxProperties"]) : 500;
+            int maxArrayElements = parameters.ContainsKey("maxArrayElements") ? System.Convert.ToInt32(parameters["maxArrayElements"]) : 50;
+            
+            ScriptableObject asset = AssetDatabase.LoadAssetAtPath<ScriptableObject>(assetPath);
+            if (asset == null)
+            {
+                return MCPResponse.Error($"未找到ScriptableObject资源: {assetPath}");
+            }
+            return MCPResponse.Success(ScriptableObjectHelper.Describe(asset, maxProperties, maxArrayElements));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取ScriptableObject字段时出错: {e.Message}");
+            return MCPResponse.Error($"获取ScriptableObject字段失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("assetPath"))
+        {
+            return "缺少必需参数: assetPath";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,115 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// ScriptableObject辅助方法: 解析类型名、批量写入字段和导出字段
+/// </summary>
+public static class ScriptableObjectHelper
+{
+    /// <summary>
+    /// 类型不存在时返回给服务端用于相似度建议的类型名数量上限
+    /// </summary>
+    private const int MaxCandidates = 2000;
+    
+    /// <summary>
+    /// 按完整类型名或短名 (不区分大小写) 查找可创建的ScriptableObject类型
+    /// 短名不唯一时error列出所有匹配；找不到时返回null且error为null
+    /// </summary>
+    public static System.Type FindType(string typeName, out string error)
+    {
+        error = null;
+        var matches = new List<System.Type>();
+        foreach (System.Type type in CreatableTypes())
+        {
+            if (string.Equals(type.FullName, typeName, System.StringComparison.OrdinalIgnoreCase))
+            {
+                return type;
+            }
+            if (string.Equals(type.Name, typeName, System.StringComparison.OrdinalIgnoreCase))
+            {
+                matches.Add(type);
+            }
+        }
+        if (matches.Count > 1)
+        {
+            var names = matches.ConvertAll(t => t.FullName);
+            error = $"类型名 '{typeName}' 不唯一，请使用完整类型名: {string.Join(", ", names)}";
+            return null;
+        }
+        return matches.Count == 1 ? matches[0] : null;
+    }
+    
+    /// <summary>
+    /// 可创建的类型名列表，项目中的类型在前
+    /// </summary>
+    public static List<string> CandidateNames()
+    {
+        var project = new List<string>();
+        var builtin = new List<string>();
+        foreach (System.Type type in CreatableTypes())
+        {
+            string assembly = type.Assembly.GetName().Name;
+            (assembly.StartsWith("Unity") ? builtin : project).Add(type.FullName);
+        }
+        project.AddRange(builtin);
+        return project.Count > MaxCandidates ? project.GetRange(0, MaxCandidates) : project;
+    }
+    
+    /// <summary>
+    /// 非抽象、非泛型、不属于编辑器的ScriptableObject类型
+    /// </summary>
+    private static IEnumerable<System.Type> CreatableTypes()
+    {
+        foreach (System.Type type in TypeCache.GetTypesDerivedFrom<ScriptableObject>())
+        {
+            if (type.IsAbstract || type.IsGenericType || typeof(Editor).IsAssignableFrom(type) ||
+                typeof(EditorWindow).IsAssignableFrom(type) || (type.Namespace != null && type.Namespace.StartsWith("UnityEditor")))
+            {
+                continue;
+            }
+            yield return type;
+        }
+    }
+    
+    /// <summary>
+    /// 写入字段，任何字段失败时不应用修改并返回错误信息；成功时返回null，previous为各字段的旧值
+    /// </summary>
+    public static string ApplyFields(SerializedObject serializedObject, Dictionary<string, object> fields, out Dictionary<string, object> previous)
+    {
+        var failed = new Dictionary<string, string>();
+        previous = SerializedPropertyHelper.SetValues(serializedObject, fields, failed);
+        if (failed.Count > 0)
+        {
+            var messages = new List<string>();
+            foreach (var pair in failed)
+            {
+                messages.Add($"{pair.Key}: {pair.Value}");
+            }
+            serializedObject.Update();
+            return $"以下字段设置失败，未做任何修改: {string.Join("; ", messages)}";
+        }
+        // ApplyModifiedProperties会注册Undo
+        serializedObject.ApplyModifiedProperties();
+        return null;
+    }
+    
+    /// <summary>
+    /// 资源信息和全部序列化字段
+    /// </summary>
+    public static Dictionary<string, object> Describe(ScriptableObject asset, int maxProperties = 500, int maxArrayElements = 50)
+    {
+        var properties = SerializedPropertyHelper.Dump(new SerializedObject(asset), maxProperties, maxArrayElements, out bool truncated);
+        return new Dictionary<string, object>
+        {
+            ["assetPath"] = AssetDatabase.GetAssetPath(asset),
+            ["name"] = asset.name,
+            ["type"] = asset.GetType().Name,
+            ["fullType"] = asset.GetType().FullName,
+            ["instanceId"] = asset.GetInstanceID(),
+            ["properties"] = properties,
+            ["propertyCount"] = properties.Count,
+            ["truncated"] = truncated
+        };
+    }
+}
@@ -0,0 +1,62 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// ScriptableObject设置工具 - 按属性路径设置ScriptableObject资源的字段，任何字段失败时不做修改
+/// </summary>
+public class ScriptableObjectSetTool : IMCPTool
+{
+    public string ToolName => "scriptable_object_set";
+    
+    public string Description => "设置ScriptableObject的序列化字段";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            ScriptableObject asset = AssetDatabase.LoadAssetAtPath<ScriptableObject>(assetPath);
+            if (asset == null)
+            {
+                return MCPResponse.Error($"未找到ScriptableObject资源: {assetPath}");
+            }
+            if (!(parameters["fields"] is Dictionary<string, object> fields) || fields.Count == 0)
+            {
+                return MCPResponse.Error("fields必须是包含至少一个属性路径的对象");
+            }
+            
+            string error = ScriptableObjectHelper.ApplyFields(new SerializedObject(asset), fields, out var previous);
+            if (error != null)
+            {
+                return MCPResponse.Error(error);
+            }
+            EditorUtility.SetDirty(asset);
+            AssetDatabase.SaveAssets();
+            
+            var result = ScriptableObjectHelper.Describe(asset);
+            result["previousValues"] = previous;
+            Debug.Log($"设置ScriptableObject '{assetPath}' 的字段: {string.Join(", ", previous.Keys)}");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置ScriptableObject字段时出错: {e.Message}");
+            return MCPResponse.Error($"设置ScriptableObject字段失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("assetPath"))
+        {
+            return "缺少必需参数: assetPath";
+        }
+        if (!parameters.ContainsKey("fields"))
+        {
+            return "缺少必需参数: fields";
+        }
+        return null;
+    }
+}
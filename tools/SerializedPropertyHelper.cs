@@ -0,0 +1,392 @@
+using System;
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 序列化属性工具类 - 按序列化字段名查找属性，在SerializedProperty和JSON值之间转换
+/// </summary>
+public static class SerializedPropertyHelper
+{
+    /// <summary>
+    /// 查找序列化属性，找不到时尝试Unity内置字段的 m_ 前缀形式 (mass → m_Mass)
+    /// </summary>
+    public static SerializedProperty FindProperty(SerializedObject serializedObject, string name)
+    {
+        SerializedProperty property = serializedObject.FindProperty(name);
+        if (property == null && !string.IsNullOrEmpty(name) && !name.StartsWith("m_") && !name.Contains("."))
+        {
+            property = serializedObject.FindProperty("m_" + char.ToUpperInvariant(name[0]) + name.Substring(1));
+        }
+        return property;
+    }
+    
+    /// <summary>
+    /// 把JSON值写入序列化属性，失败时返回错误信息，成功返回null
+    /// 对象引用可以是资源路径或InstanceID
+    /// </summary>
+    public static string SetValue(SerializedProperty property, object value)
+    {
+        try
+        {
+            switch (property.propertyType)
+            {
+                case SerializedPropertyType.Integer:
+                case SerializedPropertyType.LayerMask:
+                case SerializedPropertyType.Character:
+                case SerializedPropertyType.ArraySize:
+                    property.intValue = Convert.ToInt32(value);
+                    return null;
+                case SerializedPropertyType.Boolean:
+                    property.boolValue = Convert.ToBoolean(value);
+                    return null;
+                case SerializedPropertyType.Float:
+                    property.floatValue = Convert.ToSingle(value);
+                    return null;
+                case SerializedPropertyType.String:
+                    property.stringValue = value?.ToString() ?? "";
+                    return null;
+                case SerializedPropertyType.Enum:
+                    return SetEnum(property, value);
+                case SerializedPropertyType.Color:
+                    property.colorValue = ToColor(value, property.colorValue);
+                    return null;
+                case SerializedPropertyType.Vector2:
+                    property.vector2Value = ToVector4(value, property.vector2Value);
+                    return null;
+                case SerializedPropertyType.Vector3:
+                    property.vector3Value = ToVector4(value, property.vector3Value);
+                    return null;
+                case SerializedPropertyType.Vector4:
+                    property.vector4Value = ToVector4(value, property.vector4Value);
+                    return null;
+                case SerializedPropertyType.Quaternion:
+                    Quaternion current = property.quaternionValue;
+                    Vector4 q = ToVector4(value, new Vector4(current.x, current.y, current.z, current.w));
+                    property.quaternionValue = new Quaternion(q.x, q.y, q.z, q.w);
+                    return null;
+                case SerializedPropertyType.Rect:
+                    var rect = AsDictionary(value);
+                    Rect oldRect = property.rectValue;
+                    property.rectValue = new Rect(GetFloat(rect, "x", oldRect.x), GetFloat(rect, "y", oldRect.y),
+                        GetFloat(rect, "width", oldRect.width), GetFloat(rect, "height", oldRect.height));
+                    return null;
+                case SerializedPropertyType.ObjectReference:
+                    return SetObjectReference(property, value);
+                default:
+                    return $"不支持的属性类型: {property.propertyType}";
+            }
+        }
+        catch (Exception e)
+        {
+            return $"无法将值转换为 {property.propertyType}: {e.Message}";
+        }
+    }
+    
+    /// <summary>
+    /// 按路径写入多个属性 (不调用ApplyModifiedProperties)，返回各属性的旧值
+    /// 数组长度 (.Array.size) 先于其他路径写入，以便同一次调用中设置新元素；失败的路径记录在failed中
+    /// </summary>
+    public static Dictionary<string, object> SetValues(SerializedObject serializedObject, Dictionary<string, object> values, Dictionary<string, string> failed)
+    {
+        var paths = new List<string>(values.Keys);
+        paths.Sort((a, b) =>
+        {
+            bool sizeA = a.EndsWith(".Array.size"), sizeB = b.EndsWith(".Array.size");
+            return sizeA != sizeB ? (sizeA ? -1 : 1) : string.CompareOrdinal(a, b);
+        });
+        
+        var previous = new Dictionary<string, object>();
+        foreach (string path in paths)
+        {
+            SerializedProperty property = FindProperty(serializedObject, path);
+            if (property == null)
+            {
+                failed[path] = "没有该属性";
+                continue;
+            }
+            if (!property.editable)
+            {
+                failed[path] = "属性不可编辑";
+                continue;
+            }
+            if (property.propertyType == SerializedPropertyType.Generic)
+            {
+                failed[path] = $"数组或结构体，请设置其子属性 (数组长度使用 {property.propertyPath}.Array.size)";
+                continue;
+            }
+            if (values[path] == null && property.propertyType != SerializedPropertyType.ObjectReference)
+            {
+                failed[path] = $"只有对象引用可以设置为null (属性类型为 {property.propertyType})";
+                continue;
+            }
+            object oldValue = GetValue(property);
+            string error = SetValue(property, values[path]);
+            if (error != null)
+            {
+                failed[path] = error;
+                continue;
+            }
+            previous[property.propertyPath] = oldValue;
+        }
+        return previous;
+    }
+    
+    /// <summary>
+    /// 导出所有可见的序列化属性 (component_get的格式)，超过maxProperties时truncated为true
+    /// 只展开数组和结构体，每个数组最多列出maxArrayElements个元素
+    /// </summary>
+    public static List<Dictionary<string, object>> Dump(SerializedObject serializedObject, int maxProperties, int maxArrayElements, out bool truncated)
+    {
+        var properties = new List<Dictionary<string, object>>();
+        truncated = false;
+        SerializedProperty iterator = serializedObject.GetIterator();
+        bool enterChildren = true;
+        while (iterator.NextVisible(enterChildren))
+        {
+            // 只展开数组和结构体，向量、颜色等作为一个整体值导出
+            enterChildren = iterator.propertyType == SerializedPropertyType.Generic;
+            
+            if (ArrayIndex(iterator.propertyPath) >= maxArrayElements)
+            {
+                enterChildren = false;
+                continue;
+            }
+            if (properties.Count >= maxProperties)
+            {
+                truncated = true;
+                break;
+            }
+            
+            var entry = new Dictionary<string, object>
+            {
+                ["path"] = iterator.propertyPath,
+                ["displayName"] = iterator.displayName,
+                ["propertyType"] = iterator.propertyType.ToString(),
+                ["jsonType"] = JsonType(iterator),
+                ["value"] = GetValue(iterator),
+                ["editable"] = iterator.editable,
+                ["depth"] = iterator.depth
+            };
+            if (iterator.propertyType == SerializedPropertyType.Enum)
+            {
+                entry["enumNames"] = iterator.enumNames;
+            }
+            if (iterator.propertyType == SerializedPropertyType.ObjectReference)
+            {
+                entry["referenceType"] = iterator.type;
+            }
+            if (iterator.isArray && iterator.propertyType == SerializedPropertyType.Generic)
+            {
+                entry["arraySize"] = iterator.arraySize;
+            }
+            properties.Add(entry);
+        }
+        return properties;
+    }
+    
+    /// <summary>
+    /// 属性路径中最后一个数组元素的索引，如 m_Colors.Array.data[12] 返回12，不是数组元素返回-1
+    /// </summary>
+    private static int ArrayIndex(string propertyPath)
+    {
+        const string marker = ".Array.data[";
+        int start = propertyPath.LastIndexOf(marker);
+        if (start < 0)
+        {
+            return -1;
+        }
+        start += marker.Length;
+        int end = propertyPath.IndexOf(']', start);
+        return end > start && int.TryParse(propertyPath.Substring(start, end - start), out int index) ? index : -1;
+    }
+    
+    /// <summary>
+    /// 属性值对应的JSON类型: integer、number、boolean、string、enum (名称或索引)、object、reference (资源路径、InstanceID或null)
+    /// generic为数组或结构体，需要设置其子属性；unsupported无法读写
+    /// </summary>
+    public static string JsonType(SerializedProperty property)
+    {
+        switch (property.propertyType)
+        {
+            case SerializedPropertyType.Integer:
+            case SerializedPropertyType.LayerMask:
+            case SerializedPropertyType.Character:
+            case SerializedPropertyType.ArraySize:
+                return "integer";
+            case SerializedPropertyType.Float:
+                return "number";
+            case SerializedPropertyType.Boolean:
+                return "boolean";
+            case SerializedPropertyType.String:
+                return "string";
+            case SerializedPropertyType.Enum:
+                return "enum";
+            case SerializedPropertyType.Color:
+            case SerializedPropertyType.Vector2:
+            case SerializedPropertyType.Vector3:
+            case SerializedPropertyType.Vector4:
+            case SerializedPropertyType.Quaternion:
+            case SerializedPropertyType.Rect:
+                return "object";
+            case SerializedPropertyType.ObjectReference:
+                return "reference";
+            case SerializedPropertyType.Generic:
+                return "generic";
+            default:
+                return "unsupported";
+        }
+    }
+    
+    /// <summary>
+    /// 读取属性值，格式与SetValue接受的格式相同；generic和unsupported返回null
+    /// </summary>
+    public static object GetValue(SerializedProperty property)
+    {
+        switch (property.propertyType)
+        {
+            case SerializedPropertyType.Integer:
+            case SerializedPropertyType.LayerMask:
+            case SerializedPropertyType.Character:
+            case SerializedPropertyType.ArraySize:
+                return property.intValue;
+            case SerializedPropertyType.Float:
+                return property.floatValue;
+            case SerializedPropertyType.Boolean:
+                return property.boolValue;
+            case SerializedPropertyType.String:
+                return property.stringValue;
+            case SerializedPropertyType.Enum:
+                int index = property.enumValueIndex;
+                return index >= 0 && index < property.enumNames.Length ? (object)property.enumNames[index] : index;
+            case SerializedPropertyType.Color:
+                Color c = property.colorValue;
+                return new Dictionary<string, object> { ["r"] = c.r, ["g"] = c.g, ["b"] = c.b, ["a"] = c.a };
+            case SerializedPropertyType.Vector2:
+                Vector2 v2 = property.vector2Value;
+                return new Dictionary<string, object> { ["x"] = v2.x, ["y"] = v2.y };
+            case SerializedPropertyType.Vector3:
+                Vector3 v3 = property.vector3Value;
+                return new Dictionary<string, object> { ["x"] = v3.x, ["y"] = v3.y, ["z"] = v3.z };
+            case SerializedPropertyType.Vector4:
+                Vector4 v4 = property.vector4Value;
+                return new Dictionary<string, object> { ["x"] = v4.x, ["y"] = v4.y, ["z"] = v4.z, ["w"] = v4.w };
+            case SerializedPropertyType.Quaternion:
+                Quaternion q = property.quaternionValue;
+                return new Dictionary<string, object> { ["x"] = q.x, ["y"] = q.y, ["z"] = q.z, ["w"] = q.w };
+            case SerializedPropertyType.Rect:
+                Rect r = property.rectValue;
+                return new Dictionary<string, object> { ["x"] = r.x, ["y"] = r.y, ["width"] = r.width, ["height"] = r.height };
+            case SerializedPropertyType.ObjectReference:
+                return DescribeReference(property.objectReferenceValue);
+            default:
+                return null;
+        }
+    }
+    
+    /// <summary>
+    /// 对象引用的描述: 名称、类型、InstanceID，资源还包括路径
+    /// </summary>
+    private static object DescribeReference(UnityEngine.Object reference)
+    {
+        if (reference == null)
+        {
+            return null;
+        }
+        var info = new Dictionary<string, object>
+        {
+            ["name"] = reference.name,
+            ["type"] = reference.GetType().Name,
+            ["instanceId"] = reference.GetInstanceID()
+        };
+        string path = AssetDatabase.GetAssetPath(reference);
+        if (!string.IsNullOrEmpty(path))
+        {
+            info["assetPath"] = path;
+        }
+        return info;
+    }
+    
+    /// <summary>
+    /// 枚举可以用名称 (不区分大小写) 或索引设置
+    /// </summary>
+    private static string SetEnum(SerializedProperty property, object value)
+    {
+        if (value is string name)
+        {
+            int index = Array.FindIndex(property.enumNames, n => string.Equals(n, name, StringComparison.OrdinalIgnoreCase));
+            if (index < 0)
+            {
+                return $"无效的枚举值 '{name}'，可选值: {string.Join(", ", property.enumNames)}";
+            }
+            property.enumValueIndex = index;
+            return null;
+        }
+        property.enumValueIndex = Convert.ToInt32(value);
+        return null;
+    }
+    
+    /// <summary>
+    /// 对象引用: null清空，字符串为资源路径，数字为InstanceID
+    /// </summary>
+    private static string SetObjectReference(SerializedProperty property, object value)
+    {
+        UnityEngine.Object reference = null;
+        if (value is string path)
+        {
+            reference = AssetDatabase.LoadAssetAtPath<UnityEngine.Object>(path);
+            if (reference == null)
+            {
+                return $"未找到资源: {path}";
+            }
+        }
+        else if (value != null)
+        {
+            int instanceId = Convert.ToInt32(value);
+            reference = EditorUtility.InstanceIDToObject(instanceId);
+            if (reference == null)
+            {
+                return $"未找到对象 (InstanceID: {instanceId})";
+            }
+        }
+        
+        property.objectReferenceValue = reference;
+        // 类型不匹配时Unity会静默地把引用设为null
+        if (reference != null && property.objectReferenceValue == null)
+        {
+            return $"对象 '{reference.name}' ({reference.GetType().Name}) 的类型与属性 {property.type} 不匹配";
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 颜色和向量只需给出要修改的分量，其余分量保持当前值
+    /// </summary>
+    private static Color ToColor(object value, Color current)
+    {
+        var dict = AsDictionary(value);
+        return new Color(GetFloat(dict, "r", current.r), GetFloat(dict, "g", current.g),
+            GetFloat(dict, "b", current.b), GetFloat(dict, "a", current.a));
+    }
+    
+    private static Vector4 ToVector4(object value, Vector4 current)
+    {
+        var dict = AsDictionary(value);
+        return new Vector4(GetFloat(dict, "x", current.x), GetFloat(dict, "y", current.y),
+            GetFloat(dict, "z", current.z), GetFloat(dict, "w", current.w));
+    }
+    
+    private static Dictionary<string, object> AsDictionary(object value)
+    {
+        if (value is Dictionary<string, object> dict)
+        {
+            return dict;
+        }
+        throw new ArgumentException("需要对象类型的值");
+    }
+    
+    private static float GetFloat(Dictionary<string, object> dict, string key, float defaultValue)
+    {
+        return dict.ContainsKey(key) ? Convert.ToSingle(dict[key]) : defaultValue;
+    }
+}
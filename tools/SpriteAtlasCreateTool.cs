@@ -0,0 +1,88 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.U2D;
+using UnityEditor;
+using UnityEditor.U2D;
+
+/// <summary>
+/// 精灵图集创建工具 - 创建SpriteAtlas资源，设置打包参数和初始打包对象，可选立即打包
+/// </summary>
+public class SpriteAtlasCreateTool : IMCPTool
+{
+    private readonly MCPMessageDispatcher dispatcher;
+    
+    public SpriteAtlasCreateTool(MCPMessageDispatcher dispatcher)
+    {
+        this.dispatcher = dispatcher;
+    }
+    
+    public string ToolName => "sprite_atlas_create";
+    
+    public string Description => "创建精灵图集";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string savePath = parameters["savePath"].ToString();
+            if (AssetDatabase.LoadAssetAtPath<Object>(savePath) != null)
+            {
+                return MCPResponse.Error($"资源已存在: {savePath}");
+            }
+            List<Object> packables = SpriteAtlasHelper.LoadPackables(SpriteAtlasHelper.ReadPaths(parameters, "packables"), out string error);
+            if (packables == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            var atlas = new SpriteAtlas();
+            atlas.SetIncludeInBuild(!parameters.ContainsKey("includeInBuild") || System.Convert.ToBoolean(parameters["includeInBuild"]));
+            atlas.SetPackingSettings(new SpriteAtlasPackingSettings
+            {
+                blockOffset = 1,
+                padding = parameters.ContainsKey("padding") ? System.Convert.ToInt32(parameters["padding"]) : 4,
+                enableRotation = !parameters.ContainsKey("allowRotation") || System.Convert.ToBoolean(parameters["allowRotation"]),
+                enableTightPacking = !parameters.ContainsKey("tightPacking") || System.Convert.ToBoolean(parameters["tightPacking"])
+            });
+            if (parameters.ContainsKey("maxTextureSize"))
+            {
+                TextureImporterPlatformSettings platform = atlas.GetPlatformSettings("DefaultTexturePlatform");
+                platform.maxTextureSize = System.Convert.ToInt32(parameters["maxTextureSize"]);
+                atlas.SetPlatformSettings(platform);
+            }
+            atlas.Add(packables.ToArray());
+            
+            string directory = System.IO.Path.GetDirectoryName(savePath);
+            if (!string.IsNullOrEmpty(directory) && !System.IO.Directory.Exists(directory))
+            {
+                System.IO.Directory.CreateDirectory(directory);
+                AssetDatabase.Refresh();
+            }
+            AssetDatabase.CreateAsset(atlas, savePath);
+            AssetDatabase.SaveAssets();
+            Debug.Log($"已创建精灵图集: {savePath}");
+            
+            if (parameters.ContainsKey("pack") && System.Convert.ToBoolean(parameters["pack"]))
+            {
+                SpriteAtlasHelper.Pack(atlas, savePath, dispatcher, client);
+            }
+            
+            return MCPResponse.Success(SpriteAtlasHelper.Describe(atlas, savePath));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"创建精灵图集时出错: {e.Message}");
+            return MCPResponse.Error($"创建精灵图集失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("savePath") || string.IsNullOrEmpty(parameters["savePath"]?.ToString()))
+        {
+            return "缺少必需参数: savePath";
+        }
+        return null;
+    }
+}
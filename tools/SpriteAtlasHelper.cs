@@ -0,0 +1,114 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Reflection;
+using UnityEngine;
+using UnityEngine.U2D;
+using UnityEditor;
+using UnityEditor.U2D;
+
+/// <summary>
+/// 精灵图集工具的共享逻辑: 解析打包对象、打包并描述图集内容和打包结果
+/// </summary>
+public static class SpriteAtlasHelper
+{
+    // 打包后的图集页面，内部API
+    private static readonly MethodInfo previewTexturesMethod =
+        typeof(SpriteAtlasExtensions).GetMethod("GetPreviewTextures", BindingFlags.Static | BindingFlags.Public | BindingFlags.NonPublic);
+    
+    /// <summary>
+    /// 按资源路径加载打包对象，只接受文件夹、纹理和Sprite
+    /// </summary>
+    public static List<Object> LoadPackables(IEnumerable<string> paths, out string error)
+    {
+        var packables = new List<Object>();
+        foreach (string path in paths)
+        {
+            Object asset = AssetDatabase.IsValidFolder(path)
+                ? AssetDatabase.LoadAssetAtPath<DefaultAsset>(path)
+                : AssetDatabase.LoadAssetAtPath<Object>(path);
+            if (asset == null)
+            {
+                error = $"资源不存在: {path}";
+                return null;
+            }
+            if (!(asset is DefaultAsset) && !(asset is Texture2D) && !(asset is Sprite))
+            {
+                error = $"只能打包文件夹、纹理或Sprite: {path} ({asset.GetType().Name})";
+                return null;
+            }
+            packables.Add(asset);
+        }
+        error = null;
+        return packables;
+    }
+    
+    /// <summary>
+    /// 读取字符串数组参数
+    /// </summary>
+    public static List<string> ReadPaths(Dictionary<string, object> parameters, string key)
+    {
+        var paths = new List<string>();
+        if (parameters.ContainsKey(key) && parameters[key] is System.Collections.IEnumerable items)
+        {
+            foreach (var item in items)
+            {
+                paths.Add(item.ToString());
+            }
+        }
+        return paths;
+    }
+    
+    /// <summary>
+    /// 为当前构建目标打包图集，打包在主线程上同步进行，前后发送进度帧
+    /// </summary>
+    public static void Pack(SpriteAtlas atlas, string atlasPath, MCPMessageDispatcher dispatcher, System.Net.Sockets.TcpClient client)
+    {
+        dispatcher.SendProgress(client, 0.1f, $"打包图集 {atlasPath}");
+        var stopwatch = System.Diagnostics.Stopwatch.StartNew();
+        SpriteAtlasUtility.PackAtlases(new[] { atlas }, EditorUserBuildSettings.activeBuildTarget);
+        dispatcher.SendProgress(client, 0.9f, $"图集打包完成，耗时 {stopwatch.ElapsedMilliseconds}ms");
+        Debug.Log($"图集打包完成: {atlasPath}，耗时 {stopwatch.ElapsedMilliseconds}ms");
+    }
+    
+    /// <summary>
+    /// 图集的设置、打包对象和打包结果
+    /// </summary>
+    public static Dictionary<string, object> Describe(SpriteAtlas atlas, string atlasPath)
+    {
+        SpriteAtlasPackingSettings packing = atlas.GetPackingSettings();
+        var pages = new List<Dictionary<string, object>>();
+        if (previewTexturesMethod != null && previewTexturesMethod.Invoke(null, new object[] { atlas }) is Texture2D[] textures)
+        {
+            foreach (Texture2D texture in textures.Where(t => t != null))
+            {
+                pages.Add(new Dictionary<string, object>
+                {
+                    ["width"] = texture.width,
+                    ["height"] = texture.height,
+                    ["format"] = texture.format.ToString()
+                });
+            }
+        }
+        
+        var result = new Dictionary<string, object>
+        {
+            ["atlasPath"] = atlasPath,
+            ["includeInBuild"] = atlas.IsIncludeInBuild(),
+            ["padding"] = packing.padding,
+            ["allowRotation"] = packing.enableRotation,
+            ["tightPacking"] = packing.enableTightPacking,
+            ["maxTextureSize"] = atlas.GetPlatformSettings("DefaultTexturePlatform").maxTextureSize,
+            ["packables"] = atlas.GetPackables().Where(p => p != null).Select(AssetDatabase.GetAssetPath).ToList(),
+            ["spriteCount"] = atlas.spriteCount,
+            ["packed"] = pages.Count > 0,
+            ["pageCount"] = pages.Count,
+            ["pages"] = pages,
+            ["spritePackerMode"] = EditorSettings.spritePackerMode.ToString()
+        };
+        if (EditorSettings.spritePackerMode == SpritePackerMode.Disabled)
+        {
+            result["note"] = "Sprite Packer已在Project Settings > Editor中禁用，构建和播放模式不会使用图集";
+        }
+        return result;
+    }
+}
@@ -0,0 +1,102 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.U2D;
+using UnityEditor;
+using UnityEditor.U2D;
+
+/// <summary>
+/// 精灵图集修改工具 - 添加或移除打包对象、修改是否包含在构建中，可选重新打包，并返回图集内容和打包结果
+/// </summary>
+public class SpriteAtlasModifyTool : IMCPTool
+{
+    private readonly MCPMessageDispatcher dispatcher;
+    
+    public SpriteAtlasModifyTool(MCPMessageDispatcher dispatcher)
+    {
+        this.dispatcher = dispatcher;
+    }
+    
+    public string ToolName => "sprite_atlas_modify";
+    
+    public string Description => "修改精灵图集";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string atlasPath = parameters["atlasPath"].ToString();
+            SpriteAtlas atlas = AssetDatabase.LoadAssetAtPath<SpriteAtlas>(atlasPath);
+            if (atlas == null)
+            {
+                return MCPResponse.Error($"精灵图集不存在: {atlasPath}");
+            }
+            
+            List<Object> added = SpriteAtlasHelper.LoadPackables(SpriteAtlasHelper.ReadPaths(parameters, "add"), out string error);
+            if (added == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            var existing = atlas.GetPackables().Where(p => p != null)
+                .GroupBy(AssetDatabase.GetAssetPath).ToDictionary(g => g.Key, g => g.First());
+            var removed = new List<Object>();
+            foreach (string path in SpriteAtlasHelper.ReadPaths(parameters, "remove"))
+            {
+                if (!existing.TryGetValue(path, out Object packable))
+                {
+                    return MCPResponse.Error($"图集中没有打包对象: {path}");
+                }
+                removed.Add(packable);
+            }
+            
+            bool changed = false;
+            Object[] newPackables = added.Where(p => !existing.ContainsValue(p)).ToArray();
+            if (newPackables.Length > 0)
+            {
+                atlas.Add(newPackables);
+                changed = true;
+            }
+            if (removed.Count > 0)
+            {
+                atlas.Remove(removed.ToArray());
+                changed = true;
+            }
+            if (parameters.ContainsKey("includeInBuild"))
+            {
+                atlas.SetIncludeInBuild(System.Convert.ToBoolean(parameters["includeInBuild"]));
+                changed = true;
+            }
+            if (changed)
+            {
+                EditorUtility.SetDirty(atlas);
+                AssetDatabase.SaveAssets();
+                Debug.Log($"已修改精灵图集: {atlasPath} (添加 {newPackables.Length}，移除 {removed.Count})");
+            }
+            
+            if (parameters.ContainsKey("pack") && System.Convert.ToBoolean(parameters["pack"]))
+            {
+                SpriteAtlasHelper.Pack(atlas, atlasPath, dispatcher, client);
+            }
+            
+            Dictionary<string, object> result = SpriteAtlasHelper.Describe(atlas, atlasPath);
+            result["added"] = newPackables.Length;
+            result["removed"] = removed.Count;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"修改精灵图集时出错: {e.Message}");
+            return MCPResponse.Error($"修改精灵图集失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("atlasPath") || string.IsNullOrEmpty(parameters["atlasPath"]?.ToString()))
+        {
+            return "缺少必需参数: atlasPath";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,159 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// Sprite配置工具 - 把纹理导入为Sprite，设置模式、每单位像素和pivot，并按网格或显式矩形切分精灵图
+/// </summary>
+public class SpriteConfigureTool : IMCPTool
+{
+    public string ToolName => "sprite_configure";
+    
+    public string Description => "把纹理导入为Sprite并切分精灵图";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            TextureImporter importer = AssetImporter.GetAtPath(assetPath) as TextureImporter;
+            if (importer == null)
+            {
+                return MCPResponse.Error($"不是纹理资源: {assetPath}");
+            }
+            
+            importer.textureType = TextureImporterType.Sprite;
+            if (parameters.ContainsKey("spriteMode"))
+            {
+                importer.spriteImportMode = parameters["spriteMode"].ToString() == "Multiple" ? SpriteImportMode.Multiple : SpriteImportMode.Single;
+            }
+            if (parameters.ContainsKey("pixelsPerUnit"))
+            {
+                importer.spritePixelsPerUnit = System.Convert.ToSingle(parameters["pixelsPerUnit"]);
+            }
+            if (parameters.ContainsKey("pivot") && parameters["pivot"] is Dictionary<string, object> pivot)
+            {
+                // spritePivot只在对齐方式为Custom时生效
+                var settings = new TextureImporterSettings();
+                importer.ReadTextureSettings(settings);
+                settings.spriteAlignment = (int)SpriteAlignment.Custom;
+                settings.spritePivot = ReadVector2(pivot, settings.spritePivot);
+                importer.SetTextureSettings(settings);
+            }
+            
+            List<SpriteMetaData> slices = null;
+            if (parameters.ContainsKey("gridCellSize") || parameters.ContainsKey("rects"))
+            {
+                importer.GetSourceTextureWidthAndHeight(out int width, out int height);
+                slices = parameters.ContainsKey("gridCellSize")
+                    ? GridSlices(parameters, System.IO.Path.GetFileNameWithoutExtension(assetPath), width, height)
+                    : RectSlices(parameters);
+                if (slices.Count == 0)
+                {
+                    return MCPResponse.Error("网格切分没有产生完整的单元格");
+                }
+#pragma warning disable 618
+                importer.spritesheet = slices.ToArray();
+#pragma warning restore 618
+            }
+            
+            importer.SaveAndReimport();
+            
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["assetPath"] = assetPath,
+                ["spriteMode"] = importer.spriteImportMode.ToString(),
+                ["pixelsPerUnit"] = importer.spritePixelsPerUnit,
+                ["sliced"] = slices != null,
+                ["sprites"] = TextureGetInfoTool.DescribeSprites(assetPath)
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"配置Sprite时出错: {e.Message}");
+            return MCPResponse.Error($"配置Sprite失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 网格切分: 单元格从左上角开始按行排列，Unity的矩形y坐标从底部算起，只保留完整的单元格
+    /// </summary>
+    private static List<SpriteMetaData> GridSlices(Dictionary<string, object> parameters, string baseName, int width, int height)
+    {
+        Vector2 cell = ReadVector2((Dictionary<string, object>)parameters["gridCellSize"], Vector2.one);
+        Vector2 offset = parameters.ContainsKey("gridOffset") ? ReadVector2((Dictionary<string, object>)parameters["gridOffset"], Vector2.zero) : Vector2.zero;
+        Vector2 padding = parameters.ContainsKey("gridPadding") ? ReadVector2((Dictionary<string, object>)parameters["gridPadding"], Vector2.zero) : Vector2.zero;
+        
+        var slices = new List<SpriteMetaData>();
+        for (float top = offset.y; top + cell.y <= height; top += cell.y + padding.y)
+        {
+            for (float left = offset.x; left + cell.x <= width; left += cell.x + padding.x)
+            {
+                slices.Add(new SpriteMetaData
+                {
+                    name = $"{baseName}_{slices.Count}",
+                    rect = new Rect(left, height - top - cell.y, cell.x, cell.y),
+                    alignment = (int)SpriteAlignment.Center,
+                    pivot = new Vector2(0.5f, 0.5f)
+                });
+            }
+        }
+        return slices;
+    }
+    
+    /// <summary>
+    /// 显式矩形切分，指定pivot时使用Custom对齐
+    /// </summary>
+    private static List<SpriteMetaData> RectSlices(Dictionary<string, object> parameters)
+    {
+        var slices = new List<SpriteMetaData>();
+        foreach (Dictionary<string, object> rect in AnimatorControllerHelper.ReadObjects(parameters, "rects"))
+        {
+            var slice = new SpriteMetaData
+            {
+                name = rect["name"].ToString(),
+                rect = new Rect(
+                    System.Convert.ToSingle(rect["x"]), System.Convert.ToSingle(rect["y"]),
+                    System.Convert.ToSingle(rect["width"]), System.Convert.ToSingle(rect["height"])),
+                alignment = (int)SpriteAlignment.Center,
+                pivot = new Vector2(0.5f, 0.5f)
+            };
+            if (rect.ContainsKey("pivot") && rect["pivot"] is Dictionary<string, object> pivot)
+            {
+                slice.alignment = (int)SpriteAlignment.Custom;
+                slice.pivot = ReadVector2(pivot, slice.pivot);
+            }
+            slices.Add(slice);
+        }
+        return slices;
+    }
+    
+    private static Vector2 ReadVector2(Dictionary<string, object> dict, Vector2 current)
+    {
+        if (dict == null)
+        {
+            return current;
+        }
+        return new Vector2(
+            dict.ContainsKey("x") ? System.Convert.ToSingle(dict["x"]) : current.x,
+            dict.ContainsKey("y") ? System.Convert.ToSingle(dict["y"]) : current.y
+        );
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("assetPath") || string.IsNullOrEmpty(parameters["assetPath"]?.ToString()))
+        {
+            return "缺少必需参数: assetPath";
+        }
+        foreach (string key in new[] { "pivot", "gridCellSize", "gridOffset", "gridPadding" })
+        {
+            if (parameters.ContainsKey(key) && !(parameters[key] is Dictionary<string, object>))
+            {
+                return $"{key}必须是 {{x, y}} 对象";
+            }
+        }
+        return AnimatorControllerHelper.CheckObjects(parameters, "rects");
+    }
+}
@@ -0,0 +1,195 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Sockets;
+using System.Reflection;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// TextMeshPro文本工具 - 设置TMP_Text (TextMeshProUGUI/TextMeshPro) 的文本、字号、颜色、字体、对齐等属性
+/// 通过反射访问TextMeshPro，项目未安装TMP包时也能编译；此时返回以TMP_NOT_INSTALLED开头的错误，由Go服务器转换为提示
+/// </summary>
+public class TMPTextSetTool : IMCPTool
+{
+    public string ToolName => "tmp_text_set";
+    
+    public string Description => "设置TextMeshPro文本组件属性";
+    
+    /// <summary>
+    /// 未安装TextMeshPro包时的错误前缀，与Go服务器约定
+    /// </summary>
+    public const string NotInstalledMarker = "TMP_NOT_INSTALLED";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Type textType = FindTMPType("TMPro.TMP_Text");
+            if (textType == null)
+            {
+                return MCPResponse.Error($"{NotInstalledMarker}: 项目中未安装TextMeshPro包");
+            }
+            
+            Component text = UIControlHelper.ResolveComponent(parameters, textType, out string error);
+            if (text == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            // 先加载字体，找不到时不做任何修改
+            UnityEngine.Object font = null;
+            if (parameters.ContainsKey("fontAssetPath"))
+            {
+                string fontPath = parameters["fontAssetPath"].ToString();
+                font = AssetDatabase.LoadAssetAtPath(fontPath, FindTMPType("TMPro.TMP_FontAsset"));
+                if (font == null)
+                {
+                    return MCPResponse.Error($"未找到TMP字体资源: {fontPath}");
+                }
+            }
+            
+            Undo.RecordObject(text, "Set TextMeshPro Properties");
+            
+            if (font != null) SetValue(text, "font", font);
+            if (parameters.ContainsKey("richText")) SetValue(text, "richText", Convert.ToBoolean(parameters["richText"]));
+            if (parameters.ContainsKey("text")) SetValue(text, "text", parameters["text"].ToString());
+            if (parameters.ContainsKey("fontSize")) SetValue(text, "fontSize", Convert.ToSingle(parameters["fontSize"]));
+            if (parameters.ContainsKey("autoSize")) SetValue(text, "enableAutoSizing", Convert.ToBoolean(parameters["autoSize"]));
+            if (parameters.ContainsKey("autoSizeMin")) SetValue(text, "fontSizeMin", Convert.ToSingle(parameters["autoSizeMin"]));
+            if (parameters.ContainsKey("autoSizeMax")) SetValue(text, "fontSizeMax", Convert.ToSingle(parameters["autoSizeMax"]));
+            if (parameters.ContainsKey("color"))
+            {
+                SetValue(text, "color", UIControlHelper.ReadColor(parameters["color"], (Color)GetValue(text, "color")));
+            }
+            if (parameters.ContainsKey("alignment")) SetEnum(text, "alignment", parameters["alignment"].ToString());
+            if (parameters.ContainsKey("overflow")) SetEnum(text, "overflowMode", parameters["overflow"].ToString());
+            if (parameters.ContainsKey("characterSpacing")) SetValue(text, "characterSpacing", Convert.ToSingle(parameters["characterSpacing"]));
+            if (parameters.ContainsKey("wrapping")) SetWrapping(text, Convert.ToBoolean(parameters["wrapping"]));
+            
+            // margin为Vector4: x=左, y=上, z=右, w=下
+            if (parameters.ContainsKey("margins") && parameters["margins"] is Dictionary<string, object> margins)
+            {
+                Vector4 margin = (Vector4)GetValue(text, "margin");
+                if (margins.ContainsKey("left")) margin.x = Convert.ToSingle(margins["left"]);
+                if (margins.ContainsKey("top")) margin.y = Convert.ToSingle(margins["top"]);
+                if (margins.ContainsKey("right")) margin.z = Convert.ToSingle(margins["right"]);
+                if (margins.ContainsKey("bottom")) margin.w = Convert.ToSingle(margins["bottom"]);
+                SetValue(text, "margin", margin);
+            }
+            
+            EditorUtility.SetDirty(text);
+            
+            // 重新生成网格后preferred尺寸才反映新的设置
+            textType.GetMethod("ForceMeshUpdate", new[] { typeof(bool), typeof(bool) })?.Invoke(text, new object[] { true, false });
+            
+            Debug.Log($"成功设置UI元素 '{text.name}' 的{text.GetType().Name}组件属性");
+            return MCPResponse.Success(Describe(text));
+        }
+        catch (Exception e)
+        {
+            Debug.LogError($"设置TextMeshPro组件属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置TextMeshPro组件属性失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 在已加载的程序集中查找TextMeshPro类型，未安装TMP包时返回null
+    /// </summary>
+    public static Type FindTMPType(string fullName)
+    {
+        foreach (Assembly assembly in AppDomain.CurrentDomain.GetAssemblies())
+        {
+            Type type = assembly.GetType(fullName);
+            if (type != null)
+            {
+                return type;
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 自动换行: 新版TMP使用textWrappingMode，旧版使用enableWordWrapping
+    /// </summary>
+    private static void SetWrapping(Component text, bool wrapping)
+    {
+        if (text.GetType().GetProperty("textWrappingMode") != null)
+        {
+            SetEnum(text, "textWrappingMode", wrapping ? "Normal" : "NoWrap");
+        }
+        else
+        {
+            SetValue(text, "enableWordWrapping", wrapping);
+        }
+    }
+    
+    private static bool GetWrapping(Component text)
+    {
+        if (text.GetType().GetProperty("textWrappingMode") != null)
+        {
+            return GetValue(text, "textWrappingMode").ToString() != "NoWrap";
+        }
+        return (bool)GetValue(text, "enableWordWrapping");
+    }
+    
+    private static void SetValue(Component target, string name, object value)
+    {
+        target.GetType().GetProperty(name).SetValue(target, value);
+    }
+    
+    private static void SetEnum(Component target, string name, string value)
+    {
+        PropertyInfo property = target.GetType().GetProperty(name);
+        property.SetValue(target, Enum.Parse(property.PropertyType, value));
+    }
+    
+    private static object GetValue(Component target, string name)
+    {
+        return target.GetType().GetProperty(name).GetValue(target);
+    }
+    
+    private static Dictionary<string, object> Describe(Component text)
+    {
+        UnityEngine.Object font = GetValue(text, "font") as UnityEngine.Object;
+        Vector4 margin = (Vector4)GetValue(text, "margin");
+        return new Dictionary<string, object>
+        {
+            ["name"] = text.gameObject.name,
+            ["instanceId"] = text.gameObject.GetInstanceID(),
+            ["componentType"] = text.GetType().Name,
+            ["text"] = GetValue(text, "text"),
+            ["richText"] = GetValue(text, "richText"),
+            ["fontSize"] = GetValue(text, "fontSize"),
+            ["autoSize"] = GetValue(text, "enableAutoSizing"),
+            ["autoSizeMin"] = GetValue(text, "fontSizeMin"),
+            ["autoSizeMax"] = GetValue(text, "fontSizeMax"),
+            ["color"] = UIControlHelper.ColorToDict((Color)GetValue(text, "color")),
+            ["fontAssetPath"] = font != null ? AssetDatabase.GetAssetPath(font) : null,
+            ["alignment"] = GetValue(text, "alignment").ToString(),
+            ["wrapping"] = GetWrapping(text),
+            ["overflow"] = GetValue(text, "overflowMode").ToString(),
+            ["characterSpacing"] = GetValue(text, "characterSpacing"),
+            ["margins"] = new Dictionary<string, float>
+            {
+                ["left"] = margin.x,
+                ["right"] = margin.z,
+                ["top"] = margin.y,
+                ["bottom"] = margin.w
+            },
+            ["preferredSize"] = new Dictionary<string, object>
+            {
+                ["width"] = GetValue(text, "preferredWidth"),
+                ["height"] = GetValue(text, "preferredHeight")
+            }
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,112 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// TagManager辅助方法: 通过SerializedObject读写 ProjectSettings/TagManager.asset 中的标签、层和排序层
+/// 修改后保存资源，get工具每次重新加载，从而能确认修改已持久化
+/// </summary>
+public static class TagManagerHelper
+{
+    public const string TagManagerPath = "ProjectSettings/TagManager.asset";
+    
+    public static readonly string[] BuiltinTags = { "Untagged", "Respawn", "Finish", "EditorOnly", "MainCamera", "Player", "GameController" };
+    
+    public const int FirstUserLayer = 8;
+    
+    /// <summary>
+    /// 重新加载TagManager
+    /// </summary>
+    public static SerializedObject Load()
+    {
+        Object[] assets = AssetDatabase.LoadAllAssetsAtPath(TagManagerPath);
+        if (assets == null || assets.Length == 0)
+        {
+            throw new System.InvalidOperationException($"无法加载 {TagManagerPath}");
+        }
+        return new SerializedObject(assets[0]);
+    }
+    
+    /// <summary>
+    /// 应用修改并保存到磁盘
+    /// </summary>
+    public static void Save(SerializedObject tagManager)
+    {
+        tagManager.ApplyModifiedPropertiesWithoutUndo();
+        AssetDatabase.SaveAssets();
+    }
+    
+    /// <summary>
+    /// 用户定义的标签 (不含内置标签)
+    /// </summary>
+    public static List<string> GetUserTags(SerializedObject tagManager)
+    {
+        var tags = new List<string>();
+        SerializedProperty property = tagManager.FindProperty("tags");
+        for (int i = 0; i < property.arraySize; i++)
+        {
+            tags.Add(property.GetArrayElementAtIndex(i).stringValue);
+        }
+        return tags;
+    }
+    
+    public static Dictionary<string, object> DescribeTags()
+    {
+        var tags = new List<string>(BuiltinTags);
+        tags.AddRange(GetUserTags(Load()));
+        return new Dictionary<string, object>
+        {
+            ["tags"] = tags,
+            ["builtinTags"] = BuiltinTags
+        };
+    }
+    
+    public static Dictionary<string, object> DescribeLayers()
+    {
+        SerializedProperty property = Load().FindProperty("layers");
+        var layers = new List<object>();
+        for (int i = 0; i < property.arraySize; i++)
+        {
+            layers.Add(new Dictionary<string, object>
+            {
+                ["index"] = i,
+                ["name"] = property.GetArrayElementAtIndex(i).stringValue,
+                ["builtin"] = i < FirstUserLayer
+            });
+        }
+        return new Dictionary<string, object> { ["layers"] = layers };
+    }
+    
+    public static Dictionary<string, object> DescribeSortingLayers()
+    {
+        SerializedProperty property = Load().FindProperty("m_SortingLayers");
+        var layers = new List<object>();
+        for (int i = 0; i < property.arraySize; i++)
+        {
+            SerializedProperty layer = property.GetArrayElementAtIndex(i);
+            layers.Add(new Dictionary<string, object>
+            {
+                ["name"] = layer.FindPropertyRelative("name").stringValue,
+                ["uniqueId"] = layer.FindPropertyRelative("uniqueID").longValue,
+                ["order"] = i
+            });
+        }
+        return new Dictionary<string, object> { ["sortingLayers"] = layers };
+    }
+    
+    /// <summary>
+    /// 读取字符串数组参数
+    /// </summary>
+    public static List<string> ReadNames(Dictionary<string, object> parameters, string key)
+    {
+        var names = new List<string>();
+        if (parameters.ContainsKey(key) && parameters[key] is System.Collections.IEnumerable items)
+        {
+            foreach (var item in items)
+            {
+                names.Add(item.ToString());
+            }
+        }
+        return names;
+    }
+}
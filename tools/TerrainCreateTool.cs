@@ -0,0 +1,71 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 地形创建工具 - 创建TerrainData资源和带TerrainCollider的Terrain对象
+/// </summary>
+public class TerrainCreateTool : IMCPTool
+{
+    public string ToolName => "terrain_create";
+    
+    public string Description => "创建地形";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string name = parameters.ContainsKey("name") ? parameters["name"].ToString() : "Terrain";
+            Vector3 size = parameters.ContainsKey("size") && parameters["size"] is Dictionary<string, object> sizeDict
+                ? ReadVector3(sizeDict, new Vector3(500, 100, 500))
+                : new Vector3(500, 100, 500);
+            int resolution = parameters.ContainsKey("heightmapResolution") ? System.Convert.ToInt32(parameters["heightmapResolution"]) : 513;
+            
+            string savePath = parameters.ContainsKey("savePath")
+                ? parameters["savePath"].ToString()
+                : AssetDatabase.GenerateUniqueAssetPath($"Assets/Terrains/{name}.asset");
+            if (AssetDatabase.LoadAssetAtPath<Object>(savePath) != null)
+            {
+                return MCPResponse.Error($"资源已存在: {savePath}");
+            }
+            
+            // 修改分辨率会重置size，必须先设置分辨率
+            var data = new TerrainData { heightmapResolution = resolution };
+            data.size = size;
+            TerrainHelper.EnsureDirectory(savePath);
+            AssetDatabase.CreateAsset(data, savePath);
+            
+            GameObject terrainObject = Terrain.CreateTerrainGameObject(data);
+            terrainObject.name = name;
+            if (parameters.ContainsKey("position") && parameters["position"] is Dictionary<string, object> position)
+            {
+                terrainObject.transform.position = ReadVector3(position, Vector3.zero);
+            }
+            Undo.RegisterCreatedObjectUndo(terrainObject, $"Create {name}");
+            Selection.activeGameObject = terrainObject;
+            
+            Debug.Log($"成功创建地形: {name} (InstanceID: {terrainObject.GetInstanceID()}，TerrainData: {savePath})");
+            return MCPResponse.Success(TerrainHelper.Describe(terrainObject.GetComponent<Terrain>()));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"创建地形时出错: {e.Message}");
+            return MCPResponse.Error($"创建地形失败: {e.Message}");
+        }
+    }
+    
+    private static Vector3 ReadVector3(Dictionary<string, object> dict, Vector3 current)
+    {
+        return new Vector3(
+            dict.ContainsKey("x") ? System.Convert.ToSingle(dict["x"]) : current.x,
+            dict.ContainsKey("y") ? System.Convert.ToSingle(dict["y"]) : current.y,
+            dict.ContainsKey("z") ? System.Convert.ToSingle(dict["z"]) : current.z
+        );
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        return null;
+    }
+}
@@ -0,0 +1,101 @@
+using System.Collections.Generic;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 地形工具的共享逻辑: 描述地形的世界范围、分辨率和地形层
+/// </summary>
+public static class TerrainHelper
+{
+    /// <summary>
+    /// 地形的世界范围 (position为地形的角)、实际高度范围、分辨率、TerrainData路径和地形层
+    /// </summary>
+    public static Dictionary<string, object> Describe(Terrain terrain)
+    {
+        TerrainData data = terrain.terrainData;
+        Vector3 position = terrain.transform.position;
+        Bounds heights = data.bounds;
+        var layers = new List<Dictionary<string, object>>();
+        TerrainLayer[] terrainLayers = data.terrainLayers;
+        for (int i = 0; i < terrainLayers.Length; i++)
+        {
+            TerrainLayer layer = terrainLayers[i];
+            if (layer == null)
+            {
+                layers.Add(new Dictionary<string, object> { ["index"] = i, ["missing"] = true });
+                continue;
+            }
+            layers.Add(new Dictionary<string, object>
+            {
+                ["index"] = i,
+                ["name"] = layer.name,
+                ["layerPath"] = AssetDatabase.GetAssetPath(layer),
+                ["texturePath"] = layer.diffuseTexture != null ? AssetDatabase.GetAssetPath(layer.diffuseTexture) : null,
+                ["normalMapPath"] = layer.normalMapTexture != null ? AssetDatabase.GetAssetPath(layer.normalMapTexture) : null,
+                ["tileSize"] = new Dictionary<string, float> { ["x"] = layer.tileSize.x, ["y"] = layer.tileSize.y }
+            });
+        }
+        
+        return new Dictionary<string, object>
+        {
+            ["name"] = terrain.gameObject.name,
+            ["instanceId"] = terrain.gameObject.GetInstanceID(),
+            ["hierarchyPath"] = HierarchyPathHelper.GetPath(terrain.transform),
+            ["terrainDataPath"] = AssetDatabase.GetAssetPath(data),
+            ["bounds"] = new Dictionary<string, object>
+            {
+                ["min"] = VectorToDict(position),
+                ["max"] = VectorToDict(position + data.size),
+                ["size"] = VectorToDict(data.size)
+            },
+            ["heightRange"] = new Dictionary<string, float>
+            {
+                ["min"] = position.y + heights.min.y,
+                ["max"] = position.y + heights.max.y
+            },
+            ["heightmapResolution"] = data.heightmapResolution,
+            ["alphamapResolution"] = data.alphamapResolution,
+            ["layers"] = layers
+        };
+    }
+    
+    /// <summary>
+    /// 查找参数instanceId对应的GameObject上的Terrain组件
+    /// </summary>
+    public static Terrain Find(Dictionary<string, object> parameters, out string error)
+    {
+        int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+        GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+        if (gameObject == null)
+        {
+            error = $"未找到GameObject (InstanceID: {instanceId})";
+            return null;
+        }
+        Terrain terrain = gameObject.GetComponent<Terrain>();
+        if (terrain == null || terrain.terrainData == null)
+        {
+            error = $"GameObject上没有带TerrainData的Terrain组件: {gameObject.name}";
+            return null;
+        }
+        error = null;
+        return terrain;
+    }
+    
+    /// <summary>
+    /// 创建资源所在的目录
+    /// </summary>
+    public static void EnsureDirectory(string assetPath)
+    {
+        string directory = System.IO.Path.GetDirectoryName(assetPath);
+        if (!string.IsNullOrEmpty(directory) && !System.IO.Directory.Exists(directory))
+        {
+            System.IO.Directory.CreateDirectory(directory);
+            AssetDatabase.Refresh();
+        }
+    }
+    
+    private static Dictionary<string, float> VectorToDict(Vector3 v)
+    {
+        return new Dictionary<string, float> { ["x"] = v.x, ["y"] = v.y, ["z"] = v.z };
+    }
+}
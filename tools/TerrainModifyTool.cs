@@ -0,0 +1,256 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 地形修改工具 - set_heights按程序化函数或小尺寸heightmap设置高度，add_layer添加地形层，paint_layer在矩形区域内绘制地形层
+/// 高度为地形高度的0-1比例，矩形为0-1的地形坐标 (x沿宽度，y沿长度)
+/// </summary>
+public class TerrainModifyTool : IMCPTool
+{
+    public string ToolName => "terrain_modify";
+    
+    public string Description => "修改地形高度和地形层";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Terrain terrain = TerrainHelper.Find(parameters, out string error);
+            if (terrain == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            string operation = parameters["operation"].ToString();
+            switch (operation)
+            {
+                case "set_heights":
+                    error = SetHeights(terrain.terrainData, parameters);
+                    break;
+                case "add_layer":
+                    error = AddLayer(terrain.terrainData, parameters);
+                    break;
+                case "paint_layer":
+                    error = PaintLayer(terrain.terrainData, parameters);
+                    break;
+                default:
+                    return MCPResponse.Error($"不支持的操作: {operation}");
+            }
+            if (error != null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            EditorUtility.SetDirty(terrain.terrainData);
+            Debug.Log($"已修改地形: {terrain.gameObject.name} ({operation})");
+            Dictionary<string, object> result = TerrainHelper.Describe(terrain);
+            result["operation"] = operation;
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"修改地形时出错: {e.Message}");
+            return MCPResponse.Error($"修改地形失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 按函数或heightmap计算整个高度图，heights数组的第一维沿地形长度 (z)
+    /// </summary>
+    private static string SetHeights(TerrainData data, Dictionary<string, object> parameters)
+    {
+        int resolution = data.heightmapResolution;
+        float[,] heights = new float[resolution, resolution];
+        System.Func<int, int, float> sample;
+        
+        if (parameters.ContainsKey("heightmap"))
+        {
+            float[][] heightmap = ((System.Collections.IEnumerable)parameters["heightmap"]).Cast<object>()
+                .Select(row => ((System.Collections.IEnumerable)row).Cast<object>().Select(value => System.Convert.ToSingle(value)).ToArray())
+                .ToArray();
+            sample = (z, x) => SampleBilinear(heightmap, (float)x / (resolution - 1), (float)z / (resolution - 1));
+        }
+        else
+        {
+            float height = ReadFloat(parameters, "height", 0f);
+            switch (parameters["function"].ToString())
+            {
+                case "flat":
+                    sample = (z, x) => height;
+                    break;
+                case "slope":
+                {
+                    float from = ReadFloat(parameters, "from", 0f);
+                    float to = ReadFloat(parameters, "to", 1f);
+                    bool alongX = !parameters.ContainsKey("direction") || parameters["direction"].ToString() == "x";
+                    sample = (z, x) => Mathf.Lerp(from, to, (float)(alongX ? x : z) / (resolution - 1));
+                    break;
+                }
+                case "noise":
+                {
+                    float scale = ReadFloat(parameters, "scale", 50f);
+                    float amplitude = ReadFloat(parameters, "amplitude", 0.1f);
+                    float baseHeight = parameters.ContainsKey("height") ? height : amplitude;
+                    // 种子决定噪声的采样偏移
+                    var random = new System.Random(parameters.ContainsKey("seed") ? System.Convert.ToInt32(parameters["seed"]) : 0);
+                    float offsetX = (float)random.NextDouble() * 10000f;
+                    float offsetZ = (float)random.NextDouble() * 10000f;
+                    Vector3 size = data.size;
+                    sample = (z, x) =>
+                    {
+                        float worldX = (float)x / (resolution - 1) * size.x;
+                        float worldZ = (float)z / (resolution - 1) * size.z;
+                        float noise = Mathf.PerlinNoise(offsetX + worldX / scale, offsetZ + worldZ / scale);
+                        return baseHeight + (noise - 0.5f) * 2f * amplitude;
+                    };
+                    break;
+                }
+                default:
+                    return $"不支持的高度函数: {parameters["function"]}";
+            }
+        }
+        
+        for (int z = 0; z < resolution; z++)
+        {
+            for (int x = 0; x < resolution; x++)
+            {
+                heights[z, x] = Mathf.Clamp01(sample(z, x));
+            }
+        }
+        Undo.RegisterCompleteObjectUndo(data, "Set Terrain Heights");
+        data.SetHeights(0, 0, heights);
+        return null;
+    }
+    
+    /// <summary>
+    /// 双线性插值，u沿heightmap的列 (宽度)，v沿行 (长度)
+    /// </summary>
+    private static float SampleBilinear(float[][] heightmap, float u, float v)
+    {
+        float row = v * (heightmap.Length - 1);
+        float column = u * (heightmap[0].Length - 1);
+        int row0 = Mathf.Min((int)row, heightmap.Length - 2);
+        int column0 = Mathf.Min((int)column, heightmap[0].Length - 2);
+        float top = Mathf.Lerp(heightmap[row0][column0], heightmap[row0][column0 + 1], column - column0);
+        float bottom = Mathf.Lerp(heightmap[row0 + 1][column0], heightmap[row0 + 1][column0 + 1], column - column0);
+        return Mathf.Lerp(top, bottom, row - row0);
+    }
+    
+    /// <summary>
+    /// 为纹理创建TerrainLayer资源并追加到地形层，同一纹理不会重复添加
+    /// </summary>
+    private static string AddLayer(TerrainData data, Dictionary<string, object> parameters)
+    {
+        string texturePath = parameters["texturePath"].ToString();
+        Texture2D texture = AssetDatabase.LoadAssetAtPath<Texture2D>(texturePath);
+        if (texture == null)
+        {
+            return $"未找到纹理: {texturePath}";
+        }
+        TerrainLayer[] layers = data.terrainLayers;
+        if (layers.Any(existing => existing != null && existing.diffuseTexture == texture))
+        {
+            return $"地形已有使用该纹理的层: {texturePath}";
+        }
+        
+        var layer = new TerrainLayer { diffuseTexture = texture };
+        if (parameters.ContainsKey("normalMapPath"))
+        {
+            string normalMapPath = parameters["normalMapPath"].ToString();
+            layer.normalMapTexture = AssetDatabase.LoadAssetAtPath<Texture2D>(normalMapPath);
+            if (layer.normalMapTexture == null)
+            {
+                return $"未找到法线贴图: {normalMapPath}";
+            }
+        }
+        if (parameters.ContainsKey("tileSize") && parameters["tileSize"] is Dictionary<string, object> tileSize)
+        {
+            layer.tileSize = new Vector2(
+                tileSize.ContainsKey("x") ? System.Convert.ToSingle(tileSize["x"]) : layer.tileSize.x,
+                tileSize.ContainsKey("y") ? System.Convert.ToSingle(tileSize["y"]) : layer.tileSize.y);
+        }
+        
+        string layerPath;
+        if (parameters.ContainsKey("layerPath"))
+        {
+            layerPath = parameters["layerPath"].ToString();
+            if (AssetDatabase.LoadAssetAtPath<Object>(layerPath) != null)
+            {
+                return $"资源已存在: {layerPath}";
+            }
+        }
+        else
+        {
+            string dataPath = AssetDatabase.GetAssetPath(data);
+            string directory = string.IsNullOrEmpty(dataPath) ? "Assets/Terrains" : System.IO.Path.GetDirectoryName(dataPath).Replace('\\', '/');
+            layerPath = AssetDatabase.GenerateUniqueAssetPath($"{directory}/{texture.name}.terrainlayer");
+        }
+        TerrainHelper.EnsureDirectory(layerPath);
+        AssetDatabase.CreateAsset(layer, layerPath);
+        
+        Undo.RegisterCompleteObjectUndo(data, "Add Terrain Layer");
+        data.terrainLayers = layers.Concat(new[] { layer }).ToArray();
+        return null;
+    }
+    
+    /// <summary>
+    /// 把矩形内每个点的层权重向目标层插值，其他层按比例减少，权重之和保持为1
+    /// </summary>
+    private static string PaintLayer(TerrainData data, Dictionary<string, object> parameters)
+    {
+        int layerIndex = System.Convert.ToInt32(parameters["layerIndex"]);
+        int layerCount = data.terrainLayers.Length;
+        if (layerIndex >= layerCount)
+        {
+            return $"地形层下标超出范围: {layerIndex}，共有 {layerCount} 个层";
+        }
+        float opacity = ReadFloat(parameters, "opacity", 1f);
+        
+        int resolution = data.alphamapResolution;
+        Rect rect = new Rect(0, 0, 1, 1);
+        if (parameters.ContainsKey("rect") && parameters["rect"] is Dictionary<string, object> rectDict)
+        {
+            rect = new Rect(ReadFloat(rectDict, "x", 0f), ReadFloat(rectDict, "y", 0f), ReadFloat(rectDict, "width", 1f), ReadFloat(rectDict, "height", 1f));
+        }
+        int x0 = Mathf.Clamp(Mathf.FloorToInt(rect.x * resolution), 0, resolution - 1);
+        int z0 = Mathf.Clamp(Mathf.FloorToInt(rect.y * resolution), 0, resolution - 1);
+        int width = Mathf.Clamp(Mathf.CeilToInt(rect.width * resolution), 1, resolution - x0);
+        int length = Mathf.Clamp(Mathf.CeilToInt(rect.height * resolution), 1, resolution - z0);
+        
+        Undo.RegisterCompleteObjectUndo(data.alphamapTextures, "Paint Terrain Layer");
+        float[,,] alphamaps = data.GetAlphamaps(x0, z0, width, length);
+        for (int z = 0; z < length; z++)
+        {
+            for (int x = 0; x < width; x++)
+            {
+                for (int layer = 0; layer < layerCount; layer++)
+                {
+                    alphamaps[z, x, layer] = Mathf.Lerp(alphamaps[z, x, layer], layer == layerIndex ? 1f : 0f, opacity);
+                }
+            }
+        }
+        data.SetAlphamaps(x0, z0, alphamaps);
+        return null;
+    }
+    
+    private static float ReadFloat(Dictionary<string, object> parameters, string key, float defaultValue)
+    {
+        return parameters.ContainsKey(key) ? System.Convert.ToSingle(parameters[key]) : defaultValue;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        if (!parameters.ContainsKey("operation"))
+        {
+            return "缺少必需参数: operation";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,217 @@
+using System.Collections.Generic;
+using System.Text.RegularExpressions;
+using UnityEngine;
+using UnityEditor;
+using UnityEditor.TestTools.TestRunner.Api;
+
+/// <summary>
+/// 测试运行跟踪 - 通过Test Runner API启动测试并记录进度和结果
+/// PlayMode测试会重载脚本域，因此运行状态保存在SessionState中，回调在每次域重载后重新注册
+/// </summary>
+[InitializeOnLoad]
+public static class TestRunTracker
+{
+    private const string StateKey = "UnityMCP.TestRun";
+    
+    // 最多记录的失败数，避免SessionState过大
+    private const int MaxFailures = 200;
+    
+    /// <summary>
+    /// 一个失败的测试
+    /// </summary>
+    [System.Serializable]
+    public class Failure
+    {
+        public string name;
+        public string fullName;
+        public string message;
+        public string stackTrace;
+        public double duration;
+    }
+    
+    /// <summary>
+    /// 一次测试运行的状态
+    /// status: running/finished/canceled
+    /// </summary>
+    [System.Serializable]
+    public class RunState
+    {
+        public string runId;
+        public string testRunnerGuid;
+        public string mode;
+        public string status;
+        public string startedAt;
+        public string finishedAt;
+        public string currentTest;
+        public int total;
+        public int completed;
+        public int passed;
+        public int failed;
+        public int skipped;
+        public int inconclusive;
+        public double duration;
+        public bool failuresTruncated;
+        public List<Failure> failures = new List<Failure>();
+    }
+    
+    private static TestRunnerApi api;
+    
+    static TestRunTracker()
+    {
+        api = ScriptableObject.CreateInstance<TestRunnerApi>();
+        api.RegisterCallbacks(new Callbacks());
+    }
+    
+    /// <summary>
+    /// 当前或最近一次运行的状态，本次编辑器会话中没有运行过时返回null
+    /// </summary>
+    public static RunState Current
+    {
+        get
+        {
+            string json = SessionState.GetString(StateKey, "");
+            return string.IsNullOrEmpty(json) ? null : JsonUtility.FromJson<RunState>(json);
+        }
+    }
+    
+    private static void Save(RunState state)
+    {
+        SessionState.SetString(StateKey, JsonUtility.ToJson(state));
+    }
+    
+    /// <summary>
+    /// 启动测试运行，返回运行状态
+    /// names按完整名称前缀匹配 (命名空间、类或测试)，categories按测试类别匹配
+    /// </summary>
+    public static RunState Start(string mode, List<string> names, List<string> categories)
+    {
+        var state = new RunState
+        {
+            runId = System.Guid.NewGuid().ToString("N"),
+            mode = mode,
+            status = "running",
+            startedAt = System.DateTime.UtcNow.ToString("yyyy-MM-ddTHH:mm:ssZ")
+        };
+        Save(state);
+        
+        var filter = new Filter
+        {
+            testMode = mode == "playmode" ? TestMode.PlayMode : TestMode.EditMode
+        };
+        if (names.Count > 0)
+        {
+            filter.groupNames = names.ConvertAll(name => "^" + Regex.Escape(name)).ToArray();
+        }
+        if (categories.Count > 0)
+        {
+            filter.categoryNames = categories.ToArray();
+        }
+        
+        state.testRunnerGuid = api.Execute(new ExecutionSettings(filter));
+        Save(state);
+        return state;
+    }
+    
+    /// <summary>
+    /// 取消正在进行的运行，已完成的测试结果保留
+    /// </summary>
+    public static RunState Cancel()
+    {
+        var state = Current;
+        if (state == null || state.status != "running")
+        {
+            return state;
+        }
+        if (!string.IsNullOrEmpty(state.testRunnerGuid))
+        {
+            TestRunnerApi.CancelTestRun(state.testRunnerGuid);
+        }
+        state.status = "canceled";
+        state.finishedAt = System.DateTime.UtcNow.ToString("yyyy-MM-ddTHH:mm:ssZ");
+        Save(state);
+        return state;
+    }
+    
+    /// <summary>
+    /// Test Runner回调，每个事件都立即写入SessionState
+    /// </summary>
+    private class Callbacks : ICallbacks
+    {
+        public void RunStarted(ITestAdaptor testsToRun)
+        {
+            var state = Current;
+            if (state == null || state.status != "running")
+            {
+                return;
+            }
+            state.total = testsToRun.TestCaseCount;
+            Save(state);
+        }
+        
+        public void TestStarted(ITestAdaptor test)
+        {
+            var state = Current;
+            if (state == null || state.status != "running" || test.IsSuite)
+            {
+                return;
+            }
+            state.currentTest = test.FullName;
+            Save(state);
+        }
+        
+        public void TestFinished(ITestResultAdaptor result)
+        {
+            var state = Current;
+            if (state == null || state.status != "running" || result.Test.IsSuite)
+            {
+                return;
+            }
+            state.completed++;
+            switch (result.TestStatus)
+            {
+                case TestStatus.Passed:
+                    state.passed++;
+                    break;
+                case TestStatus.Failed:
+                    state.failed++;
+                    if (state.failures.Count < MaxFailures)
+                    {
+                        state.failures.Add(new Failure
+                        {
+                            name = result.Test.Name,
+                            fullName = result.Test.FullName,
+                            message = result.Message,
+                            stackTrace = result.StackTrace,
+                            duration = result.Duration
+                        });
+                    }
+                    else
+                    {
+                        state.failuresTruncated = true;
+                    }
+                    break;
+                case TestStatus.Skipped:
+                    state.skipped++;
+                    break;
+                default:
+                    state.inconclusive++;
+                    break;
+            }
+            Save(state);
+        }
+        
+        public void RunFinished(ITestResultAdaptor result)
+        {
+            var state = Current;
+            if (state == null || state.status != "running")
+            {
+                return;
+            }
+            state.status = "finished";
+            state.currentTest = null;
+            state.duration = result.Duration;
+            state.finishedAt = System.DateTime.UtcNow.ToString("yyyy-MM-ddTHH:mm:ssZ");
+            Save(state);
+        }
+    }
+}
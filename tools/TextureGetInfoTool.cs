@@ -0,0 +1,206 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using System.Reflection;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// 纹理信息工具 - 返回纹理的尺寸、格式、导入设置、Sprite子资源，以及各平台的导入设置和压缩后大小估算
+/// </summary>
+public class TextureGetInfoTool : IMCPTool
+{
+    /// <summary>
+    /// 报告导入设置的平台 (TextureImporter的平台名称)
+    /// </summary>
+    private static readonly string[] Platforms = { "Standalone", "Android", "iPhone", "WebGL" };
+    
+    // 当前平台的实际存储大小，内部API
+    private static readonly MethodInfo storageSizeMethod =
+        System.Type.GetType("UnityEditor.TextureUtil,UnityEditor")?.GetMethod("GetStorageMemorySizeLong", BindingFlags.Static | BindingFlags.Public | BindingFlags.NonPublic);
+    
+    public string ToolName => "texture_get_info";
+    
+    public string Description => "获取纹理的尺寸、格式和导入设置";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string assetPath = parameters["assetPath"].ToString();
+            TextureImporter importer = AssetImporter.GetAtPath(assetPath) as TextureImporter;
+            Texture texture = AssetDatabase.LoadAssetAtPath<Texture>(assetPath);
+            if (importer == null || texture == null)
+            {
+                return MCPResponse.Error($"不是纹理资源: {assetPath}");
+            }
+            
+            importer.GetSourceTextureWidthAndHeight(out int sourceWidth, out int sourceHeight);
+            var result = new Dictionary<string, object>
+            {
+                ["assetPath"] = assetPath,
+                ["sourceWidth"] = sourceWidth,
+                ["sourceHeight"] = sourceHeight,
+                ["width"] = texture.width,
+                ["height"] = texture.height,
+                ["mipmapCount"] = texture.mipmapCount,
+                ["textureType"] = importer.textureType.ToString(),
+                ["spriteMode"] = importer.spriteImportMode.ToString(),
+                ["pixelsPerUnit"] = importer.spritePixelsPerUnit,
+                ["mipmapEnabled"] = importer.mipmapEnabled,
+                ["isReadable"] = importer.isReadable,
+                ["sRGB"] = importer.sRGBTexture,
+                ["filterMode"] = importer.filterMode.ToString(),
+                ["wrapMode"] = importer.wrapMode.ToString(),
+                ["maxTextureSize"] = importer.maxTextureSize,
+                ["compression"] = importer.textureCompression.ToString(),
+                ["sprites"] = DescribeSprites(assetPath)
+            };
+            if (texture is Texture2D texture2D)
+            {
+                result["format"] = texture2D.format.ToString();
+            }
+            if (storageSizeMethod != null)
+            {
+                long storage = (long)storageSizeMethod.Invoke(null, new object[] { texture });
+                result["storageBytes"] = storage;
+                result["storage"] = EditorUtility.FormatBytes(storage);
+            }
+            
+            var platforms = new List<Dictionary<string, object>>();
+            foreach (string platform in Platforms)
+            {
+                TextureImporterPlatformSettings settings = importer.GetPlatformTextureSettings(platform);
+                string format = settings.overridden ? settings.format.ToString() : importer.GetAutomaticFormat(platform).ToString();
+                int maxSize = settings.overridden ? settings.maxTextureSize : importer.maxTextureSize;
+                long? estimate = EstimateSize(format, sourceWidth, sourceHeight, maxSize, importer.mipmapEnabled);
+                platforms.Add(new Dictionary<string, object>
+                {
+                    ["platform"] = platform,
+                    ["overridden"] = settings.overridden,
+                    ["format"] = format,
+                    ["maxTextureSize"] = maxSize,
+                    ["estimatedBytes"] = estimate,
+                    ["estimated"] = estimate.HasValue ? EditorUtility.FormatBytes(estimate.Value) : null
+                });
+            }
+            result["platforms"] = platforms;
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取纹理信息时出错: {e.Message}");
+            return MCPResponse.Error($"获取纹理信息失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 纹理中的Sprite子资源 (名称、矩形、pivot)，ui_image_set可以按名称引用
+    /// </summary>
+    public static List<Dictionary<string, object>> DescribeSprites(string assetPath)
+    {
+        return AssetDatabase.LoadAllAssetsAtPath(assetPath).OfType<Sprite>()
+            .OrderBy(sprite => sprite.name)
+            .Select(sprite => new Dictionary<string, object>
+            {
+                ["name"] = sprite.name,
+                ["rect"] = new Dictionary<string, float>
+                {
+                    ["x"] = sprite.rect.x, ["y"] = sprite.rect.y, ["width"] = sprite.rect.width, ["height"] = sprite.rect.height
+                },
+                ["pivot"] = new Dictionary<string, float>
+                {
+                    ["x"] = sprite.pivot.x / sprite.rect.width, ["y"] = sprite.pivot.y / sprite.rect.height
+                }
+            })
+            .ToList();
+    }
+    
+    /// <summary>
+    /// 按格式的每像素位数估算压缩后的大小 (缩小到maxSize以内，含mipmap)，未知格式返回null
+    /// </summary>
+    private static long? EstimateSize(string format, int width, int height, int maxSize, bool mipmaps)
+    {
+        float? bitsPerPixel = BitsPerPixel(format);
+        if (!bitsPerPixel.HasValue || width <= 0 || height <= 0)
+        {
+            return null;
+        }
+        float scale = Mathf.Min(1f, (float)maxSize / Mathf.Max(width, height));
+        double bytes = Mathf.Max(1, Mathf.RoundToInt(width * scale)) * (double)Mathf.Max(1, Mathf.RoundToInt(height * scale)) * bitsPerPixel.Value / 8;
+        if (mipmaps)
+        {
+            bytes *= 4.0 / 3.0;
+        }
+        return (long)bytes;
+    }
+    
+    private static float? BitsPerPixel(string format)
+    {
+        // ASTC按块大小计算: 128位每块
+        var astc = System.Text.RegularExpressions.Regex.Match(format, @"ASTC_(?:RGBA?_|HDR_)?(\d+)x(\d+)");
+        if (astc.Success)
+        {
+            return 128f / (int.Parse(astc.Groups[1].Value) * int.Parse(astc.Groups[2].Value));
+        }
+        switch (format)
+        {
+            case "PVRTC_RGB2":
+            case "PVRTC_RGBA2":
+                return 2;
+            case "DXT1":
+            case "DXT1Crunched":
+            case "BC4":
+            case "ETC_RGB4":
+            case "ETC_RGB4Crunched":
+            case "ETC2_RGB4":
+            case "ETC2_RGB4_PUNCHTHROUGH_ALPHA":
+            case "EAC_R":
+            case "EAC_R_SIGNED":
+            case "PVRTC_RGB4":
+            case "PVRTC_RGBA4":
+                return 4;
+            case "DXT5":
+            case "DXT5Crunched":
+            case "BC5":
+            case "BC6H":
+            case "BC7":
+            case "ETC2_RGBA8":
+            case "ETC2_RGBA8Crunched":
+            case "EAC_RG":
+            case "EAC_RG_SIGNED":
+            case "Alpha8":
+            case "R8":
+                return 8;
+            case "RGB16":
+            case "RGBA16":
+            case "ARGB16":
+            case "RGB565":
+            case "R16":
+            case "RG16":
+                return 16;
+            case "RGB24":
+                return 24;
+            case "RGBA32":
+            case "ARGB32":
+            case "BGRA32":
+            case "RGB48":
+                return 32;
+            case "RGBAHalf":
+                return 64;
+            case "RGBAFloat":
+                return 128;
+        }
+        return null;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("assetPath") || string.IsNullOrEmpty(parameters["assetPath"]?.ToString()))
+        {
+            return "缺少必需参数: assetPath";
+        }
+        return null;
+    }
+}
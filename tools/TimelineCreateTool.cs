@@ -0,0 +1,206 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.Playables;
+using UnityEditor;
+using Object = UnityEngine.Object;
+
+/// <summary>
+/// Timeline创建工具 - 创建TimelineAsset，添加动画、激活和音频轨道并绑定对象，指定给director上的PlayableDirector
+/// 先加载所有片段和绑定对象，任何一个找不到时不创建资源
+/// </summary>
+public class TimelineCreateTool : IMCPTool
+{
+    public string ToolName => "timeline_create";
+    
+    public string Description => "创建Timeline";
+    
+    /// <summary>
+    /// 解析后的轨道定义
+    /// </summary>
+    private class TrackSpec
+    {
+        public string type;
+        public string name;
+        public GameObject binding;
+        public Object clip;
+        public double start;
+        public List<Dictionary<string, object>> ranges;
+    }
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Type timelineType = TimelineHelper.TimelineAssetType;
+            if (timelineType == null)
+            {
+                return MCPResponse.Error(TimelineHelper.NotInstalledError);
+            }
+            
+            string savePath = parameters["savePath"].ToString();
+            if (AssetDatabase.LoadAssetAtPath<Object>(savePath) != null)
+            {
+                return MCPResponse.Error($"资源已存在: {savePath}");
+            }
+            GameObject directorObject = TimelineHelper.FindGameObject(parameters["director"]);
+            if (directorObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {parameters["director"]})");
+            }
+            var specs = new List<TrackSpec>();
+            foreach (Dictionary<string, object> definition in AnimatorControllerHelper.ReadObjects(parameters, "tracks"))
+            {
+                TrackSpec spec = ReadTrack(definition, out string error);
+                if (spec == null)
+                {
+                    return MCPResponse.Error(error);
+                }
+                specs.Add(spec);
+            }
+            
+            var timeline = (PlayableAsset)ScriptableObject.CreateInstance(timelineType);
+            TerrainHelper.EnsureDirectory(savePath);
+            AssetDatabase.CreateAsset(timeline, savePath);
+            
+            PlayableDirector director = directorObject.GetComponent<PlayableDirector>();
+            if (director == null)
+            {
+                director = Undo.AddComponent<PlayableDirector>(directorObject);
+            }
+            Undo.RecordObject(director, "Create Timeline");
+            director.playableAsset = timeline;
+            
+            Type trackAssetType = TimelineHelper.FindType("UnityEngine.Timeline.TrackAsset");
+            var createTrack = timelineType.GetMethod("CreateTrack", new[] { typeof(Type), trackAssetType, typeof(string) });
+            foreach (TrackSpec spec in specs)
+            {
+                Type trackType = TimelineHelper.FindType(TimelineHelper.TrackTypes[spec.type]);
+                var track = (PlayableAsset)createTrack.Invoke(timeline, new object[] { trackType, null, spec.name });
+                AddClips(track, spec);
+                Object binding = Bind(spec);
+                if (binding != null)
+                {
+                    director.SetGenericBinding(track, binding);
+                }
+            }
+            EditorUtility.SetDirty(timeline);
+            AssetDatabase.SaveAssets();
+            
+            Debug.Log($"已创建Timeline: {savePath} ({specs.Count} 条轨道，PlayableDirector: {directorObject.name})");
+            return MCPResponse.Success(TimelineHelper.Describe(timeline, director));
+        }
+        catch (Exception e)
+        {
+            Debug.LogError($"创建Timeline时出错: {e.Message}");
+            return MCPResponse.Error($"创建Timeline失败: {e.Message}");
+        }
+    }
+    
+    private static TrackSpec ReadTrack(Dictionary<string, object> definition, out string error)
+    {
+        error = null;
+        var spec = new TrackSpec
+        {
+            type = definition["type"].ToString(),
+            start = definition.ContainsKey("start") ? Convert.ToDouble(definition["start"]) : 0
+        };
+        if (!TimelineHelper.TrackTypes.ContainsKey(spec.type))
+        {
+            error = $"不支持的轨道类型: {spec.type}";
+            return null;
+        }
+        spec.name = definition.ContainsKey("name") ? definition["name"].ToString() : char.ToUpper(spec.type[0]) + spec.type.Substring(1);
+        if (definition.ContainsKey("binding"))
+        {
+            spec.binding = TimelineHelper.FindGameObject(definition["binding"]);
+            if (spec.binding == null)
+            {
+                error = $"未找到轨道 {spec.name} 绑定的GameObject (InstanceID: {definition["binding"]})";
+                return null;
+            }
+        }
+        
+        switch (spec.type)
+        {
+            case "animation":
+            case "audio":
+                string clipPath = definition["clipPath"].ToString();
+                spec.clip = spec.type == "animation"
+                    ? (Object)AssetDatabase.LoadAssetAtPath<AnimationClip>(clipPath)
+                    : AssetDatabase.LoadAssetAtPath<AudioClip>(clipPath);
+                if (spec.clip == null)
+                {
+                    error = $"未找到{(spec.type == "animation" ? "动画" : "音频")}片段: {clipPath}";
+                    return null;
+                }
+                break;
+            case "activation":
+                spec.ranges = AnimatorControllerHelper.ReadObjects(definition, "ranges");
+                break;
+        }
+        return spec;
+    }
+    
+    /// <summary>
+    /// 动画和音频轨道添加一个片段，激活轨道为每个区间添加一个激活片段
+    /// </summary>
+    private static void AddClips(PlayableAsset track, TrackSpec spec)
+    {
+        if (spec.type == "activation")
+        {
+            var createDefaultClip = track.GetType().GetMethod("CreateDefaultClip", Type.EmptyTypes);
+            foreach (Dictionary<string, object> range in spec.ranges)
+            {
+                object clip = createDefaultClip.Invoke(track, null);
+                double start = Convert.ToDouble(range["start"]);
+                TimelineHelper.SetProperty(clip, "start", start);
+                TimelineHelper.SetProperty(clip, "duration", Convert.ToDouble(range["end"]) - start);
+            }
+            return;
+        }
+        object created = track.GetType().GetMethod("CreateClip", new[] { spec.clip.GetType() }).Invoke(track, new object[] { spec.clip });
+        TimelineHelper.SetProperty(created, "start", spec.start);
+    }
+    
+    /// <summary>
+    /// 轨道的绑定对象: 动画轨道绑定Animator，音频轨道绑定AudioSource (缺少时添加)，激活轨道绑定GameObject
+    /// </summary>
+    private static Object Bind(TrackSpec spec)
+    {
+        if (spec.binding == null)
+        {
+            return null;
+        }
+        switch (spec.type)
+        {
+            case "animation":
+                Animator animator = spec.binding.GetComponent<Animator>();
+                return animator != null ? animator : Undo.AddComponent<Animator>(spec.binding);
+            case "audio":
+                AudioSource source = spec.binding.GetComponent<AudioSource>();
+                return source != null ? source : Undo.AddComponent<AudioSource>(spec.binding);
+            default:
+                return spec.binding;
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("savePath") || string.IsNullOrEmpty(parameters["savePath"]?.ToString()))
+        {
+            return "缺少必需参数: savePath";
+        }
+        if (!parameters.ContainsKey("director"))
+        {
+            return "缺少必需参数: director";
+        }
+        if (!parameters.ContainsKey("tracks"))
+        {
+            return "缺少必需参数: tracks";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,77 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.Playables;
+using UnityEditor;
+
+/// <summary>
+/// Timeline信息工具 - 读取时间线的轨道和片段，给出director时包含轨道绑定
+/// </summary>
+public class TimelineGetInfoTool : IMCPTool
+{
+    public string ToolName => "timeline_get_info";
+    
+    public string Description => "获取Timeline的轨道和绑定";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            System.Type timelineType = TimelineHelper.TimelineAssetType;
+            if (timelineType == null)
+            {
+                return MCPResponse.Error(TimelineHelper.NotInstalledError);
+            }
+            
+            PlayableDirector director = null;
+            if (parameters.ContainsKey("director"))
+            {
+                GameObject directorObject = TimelineHelper.FindGameObject(parameters["director"]);
+                if (directorObject == null)
+                {
+                    return MCPResponse.Error($"未找到GameObject (InstanceID: {parameters["director"]})");
+                }
+                director = directorObject.GetComponent<PlayableDirector>();
+                if (director == null)
+                {
+                    return MCPResponse.Error($"GameObject上没有PlayableDirector组件: {directorObject.name}");
+                }
+            }
+            
+            PlayableAsset timeline;
+            if (parameters.ContainsKey("assetPath"))
+            {
+                string assetPath = parameters["assetPath"].ToString();
+                timeline = AssetDatabase.LoadAssetAtPath(assetPath, timelineType) as PlayableAsset;
+                if (timeline == null)
+                {
+                    return MCPResponse.Error($"未找到Timeline资源: {assetPath}");
+                }
+            }
+            else
+            {
+                timeline = director.playableAsset;
+                if (timeline == null || !timelineType.IsInstanceOfType(timeline))
+                {
+                    return MCPResponse.Error($"PlayableDirector没有指定Timeline: {director.gameObject.name}");
+                }
+            }
+            
+            return MCPResponse.Success(TimelineHelper.Describe(timeline, director));
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取Timeline信息时出错: {e.Message}");
+            return MCPResponse.Error($"获取Timeline信息失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("assetPath") && !parameters.ContainsKey("director"))
+        {
+            return "缺少必需参数: assetPath或director";
+        }
+        return null;
+    }
+}
@@ -0,0 +1,151 @@
+using System;
+using System.Collections;
+using System.Collections.Generic;
+using System.Reflection;
+using UnityEngine;
+using UnityEngine.Playables;
+using UnityEditor;
+using Object = UnityEngine.Object;
+
+/// <summary>
+/// Timeline工具的共享逻辑: 通过反射访问Timeline包 (未安装时也能编译)，描述时间线的轨道、片段和绑定
+/// </summary>
+public static class TimelineHelper
+{
+    /// <summary>
+    /// 未安装Timeline包时的错误前缀，与Go服务器约定
+    /// </summary>
+    public const string NotInstalledMarker = "TIMELINE_NOT_INSTALLED";
+    
+    public const string NotInstalledError = NotInstalledMarker + ": 项目中未安装Timeline包";
+    
+    /// <summary>
+    /// 工具中的轨道类型名称对应的Timeline轨道类
+    /// </summary>
+    public static readonly Dictionary<string, string> TrackTypes = new Dictionary<string, string>
+    {
+        ["animation"] = "UnityEngine.Timeline.AnimationTrack",
+        ["activation"] = "UnityEngine.Timeline.ActivationTrack",
+        ["audio"] = "UnityEngine.Timeline.AudioTrack"
+    };
+    
+    public static Type TimelineAssetType => FindType("UnityEngine.Timeline.TimelineAsset");
+    
+    public static Type FindType(string fullName)
+    {
+        return TMPTextSetTool.FindTMPType(fullName);
+    }
+    
+    /// <summary>
+    /// 时间线的时长和所有输出轨道，给出director时包含每条轨道的绑定
+    /// </summary>
+    public static Dictionary<string, object> Describe(PlayableAsset timeline, PlayableDirector director)
+    {
+        var tracks = new List<Dictionary<string, object>>();
+        var outputTracks = (IEnumerable)timeline.GetType().GetMethod("GetOutputTracks").Invoke(timeline, null);
+        foreach (PlayableAsset track in outputTracks)
+        {
+            var clips = new List<Dictionary<string, object>>();
+            foreach (object clip in (IEnumerable)track.GetType().GetMethod("GetClips").Invoke(track, null))
+            {
+                var entry = new Dictionary<string, object>
+                {
+                    ["name"] = GetProperty(clip, "displayName"),
+                    ["start"] = GetProperty(clip, "start"),
+                    ["duration"] = GetProperty(clip, "duration")
+                };
+                // AnimationPlayableAsset和AudioPlayableAsset都通过clip属性引用片段资源
+                if (GetProperty(clip, "asset") is Object asset && GetProperty(asset, "clip") is Object clipAsset)
+                {
+                    entry["clipPath"] = AssetDatabase.GetAssetPath(clipAsset);
+                }
+                clips.Add(entry);
+            }
+            
+            var description = new Dictionary<string, object>
+            {
+                ["name"] = track.name,
+                ["type"] = TrackTypeName(track.GetType()),
+                ["muted"] = GetProperty(track, "muted"),
+                ["clips"] = clips
+            };
+            if (director != null)
+            {
+                description["binding"] = DescribeBinding(director.GetGenericBinding(track));
+            }
+            tracks.Add(description);
+        }
+        
+        var result = new Dictionary<string, object>
+        {
+            ["assetPath"] = AssetDatabase.GetAssetPath(timeline),
+            ["duration"] = timeline.duration,
+            ["tracks"] = tracks
+        };
+        if (director != null)
+        {
+            result["director"] = new Dictionary<string, object>
+            {
+                ["instanceId"] = director.gameObject.GetInstanceID(),
+                ["path"] = HierarchyPathHelper.GetPath(director.transform),
+                ["playOnAwake"] = director.playOnAwake,
+                ["wrapMode"] = director.extrapolationMode.ToString()
+            };
+        }
+        return result;
+    }
+    
+    /// <summary>
+    /// 轨道类型名称: 工具支持的类型使用工具中的名称，其他轨道使用类名
+    /// </summary>
+    private static string TrackTypeName(Type type)
+    {
+        foreach (var entry in TrackTypes)
+        {
+            if (entry.Value == type.FullName)
+            {
+                return entry.Key;
+            }
+        }
+        return type.Name;
+    }
+    
+    private static object DescribeBinding(Object binding)
+    {
+        if (binding == null)
+        {
+            return null;
+        }
+        GameObject gameObject = binding as GameObject ?? (binding as Component)?.gameObject;
+        var result = new Dictionary<string, object>
+        {
+            ["type"] = binding.GetType().Name,
+            ["name"] = binding.name
+        };
+        if (gameObject != null)
+        {
+            result["instanceId"] = gameObject.GetInstanceID();
+            result["path"] = HierarchyPathHelper.GetPath(gameObject.transform);
+        }
+        return result;
+    }
+    
+    public static object GetProperty(object target, string name)
+    {
+        return target.GetType().GetProperty(name, BindingFlags.Instance | BindingFlags.Public)?.GetValue(target);
+    }
+    
+    public static void SetProperty(object target, string name, object value)
+    {
+        target.GetType().GetProperty(name, BindingFlags.Instance | BindingFlags.Public).SetValue(target, value);
+    }
+    
+    /// <summary>
+    /// instanceId对应的GameObject，组件的instanceId返回其GameObject
+    /// </summary>
+    public static GameObject FindGameObject(object instanceId)
+    {
+        Object obj = EditorUtility.InstanceIDToObject(Convert.ToInt32(instanceId));
+        return obj as GameObject ?? (obj as Component)?.gameObject;
+    }
+}
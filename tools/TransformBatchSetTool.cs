@@ -0,0 +1,168 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+
+/// <summary>
+/// Transform批量设置工具 - 在一次调用和一个Undo组中设置多个GameObject的position、rotation和scale
+/// 某个条目的目标不存在时只有该条目失败，其余条目照常设置
+/// </summary>
+public class TransformBatchSetTool : IMCPTool
+{
+    public string ToolName => "transform_batch_set";
+    
+    public string Description => "批量设置GameObject的Transform";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            bool defaultWorldSpace = parameters.ContainsKey("worldSpace") ? System.Convert.ToBoolean(parameters["worldSpace"]) : true;
+            var entries = new List<Dictionary<string, object>>();
+            foreach (var item in (System.Collections.IEnumerable)parameters["entries"])
+            {
+                entries.Add((Dictionary<string, object>)item);
+            }
+            
+            // 所有修改合并为一个Undo操作
+            Undo.IncrementCurrentGroup();
+            int undoGroup = Undo.GetCurrentGroup();
+            Undo.SetCurrentGroupName($"Set {entries.Count} Transforms");
+            
+            var results = new List<object>();
+            int succeeded = 0;
+            for (int i = 0; i < entries.Count; i++)
+            {
+                var entry = entries[i];
+                var result = new Dictionary<string, object> { ["index"] = i };
+                results.Add(result);
+                
+                string error = FindTarget(entry, out GameObject targetObject);
+                if (error != null)
+                {
+                    result["success"] = false;
+                    result["error"] = error;
+                    continue;
+                }
+                bool worldSpace = entry.ContainsKey("worldSpace") ? System.Convert.ToBoolean(entry["worldSpace"]) : defaultWorldSpace;
+                Apply(targetObject.transform, entry, worldSpace);
+                
+                Transform transform = targetObject.transform;
+                result["success"] = true;
+                result["instanceId"] = targetObject.GetInstanceID();
+                result["path"] = HierarchyPathHelper.GetPath(transform);
+                result["worldSpace"] = worldSpace;
+                result["position"] = ToDictionary(worldSpace ? transform.position : transform.localPosition);
+                result["rotation"] = ToDictionary(worldSpace ? transform.eulerAngles : transform.localEulerAngles);
+                result["scale"] = ToDictionary(transform.localScale);
+                succeeded++;
+            }
+            Undo.CollapseUndoOperations(undoGroup);
+            
+            Debug.Log($"批量设置Transform: 成功 {succeeded} 个，失败 {entries.Count - succeeded} 个");
+            return MCPResponse.Success(new Dictionary<string, object>
+            {
+                ["total"] = entries.Count,
+                ["succeeded"] = succeeded,
+                ["failed"] = entries.Count - succeeded,
+                ["undoGroupName"] = Undo.GetCurrentGroupName(),
+                ["results"] = results
+            });
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"批量设置Transform时出错: {e.Message}");
+            return MCPResponse.Error($"批量设置Transform失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 按instanceId或层级路径查找条目的目标对象，失败时返回错误信息
+    /// </summary>
+    private static string FindTarget(Dictionary<string, object> entry, out GameObject target)
+    {
+        target = null;
+        if (entry.ContainsKey("path"))
+        {
+            return HierarchyPathHelper.Resolve(entry["path"].ToString(), out target);
+        }
+        if (!entry.ContainsKey("instanceId"))
+        {
+            return "缺少instanceId或path";
+        }
+        int instanceId = System.Convert.ToInt32(entry["instanceId"]);
+        target = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+        return target == null ? $"未找到GameObject (InstanceID: {instanceId})" : null;
+    }
+    
+    /// <summary>
+    /// 设置一个条目的position、rotation和scale，未提供的分量保持当前值
+    /// </summary>
+    private static void Apply(Transform transform, Dictionary<string, object> entry, bool worldSpace)
+    {
+        Undo.RecordObject(transform, "Set Transform");
+        if (entry.ContainsKey("position") && entry["position"] is Dictionary<string, object> position)
+        {
+            Vector3 value = SceneTransformSetTool.ResolveVector(position, worldSpace ? transform.position : transform.localPosition, false);
+            if (worldSpace)
+            {
+                transform.position = value;
+            }
+            else
+            {
+                transform.localPosition = value;
+            }
+        }
+        if (entry.ContainsKey("rotation") && entry["rotation"] is Dictionary<string, object> rotation)
+        {
+            Vector3 value = SceneTransformSetTool.ResolveVector(rotation, worldSpace ? transform.eulerAngles : transform.localEulerAngles, false);
+            if (worldSpace)
+            {
+                transform.rotation = Quaternion.Euler(value);
+            }
+            else
+            {
+                transform.localRotation = Quaternion.Euler(value);
+            }
+        }
+        if (entry.ContainsKey("scale") && entry["scale"] is Dictionary<string, object> scale)
+        {
+            transform.localScale = SceneTransformSetTool.ResolveVector(scale, transform.localScale, false);
+        }
+    }
+    
+    private static Dictionary<string, float> ToDictionary(Vector3 v)
+    {
+        return new Dictionary<string, float>
+        {
+            ["x"] = v.x,
+            ["y"] = v.y,
+            ["z"] = v.z
+        };
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("entries") || !(parameters["entries"] is System.Collections.IEnumerable))
+        {
+            return "缺少必需参数: entries";
+        }
+        int index = 0;
+        foreach (var item in (System.Collections.IEnumerable)parameters["entries"])
+        {
+            if (!(item is Dictionary<string, object> entry))
+            {
+                return $"entries[{index}] 必须是对象";
+            }
+            foreach (string key in new[] { "position", "rotation", "scale" })
+            {
+                if (entry.ContainsKey(key) && !(entry[key] is Dictionary<string, object>))
+                {
+                    return $"entries[{index}].{key} 必须是 {{x, y, z}} 对象";
+                }
+            }
+            index++;
+        }
+        return null;
+    }
+}
@@ -0,0 +1,163 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Sockets;
+using System.Reflection;
+using UnityEngine;
+using UnityEngine.Events;
+using UnityEditor;
+using UnityEditor.Events;
+using UnityEngine.UI;
+
+/// <summary>
+/// UI Button组件工具 - 设置交互状态、过渡颜色、导航，并把onClick连接到持久监听器
+/// </summary>
+public class UIButtonSetTool : IMCPTool
+{
+    public string ToolName => "ui_button_set";
+    
+    public string Description => "设置Button组件属性并连接onClick";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Button button = UIControlHelper.ResolveComponent<Button>(parameters, out string error);
+            if (button == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            Undo.RecordObject(button, "Set Button Properties");
+            UIControlHelper.ApplySelectable(button, parameters);
+            
+            if (parameters.ContainsKey("clearOnClick") && System.Convert.ToBoolean(parameters["clearOnClick"]))
+            {
+                for (int i = button.onClick.GetPersistentEventCount() - 1; i >= 0; i--)
+                {
+                    UnityEventTools.RemovePersistentListener(button.onClick, i);
+                }
+            }
+            if (parameters.ContainsKey("onClickTargetInstanceId"))
+            {
+                string listenerError = AddListener(button, parameters);
+                if (listenerError != null)
+                {
+                    return MCPResponse.Error(listenerError);
+                }
+            }
+            
+            EditorUtility.SetDirty(button);
+            
+            var result = UIControlHelper.DescribeSelectable(button);
+            var listeners = new List<object>();
+            for (int i = 0; i < button.onClick.GetPersistentEventCount(); i++)
+            {
+                UnityEngine.Object target = button.onClick.GetPersistentTarget(i);
+                listeners.Add(new Dictionary<string, object>
+                {
+                    ["target"] = target != null ? target.name : null,
+                    ["targetType"] = target != null ? target.GetType().Name : null,
+                    ["method"] = button.onClick.GetPersistentMethodName(i)
+                });
+            }
+            result["onClick"] = listeners;
+            
+            Debug.Log($"成功设置UI元素 '{button.name}' 的Button组件属性");
+            return MCPResponse.Success(result);
+        }
+        catch (Exception e)
+        {
+            Debug.LogError($"设置Button组件属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置Button组件属性失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 添加持久监听器: 在目标对象的组件上查找公共方法，方法可以无参数或有一个string/int/float/bool参数
+    /// onClickMethod 可以写成 "Type.Method" 指定组件类型
+    /// </summary>
+    private string AddListener(Button button, Dictionary<string, object> parameters)
+    {
+        int targetId = System.Convert.ToInt32(parameters["onClickTargetInstanceId"]);
+        GameObject target = EditorUtility.InstanceIDToObject(targetId) as GameObject;
+        if (target == null)
+        {
+            return $"未找到onClick目标GameObject (InstanceID: {targetId})";
+        }
+        
+        string methodName = parameters["onClickMethod"].ToString();
+        string typeName = null;
+        int dot = methodName.LastIndexOf('.');
+        if (dot > 0)
+        {
+            typeName = methodName.Substring(0, dot);
+            methodName = methodName.Substring(dot + 1);
+        }
+        bool hasArgument = parameters.ContainsKey("onClickArgument");
+        
+        foreach (var component in target.GetComponents<Component>())
+        {
+            if (component == null) continue;
+            Type type = component.GetType();
+            if (typeName != null && type.Name != typeName && type.FullName != typeName) continue;
+            
+            foreach (MethodInfo method in type.GetMethods(BindingFlags.Public | BindingFlags.Instance))
+            {
+                if (method.Name != methodName || method.ReturnType != typeof(void)) continue;
+                ParameterInfo[] args = method.GetParameters();
+                
+                if (!hasArgument && args.Length == 0)
+                {
+                    var action = (UnityAction)Delegate.CreateDelegate(typeof(UnityAction), component, method);
+                    UnityEventTools.AddPersistentListener(button.onClick, action);
+                    return null;
+                }
+                if (hasArgument && args.Length == 1)
+                {
+                    object argument = parameters["onClickArgument"];
+                    Type argType = args[0].ParameterType;
+                    if (argType == typeof(string))
+                    {
+                        UnityEventTools.AddStringPersistentListener(button.onClick,
+                            (UnityAction<string>)Delegate.CreateDelegate(typeof(UnityAction<string>), component, method), argument.ToString());
+                        return null;
+                    }
+                    if (argType == typeof(int))
+                    {
+                        UnityEventTools.AddIntPersistentListener(button.onClick,
+                            (UnityAction<int>)Delegate.CreateDelegate(typeof(UnityAction<int>), component, method), System.Convert.ToInt32(argument));
+                        return null;
+                    }
+                    if (argType == typeof(float))
+                    {
+                        UnityEventTools.AddFloatPersistentListener(button.onClick,
+                            (UnityAction<float>)Delegate.CreateDelegate(typeof(UnityAction<float>), component, method), System.Convert.ToSingle(argument));
+                        return null;
+                    }
+                    if (argType == typeof(bool))
+                    {
+                        UnityEventTools.AddBoolPersistentListener(button.onClick,
+                            (UnityAction<bool>)Delegate.CreateDelegate(typeof(UnityAction<bool>), component, method), System.Convert.ToBoolean(argument));
+                        return null;
+                    }
+                }
+            }
+        }
+        
+        string signature = hasArgument ? "一个string/int/float/bool参数" : "无参数";
+        return $"对象 '{target.name}' 上没有{signature}的公共void方法 '{parameters["onClickMethod"]}'";
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        if (parameters.ContainsKey("onClickTargetInstanceId") && !parameters.ContainsKey("onClickMethod"))
+        {
+            return "onClickTargetInstanceId需要onClickMethod";
+        }
+        return null;
+    }
+}
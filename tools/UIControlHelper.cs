@@ -0,0 +1,167 @@
+using System;
+using System.Collections.Generic;
+using System.Reflection;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.UI;
+
+/// <summary>
+/// UI控件工具共用的辅助方法: 按instanceId解析组件、Selectable的通用设置和颜色转换
+/// </summary>
+public static class UIControlHelper
+{
+    /// <summary>
+    /// 按instanceId查找GameObject上的组件，找不到对象或组件时返回错误信息
+    /// </summary>
+    public static T ResolveComponent<T>(Dictionary<string, object> parameters, out string error) where T : Component
+    {
+        Component component = ResolveComponent(parameters, typeof(T), out error);
+        return component as T;
+    }
+    
+    /// <summary>
+    /// 按instanceId查找GameObject上指定类型的组件
+    /// </summary>
+    public static Component ResolveComponent(Dictionary<string, object> parameters, Type type, out string error)
+    {
+        error = null;
+        int instanceId = Convert.ToInt32(parameters["instanceId"]);
+        GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+        if (gameObject == null)
+        {
+            error = $"未找到GameObject (InstanceID: {instanceId})";
+            return null;
+        }
+        Component component = gameObject.GetComponent(type);
+        if (component == null)
+        {
+            error = $"GameObject '{gameObject.name}' 没有{type.Name}组件";
+        }
+        return component;
+    }
+    
+    /// <summary>
+    /// 按类型名查找组件，用于可选包中的组件 (如TextMeshPro的TMP_InputField)
+    /// </summary>
+    public static Component FindComponentByTypeName(GameObject gameObject, string typeName)
+    {
+        foreach (var component in gameObject.GetComponents<Component>())
+        {
+            if (component != null && component.GetType().Name == typeName)
+            {
+                return component;
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 读取颜色参数，省略的分量保持当前值
+    /// </summary>
+    public static Color ReadColor(object value, Color current)
+    {
+        if (!(value is Dictionary<string, object> dict))
+        {
+            return current;
+        }
+        return new Color(
+            dict.ContainsKey("r") ? Convert.ToSingle(dict["r"]) : current.r,
+            dict.ContainsKey("g") ? Convert.ToSingle(dict["g"]) : current.g,
+            dict.ContainsKey("b") ? Convert.ToSingle(dict["b"]) : current.b,
+            dict.ContainsKey("a") ? Convert.ToSingle(dict["a"]) : current.a
+        );
+    }
+    
+    public static Dictionary<string, float> ColorToDict(Color color)
+    {
+        return new Dictionary<string, float>
+        {
+            ["r"] = color.r,
+            ["g"] = color.g,
+            ["b"] = color.b,
+            ["a"] = color.a
+        };
+    }
+    
+    /// <summary>
+    /// 应用Selectable的通用设置: interactable、transition、过渡颜色和导航模式
+    /// </summary>
+    public static void ApplySelectable(Selectable selectable, Dictionary<string, object> parameters)
+    {
+        if (parameters.ContainsKey("interactable"))
+        {
+            selectable.interactable = Convert.ToBoolean(parameters["interactable"]);
+        }
+        if (parameters.ContainsKey("transition"))
+        {
+            selectable.transition = (Selectable.Transition)Enum.Parse(typeof(Selectable.Transition), parameters["transition"].ToString());
+        }
+        
+        ColorBlock colors = selectable.colors;
+        if (parameters.ContainsKey("normalColor")) colors.normalColor = ReadColor(parameters["normalColor"], colors.normalColor);
+        if (parameters.ContainsKey("highlightedColor")) colors.highlightedColor = ReadColor(parameters["highlightedColor"], colors.highlightedColor);
+        if (parameters.ContainsKey("pressedColor")) colors.pressedColor = ReadColor(parameters["pressedColor"], colors.pressedColor);
+        if (parameters.ContainsKey("selectedColor")) colors.selectedColor = ReadColor(parameters["selectedColor"], colors.selectedColor);
+        if (parameters.ContainsKey("disabledColor")) colors.disabledColor = ReadColor(parameters["disabledColor"], colors.disabledColor);
+        if (parameters.ContainsKey("colorMultiplier")) colors.colorMultiplier = Convert.ToSingle(parameters["colorMultiplier"]);
+        if (parameters.ContainsKey("fadeDuration")) colors.fadeDuration = Convert.ToSingle(parameters["fadeDuration"]);
+        selectable.colors = colors;
+        
+        if (parameters.ContainsKey("navigation"))
+        {
+            Navigation navigation = selectable.navigation;
+            navigation.mode = (Navigation.Mode)Enum.Parse(typeof(Navigation.Mode), parameters["navigation"].ToString());
+            selectable.navigation = navigation;
+        }
+    }
+    
+    /// <summary>
+    /// Selectable的通用状态
+    /// </summary>
+    public static Dictionary<string, object> DescribeSelectable(Selectable selectable)
+    {
+        ColorBlock colors = selectable.colors;
+        return new Dictionary<string, object>
+        {
+            ["name"] = selectable.gameObject.name,
+            ["instanceId"] = selectable.gameObject.GetInstanceID(),
+            ["interactable"] = selectable.interactable,
+            ["transition"] = selectable.transition.ToString(),
+            ["colors"] = new Dictionary<string, object>
+            {
+                ["normalColor"] = ColorToDict(colors.normalColor),
+                ["highlightedColor"] = ColorToDict(colors.highlightedColor),
+                ["pressedColor"] = ColorToDict(colors.pressedColor),
+                ["selectedColor"] = ColorToDict(colors.selectedColor),
+                ["disabledColor"] = ColorToDict(colors.disabledColor),
+                ["colorMultiplier"] = colors.colorMultiplier,
+                ["fadeDuration"] = colors.fadeDuration
+            },
+            ["navigation"] = selectable.navigation.mode.ToString()
+        };
+    }
+    
+    /// <summary>
+    /// 通过反射设置属性，用于不直接引用程序集的组件
+    /// </summary>
+    public static void SetProperty(object target, string name, object value)
+    {
+        PropertyInfo property = target.GetType().GetProperty(name, BindingFlags.Public | BindingFlags.Instance);
+        if (property == null || !property.CanWrite)
+        {
+            throw new ArgumentException($"{target.GetType().Name} 没有可写属性 {name}");
+        }
+        Type type = property.PropertyType;
+        object converted = type.IsEnum ? Enum.Parse(type, value.ToString()) : Convert.ChangeType(value, type);
+        property.SetValue(target, converted);
+    }
+    
+    /// <summary>
+    /// 通过反射读取属性
+    /// </summary>
+    public static object GetProperty(object target, string name)
+    {
+        PropertyInfo property = target.GetType().GetProperty(name, BindingFlags.Public | BindingFlags.Instance);
+        return property != null ? property.GetValue(target) : null;
+    }
+}
@@ -0,0 +1,320 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.EventSystems;
+using UnityEngine.UI;
+
+/// <summary>
+/// UI元素创建工具 - 一次创建标准UI控件，结果与GameObject→UI菜单相同
+/// 控件由DefaultControls按编辑器内置UI精灵创建；场景中没有Canvas时可自动创建Canvas和EventSystem
+/// </summary>
+public class UICreateElementTool : IMCPTool
+{
+    public string ToolName => "ui_create_element";
+    
+    public string Description => "创建标准UI控件（按钮、文本、图片、滑动条等）";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            string elementType = parameters["elementType"].ToString().ToLower();
+            bool createCanvasIfMissing = !parameters.ContainsKey("createCanvasIfMissing") || System.Convert.ToBoolean(parameters["createCanvasIfMissing"]);
+            
+            // 确定父对象: 控件必须位于Canvas之下
+            Transform parent = null;
+            if (parameters.ContainsKey("parentInstanceId"))
+            {
+                int parentId = System.Convert.ToInt32(parameters["parentInstanceId"]);
+                GameObject parentObject = EditorUtility.InstanceIDToObject(parentId) as GameObject;
+                if (parentObject == null)
+                {
+                    return MCPResponse.Error($"未找到父对象 (InstanceID: {parentId})");
+                }
+                parent = parentObject.transform;
+            }
+            
+            GameObject createdCanvas = null;
+            if (elementType != "canvas")
+            {
+                Canvas canvas = parent != null ? parent.GetComponentInParent<Canvas>() : FindSceneCanvas();
+                if (canvas == null)
+                {
+                    if (!createCanvasIfMissing)
+                    {
+                        return MCPResponse.Error(parent != null
+                            ? $"父对象 '{parent.name}' 不在Canvas下，设置createCanvasIfMissing为true可自动创建Canvas"
+                            : "当前场景没有Canvas，设置createCanvasIfMissing为true可自动创建Canvas");
+                    }
+                    createdCanvas = CreateCanvas("Canvas", parent);
+                    canvas = createdCanvas.GetComponent<Canvas>();
+                }
+                if (parent == null)
+                {
+                    parent = canvas.transform;
+                }
+                else if (createdCanvas != null)
+                {
+                    parent = createdCanvas.transform;
+                }
+            }
+            
+            GameObject element;
+            if (elementType == "canvas")
+            {
+                element = CreateCanvas(parameters.ContainsKey("name") ? parameters["name"].ToString() : "Canvas", parent);
+            }
+            else
+            {
+                element = CreateControl(elementType);
+                if (element == null)
+                {
+                    return MCPResponse.Error($"不支持的UI元素类型: {elementType}");
+                }
+                if (parameters.ContainsKey("name"))
+                {
+                    element.name = parameters["name"].ToString();
+                }
+                GameObjectUtility.SetParentAndAlign(element, parent.gameObject);
+                GameObjectUtility.EnsureUniqueNameForSibling(element);
+                Undo.RegisterCreatedObjectUndo(element, $"Create {element.name}");
+            }
+            
+            if (parameters.ContainsKey("text"))
+            {
+                ApplyText(element, elementType, parameters["text"].ToString());
+            }
+            if (parameters.ContainsKey("spritePath"))
+            {
+                string spritePath = parameters["spritePath"].ToString();
+                Sprite sprite = AssetDatabase.LoadAssetAtPath<Sprite>(spritePath);
+                if (sprite == null)
+                {
+                    Undo.DestroyObjectImmediate(createdCanvas != null ? createdCanvas : element);
+                    return MCPResponse.Error($"未找到精灵资源: {spritePath}");
+                }
+                element.GetComponent<Image>().sprite = sprite;
+            }
+            if (parameters.ContainsKey("anchorMin") && parameters.ContainsKey("anchorMax"))
+            {
+                ApplyAnchors(element.GetComponent<RectTransform>(),
+                    ReadVector2(parameters["anchorMin"]), ReadVector2(parameters["anchorMax"]));
+            }
+            
+            GameObject eventSystem = EnsureEventSystem();
+            Selection.activeGameObject = element;
+            
+            var result = new Dictionary<string, object>
+            {
+                ["elementType"] = elementType,
+                ["element"] = DescribeSubtree(element.transform),
+                ["createdCanvas"] = createdCanvas != null ? Describe(createdCanvas.transform) : null,
+                ["createdEventSystem"] = eventSystem != null ? Describe(eventSystem.transform) : null
+            };
+            
+            Debug.Log($"成功创建UI元素 '{GetHierarchyPath(element.transform)}' ({elementType})");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"创建UI元素时出错: {e.Message}");
+            return MCPResponse.Error($"创建UI元素失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 按类型创建控件，与GameObject→UI菜单使用相同的DefaultControls和内置精灵
+    /// </summary>
+    private static GameObject CreateControl(string elementType)
+    {
+        var resources = new DefaultControls.Resources
+        {
+            standard = AssetDatabase.GetBuiltinExtraResource<Sprite>("UI/Skin/UISprite.psd"),
+            background = AssetDatabase.GetBuiltinExtraResource<Sprite>("UI/Skin/Background.psd"),
+            inputField = AssetDatabase.GetBuiltinExtraResource<Sprite>("UI/Skin/InputFieldBackground.psd"),
+            knob = AssetDatabase.GetBuiltinExtraResource<Sprite>("UI/Skin/Knob.psd"),
+            checkmark = AssetDatabase.GetBuiltinExtraResource<Sprite>("UI/Skin/Checkmark.psd"),
+            dropdown = AssetDatabase.GetBuiltinExtraResource<Sprite>("UI/Skin/DropdownArrow.psd"),
+            mask = AssetDatabase.GetBuiltinExtraResource<Sprite>("UI/Skin/UIMask.psd")
+        };
+        
+        switch (elementType)
+        {
+            case "panel": return DefaultControls.CreatePanel(resources);
+            case "button": return DefaultControls.CreateButton(resources);
+            case "text": return DefaultControls.CreateText(resources);
+            case "image": return DefaultControls.CreateImage(resources);
+            case "slider": return DefaultControls.CreateSlider(resources);
+            case "toggle": return DefaultControls.CreateToggle(resources);
+            case "dropdown": return DefaultControls.CreateDropdown(resources);
+            case "inputfield": return DefaultControls.CreateInputField(resources);
+            case "scrollview": return DefaultControls.CreateScrollView(resources);
+            default: return null;
+        }
+    }
+    
+    /// <summary>
+    /// 创建Canvas (Screen Space - Overlay)，与GameObject→UI→Canvas菜单相同
+    /// </summary>
+    private static GameObject CreateCanvas(string name, Transform parent)
+    {
+        GameObject canvasObject = new GameObject(name, typeof(Canvas), typeof(CanvasScaler), typeof(GraphicRaycaster));
+        canvasObject.layer = LayerMask.NameToLayer("UI");
+        canvasObject.GetComponent<Canvas>().renderMode = RenderMode.ScreenSpaceOverlay;
+        if (parent != null)
+        {
+            GameObjectUtility.SetParentAndAlign(canvasObject, parent.gameObject);
+        }
+        GameObjectUtility.EnsureUniqueNameForSibling(canvasObject);
+        Undo.RegisterCreatedObjectUndo(canvasObject, $"Create {canvasObject.name}");
+        return canvasObject;
+    }
+    
+    /// <summary>
+    /// 场景中没有EventSystem时创建一个，返回新建的对象，已存在时返回null
+    /// </summary>
+    private static GameObject EnsureEventSystem()
+    {
+        if (Object.FindObjectOfType<EventSystem>() != null)
+        {
+            return null;
+        }
+        GameObject eventSystem = new GameObject("EventSystem", typeof(EventSystem), typeof(StandaloneInputModule));
+        Undo.RegisterCreatedObjectUndo(eventSystem, "Create EventSystem");
+        return eventSystem;
+    }
+    
+    /// <summary>
+    /// 当前场景中的第一个根Canvas
+    /// </summary>
+    private static Canvas FindSceneCanvas()
+    {
+        foreach (Canvas canvas in Object.FindObjectsOfType<Canvas>())
+        {
+            if (canvas.isRootCanvas)
+            {
+                return canvas;
+            }
+        }
+        return null;
+    }
+    
+    /// <summary>
+    /// 设置控件的显示文本: 按钮和开关的标签、输入框的占位文本或文本元素本身
+    /// </summary>
+    private static void ApplyText(GameObject element, string elementType, string value)
+    {
+        Text text;
+        switch (elementType)
+        {
+            case "inputfield":
+                text = element.GetComponent<InputField>().placeholder as Text;
+                break;
+            case "text":
+                text = element.GetComponent<Text>();
+                break;
+            default:
+                text = element.GetComponentInChildren<Text>(true);
+                break;
+        }
+        if (text != null)
+        {
+            text.text = value;
+        }
+    }
+    
+    /// <summary>
+    /// 设置锚点；拉伸的轴填满父对象，其余轴把轴心和位置对齐到锚点
+    /// </summary>
+    private static void ApplyAnchors(RectTransform rect, Vector2 anchorMin, Vector2 anchorMax)
+    {
+        rect.anchorMin = anchorMin;
+        rect.anchorMax = anchorMax;
+        Vector2 pivot = rect.pivot;
+        Vector2 sizeDelta = rect.sizeDelta;
+        for (int axis = 0; axis < 2; axis++)
+        {
+            if (anchorMin[axis] != anchorMax[axis])
+            {
+                sizeDelta[axis] = 0;
+            }
+            else
+            {
+                pivot[axis] = anchorMin[axis];
+            }
+        }
+        rect.pivot = pivot;
+        rect.sizeDelta = sizeDelta;
+        rect.anchoredPosition = Vector2.zero;
+    }
+    
+    private static Vector2 ReadVector2(object value)
+    {
+        var dict = (Dictionary<string, object>)value;
+        return new Vector2(System.Convert.ToSingle(dict["x"]), System.Convert.ToSingle(dict["y"]));
+    }
+    
+    /// <summary>
+    /// 对象及其所有子对象的名称、instanceId、层级路径和组件
+    /// </summary>
+    private static Dictionary<string, object> DescribeSubtree(Transform transform)
+    {
+        var info = Describe(transform);
+        var children = new List<object>();
+        foreach (Transform child in transform)
+        {
+            children.Add(DescribeSubtree(child));
+        }
+        info["children"] = children;
+        return info;
+    }
+    
+    private static Dictionary<string, object> Describe(Transform transform)
+    {
+        var components = new List<string>();
+        foreach (var component in transform.GetComponents<Component>())
+        {
+            if (component != null)
+            {
+                components.Add(component.GetType().Name);
+            }
+        }
+        return new Dictionary<string, object>
+        {
+            ["name"] = transform.name,
+            ["instanceId"] = transform.gameObject.GetInstanceID(),
+            ["path"] = GetHierarchyPath(transform),
+            ["components"] = components
+        };
+    }
+    
+    /// <summary>
+    /// 对象的层级路径，如 Canvas/Panel/Button
+    /// </summary>
+    private static string GetHierarchyPath(Transform transform)
+    {
+        string path = transform.name;
+        for (Transform parent = transform.parent; parent != null; parent = parent.parent)
+        {
+            path = parent.name + "/" + path;
+        }
+        return path;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("elementType"))
+        {
+            return "缺少必需参数: elementType";
+        }
+        foreach (string key in new[] { "anchorMin", "anchorMax" })
+        {
+            if (parameters.ContainsKey(key) && !(parameters[key] is Dictionary<string, object> dict && dict.ContainsKey("x") && dict.ContainsKey("y")))
+            {
+                return $"{key}必须是 {{x, y}} 对象";
+            }
+        }
+        return null;
+    }
+}
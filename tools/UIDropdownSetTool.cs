@@ -0,0 +1,89 @@
+using System.Collections;
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.UI;
+
+/// <summary>
+/// UI Dropdown组件工具 - 设置选项列表和当前选项
+/// 同时支持Dropdown和TMP_Dropdown，TMP_Dropdown通过反射访问，不引用TextMeshPro程序集
+/// </summary>
+public class UIDropdownSetTool : IMCPTool
+{
+    public string ToolName => "ui_dropdown_set";
+    
+    public string Description => "设置Dropdown/TMP_Dropdown组件的选项和当前值";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Selectable dropdown = UIControlHelper.ResolveComponent<Dropdown>(parameters, out string error);
+            if (dropdown == null)
+            {
+                GameObject gameObject = EditorUtility.InstanceIDToObject(System.Convert.ToInt32(parameters["instanceId"])) as GameObject;
+                dropdown = gameObject != null ? UIControlHelper.FindComponentByTypeName(gameObject, "TMP_Dropdown") as Selectable : null;
+                if (dropdown == null)
+                {
+                    return MCPResponse.Error(gameObject != null ? $"GameObject '{gameObject.name}' 没有Dropdown或TMP_Dropdown组件" : error);
+                }
+            }
+            
+            Undo.RecordObject(dropdown, "Set Dropdown Properties");
+            UIControlHelper.ApplySelectable(dropdown, parameters);
+            
+            // ClearOptions/AddOptions(List<string>) 在两种Dropdown上签名相同
+            if (parameters.ContainsKey("options") && parameters["options"] is IEnumerable items)
+            {
+                var options = new List<string>();
+                foreach (var item in items)
+                {
+                    options.Add(item.ToString());
+                }
+                dropdown.GetType().GetMethod("ClearOptions").Invoke(dropdown, null);
+                dropdown.GetType().GetMethod("AddOptions", new[] { typeof(List<string>) }).Invoke(dropdown, new object[] { options });
+            }
+            if (parameters.ContainsKey("value"))
+            {
+                int value = System.Convert.ToInt32(parameters["value"]);
+                int count = ((IList)UIControlHelper.GetProperty(dropdown, "options")).Count;
+                if (value < 0 || value >= count)
+                {
+                    return MCPResponse.Error($"value {value} 超出选项范围 (共{count}个选项)");
+                }
+                UIControlHelper.SetProperty(dropdown, "value", value);
+            }
+            dropdown.GetType().GetMethod("RefreshShownValue").Invoke(dropdown, null);
+            
+            EditorUtility.SetDirty(dropdown);
+            
+            var result = UIControlHelper.DescribeSelectable(dropdown);
+            var optionTexts = new List<string>();
+            foreach (var option in (IList)UIControlHelper.GetProperty(dropdown, "options"))
+            {
+                optionTexts.Add(UIControlHelper.GetProperty(option, "text") as string);
+            }
+            result["componentType"] = dropdown.GetType().Name;
+            result["options"] = optionTexts;
+            result["value"] = UIControlHelper.GetProperty(dropdown, "value");
+            
+            Debug.Log($"成功设置UI元素 '{dropdown.name}' 的{dropdown.GetType().Name}组件属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置Dropdown组件属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置Dropdown组件属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
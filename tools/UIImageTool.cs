@@ -1,4 +1,5 @@
 using System.Collections.Generic;
+using System.Linq;
 using System.Net.Sockets;
 using UnityEngine;
 using UnityEditor;
@@ -48,7 +49,11 @@ public class UIImageTool : IMCPTool
                 string spritePath = parameters["spritePath"].ToString();
                 if (!string.IsNullOrEmpty(spritePath))
                 {
-                    Sprite sprite = AssetDatabase.LoadAssetAtPath<Sprite>(spritePath);
+                    // 切片后的精灵表包含多个Sprite子资源，按spriteName选择
+                    string spriteName = parameters.ContainsKey("spriteName") ? parameters["spriteName"].ToString() : null;
+                    Sprite sprite = string.IsNullOrEmpty(spriteName)
+                        ? AssetDatabase.LoadAssetAtPath<Sprite>(spritePath)
+                        : AssetDatabase.LoadAllAssetsAtPath(spritePath).OfType<Sprite>().FirstOrDefault(s => s.name == spriteName);
                     if (sprite != null)
                     {
                         image.sprite = sprite;
@@ -56,7 +61,7 @@ public class UIImageTool : IMCPTool
                     }
                     else
                     {
-                        Debug.LogWarning($"未找到Sprite资源: {spritePath}");
+                        Debug.LogWarning($"未找到Sprite资源: {spritePath}{(string.IsNullOrEmpty(spriteName) ? "" : "#" + spriteName)}");
                     }
                 }
             }
@@ -78,10 +83,11 @@ public class UIImageTool : IMCPTool
                 }
             }
             
-            // 设置材质
-            if (parameters.ContainsKey("material"))
+            // 设置材质 (materialPath，兼容旧参数名material)
+            string materialKey = parameters.ContainsKey("materialPath") ? "materialPath" : "material";
+            if (parameters.ContainsKey(materialKey))
             {
-                string materialPath = parameters["material"].ToString();
+                string materialPath = parameters[materialKey].ToString();
                 if (!string.IsNullOrEmpty(materialPath))
                 {
                     Material material = AssetDatabase.LoadAssetAtPath<Material>(materialPath);
@@ -97,11 +103,19 @@ public class UIImageTool : IMCPTool
                 }
             }
             
+            // 设置是否接收射线检测
+            if (parameters.ContainsKey("raycastTarget"))
+            {
+                bool raycastTarget = System.Convert.ToBoolean(parameters["raycastTarget"]);
+                image.raycastTarget = raycastTarget;
+                Debug.Log($"设置 '{gameObject.name}' 的 raycastTarget: {raycastTarget}");
+            }
+            
             // 设置Image类型
             if (parameters.ContainsKey("imageType"))
             {
                 string imageTypeStr = parameters["imageType"].ToString();
-                if (System.Enum.TryParse<Image.Type>(imageTypeStr, out Image.Type imageType))
+                if (System.Enum.TryParse<Image.Type>(imageTypeStr, true, out Image.Type imageType))
                 {
                     image.type = imageType;
                     Debug.Log($"设置 '{gameObject.name}' 的 imageType: {imageType}");
@@ -124,7 +138,7 @@ public class UIImageTool : IMCPTool
             if (parameters.ContainsKey("fillMethod") && image.type == Image.Type.Filled)
             {
                 string fillMethodStr = parameters["fillMethod"].ToString();
-                if (System.Enum.TryParse<Image.FillMethod>(fillMethodStr, out Image.FillMethod fillMethod))
+                if (System.Enum.TryParse<Image.FillMethod>(fillMethodStr, true, out Image.FillMethod fillMethod))
                 {
                     image.fillMethod = fillMethod;
                     Debug.Log($"设置 '{gameObject.name}' 的 fillMethod: {fillMethod}");
@@ -185,6 +199,7 @@ public class UIImageTool : IMCPTool
                 } : null,
                 ["imageType"] = image.type.ToString(),
                 ["preserveAspect"] = image.preserveAspect,
+                ["raycastTarget"] = image.raycastTarget,
                 ["alphaHitTestMinimumThreshold"] = image.alphaHitTestMinimumThreshold
             };
             
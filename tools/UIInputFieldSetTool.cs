@@ -0,0 +1,89 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.UI;
+
+/// <summary>
+/// UI InputField组件工具 - 设置文本、占位文本、字符上限和内容类型
+/// 同时支持InputField和TMP_InputField，TMP_InputField通过反射访问，不引用TextMeshPro程序集
+/// </summary>
+public class UIInputFieldSetTool : IMCPTool
+{
+    public string ToolName => "ui_inputfield_set";
+    
+    public string Description => "设置InputField/TMP_InputField组件属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Selectable input = UIControlHelper.ResolveComponent<InputField>(parameters, out string error);
+            if (input == null)
+            {
+                GameObject gameObject = EditorUtility.InstanceIDToObject(System.Convert.ToInt32(parameters["instanceId"])) as GameObject;
+                input = gameObject != null ? UIControlHelper.FindComponentByTypeName(gameObject, "TMP_InputField") as Selectable : null;
+                if (input == null)
+                {
+                    return MCPResponse.Error(gameObject != null ? $"GameObject '{gameObject.name}' 没有InputField或TMP_InputField组件" : error);
+                }
+            }
+            
+            Undo.RecordObject(input, "Set InputField Properties");
+            UIControlHelper.ApplySelectable(input, parameters);
+            
+            // contentType会改写lineType等相关设置，因此先于文本设置
+            if (parameters.ContainsKey("contentType"))
+            {
+                UIControlHelper.SetProperty(input, "contentType", parameters["contentType"]);
+            }
+            if (parameters.ContainsKey("characterLimit"))
+            {
+                UIControlHelper.SetProperty(input, "characterLimit", System.Convert.ToInt32(parameters["characterLimit"]));
+            }
+            if (parameters.ContainsKey("text"))
+            {
+                UIControlHelper.SetProperty(input, "text", parameters["text"].ToString());
+            }
+            
+            // 占位文本是placeholder引用的Text/TMP_Text组件
+            Component placeholder = UIControlHelper.GetProperty(input, "placeholder") as Component;
+            if (parameters.ContainsKey("placeholder"))
+            {
+                if (placeholder == null)
+                {
+                    return MCPResponse.Error($"'{input.name}' 没有设置placeholder组件");
+                }
+                Undo.RecordObject(placeholder, "Set InputField Placeholder");
+                UIControlHelper.SetProperty(placeholder, "text", parameters["placeholder"].ToString());
+                EditorUtility.SetDirty(placeholder);
+            }
+            
+            EditorUtility.SetDirty(input);
+            
+            var result = UIControlHelper.DescribeSelectable(input);
+            result["componentType"] = input.GetType().Name;
+            result["text"] = UIControlHelper.GetProperty(input, "text");
+            result["placeholder"] = placeholder != null ? UIControlHelper.GetProperty(placeholder, "text") : null;
+            result["characterLimit"] = UIControlHelper.GetProperty(input, "characterLimit");
+            result["contentType"] = UIControlHelper.GetProperty(input, "contentType")?.ToString();
+            
+            Debug.Log($"成功设置UI元素 '{input.name}' 的{input.GetType().Name}组件属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置InputField组件属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置InputField组件属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
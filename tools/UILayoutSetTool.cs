@@ -0,0 +1,264 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.UI;
+
+/// <summary>
+/// UI布局工具 - 设置HorizontalLayoutGroup/VerticalLayoutGroup/GridLayoutGroup和ContentSizeFitter
+/// 组件不存在时自动添加；更换布局类型时替换原有的布局组 (一个对象只能有一个LayoutGroup)
+/// </summary>
+public class UILayoutSetTool : IMCPTool
+{
+    public string ToolName => "ui_layout_set";
+    
+    public string Description => "设置UI布局组和ContentSizeFitter";
+    
+    private static readonly string[] LinearOnlyParams = { "childForceExpandWidth", "childForceExpandHeight", "childControlWidth", "childControlHeight", "childScaleWidth", "childScaleHeight" };
+    private static readonly string[] GridOnlyParams = { "cellSize", "startCorner", "startAxis", "constraint", "constraintCount" };
+    private static readonly string[] CommonParams = { "padding", "spacing", "childAlignment" };
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            int instanceId = System.Convert.ToInt32(parameters["instanceId"]);
+            GameObject gameObject = EditorUtility.InstanceIDToObject(instanceId) as GameObject;
+            if (gameObject == null)
+            {
+                return MCPResponse.Error($"未找到GameObject (InstanceID: {instanceId})");
+            }
+            if (gameObject.GetComponent<RectTransform>() == null)
+            {
+                return MCPResponse.Error($"GameObject '{gameObject.name}' 不是UI元素 (没有RectTransform组件)");
+            }
+            
+            LayoutGroup group = gameObject.GetComponent<LayoutGroup>();
+            if (parameters.ContainsKey("layoutType"))
+            {
+                System.Type groupType = LayoutGroupType(parameters["layoutType"].ToString());
+                if (group != null && (groupType == null || group.GetType() != groupType))
+                {
+                    Undo.DestroyObjectImmediate(group);
+                    group = null;
+                }
+                if (groupType != null && group == null)
+                {
+                    group = (LayoutGroup)Undo.AddComponent(gameObject, groupType);
+                }
+            }
+            else if (group == null && HasAny(parameters, CommonParams, LinearOnlyParams, GridOnlyParams))
+            {
+                return MCPResponse.Error($"GameObject '{gameObject.name}' 没有布局组，请指定layoutType");
+            }
+            
+            if (group != null)
+            {
+                string mismatch = CheckParams(group, parameters);
+                if (mismatch != null)
+                {
+                    return MCPResponse.Error(mismatch);
+                }
+                Undo.RecordObject(group, "Set Layout Group");
+                ApplyLayoutGroup(group, parameters);
+                EditorUtility.SetDirty(group);
+            }
+            
+            ContentSizeFitter fitter = gameObject.GetComponent<ContentSizeFitter>();
+            if (parameters.ContainsKey("removeContentSizeFitter") && System.Convert.ToBoolean(parameters["removeContentSizeFitter"]))
+            {
+                if (fitter != null)
+                {
+                    Undo.DestroyObjectImmediate(fitter);
+                    fitter = null;
+                }
+            }
+            else if (parameters.ContainsKey("horizontalFit") || parameters.ContainsKey("verticalFit"))
+            {
+                if (fitter == null)
+                {
+                    fitter = Undo.AddComponent<ContentSizeFitter>(gameObject);
+                }
+                Undo.RecordObject(fitter, "Set Content Size Fitter");
+                if (parameters.ContainsKey("horizontalFit"))
+                {
+                    fitter.horizontalFit = (ContentSizeFitter.FitMode)System.Enum.Parse(typeof(ContentSizeFitter.FitMode), parameters["horizontalFit"].ToString());
+                }
+                if (parameters.ContainsKey("verticalFit"))
+                {
+                    fitter.verticalFit = (ContentSizeFitter.FitMode)System.Enum.Parse(typeof(ContentSizeFitter.FitMode), parameters["verticalFit"].ToString());
+                }
+                EditorUtility.SetDirty(fitter);
+            }
+            
+            LayoutRebuilder.ForceRebuildLayoutImmediate(gameObject.GetComponent<RectTransform>());
+            
+            var result = new Dictionary<string, object>
+            {
+                ["name"] = gameObject.name,
+                ["instanceId"] = gameObject.GetInstanceID(),
+                ["layoutGroup"] = group != null ? DescribeLayoutGroup(group) : null,
+                ["contentSizeFitter"] = fitter != null ? new Dictionary<string, object>
+                {
+                    ["horizontalFit"] = fitter.horizontalFit.ToString(),
+                    ["verticalFit"] = fitter.verticalFit.ToString()
+                } : null
+            };
+            
+            Debug.Log($"成功设置UI元素 '{gameObject.name}' 的布局");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置UI布局时出错: {e.Message}");
+            return MCPResponse.Error($"设置UI布局失败: {e.Message}");
+        }
+    }
+    
+    private static System.Type LayoutGroupType(string layoutType)
+    {
+        switch (layoutType.ToLower())
+        {
+            case "horizontal": return typeof(HorizontalLayoutGroup);
+            case "vertical": return typeof(VerticalLayoutGroup);
+            case "grid": return typeof(GridLayoutGroup);
+            default: return null;
+        }
+    }
+    
+    private static bool HasAny(Dictionary<string, object> parameters, params string[][] groups)
+    {
+        foreach (var names in groups)
+        {
+            foreach (var name in names)
+            {
+                if (parameters.ContainsKey(name)) return true;
+            }
+        }
+        return false;
+    }
+    
+    /// <summary>
+    /// 按布局组的类型逐项检查参数是否适用，在修改组件之前检查
+    /// </summary>
+    private static string CheckParams(LayoutGroup group, Dictionary<string, object> parameters)
+    {
+        string[] invalid = group is GridLayoutGroup ? LinearOnlyParams : GridOnlyParams;
+        var problems = new List<string>();
+        foreach (var name in invalid)
+        {
+            if (parameters.ContainsKey(name))
+            {
+                problems.Add($"{name} 不适用于 {group.GetType().Name}");
+            }
+        }
+        if (group is GridLayoutGroup grid && parameters.ContainsKey("constraintCount"))
+        {
+            string constraint = parameters.ContainsKey("constraint") ? parameters["constraint"].ToString() : grid.constraint.ToString();
+            if (constraint == "Flexible")
+            {
+                problems.Add("constraintCount需要constraint为FixedColumnCount或FixedRowCount");
+            }
+        }
+        return problems.Count > 0 ? string.Join("; ", problems) : null;
+    }
+    
+    private static void ApplyLayoutGroup(LayoutGroup group, Dictionary<string, object> parameters)
+    {
+        if (parameters.ContainsKey("padding") && parameters["padding"] is Dictionary<string, object> padding)
+        {
+            RectOffset offset = group.padding;
+            group.padding = new RectOffset(
+                padding.ContainsKey("left") ? System.Convert.ToInt32(padding["left"]) : offset.left,
+                padding.ContainsKey("right") ? System.Convert.ToInt32(padding["right"]) : offset.right,
+                padding.ContainsKey("top") ? System.Convert.ToInt32(padding["top"]) : offset.top,
+                padding.ContainsKey("bottom") ? System.Convert.ToInt32(padding["bottom"]) : offset.bottom
+            );
+        }
+        if (parameters.ContainsKey("childAlignment"))
+        {
+            group.childAlignment = (TextAnchor)System.Enum.Parse(typeof(TextAnchor), parameters["childAlignment"].ToString());
+        }
+        
+        if (group is HorizontalOrVerticalLayoutGroup linear)
+        {
+            if (parameters.ContainsKey("spacing")) linear.spacing = System.Convert.ToSingle(parameters["spacing"]);
+            if (parameters.ContainsKey("childForceExpandWidth")) linear.childForceExpandWidth = System.Convert.ToBoolean(parameters["childForceExpandWidth"]);
+            if (parameters.ContainsKey("childForceExpandHeight")) linear.childForceExpandHeight = System.Convert.ToBoolean(parameters["childForceExpandHeight"]);
+            if (parameters.ContainsKey("childControlWidth")) linear.childControlWidth = System.Convert.ToBoolean(parameters["childControlWidth"]);
+            if (parameters.ContainsKey("childControlHeight")) linear.childControlHeight = System.Convert.ToBoolean(parameters["childControlHeight"]);
+            if (parameters.ContainsKey("childScaleWidth")) linear.childScaleWidth = System.Convert.ToBoolean(parameters["childScaleWidth"]);
+            if (parameters.ContainsKey("childScaleHeight")) linear.childScaleHeight = System.Convert.ToBoolean(parameters["childScaleHeight"]);
+        }
+        else if (group is GridLayoutGroup grid)
+        {
+            if (parameters.ContainsKey("spacing")) grid.spacing = ReadVector2(parameters["spacing"], grid.spacing);
+            if (parameters.ContainsKey("cellSize")) grid.cellSize = ReadVector2(parameters["cellSize"], grid.cellSize);
+            if (parameters.ContainsKey("startCorner")) grid.startCorner = (GridLayoutGroup.Corner)System.Enum.Parse(typeof(GridLayoutGroup.Corner), parameters["startCorner"].ToString());
+            if (parameters.ContainsKey("startAxis")) grid.startAxis = (GridLayoutGroup.Axis)System.Enum.Parse(typeof(GridLayoutGroup.Axis), parameters["startAxis"].ToString());
+            if (parameters.ContainsKey("constraint")) grid.constraint = (GridLayoutGroup.Constraint)System.Enum.Parse(typeof(GridLayoutGroup.Constraint), parameters["constraint"].ToString());
+            if (parameters.ContainsKey("constraintCount")) grid.constraintCount = System.Convert.ToInt32(parameters["constraintCount"]);
+        }
+    }
+    
+    /// <summary>
+    /// 读取 {x, y}，省略的分量保持当前值；数字表示两个分量相同
+    /// </summary>
+    private static Vector2 ReadVector2(object value, Vector2 current)
+    {
+        if (value is Dictionary<string, object> dict)
+        {
+            return new Vector2(
+                dict.ContainsKey("x") ? System.Convert.ToSingle(dict["x"]) : current.x,
+                dict.ContainsKey("y") ? System.Convert.ToSingle(dict["y"]) : current.y
+            );
+        }
+        float n = System.Convert.ToSingle(value);
+        return new Vector2(n, n);
+    }
+    
+    private static Dictionary<string, object> DescribeLayoutGroup(LayoutGroup group)
+    {
+        var info = new Dictionary<string, object>
+        {
+            ["type"] = group.GetType().Name,
+            ["padding"] = new Dictionary<string, object>
+            {
+                ["left"] = group.padding.left,
+                ["right"] = group.padding.right,
+                ["top"] = group.padding.top,
+                ["bottom"] = group.padding.bottom
+            },
+            ["childAlignment"] = group.childAlignment.ToString()
+        };
+        if (group is HorizontalOrVerticalLayoutGroup linear)
+        {
+            info["spacing"] = linear.spacing;
+            info["childForceExpandWidth"] = linear.childForceExpandWidth;
+            info["childForceExpandHeight"] = linear.childForceExpandHeight;
+            info["childControlWidth"] = linear.childControlWidth;
+            info["childControlHeight"] = linear.childControlHeight;
+            info["childScaleWidth"] = linear.childScaleWidth;
+            info["childScaleHeight"] = linear.childScaleHeight;
+        }
+        else if (group is GridLayoutGroup grid)
+        {
+            info["spacing"] = new Dictionary<string, float> { ["x"] = grid.spacing.x, ["y"] = grid.spacing.y };
+            info["cellSize"] = new Dictionary<string, float> { ["x"] = grid.cellSize.x, ["y"] = grid.cellSize.y };
+            info["startCorner"] = grid.startCorner.ToString();
+            info["startAxis"] = grid.startAxis.ToString();
+            info["constraint"] = grid.constraint.ToString();
+            info["constraintCount"] = grid.constraintCount;
+        }
+        return info;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
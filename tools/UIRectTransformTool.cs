@@ -38,6 +38,14 @@ public class UIRectTransformTool : IMCPTool
                 return MCPResponse.Error($"GameObject '{gameObject.name}' 没有RectTransform组件，可能不是UI元素");
             }
             
+            // 修改前检查锚点: anchorMin的每个分量都不能大于anchorMax
+            Vector2 newAnchorMin = ReadVector2(parameters, "anchorMin", rectTransform.anchorMin);
+            Vector2 newAnchorMax = ReadVector2(parameters, "anchorMax", rectTransform.anchorMax);
+            if (newAnchorMin.x > newAnchorMax.x || newAnchorMin.y > newAnchorMax.y)
+            {
+                return MCPResponse.Error($"anchorMin {newAnchorMin} 不能大于 anchorMax {newAnchorMax}");
+            }
+            
             // 记录Undo操作
             Undo.RecordObject(rectTransform, "Set RectTransform Properties");
             
@@ -116,6 +124,21 @@ public class UIRectTransformTool : IMCPTool
                 }
             }
             
+            // 设置相对锚点的边距 (左下角/右上角)
+            if (parameters.ContainsKey("offsetMin"))
+            {
+                Vector2 offsetMin = ReadVector2(parameters, "offsetMin", rectTransform.offsetMin);
+                rectTransform.offsetMin = offsetMin;
+                Debug.Log($"设置 '{gameObject.name}' 的 offsetMin: {offsetMin}");
+            }
+            
+            if (parameters.ContainsKey("offsetMax"))
+            {
+                Vector2 offsetMax = ReadVector2(parameters, "offsetMax", rectTransform.offsetMax);
+                rectTransform.offsetMax = offsetMax;
+                Debug.Log($"设置 '{gameObject.name}' 的 offsetMax: {offsetMax}");
+            }
+            
             // 设置旋转
             if (parameters.ContainsKey("rotation"))
             {
@@ -178,6 +201,16 @@ public class UIRectTransformTool : IMCPTool
                     ["x"] = rectTransform.anchoredPosition.x,
                     ["y"] = rectTransform.anchoredPosition.y
                 },
+                ["offsetMin"] = new Dictionary<string, float>
+                {
+                    ["x"] = rectTransform.offsetMin.x,
+                    ["y"] = rectTransform.offsetMin.y
+                },
+                ["offsetMax"] = new Dictionary<string, float>
+                {
+                    ["x"] = rectTransform.offsetMax.x,
+                    ["y"] = rectTransform.offsetMax.y
+                },
                 ["rotation"] = new Dictionary<string, float>
                 {
                     ["x"] = rectTransform.eulerAngles.x,
@@ -203,6 +236,21 @@ public class UIRectTransformTool : IMCPTool
         }
     }
     
+    /// <summary>
+    /// 读取 {x, y} 参数，未提供的分量使用当前值
+    /// </summary>
+    private static Vector2 ReadVector2(Dictionary<string, object> parameters, string key, Vector2 current)
+    {
+        if (!parameters.ContainsKey(key) || !(parameters[key] is Dictionary<string, object> values))
+        {
+            return current;
+        }
+        return new Vector2(
+            values.ContainsKey("x") ? System.Convert.ToSingle(values["x"]) : current.x,
+            values.ContainsKey("y") ? System.Convert.ToSingle(values["y"]) : current.y
+        );
+    }
+    
     public string ValidateParameters(Dictionary<string, object> parameters)
     {
         // 检查必需参数
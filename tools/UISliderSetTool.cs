@@ -0,0 +1,82 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.UI;
+
+/// <summary>
+/// UI Slider组件工具 - 设置取值范围、当前值、整数模式和方向
+/// </summary>
+public class UISliderSetTool : IMCPTool
+{
+    public string ToolName => "ui_slider_set";
+    
+    public string Description => "设置Slider组件属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Slider slider = UIControlHelper.ResolveComponent<Slider>(parameters, out string error);
+            if (slider == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            Undo.RecordObject(slider, "Set Slider Properties");
+            UIControlHelper.ApplySelectable(slider, parameters);
+            
+            // 先设置范围和整数模式，value会被限制在范围内
+            if (parameters.ContainsKey("wholeNumbers"))
+            {
+                slider.wholeNumbers = System.Convert.ToBoolean(parameters["wholeNumbers"]);
+            }
+            if (parameters.ContainsKey("minValue"))
+            {
+                slider.minValue = System.Convert.ToSingle(parameters["minValue"]);
+            }
+            if (parameters.ContainsKey("maxValue"))
+            {
+                slider.maxValue = System.Convert.ToSingle(parameters["maxValue"]);
+            }
+            if (slider.minValue > slider.maxValue)
+            {
+                return MCPResponse.Error($"minValue ({slider.minValue}) 不能大于 maxValue ({slider.maxValue})");
+            }
+            if (parameters.ContainsKey("value"))
+            {
+                slider.value = System.Convert.ToSingle(parameters["value"]);
+            }
+            if (parameters.ContainsKey("direction"))
+            {
+                slider.direction = (Slider.Direction)System.Enum.Parse(typeof(Slider.Direction), parameters["direction"].ToString());
+            }
+            
+            EditorUtility.SetDirty(slider);
+            
+            var result = UIControlHelper.DescribeSelectable(slider);
+            result["minValue"] = slider.minValue;
+            result["maxValue"] = slider.maxValue;
+            result["value"] = slider.value;
+            result["wholeNumbers"] = slider.wholeNumbers;
+            result["direction"] = slider.direction.ToString();
+            
+            Debug.Log($"成功设置UI元素 '{slider.name}' 的Slider组件属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置Slider组件属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置Slider组件属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
@@ -36,6 +36,13 @@ public class UITextTool : IMCPTool
             Text text = gameObject.GetComponent<Text>();
             if (text == null)
             {
+                // 目标只有TextMeshPro文本时提示改用tmp_text_set
+                System.Type tmpType = TMPTextSetTool.FindTMPType("TMPro.TMP_Text");
+                Component tmpText = tmpType != null ? gameObject.GetComponent(tmpType) : null;
+                if (tmpText != null)
+                {
+                    return MCPResponse.Error($"GameObject '{gameObject.name}' 没有Text组件，但有{tmpText.GetType().Name}组件，请使用tmp_text_set");
+                }
                 return MCPResponse.Error($"GameObject '{gameObject.name}' 没有Text组件");
             }
             
@@ -98,7 +105,7 @@ public class UITextTool : IMCPTool
             if (parameters.ContainsKey("alignment"))
             {
                 string alignmentStr = parameters["alignment"].ToString();
-                if (System.Enum.TryParse<TextAnchor>(alignmentStr, out TextAnchor alignment))
+                if (System.Enum.TryParse<TextAnchor>(alignmentStr, true, out TextAnchor alignment))
                 {
                     text.alignment = alignment;
                     Debug.Log($"设置 '{gameObject.name}' 的对齐方式: {alignment}");
@@ -129,7 +136,7 @@ public class UITextTool : IMCPTool
             if (parameters.ContainsKey("fontStyle"))
             {
                 string fontStyleStr = parameters["fontStyle"].ToString();
-                if (System.Enum.TryParse<FontStyle>(fontStyleStr, out FontStyle fontStyle))
+                if (System.Enum.TryParse<FontStyle>(fontStyleStr, true, out FontStyle fontStyle))
                 {
                     text.fontStyle = fontStyle;
                     Debug.Log($"设置 '{gameObject.name}' 的字体样式: {fontStyle}");
@@ -144,7 +151,7 @@ public class UITextTool : IMCPTool
             if (parameters.ContainsKey("horizontalOverflow"))
             {
                 string horizontalOverflowStr = parameters["horizontalOverflow"].ToString();
-                if (System.Enum.TryParse<HorizontalWrapMode>(horizontalOverflowStr, out HorizontalWrapMode horizontalOverflow))
+                if (System.Enum.TryParse<HorizontalWrapMode>(horizontalOverflowStr, true, out HorizontalWrapMode horizontalOverflow))
                 {
                     text.horizontalOverflow = horizontalOverflow;
                     Debug.Log($"设置 '{gameObject.name}' 的水平溢出处理: {horizontalOverflow}");
@@ -159,7 +166,7 @@ public class UITextTool : IMCPTool
             if (parameters.ContainsKey("verticalOverflow"))
             {
                 string verticalOverflowStr = parameters["verticalOverflow"].ToString();
-                if (System.Enum.TryParse<VerticalWrapMode>(verticalOverflowStr, out VerticalWrapMode verticalOverflow))
+                if (System.Enum.TryParse<VerticalWrapMode>(verticalOverflowStr, true, out VerticalWrapMode verticalOverflow))
                 {
                     text.verticalOverflow = verticalOverflow;
                     Debug.Log($"设置 '{gameObject.name}' 的垂直溢出处理: {verticalOverflow}");
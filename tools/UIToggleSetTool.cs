@@ -0,0 +1,81 @@
+using System.Collections.Generic;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEditor;
+using UnityEngine.UI;
+
+/// <summary>
+/// UI Toggle组件工具 - 设置isOn、ToggleGroup和Selectable通用属性
+/// </summary>
+public class UIToggleSetTool : IMCPTool
+{
+    public string ToolName => "ui_toggle_set";
+    
+    public string Description => "设置Toggle组件属性";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            Toggle toggle = UIControlHelper.ResolveComponent<Toggle>(parameters, out string error);
+            if (toggle == null)
+            {
+                return MCPResponse.Error(error);
+            }
+            
+            Undo.RecordObject(toggle, "Set Toggle Properties");
+            UIControlHelper.ApplySelectable(toggle, parameters);
+            
+            // groupInstanceId为0时移出ToggleGroup
+            if (parameters.ContainsKey("groupInstanceId"))
+            {
+                int groupId = System.Convert.ToInt32(parameters["groupInstanceId"]);
+                if (groupId == 0)
+                {
+                    toggle.group = null;
+                }
+                else
+                {
+                    GameObject groupObject = EditorUtility.InstanceIDToObject(groupId) as GameObject;
+                    ToggleGroup group = groupObject != null ? groupObject.GetComponent<ToggleGroup>() : null;
+                    if (group == null)
+                    {
+                        return MCPResponse.Error($"未找到ToggleGroup (InstanceID: {groupId})");
+                    }
+                    toggle.group = group;
+                }
+            }
+            if (parameters.ContainsKey("isOn"))
+            {
+                toggle.isOn = System.Convert.ToBoolean(parameters["isOn"]);
+            }
+            
+            EditorUtility.SetDirty(toggle);
+            
+            var result = UIControlHelper.DescribeSelectable(toggle);
+            result["isOn"] = toggle.isOn;
+            result["group"] = toggle.group != null ? new Dictionary<string, object>
+            {
+                ["name"] = toggle.group.name,
+                ["instanceId"] = toggle.group.gameObject.GetInstanceID()
+            } : null;
+            
+            Debug.Log($"成功设置UI元素 '{toggle.name}' 的Toggle组件属性");
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"设置Toggle组件属性时出错: {e.Message}");
+            return MCPResponse.Error($"设置Toggle组件属性失败: {e.Message}");
+        }
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        if (!parameters.ContainsKey("instanceId"))
+        {
+            return "缺少必需参数: instanceId";
+        }
+        return null;
+    }
+}
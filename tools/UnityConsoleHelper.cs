@@ -0,0 +1,79 @@
+using System.Reflection;
+using UnityEngine;
+
+/// <summary>
+/// Unity Console工具类 - 通过反射访问内部的UnityEditor.LogEntries: 清除Console、读取各类型的条目数、设置Collapse
+/// 内部API不可用时各方法返回false，调用方退回到EditorLogBuffer的数据
+/// </summary>
+public static class UnityConsoleHelper
+{
+    // ConsoleWindow.ConsoleFlags.Collapse
+    private const int CollapseFlag = 1 << 0;
+    
+    private static readonly MethodInfo clearMethod;
+    private static readonly MethodInfo getCountsMethod;
+    private static readonly MethodInfo setFlagMethod;
+    
+    static UnityConsoleHelper()
+    {
+        try
+        {
+            System.Type logEntriesType = System.Type.GetType("UnityEditor.LogEntries,UnityEditor");
+            if (logEntriesType != null)
+            {
+                const BindingFlags flags = BindingFlags.Static | BindingFlags.Public | BindingFlags.NonPublic;
+                clearMethod = logEntriesType.GetMethod("Clear", flags);
+                getCountsMethod = logEntriesType.GetMethod("GetCountsByType", flags);
+                setFlagMethod = logEntriesType.GetMethod("SetConsoleFlag", flags);
+            }
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogWarning($"初始化Console反射失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 清除Unity Console
+    /// </summary>
+    public static bool Clear()
+    {
+        if (clearMethod == null)
+        {
+            return false;
+        }
+        clearMethod.Invoke(null, null);
+        return true;
+    }
+    
+    /// <summary>
+    /// Unity Console中错误、警告和普通日志的条目数 (与Console窗口标题栏的计数一致)
+    /// </summary>
+    public static bool GetCounts(out int errors, out int warnings, out int logs)
+    {
+        errors = warnings = logs = 0;
+        if (getCountsMethod == null)
+        {
+            return false;
+        }
+        object[] args = { 0, 0, 0 };
+        getCountsMethod.Invoke(null, args);
+        errors = (int)args[0];
+        warnings = (int)args[1];
+        logs = (int)args[2];
+        return true;
+    }
+    
+    /// <summary>
+    /// 打开或关闭Console的Collapse (合并相同的日志)
+    /// </summary>
+    public static bool SetCollapse(bool collapse)
+    {
+        if (setFlagMethod == null)
+        {
+            return false;
+        }
+        setFlagMethod.Invoke(null, new object[] { CollapseFlag, collapse });
+        return true;
+    }
+}
@@ -0,0 +1,118 @@
+using System.Collections.Generic;
+using System.IO;
+using System.Net.Sockets;
+using UnityEngine;
+using UnityEngine.Rendering;
+using UnityEditor;
+using PackageInfo = UnityEditor.PackageManager.PackageInfo;
+
+/// <summary>
+/// 编辑器信息工具 - 返回Unity版本、项目、平台、渲染管线、播放模式和关键包的版本
+/// 服务器在会话内缓存结果，这里每次都重新读取
+/// </summary>
+public class UnityInfoTool : IMCPTool
+{
+    /// <summary>
+    /// 结果中报告的关键包 (结果中的名称 → 包名)，未安装的包为null
+    /// </summary>
+    private static readonly Dictionary<string, string> KeyPackages = new Dictionary<string, string>
+    {
+        ["textMeshPro"] = "com.unity.textmeshpro",
+        ["ugui"] = "com.unity.ugui",
+        ["inputSystem"] = "com.unity.inputsystem",
+        ["urp"] = "com.unity.render-pipelines.universal",
+        ["hdrp"] = "com.unity.render-pipelines.high-definition",
+        ["addressables"] = "com.unity.addressables",
+        ["aiNavigation"] = "com.unity.ai.navigation",
+        ["cinemachine"] = "com.unity.cinemachine",
+        ["testFramework"] = "com.unity.test-framework"
+    };
+    
+    public string ToolName => "unity_info";
+    
+    public string Description => "获取编辑器、项目和平台信息";
+    
+    public MCPResponse Execute(Dictionary<string, object> parameters, TcpClient client)
+    {
+        try
+        {
+            BuildTarget target = EditorUserBuildSettings.activeBuildTarget;
+            BuildTargetGroup group = BuildPipeline.GetBuildTargetGroup(target);
+            RenderPipelineAsset pipeline = GraphicsSettings.currentRenderPipeline;
+            
+            var packages = new Dictionary<string, object>();
+            foreach (var entry in KeyPackages)
+            {
+                packages[entry.Key] = null;
+            }
+            foreach (PackageInfo package in PackageInfo.GetAllRegisteredPackages())
+            {
+                foreach (var entry in KeyPackages)
+                {
+                    if (entry.Value == package.name)
+                    {
+                        packages[entry.Key] = package.version;
+                    }
+                }
+            }
+            // Unity 6 起TextMeshPro合并到ugui包中
+            bool hasTextMeshPro = System.Type.GetType("TMPro.TMP_Text, Unity.TextMeshPro") != null;
+            
+            var result = new Dictionary<string, object>
+            {
+                ["unityVersion"] = Application.unityVersion,
+                ["projectName"] = Path.GetFileName(Path.GetDirectoryName(Application.dataPath)),
+                ["productName"] = PlayerSettings.productName,
+                ["companyName"] = PlayerSettings.companyName,
+                ["projectPath"] = Path.GetDirectoryName(Application.dataPath),
+                ["editorPlatform"] = Application.platform.ToString(),
+                ["activeBuildTarget"] = target.ToString(),
+                ["buildTargetGroup"] = group.ToString(),
+                ["scriptingBackend"] = PlayerSettings.GetScriptingBackend(group).ToString(),
+                ["colorSpace"] = PlayerSettings.colorSpace.ToString(),
+                ["graphicsDevice"] = SystemInfo.graphicsDeviceType.ToString(),
+                ["renderPipeline"] = RenderPipelineName(pipeline),
+                ["renderPipelineAsset"] = pipeline != null ? AssetDatabase.GetAssetPath(pipeline) : null,
+                ["isPlaying"] = EditorApplication.isPlaying,
+                ["isPaused"] = EditorApplication.isPaused,
+                ["isCompiling"] = EditorApplication.isCompiling,
+                ["textMeshProAvailable"] = hasTextMeshPro,
+                ["packages"] = packages
+            };
+            
+            return MCPResponse.Success(result);
+        }
+        catch (System.Exception e)
+        {
+            Debug.LogError($"获取编辑器信息时出错: {e.Message}");
+            return MCPResponse.Error($"获取编辑器信息失败: {e.Message}");
+        }
+    }
+    
+    /// <summary>
+    /// 渲染管线名称: Built-in、URP、HDRP，其他自定义管线返回资源类型名
+    /// </summary>
+    private static string RenderPipelineName(RenderPipelineAsset pipeline)
+    {
+        if (pipeline == null)
+        {
+            return "Built-in";
+        }
+        string typeName = pipeline.GetType().Name;
+        if (typeName.Contains("Universal"))
+        {
+            return "URP";
+        }
+        if (typeName.Contains("HDRenderPipeline"))
+        {
+            return "HDRP";
+        }
+        return typeName;
+    }
+    
+    public string ValidateParameters(Dictionary<string, object> parameters)
+    {
+        // 此工具不需要参数
+        return null;
+    }
+}